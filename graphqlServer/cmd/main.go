@@ -5,9 +5,16 @@ import (
 	"fmt"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/server"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/health"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/httpserver"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/tracing"
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
 )
 
 func main() {
@@ -26,12 +33,60 @@ func main() {
 		fmt.Printf("Failed to load API config: %v", err)
 		return
 	}
-	ctx := context.Background()
+	var gqlConfig server.GraphQLConfig
+	err = envconfig.Process("", &gqlConfig)
+	if err != nil {
+		fmt.Printf("Failed to load GraphQL config: %v", err)
+		return
+	}
+	var adminConfig server.AdminConfig
+	err = envconfig.Process("", &adminConfig)
+	if err != nil {
+		fmt.Printf("Failed to load admin config: %v", err)
+		return
+	}
+	var healthConfig health.Config
+	err = envconfig.Process("", &healthConfig)
+	if err != nil {
+		fmt.Printf("Failed to load health config: %v", err)
+		return
+	}
+	var serverConfig httpserver.Config
+	err = envconfig.Process("", &serverConfig)
+	if err != nil {
+		fmt.Printf("Failed to load server config: %v", err)
+		return
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 	ctx, err = log.SetupLogger(ctx, cfg)
 	if err != nil {
 		fmt.Printf("Error on setup %+v", err)
 		return
 	}
-	s := server.NewServer(apiConfig)
-	s.Start()
+	var tracingConfig tracing.Config
+	if err = envconfig.Process("", &tracingConfig); err != nil {
+		fmt.Printf("Failed to load tracing config: %v", err)
+		return
+	}
+	shutdownTracing, err := tracing.Setup(ctx, tracingConfig, "graphql-server", "github.com/Victor-Uzunov/devops-project/graphqlServer")
+	if err != nil {
+		fmt.Printf("Error on setup tracing %+v", err)
+		return
+	}
+
+	healthChecks := health.New(healthConfig)
+	healthChecks.Register(client.NewUpstreamChecker(http.DefaultTransport, apiConfig))
+
+	s := server.NewServer(apiConfig, gqlConfig, adminConfig, healthChecks)
+	if err = s.Run(ctx, serverConfig); err != nil {
+		log.C(ctx).Errorf("graphql server failed: %v", err)
+	}
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), serverConfig.ShutdownTimeout)
+	defer cancel()
+	if err = shutdownTracing(shutdownCtx); err != nil {
+		log.C(ctx).Errorf("error flushing traces: %v", err)
+	}
+	log.C(ctx).Info("graphql server stopped")
 }
@@ -8,14 +8,75 @@ import (
 	"strconv"
 )
 
+type Attachment struct {
+	ID           string `json:"id"`
+	TodoID       string `json:"todoId"`
+	Filename     string `json:"filename"`
+	ContentType  string `json:"contentType"`
+	Size         int    `json:"size"`
+	DownloadPath string `json:"downloadPath"`
+	CreatedAt    string `json:"createdAt"`
+}
+
+type BulkTodoOperation struct {
+	Action     BulkTodoAction `json:"action"`
+	TodoID     string         `json:"todoId"`
+	AssignedTo *string        `json:"assignedTo,omitempty"`
+	Priority   *Priority      `json:"priority,omitempty"`
+	ListID     *string        `json:"listId,omitempty"`
+	TagID      *string        `json:"tagId,omitempty"`
+}
+
+type BulkTodoResult struct {
+	Action  BulkTodoAction `json:"action"`
+	TodoID  string         `json:"todoId"`
+	Success bool           `json:"success"`
+	Status  int            `json:"status"`
+	Error   *string        `json:"error,omitempty"`
+	Todo    *Todo          `json:"todo,omitempty"`
+}
+
+type CloneListInput struct {
+	Name                 *string `json:"name,omitempty"`
+	IncludeCollaborators *bool   `json:"includeCollaborators,omitempty"`
+	ResetCompletion      *bool   `json:"resetCompletion,omitempty"`
+	AnchorDate           *string `json:"anchorDate,omitempty"`
+}
+
+type Column struct {
+	Status *Status `json:"status"`
+	Todos  []*Todo `json:"todos"`
+}
+
+type Comment struct {
+	ID        string  `json:"id"`
+	TodoID    string  `json:"todoId"`
+	Author    *User   `json:"author"`
+	Body      string  `json:"body"`
+	Mentions  []*User `json:"mentions"`
+	CreatedAt string  `json:"createdAt"`
+	UpdatedAt string  `json:"updatedAt"`
+}
+
+type CommentPage struct {
+	Comments    []*Comment `json:"comments"`
+	TotalCount  int        `json:"totalCount"`
+	HasNextPage bool       `json:"hasNextPage"`
+}
+
 type CreateListInput struct {
 	Name        string     `json:"name"`
 	Description *string    `json:"description,omitempty"`
 	Visibility  Visibility `json:"visibility"`
-	Tags        []string   `json:"tags,omitempty"`
 	Shared      []string   `json:"shared,omitempty"`
 }
 
+type CreateTagInput struct {
+	ListID string  `json:"listId"`
+	Name   string  `json:"name"`
+	Color  *string `json:"color,omitempty"`
+}
+
 type CreateTodoInput struct {
 	ListID      string    `json:"listId"`
 	Title       string    `json:"title"`
@@ -23,7 +84,7 @@ type CreateTodoInput struct {
 	DueDate     *string   `json:"dueDate,omitempty"`
 	StartDate   *string   `json:"startDate,omitempty"`
 	Priority    *Priority `json:"priority,omitempty"`
-	Tags        []string  `json:"tags,omitempty"`
+	TagIds      []string  `json:"tagIds,omitempty"`
 	Completed   *bool     `json:"completed,omitempty"`
 	AssignedTo  *string   `json:"assignedTo,omitempty"`
 }
@@ -47,10 +108,12 @@ type List struct {
 	Description   *string       `json:"description,omitempty"`
 	Owner         *User         `json:"owner"`
 	Visibility    Visibility    `json:"visibility"`
-	Tags          []string      `json:"tags,omitempty"`
+	Tags          []*Tag        `json:"tags"`
 	CreatedAt     string        `json:"createdAt"`
 	UpdatedAt     string        `json:"updatedAt"`
+	ArchivedAt    *string       `json:"archivedAt,omitempty"`
 	Todos         []*Todo       `json:"todos"`
+	Columns       []*Column     `json:"columns"`
 	Collaborators []*ListAccess `json:"collaborators"`
 }
 
@@ -61,32 +124,65 @@ type ListAccess struct {
 	Status      *string     `json:"status,omitempty"`
 }
 
+type ListTemplate struct {
+	ID          string   `json:"id"`
+	Name        string   `json:"name"`
+	Description *string  `json:"description,omitempty"`
+	Statuses    []string `json:"statuses"`
+	TodoCount   int      `json:"todoCount"`
+	CreatedAt   string   `json:"createdAt"`
+}
+
 type Mutation struct {
 }
 
 type Query struct {
 }
 
+type Status struct {
+	ID          string   `json:"id"`
+	ListID      string   `json:"listId"`
+	Name        string   `json:"name"`
+	Position    int      `json:"position"`
+	Done        bool     `json:"done"`
+	AllowedNext []string `json:"allowedNext"`
+}
+
+type Tag struct {
+	ID     string `json:"id"`
+	ListID string `json:"listId"`
+	Name   string `json:"name"`
+	Color  string `json:"color"`
+}
+
 type Todo struct {
-	ID          string    `json:"id"`
-	List        *List     `json:"list"`
-	Title       string    `json:"title"`
-	Description *string   `json:"description,omitempty"`
-	Completed   bool      `json:"completed"`
-	DueDate     *string   `json:"dueDate,omitempty"`
-	StartDate   *string   `json:"startDate,omitempty"`
-	Priority    *Priority `json:"priority,omitempty"`
-	Tags        []string  `json:"tags,omitempty"`
-	CreatedAt   string    `json:"createdAt"`
-	UpdatedAt   string    `json:"updatedAt"`
-	AssignedTo  *User     `json:"assignedTo,omitempty"`
+	ID          string        `json:"id"`
+	List        *List         `json:"list"`
+	Title       string        `json:"title"`
+	Description *string       `json:"description,omitempty"`
+	Completed   bool          `json:"completed"`
+	DueDate     *string       `json:"dueDate,omitempty"`
+	StartDate   *string       `json:"startDate,omitempty"`
+	Priority    *Priority     `json:"priority,omitempty"`
+	Tags        []*Tag        `json:"tags"`
+	CreatedAt   string        `json:"createdAt"`
+	UpdatedAt   string        `json:"updatedAt"`
+	AssignedTo  *User         `json:"assignedTo,omitempty"`
+	Comments    *CommentPage  `json:"comments"`
+	Attachments []*Attachment `json:"attachments"`
+	BlockedBy   []*Todo       `json:"blockedBy"`
+	Blocks      []*Todo       `json:"blocks"`
 }
 
 type UpdateListInput struct {
 	Name        *string     `json:"name,omitempty"`
 	Description *string     `json:"description,omitempty"`
 	Visibility  *Visibility `json:"visibility,omitempty"`
-	Tags        []string    `json:"tags,omitempty"`
+}
+
+type UpdateTagInput struct {
+	Name  *string `json:"name,omitempty"`
+	Color *string `json:"color,omitempty"`
 }
 
 type UpdateTodoInput struct {
@@ -96,7 +192,7 @@ type UpdateTodoInput struct {
 	DueDate     *string   `json:"dueDate,omitempty"`
 	StartDate   *string   `json:"startDate,omitempty"`
 	Priority    *Priority `json:"priority,omitempty"`
-	Tags        []string  `json:"tags,omitempty"`
+	TagIds      []string  `json:"tagIds,omitempty"`
 	AssignedTo  *string   `json:"assignedTo,omitempty"`
 }
 
@@ -158,6 +254,55 @@ func (e AccessLevel) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+type BulkTodoAction string
+
+const (
+	BulkTodoActionComplete    BulkTodoAction = "COMPLETE"
+	BulkTodoActionDelete      BulkTodoAction = "DELETE"
+	BulkTodoActionReassign    BulkTodoAction = "REASSIGN"
+	BulkTodoActionSetPriority BulkTodoAction = "SET_PRIORITY"
+	BulkTodoActionMove        BulkTodoAction = "MOVE"
+	BulkTodoActionAddTag      BulkTodoAction = "ADD_TAG"
+)
+
+var AllBulkTodoAction = []BulkTodoAction{
+	BulkTodoActionComplete,
+	BulkTodoActionDelete,
+	BulkTodoActionReassign,
+	BulkTodoActionSetPriority,
+	BulkTodoActionMove,
+	BulkTodoActionAddTag,
+}
+
+func (e BulkTodoAction) IsValid() bool {
+	switch e {
+	case BulkTodoActionComplete, BulkTodoActionDelete, BulkTodoActionReassign, BulkTodoActionSetPriority, BulkTodoActionMove, BulkTodoActionAddTag:
+		return true
+	}
+	return false
+}
+
+func (e BulkTodoAction) String() string {
+	return string(e)
+}
+
+func (e *BulkTodoAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = BulkTodoAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid BulkTodoAction", str)
+	}
+	return nil
+}
+
+func (e BulkTodoAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type Priority string
 
 const (
@@ -0,0 +1,389 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/99designs/gqlgen/graphql"
+	graphql1 "github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+)
+
+// Author is the resolver for the author field.
+func (r *commentResolver) Author(ctx context.Context, obj *graphql1.Comment) (*graphql1.User, error) {
+	panic(fmt.Errorf("not implemented: Author - author"))
+}
+
+// Mentions is the resolver for the mentions field.
+func (r *commentResolver) Mentions(ctx context.Context, obj *graphql1.Comment) ([]*graphql1.User, error) {
+	panic(fmt.Errorf("not implemented: Mentions - mentions"))
+}
+
+// Owner is the resolver for the owner field.
+func (r *listResolver) Owner(ctx context.Context, obj *graphql1.List) (*graphql1.User, error) {
+	panic(fmt.Errorf("not implemented: Owner - owner"))
+}
+
+// Todos is the resolver for the todos field.
+func (r *listResolver) Todos(ctx context.Context, obj *graphql1.List) ([]*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: Todos - todos"))
+}
+
+// Columns is the resolver for the columns field.
+func (r *listResolver) Columns(ctx context.Context, obj *graphql1.List) ([]*graphql1.Column, error) {
+	panic(fmt.Errorf("not implemented: Columns - columns"))
+}
+
+// Collaborators is the resolver for the collaborators field.
+func (r *listResolver) Collaborators(ctx context.Context, obj *graphql1.List) ([]*graphql1.ListAccess, error) {
+	panic(fmt.Errorf("not implemented: Collaborators - collaborators"))
+}
+
+// CreateUser is the resolver for the createUser field.
+func (r *mutationResolver) CreateUser(ctx context.Context, input graphql1.CreateUserInput) (*graphql1.User, error) {
+	panic(fmt.Errorf("not implemented: CreateUser - createUser"))
+}
+
+// UpdateUser is the resolver for the updateUser field.
+func (r *mutationResolver) UpdateUser(ctx context.Context, id string, input graphql1.UpdateUserInput) (*graphql1.User, error) {
+	panic(fmt.Errorf("not implemented: UpdateUser - updateUser"))
+}
+
+// DeleteUser is the resolver for the deleteUser field.
+func (r *mutationResolver) DeleteUser(ctx context.Context, id string) (*graphql1.User, error) {
+	panic(fmt.Errorf("not implemented: DeleteUser - deleteUser"))
+}
+
+// CreateList is the resolver for the createList field.
+func (r *mutationResolver) CreateList(ctx context.Context, input graphql1.CreateListInput) (*graphql1.List, error) {
+	panic(fmt.Errorf("not implemented: CreateList - createList"))
+}
+
+// UpdateListName is the resolver for the updateListName field.
+func (r *mutationResolver) UpdateListName(ctx context.Context, id string, name string) (*graphql1.List, error) {
+	panic(fmt.Errorf("not implemented: UpdateListName - updateListName"))
+}
+
+// UpdateListDescription is the resolver for the updateListDescription field.
+func (r *mutationResolver) UpdateListDescription(ctx context.Context, id string, description string) (*graphql1.List, error) {
+	panic(fmt.Errorf("not implemented: UpdateListDescription - updateListDescription"))
+}
+
+// UpdateList is the resolver for the updateList field.
+func (r *mutationResolver) UpdateList(ctx context.Context, id string, input graphql1.UpdateListInput) (*graphql1.List, error) {
+	panic(fmt.Errorf("not implemented: UpdateList - updateList"))
+}
+
+// ReorderList is the resolver for the reorderList field.
+func (r *mutationResolver) ReorderList(ctx context.Context, id string, beforeID *string, afterID *string) (*graphql1.List, error) {
+	panic(fmt.Errorf("not implemented: ReorderList - reorderList"))
+}
+
+// DeleteList is the resolver for the deleteList field.
+func (r *mutationResolver) DeleteList(ctx context.Context, id string) (*graphql1.List, error) {
+	panic(fmt.Errorf("not implemented: DeleteList - deleteList"))
+}
+
+// ArchiveList is the resolver for the archiveList field.
+func (r *mutationResolver) ArchiveList(ctx context.Context, id string) (*graphql1.List, error) {
+	panic(fmt.Errorf("not implemented: ArchiveList - archiveList"))
+}
+
+// UnarchiveList is the resolver for the unarchiveList field.
+func (r *mutationResolver) UnarchiveList(ctx context.Context, id string) (*graphql1.List, error) {
+	panic(fmt.Errorf("not implemented: UnarchiveList - unarchiveList"))
+}
+
+// CloneList is the resolver for the cloneList field.
+func (r *mutationResolver) CloneList(ctx context.Context, id string, input *graphql1.CloneListInput) (*graphql1.List, error) {
+	panic(fmt.Errorf("not implemented: CloneList - cloneList"))
+}
+
+// SaveListAsTemplate is the resolver for the saveListAsTemplate field.
+func (r *mutationResolver) SaveListAsTemplate(ctx context.Context, listID string, name string, description *string) (*graphql1.ListTemplate, error) {
+	panic(fmt.Errorf("not implemented: SaveListAsTemplate - saveListAsTemplate"))
+}
+
+// CreateListFromTemplate is the resolver for the createListFromTemplate field.
+func (r *mutationResolver) CreateListFromTemplate(ctx context.Context, templateID string, name *string, anchorDate *string) (*graphql1.List, error) {
+	panic(fmt.Errorf("not implemented: CreateListFromTemplate - createListFromTemplate"))
+}
+
+// DeleteTemplate is the resolver for the deleteTemplate field.
+func (r *mutationResolver) DeleteTemplate(ctx context.Context, id string) (*bool, error) {
+	panic(fmt.Errorf("not implemented: DeleteTemplate - deleteTemplate"))
+}
+
+// CreateTodo is the resolver for the createTodo field.
+func (r *mutationResolver) CreateTodo(ctx context.Context, input graphql1.CreateTodoInput) (*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: CreateTodo - createTodo"))
+}
+
+// UpdateTodoTitle is the resolver for the updateTodoTitle field.
+func (r *mutationResolver) UpdateTodoTitle(ctx context.Context, id string, title string) (*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: UpdateTodoTitle - updateTodoTitle"))
+}
+
+// UpdateTodoDescription is the resolver for the updateTodoDescription field.
+func (r *mutationResolver) UpdateTodoDescription(ctx context.Context, id string, description string) (*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: UpdateTodoDescription - updateTodoDescription"))
+}
+
+// UpdateTodoPriority is the resolver for the updateTodoPriority field.
+func (r *mutationResolver) UpdateTodoPriority(ctx context.Context, id string, priority graphql1.Priority) (*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: UpdateTodoPriority - updateTodoPriority"))
+}
+
+// UpdateTodoAssignTo is the resolver for the updateTodoAssignTo field.
+func (r *mutationResolver) UpdateTodoAssignTo(ctx context.Context, id string, userID string) (*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: UpdateTodoAssignTo - updateTodoAssignTo"))
+}
+
+// CompleteTodo is the resolver for the completeTodo field.
+func (r *mutationResolver) CompleteTodo(ctx context.Context, id string) (*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: CompleteTodo - completeTodo"))
+}
+
+// MoveTodo is the resolver for the moveTodo field.
+func (r *mutationResolver) MoveTodo(ctx context.Context, todoID string, statusID string, position *int) (*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: MoveTodo - moveTodo"))
+}
+
+// ReorderTodo is the resolver for the reorderTodo field.
+func (r *mutationResolver) ReorderTodo(ctx context.Context, id string, beforeID *string, afterID *string) (*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: ReorderTodo - reorderTodo"))
+}
+
+// MoveTodoToList is the resolver for the moveTodoToList field.
+func (r *mutationResolver) MoveTodoToList(ctx context.Context, id string, listID string) (*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: MoveTodoToList - moveTodoToList"))
+}
+
+// MoveTodosToList is the resolver for the moveTodosToList field.
+func (r *mutationResolver) MoveTodosToList(ctx context.Context, ids []string, listID string) ([]*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: MoveTodosToList - moveTodosToList"))
+}
+
+// BulkUpdateTodos is the resolver for the bulkUpdateTodos field.
+func (r *mutationResolver) BulkUpdateTodos(ctx context.Context, operations []*graphql1.BulkTodoOperation) ([]*graphql1.BulkTodoResult, error) {
+	panic(fmt.Errorf("not implemented: BulkUpdateTodos - bulkUpdateTodos"))
+}
+
+// CopyTodo is the resolver for the copyTodo field.
+func (r *mutationResolver) CopyTodo(ctx context.Context, id string, listID string) (*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: CopyTodo - copyTodo"))
+}
+
+// UpdateTodo is the resolver for the updateTodo field.
+func (r *mutationResolver) UpdateTodo(ctx context.Context, id string, input graphql1.UpdateTodoInput) (*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: UpdateTodo - updateTodo"))
+}
+
+// DeleteTodo is the resolver for the deleteTodo field.
+func (r *mutationResolver) DeleteTodo(ctx context.Context, id string) (*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: DeleteTodo - deleteTodo"))
+}
+
+// AddTodoTag is the resolver for the addTodoTag field.
+func (r *mutationResolver) AddTodoTag(ctx context.Context, id string, tagID string) (*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: AddTodoTag - addTodoTag"))
+}
+
+// RemoveTodoTag is the resolver for the removeTodoTag field.
+func (r *mutationResolver) RemoveTodoTag(ctx context.Context, id string, tagID string) (*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: RemoveTodoTag - removeTodoTag"))
+}
+
+// AddTodoDependency is the resolver for the addTodoDependency field.
+func (r *mutationResolver) AddTodoDependency(ctx context.Context, todoID string, blockedByID string) (*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: AddTodoDependency - addTodoDependency"))
+}
+
+// RemoveTodoDependency is the resolver for the removeTodoDependency field.
+func (r *mutationResolver) RemoveTodoDependency(ctx context.Context, todoID string, blockedByID string) (*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: RemoveTodoDependency - removeTodoDependency"))
+}
+
+// AddComment is the resolver for the addComment field.
+func (r *mutationResolver) AddComment(ctx context.Context, todoID string, body string) (*graphql1.Comment, error) {
+	panic(fmt.Errorf("not implemented: AddComment - addComment"))
+}
+
+// UploadAttachment is the resolver for the uploadAttachment field.
+func (r *mutationResolver) UploadAttachment(ctx context.Context, todoID string, file graphql.Upload) (*graphql1.Attachment, error) {
+	panic(fmt.Errorf("not implemented: UploadAttachment - uploadAttachment"))
+}
+
+// DeleteAttachment is the resolver for the deleteAttachment field.
+func (r *mutationResolver) DeleteAttachment(ctx context.Context, todoID string, id string) (*bool, error) {
+	panic(fmt.Errorf("not implemented: DeleteAttachment - deleteAttachment"))
+}
+
+// CreateTag is the resolver for the createTag field.
+func (r *mutationResolver) CreateTag(ctx context.Context, input graphql1.CreateTagInput) (*graphql1.Tag, error) {
+	panic(fmt.Errorf("not implemented: CreateTag - createTag"))
+}
+
+// UpdateTag is the resolver for the updateTag field.
+func (r *mutationResolver) UpdateTag(ctx context.Context, listID string, id string, input graphql1.UpdateTagInput) (*graphql1.Tag, error) {
+	panic(fmt.Errorf("not implemented: UpdateTag - updateTag"))
+}
+
+// DeleteTag is the resolver for the deleteTag field.
+func (r *mutationResolver) DeleteTag(ctx context.Context, listID string, id string) (*bool, error) {
+	panic(fmt.Errorf("not implemented: DeleteTag - deleteTag"))
+}
+
+// AddListAccess is the resolver for the addListAccess field.
+func (r *mutationResolver) AddListAccess(ctx context.Context, input graphql1.GrantListAccessInput) (*graphql1.ListAccess, error) {
+	panic(fmt.Errorf("not implemented: AddListAccess - addListAccess"))
+}
+
+// RemoveListAccess is the resolver for the removeListAccess field.
+func (r *mutationResolver) RemoveListAccess(ctx context.Context, listID string) (*graphql1.ListAccess, error) {
+	panic(fmt.Errorf("not implemented: RemoveListAccess - removeListAccess"))
+}
+
+// AcceptList is the resolver for the acceptList field.
+func (r *mutationResolver) AcceptList(ctx context.Context, listID string) (*bool, error) {
+	panic(fmt.Errorf("not implemented: AcceptList - acceptList"))
+}
+
+// RemoveCollaborator is the resolver for the removeCollaborator field.
+func (r *mutationResolver) RemoveCollaborator(ctx context.Context, listID string, userID string) (*graphql1.ListAccess, error) {
+	panic(fmt.Errorf("not implemented: RemoveCollaborator - removeCollaborator"))
+}
+
+// Users is the resolver for the users field.
+func (r *queryResolver) Users(ctx context.Context) ([]*graphql1.User, error) {
+	panic(fmt.Errorf("not implemented: Users - users"))
+}
+
+// User is the resolver for the user field.
+func (r *queryResolver) User(ctx context.Context, id string) (*graphql1.User, error) {
+	panic(fmt.Errorf("not implemented: User - user"))
+}
+
+// UserByEmail is the resolver for the userByEmail field.
+func (r *queryResolver) UserByEmail(ctx context.Context) (*graphql1.User, error) {
+	panic(fmt.Errorf("not implemented: UserByEmail - userByEmail"))
+}
+
+// UsersByList is the resolver for the usersByList field.
+func (r *queryResolver) UsersByList(ctx context.Context, id string) ([]*graphql1.User, error) {
+	panic(fmt.Errorf("not implemented: UsersByList - usersByList"))
+}
+
+// ListsGlobal is the resolver for the listsGlobal field.
+func (r *queryResolver) ListsGlobal(ctx context.Context) ([]*graphql1.List, error) {
+	panic(fmt.Errorf("not implemented: ListsGlobal - listsGlobal"))
+}
+
+// List is the resolver for the list field.
+func (r *queryResolver) List(ctx context.Context, id string) (*graphql1.List, error) {
+	panic(fmt.Errorf("not implemented: List - list"))
+}
+
+// ListsPending is the resolver for the listsPending field.
+func (r *queryResolver) ListsPending(ctx context.Context) ([]*graphql1.List, error) {
+	panic(fmt.Errorf("not implemented: ListsPending - listsPending"))
+}
+
+// Lists is the resolver for the lists field.
+func (r *queryResolver) Lists(ctx context.Context, includeArchived *bool) ([]*graphql1.List, error) {
+	panic(fmt.Errorf("not implemented: Lists - lists"))
+}
+
+// ListsAccepted is the resolver for the listsAccepted field.
+func (r *queryResolver) ListsAccepted(ctx context.Context) ([]*graphql1.List, error) {
+	panic(fmt.Errorf("not implemented: ListsAccepted - listsAccepted"))
+}
+
+// TodosGlobal is the resolver for the todosGlobal field.
+func (r *queryResolver) TodosGlobal(ctx context.Context) ([]*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: TodosGlobal - todosGlobal"))
+}
+
+// Todo is the resolver for the todo field.
+func (r *queryResolver) Todo(ctx context.Context, id string) (*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: Todo - todo"))
+}
+
+// TodosByList is the resolver for the todosByList field.
+func (r *queryResolver) TodosByList(ctx context.Context, id string, tagID *string) ([]*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: TodosByList - todosByList"))
+}
+
+// Todos is the resolver for the todos field.
+func (r *queryResolver) Todos(ctx context.Context) ([]*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: Todos - todos"))
+}
+
+// GetListAccesses is the resolver for the getListAccesses field.
+func (r *queryResolver) GetListAccesses(ctx context.Context, listID string) ([]*graphql1.ListAccess, error) {
+	panic(fmt.Errorf("not implemented: GetListAccesses - getListAccesses"))
+}
+
+// Tags is the resolver for the tags field.
+func (r *queryResolver) Tags(ctx context.Context, listID string) ([]*graphql1.Tag, error) {
+	panic(fmt.Errorf("not implemented: Tags - tags"))
+}
+
+// Templates is the resolver for the templates field.
+func (r *queryResolver) Templates(ctx context.Context) ([]*graphql1.ListTemplate, error) {
+	panic(fmt.Errorf("not implemented: Templates - templates"))
+}
+
+// List is the resolver for the list field.
+func (r *todoResolver) List(ctx context.Context, obj *graphql1.Todo) (*graphql1.List, error) {
+	panic(fmt.Errorf("not implemented: List - list"))
+}
+
+// AssignedTo is the resolver for the assignedTo field.
+func (r *todoResolver) AssignedTo(ctx context.Context, obj *graphql1.Todo) (*graphql1.User, error) {
+	panic(fmt.Errorf("not implemented: AssignedTo - assignedTo"))
+}
+
+// Comments is the resolver for the comments field.
+func (r *todoResolver) Comments(ctx context.Context, obj *graphql1.Todo, limit *int, offset *int) (*graphql1.CommentPage, error) {
+	panic(fmt.Errorf("not implemented: Comments - comments"))
+}
+
+// Attachments is the resolver for the attachments field.
+func (r *todoResolver) Attachments(ctx context.Context, obj *graphql1.Todo) ([]*graphql1.Attachment, error) {
+	panic(fmt.Errorf("not implemented: Attachments - attachments"))
+}
+
+// BlockedBy is the resolver for the blockedBy field.
+func (r *todoResolver) BlockedBy(ctx context.Context, obj *graphql1.Todo) ([]*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: BlockedBy - blockedBy"))
+}
+
+// Blocks is the resolver for the blocks field.
+func (r *todoResolver) Blocks(ctx context.Context, obj *graphql1.Todo) ([]*graphql1.Todo, error) {
+	panic(fmt.Errorf("not implemented: Blocks - blocks"))
+}
+
+// Comment returns CommentResolver implementation.
+func (r *Resolver) Comment() CommentResolver { return &commentResolver{r} }
+
+// List returns ListResolver implementation.
+func (r *Resolver) List() ListResolver { return &listResolver{r} }
+
+// Mutation returns MutationResolver implementation.
+func (r *Resolver) Mutation() MutationResolver { return &mutationResolver{r} }
+
+// Query returns QueryResolver implementation.
+func (r *Resolver) Query() QueryResolver { return &queryResolver{r} }
+
+// Todo returns TodoResolver implementation.
+func (r *Resolver) Todo() TodoResolver { return &todoResolver{r} }
+
+type commentResolver struct{ *Resolver }
+type listResolver struct{ *Resolver }
+type mutationResolver struct{ *Resolver }
+type queryResolver struct{ *Resolver }
+type todoResolver struct{ *Resolver }
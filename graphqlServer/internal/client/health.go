@@ -0,0 +1,32 @@
+package client
+
+import (
+	"context"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/health"
+	"net/http"
+	"strings"
+)
+
+// NewUpstreamChecker reports the facade as not ready while the todo service liveness endpoint is unreachable.
+// It bypasses the resilient transport so that probes neither retry nor trip the circuit breaker.
+func NewUpstreamChecker(transport http.RoundTripper, apiConfig APIConfig) health.Checker {
+	httpClient := &http.Client{Transport: transport}
+	endpoint := strings.TrimSuffix(apiConfig.Endpoint, "/") + "/healthz"
+
+	return health.CheckerFunc("todoservice", func(ctx context.Context) error {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+		if err != nil {
+			return fmt.Errorf("error creating request: %w", err)
+		}
+		resp, err := httpClient.Do(req)
+		if err != nil {
+			return fmt.Errorf("error executing request: %w", err)
+		}
+		defer resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			return fmt.Errorf("todo service responded with status code %d", resp.StatusCode)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,52 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
+	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"testing"
+)
+
+func TestUpstreamChecker(t *testing.T) {
+	tests := []struct {
+		name          string
+		response      mock2.HTTPResponseMock
+		expectedError string
+	}{
+		{
+			name:     "todo service is live",
+			response: mock2.HTTPResponseMock{StatusCode: http.StatusOK, Body: map[string]string{"status": "ok"}},
+		},
+		{
+			name:          "todo service answers with an error",
+			response:      mock2.HTTPResponseMock{StatusCode: http.StatusServiceUnavailable, Body: "unavailable"},
+			expectedError: "todo service responded with status code 503",
+		},
+		{
+			name:          "todo service is unreachable",
+			response:      mock2.HTTPResponseMock{Err: errors.New("connection refused")},
+			expectedError: "error executing request",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roundTripper := mock2.NewRoundTripperMock()
+			roundTripper.SetResponse("/healthz", tt.response)
+
+			checker := client.NewUpstreamChecker(roundTripper, testConfig())
+			err := checker.Check(context.Background())
+
+			assert.Equal(t, "todoservice", checker.Name())
+			if tt.expectedError != "" {
+				assert.ErrorContains(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, 1, roundTripper.Calls("/healthz"))
+		})
+	}
+}
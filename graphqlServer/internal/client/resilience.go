@@ -0,0 +1,162 @@
+package client
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var ErrCircuitOpen = errors.New("todo service circuit breaker is open")
+
+var (
+	attemptsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "todoservice_client_attempts_total",
+		Help: "Requests attempted against the todo service by method and outcome.",
+	}, []string{"method", "outcome"})
+	retriesTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "todoservice_client_retries_total",
+		Help: "Retries of idempotent requests against the todo service.",
+	}, []string{"method"})
+	attemptDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "todoservice_client_attempt_duration_seconds",
+		Help:    "Duration of single attempts against the todo service.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"method"})
+	circuitOpen = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "todoservice_client_circuit_open",
+		Help: "1 while the todo service circuit breaker is rejecting requests.",
+	})
+)
+
+type resilientTransport struct {
+	next    http.RoundTripper
+	config  APIConfig
+	breaker *circuitBreaker
+}
+
+// NewResilientTransport retries idempotent requests that failed with a transport error or a 502/503/504 using
+// jittered exponential backoff, and stops calling next while the circuit breaker is open.
+func NewResilientTransport(next http.RoundTripper, config APIConfig) http.RoundTripper {
+	return &resilientTransport{
+		next:    next,
+		config:  config,
+		breaker: newCircuitBreaker(config.BreakerThreshold, config.BreakerCooldown),
+	}
+}
+
+func (t *resilientTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	retryable := isIdempotent(req.Method) && (req.Body == nil || req.GetBody != nil)
+
+	for attempt := 0; ; attempt++ {
+		if !t.breaker.allow() {
+			attemptsTotal.WithLabelValues(req.Method, "circuit_open").Inc()
+			return nil, ErrCircuitOpen
+		}
+
+		resp, err := t.attempt(req, attempt)
+		failed := err != nil || resp.StatusCode >= http.StatusInternalServerError
+		t.breaker.record(!failed)
+		circuitOpen.Set(t.breaker.openGauge())
+
+		if !retryable || attempt >= t.config.MaxRetries || !shouldRetry(resp, err) || ctx.Err() != nil {
+			return resp, err
+		}
+		delay := t.backoff(attempt)
+		if deadline, ok := ctx.Deadline(); ok && time.Until(deadline) < delay {
+			return resp, err
+		}
+		if resp != nil {
+			_, _ = io.Copy(io.Discard, resp.Body)
+			_ = resp.Body.Close()
+		}
+
+		log.C(ctx).Warnf("retrying %s %s in %s after attempt %d failed", req.Method, req.URL.Path, delay, attempt+1)
+		retriesTotal.WithLabelValues(req.Method).Inc()
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+	}
+}
+
+func (t *resilientTransport) attempt(req *http.Request, attempt int) (*http.Response, error) {
+	ctx, cancel := req.Context(), context.CancelFunc(func() {})
+	if t.config.AttemptTimeout > 0 {
+		ctx, cancel = context.WithTimeout(ctx, t.config.AttemptTimeout)
+	}
+
+	attemptReq := req.Clone(ctx)
+	if attempt > 0 && req.GetBody != nil {
+		body, err := req.GetBody()
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		attemptReq.Body = body
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(attemptReq)
+	attemptDuration.WithLabelValues(req.Method).Observe(time.Since(start).Seconds())
+	if err != nil {
+		cancel()
+		attemptsTotal.WithLabelValues(req.Method, "error").Inc()
+		return nil, err
+	}
+	attemptsTotal.WithLabelValues(req.Method, strconv.Itoa(resp.StatusCode/100)+"xx").Inc()
+	resp.Body = &cancelOnClose{ReadCloser: resp.Body, cancel: cancel}
+	return resp, nil
+}
+
+// backoff returns a random delay up to RetryBaseDelay * 2^attempt, capped at RetryMaxDelay.
+func (t *resilientTransport) backoff(attempt int) time.Duration {
+	ceiling := t.config.RetryBaseDelay << attempt
+	if ceiling <= 0 || ceiling > t.config.RetryMaxDelay {
+		ceiling = t.config.RetryMaxDelay
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int64N(int64(ceiling))) + 1
+}
+
+func isIdempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	default:
+		return false
+	}
+}
+
+func shouldRetry(resp *http.Response, err error) bool {
+	if err != nil {
+		return true
+	}
+	switch resp.StatusCode {
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return true
+	default:
+		return false
+	}
+}
+
+// cancelOnClose keeps the attempt context alive until the caller has finished reading the body.
+type cancelOnClose struct {
+	io.ReadCloser
+	cancel context.CancelFunc
+}
+
+func (c *cancelOnClose) Close() error {
+	defer c.cancel()
+	return c.ReadCloser.Close()
+}
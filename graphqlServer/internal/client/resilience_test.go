@@ -0,0 +1,136 @@
+package client_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
+	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/pkg/todoclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func testConfig() client.APIConfig {
+	return client.APIConfig{
+		Endpoint:         "http://todoservice",
+		Timeout:          time.Second,
+		AttemptTimeout:   time.Second,
+		MaxRetries:       2,
+		RetryBaseDelay:   time.Millisecond,
+		RetryMaxDelay:    5 * time.Millisecond,
+		BreakerThreshold: 3,
+		BreakerCooldown:  time.Hour,
+	}
+}
+
+func TestResilientTransport_Retries(t *testing.T) {
+	unavailable := mock2.HTTPResponseMock{StatusCode: http.StatusServiceUnavailable, Body: "unavailable"}
+	ok := mock2.HTTPResponseMock{StatusCode: http.StatusOK, Body: map[string]string{"id": "1"}}
+
+	tests := []struct {
+		name           string
+		method         string
+		responses      []mock2.HTTPResponseMock
+		expectedCalls  int
+		expectedStatus int
+		expectError    bool
+	}{
+		{
+			name:          "idempotent request succeeds after transient failures",
+			method:        http.MethodGet,
+			responses:     []mock2.HTTPResponseMock{unavailable, {Err: errors.New("connection reset")}, ok},
+			expectedCalls: 3,
+		},
+		{
+			name:           "idempotent request gives up after max retries",
+			method:         http.MethodGet,
+			responses:      []mock2.HTTPResponseMock{unavailable, unavailable, unavailable, ok},
+			expectedCalls:  3,
+			expectedStatus: http.StatusServiceUnavailable,
+			expectError:    true,
+		},
+		{
+			name:           "non idempotent request is not retried",
+			method:         http.MethodPost,
+			responses:      []mock2.HTTPResponseMock{unavailable, ok},
+			expectedCalls:  1,
+			expectedStatus: http.StatusServiceUnavailable,
+			expectError:    true,
+		},
+		{
+			name:           "client errors are not retried",
+			method:         http.MethodGet,
+			responses:      []mock2.HTTPResponseMock{{StatusCode: http.StatusNotFound, Body: "missing"}, ok},
+			expectedCalls:  1,
+			expectedStatus: http.StatusNotFound,
+			expectError:    true,
+		},
+		{
+			name:          "request body is replayed on retries",
+			method:        http.MethodPut,
+			responses:     []mock2.HTTPResponseMock{unavailable, ok},
+			expectedCalls: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			roundTripper := mock2.NewRoundTripperMock()
+			roundTripper.SetResponseSequence("/lists/1", tt.responses...)
+			doer := client.NewTodoServiceClient(roundTripper, testConfig())
+
+			ctx := todoclient.WithToken(context.Background(), "token")
+			_, err := doer.Do(ctx, tt.method, "/lists/1", []byte(`{"name": "list"}`))
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Equal(t, tt.expectedStatus, todoclient.StatusCode(err))
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedCalls, roundTripper.Calls("/lists/1"))
+		})
+	}
+}
+
+func TestResilientTransport_CircuitBreaker(t *testing.T) {
+	config := testConfig()
+	config.MaxRetries = 0
+
+	roundTripper := mock2.NewRoundTripperMock()
+	roundTripper.SetResponse("/lists/1", mock2.HTTPResponseMock{StatusCode: http.StatusInternalServerError, Body: "down"})
+	doer := client.NewTodoServiceClient(roundTripper, config)
+	ctx := todoclient.WithToken(context.Background(), "token")
+
+	for i := 0; i < config.BreakerThreshold; i++ {
+		_, err := doer.Do(ctx, http.MethodGet, "/lists/1", nil)
+		require.Error(t, err)
+		assert.Equal(t, http.StatusInternalServerError, todoclient.StatusCode(err))
+	}
+
+	_, err := doer.Do(ctx, http.MethodGet, "/lists/1", nil)
+	assert.ErrorIs(t, err, client.ErrCircuitOpen)
+	assert.Equal(t, config.BreakerThreshold, roundTripper.Calls("/lists/1"))
+}
+
+func TestResilientTransport_ContextDeadline(t *testing.T) {
+	config := testConfig()
+	config.RetryBaseDelay = time.Second
+	config.RetryMaxDelay = time.Second
+
+	roundTripper := mock2.NewRoundTripperMock()
+	roundTripper.SetResponse("/lists/1", mock2.HTTPResponseMock{StatusCode: http.StatusServiceUnavailable, Body: "unavailable"})
+	doer := client.NewTodoServiceClient(roundTripper, config)
+
+	ctx, cancel := context.WithTimeout(todoclient.WithToken(context.Background(), "token"), 20*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, err := doer.Do(ctx, http.MethodGet, "/lists/1", nil)
+
+	assert.Error(t, err)
+	assert.Less(t, time.Since(start), 500*time.Millisecond)
+}
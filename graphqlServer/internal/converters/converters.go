@@ -3,6 +3,7 @@ package converters
 import (
 	"fmt"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/pkg/todoclient"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 )
 
@@ -83,3 +84,130 @@ func ConvertRoleToGraphQL(role constants.Role) (graphql.UserRole, error) {
 		return graphql.UserRoleReader, fmt.Errorf("invalid role: %v", role)
 	}
 }
+
+func ConvertBulkActionFromGraphQL(action graphql.BulkTodoAction) (todoclient.BulkAction, error) {
+	switch action {
+	case graphql.BulkTodoActionComplete:
+		return todoclient.BulkComplete, nil
+	case graphql.BulkTodoActionDelete:
+		return todoclient.BulkDelete, nil
+	case graphql.BulkTodoActionReassign:
+		return todoclient.BulkReassign, nil
+	case graphql.BulkTodoActionSetPriority:
+		return todoclient.BulkSetPriority, nil
+	case graphql.BulkTodoActionMove:
+		return todoclient.BulkMove, nil
+	case graphql.BulkTodoActionAddTag:
+		return todoclient.BulkAddTag, nil
+	default:
+		return "", fmt.Errorf("invalid bulk action: %v", action)
+	}
+}
+
+func ConvertBulkActionToGraphQL(action todoclient.BulkAction) (graphql.BulkTodoAction, error) {
+	switch action {
+	case todoclient.BulkComplete:
+		return graphql.BulkTodoActionComplete, nil
+	case todoclient.BulkDelete:
+		return graphql.BulkTodoActionDelete, nil
+	case todoclient.BulkReassign:
+		return graphql.BulkTodoActionReassign, nil
+	case todoclient.BulkSetPriority:
+		return graphql.BulkTodoActionSetPriority, nil
+	case todoclient.BulkMove:
+		return graphql.BulkTodoActionMove, nil
+	case todoclient.BulkAddTag:
+		return graphql.BulkTodoActionAddTag, nil
+	default:
+		return "", fmt.Errorf("invalid bulk action: %v", action)
+	}
+}
+
+// ConvertTagsToGraphQL converts the tags of a todo or a list. Missing tags convert to an empty slice.
+func ConvertTagsToGraphQL(tags []todoclient.Tag) []*graphql.Tag {
+	result := make([]*graphql.Tag, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, ConvertTagToGraphQL(tag))
+	}
+	return result
+}
+
+func ConvertTagToGraphQL(tag todoclient.Tag) *graphql.Tag {
+	return &graphql.Tag{
+		ID:     tag.ID,
+		ListID: tag.ListID,
+		Name:   tag.Name,
+		Color:  tag.Color,
+	}
+}
+
+func ConvertStatusToGraphQL(status todoclient.Status) *graphql.Status {
+	allowedNext := make([]string, 0, len(status.AllowedNext))
+	allowedNext = append(allowedNext, status.AllowedNext...)
+	return &graphql.Status{
+		ID:          status.ID,
+		ListID:      status.ListID,
+		Name:        status.Name,
+		Position:    status.Position,
+		Done:        status.Done,
+		AllowedNext: allowedNext,
+	}
+}
+
+// ConvertCommentToGraphQL sets only the ids of the author and of the mentioned users, the users are resolved by the comment resolver.
+func ConvertCommentToGraphQL(comment todoclient.Comment) *graphql.Comment {
+	mentions := make([]*graphql.User, 0, len(comment.Mentions))
+	for _, mention := range comment.Mentions {
+		mentions = append(mentions, &graphql.User{ID: mention.UserID, Email: mention.Email})
+	}
+	return &graphql.Comment{
+		ID:        comment.ID,
+		TodoID:    comment.TodoID,
+		Author:    &graphql.User{ID: comment.AuthorID},
+		Body:      comment.Body,
+		Mentions:  mentions,
+		CreatedAt: comment.CreatedAt.Format(constants.DateFormat),
+		UpdatedAt: comment.UpdatedAt.Format(constants.DateFormat),
+	}
+}
+
+// ConvertCommentPageToGraphQL converts a page that starts at offset.
+func ConvertCommentPageToGraphQL(page todoclient.CommentPage) *graphql.CommentPage {
+	comments := make([]*graphql.Comment, 0, len(page.Comments))
+	for _, comment := range page.Comments {
+		comments = append(comments, ConvertCommentToGraphQL(comment))
+	}
+	return &graphql.CommentPage{
+		Comments:    comments,
+		TotalCount:  page.Total,
+		HasNextPage: page.Offset+len(page.Comments) < page.Total,
+	}
+}
+
+func ConvertAttachmentToGraphQL(attachment todoclient.Attachment) *graphql.Attachment {
+	return &graphql.Attachment{
+		ID:           attachment.ID,
+		TodoID:       attachment.TodoID,
+		Filename:     attachment.Filename,
+		ContentType:  attachment.ContentType,
+		Size:         int(attachment.Size),
+		DownloadPath: todoclient.DownloadPath(&attachment),
+		CreatedAt:    attachment.CreatedAt.Format(constants.DateFormat),
+	}
+}
+
+// ConvertTemplateToGraphQL summarizes the blueprint of the template with the names of its statuses and the number of todos.
+func ConvertTemplateToGraphQL(template todoclient.Template) *graphql.ListTemplate {
+	statuses := make([]string, 0, len(template.Blueprint.Statuses))
+	for _, status := range template.Blueprint.Statuses {
+		statuses = append(statuses, status.Name)
+	}
+	return &graphql.ListTemplate{
+		ID:          template.ID,
+		Name:        template.Name,
+		Description: &template.Description,
+		Statuses:    statuses,
+		TodoCount:   len(template.Blueprint.Todos),
+		CreatedAt:   template.CreatedAt.Format(constants.DateFormat),
+	}
+}
@@ -1,11 +1,11 @@
 package converters
 
 import (
-	"encoding/json"
 	"fmt"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	format "github.com/Victor-Uzunov/devops-project/todoservice/pkg/time"
 )
 
 //go:generate mockery --name=ListConverter --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
@@ -30,24 +30,17 @@ func (c *ConverterListGraphQL) ConvertListToGraphQL(list models.List) (*graphql.
 	if err != nil {
 		return &graphql.List{}, fmt.Errorf("converting visibility to graphQL: %w", err)
 	}
-	var tags []string
-
-	if list.Tags != nil {
-		err := json.Unmarshal(list.Tags, &tags)
-		if err != nil {
-			fmt.Println("Error unmarshalling tags:", err)
-			tags = make([]string, 0)
-		}
-	}
+	tags := ConvertTagsToGraphQL(list.Tags)
 	return &graphql.List{
 		ID:            list.ID,
 		Name:          list.Name,
 		Description:   &list.Description,
-		Owner:         nil,
+		Owner:         &graphql.User{ID: list.OwnerID},
 		Visibility:    visibility,
 		Tags:          tags,
 		CreatedAt:     list.CreatedAt.Format(constants.DateFormat),
 		UpdatedAt:     list.UpdatedAt.Format(constants.DateFormat),
+		ArchivedAt:    format.TimeToString(list.ArchivedAt),
 		Todos:         make([]*graphql.Todo, 0),
 		Collaborators: make([]*graphql.ListAccess, 0),
 	}, nil
@@ -67,12 +60,6 @@ func convertVisibilityToGraphQL(visibility constants.Visibility) (graphql.Visibi
 }
 
 func (c *ConverterListGraphQL) ConvertCreateListInput(input graphql.CreateListInput, userID string) (models.List, error) {
-	jsonBytes, err := json.Marshal(input.Tags)
-	if err != nil {
-		return models.List{}, fmt.Errorf("convertCreateListInput: %w", err)
-	}
-	jsonRawMessage := json.RawMessage(jsonBytes)
-
 	visibility, err := ConvertVisibilityFromGraphQL(input.Visibility)
 	if err != nil {
 		return models.List{}, fmt.Errorf("convertVisibilityFromGraphQL: %w", err)
@@ -87,18 +74,11 @@ func (c *ConverterListGraphQL) ConvertCreateListInput(input graphql.CreateListIn
 		Description: *input.Description,
 		OwnerID:     userID,
 		Visibility:  visibility,
-		Tags:        jsonRawMessage,
 		SharedWith:  shared,
 	}, nil
 }
 
 func (c *ConverterListGraphQL) ConvertUpdateListInput(input graphql.UpdateListInput) (models.List, error) {
-	jsonBytes, err := json.Marshal(input.Tags)
-	if err != nil {
-		return models.List{}, fmt.Errorf("convertCreateListInput: %w", err)
-	}
-	jsonRawMessage := json.RawMessage(jsonBytes)
-
 	visibility, err := ConvertVisibilityFromGraphQL(*input.Visibility)
 	if err != nil {
 		return models.List{}, fmt.Errorf("convertVisibilityFromGraphQL: %w", err)
@@ -107,7 +87,6 @@ func (c *ConverterListGraphQL) ConvertUpdateListInput(input graphql.UpdateListIn
 		Name:        *input.Name,
 		Description: *input.Description,
 		Visibility:  visibility,
-		Tags:        jsonRawMessage,
 	}, nil
 }
 
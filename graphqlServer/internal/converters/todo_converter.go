@@ -1,7 +1,6 @@
 package converters
 
 import (
-	"encoding/json"
 	"fmt"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
@@ -29,19 +28,16 @@ func (c *ConverterTodoGraphQL) ConvertTodoToGraphQL(todo models.Todo) (*graphql.
 	if err != nil {
 		return &graphql.Todo{}, fmt.Errorf("convertPriorityToGraphQL: %w", err)
 	}
-	var tags []string
+	tags := ConvertTagsToGraphQL(todo.Tags)
 
-	if todo.Tags != nil {
-		err := json.Unmarshal(todo.Tags, &tags)
-		if err != nil {
-			fmt.Println("Error unmarshalling tags:", err)
-			tags = make([]string, 0)
-		}
+	var assignedTo *graphql.User
+	if todo.AssignedTo != nil && *todo.AssignedTo != "" {
+		assignedTo = &graphql.User{ID: *todo.AssignedTo}
 	}
 
 	return &graphql.Todo{
 		ID:          todo.ID,
-		List:        nil,
+		List:        &graphql.List{ID: todo.ListID},
 		Title:       todo.Title,
 		Completed:   todo.Completed,
 		Description: &todo.Description,
@@ -51,7 +47,7 @@ func (c *ConverterTodoGraphQL) ConvertTodoToGraphQL(todo models.Todo) (*graphql.
 		StartDate:   format.TimeToString(todo.StartDate),
 		CreatedAt:   todo.CreatedAt.Format(constants.DateFormat),
 		UpdatedAt:   todo.UpdatedAt.Format(constants.DateFormat),
-		AssignedTo:  nil,
+		AssignedTo:  assignedTo,
 	}, nil
 }
 
@@ -90,11 +86,6 @@ func (c *ConverterTodoGraphQL) ConvertCreateTodoInput(input graphql.CreateTodoIn
 		}
 	}
 
-	jsonBytes, err := json.Marshal(input.Tags)
-	if err != nil {
-		return models.Todo{}, fmt.Errorf("convertCreateTodoInput: %w", err)
-	}
-	jsonRawMessage := json.RawMessage(jsonBytes)
 	return models.Todo{
 		Title:       input.Title,
 		ListID:      input.ListID,
@@ -102,7 +93,6 @@ func (c *ConverterTodoGraphQL) ConvertCreateTodoInput(input graphql.CreateTodoIn
 		DueDate:     &dueDate,
 		StartDate:   &startDate,
 		Priority:    priority,
-		Tags:        jsonRawMessage,
 		Completed:   *input.Completed,
 		AssignedTo:  input.AssignedTo,
 	}, nil
@@ -130,18 +120,12 @@ func (c *ConverterTodoGraphQL) ConvertUpdateTodoInput(input graphql.UpdateTodoIn
 		}
 	}
 
-	jsonBytes, err := json.Marshal(input.Tags)
-	if err != nil {
-		return models.Todo{}, fmt.Errorf("convertCreateTodoInput: %w", err)
-	}
-	jsonRawMessage := json.RawMessage(jsonBytes)
 	return models.Todo{
 		Title:       *input.Title,
 		Description: *input.Description,
 		DueDate:     &dueDate,
 		StartDate:   &startDate,
 		Priority:    priority,
-		Tags:        jsonRawMessage,
 		AssignedTo:  input.AssignedTo,
 	}, nil
 }
@@ -0,0 +1,140 @@
+package dataloader
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+type BatchFunc[K comparable, V any] func(ctx context.Context, keys []K) (map[K]V, error)
+
+// Loader collects the keys requested within a short window and resolves them with a single BatchFunc call.
+// Results are cached for the lifetime of the loader, so it should be created per request.
+type Loader[K comparable, V any] struct {
+	fetch    BatchFunc[K, V]
+	wait     time.Duration
+	maxBatch int
+
+	mu    sync.Mutex
+	cache map[K]*result[V]
+	batch *batch[K, V]
+}
+
+type result[V any] struct {
+	done  chan struct{}
+	value V
+	err   error
+}
+
+type batch[K comparable, V any] struct {
+	keys    []K
+	results map[K]*result[V]
+	closed  bool
+}
+
+func New[K comparable, V any](fetch BatchFunc[K, V], wait time.Duration, maxBatch int) *Loader[K, V] {
+	return &Loader[K, V]{
+		fetch:    fetch,
+		wait:     wait,
+		maxBatch: maxBatch,
+		cache:    make(map[K]*result[V]),
+	}
+}
+
+// Load returns the value for key. Keys missing from the batch response resolve to the zero value.
+func (l *Loader[K, V]) Load(ctx context.Context, key K) (V, error) {
+	l.mu.Lock()
+	res := l.resultFor(ctx, key)
+	l.mu.Unlock()
+
+	return wait(ctx, res)
+}
+
+// LoadAll queues every key before waiting, so all of them end up in the same batch.
+func (l *Loader[K, V]) LoadAll(ctx context.Context, keys []K) ([]V, error) {
+	l.mu.Lock()
+	results := make([]*result[V], len(keys))
+	for i, key := range keys {
+		results[i] = l.resultFor(ctx, key)
+	}
+	l.mu.Unlock()
+
+	values := make([]V, len(keys))
+	for i, res := range results {
+		value, err := wait(ctx, res)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = value
+	}
+	return values, nil
+}
+
+func (l *Loader[K, V]) resultFor(ctx context.Context, key K) *result[V] {
+	res, ok := l.cache[key]
+	if !ok {
+		res = &result[V]{done: make(chan struct{})}
+		l.cache[key] = res
+		l.enqueue(ctx, key, res)
+	}
+	return res
+}
+
+func wait[V any](ctx context.Context, res *result[V]) (V, error) {
+	select {
+	case <-res.done:
+		return res.value, res.err
+	case <-ctx.Done():
+		var zero V
+		return zero, ctx.Err()
+	}
+}
+
+func (l *Loader[K, V]) enqueue(ctx context.Context, key K, res *result[V]) {
+	if l.batch == nil {
+		l.batch = &batch[K, V]{results: make(map[K]*result[V])}
+		b := l.batch
+		time.AfterFunc(l.wait, func() {
+			l.dispatch(ctx, b)
+		})
+	}
+	l.batch.keys = append(l.batch.keys, key)
+	l.batch.results[key] = res
+
+	if len(l.batch.keys) >= l.maxBatch {
+		b := l.batch
+		l.batch = nil
+		go l.dispatch(ctx, b)
+	}
+}
+
+func (l *Loader[K, V]) dispatch(ctx context.Context, b *batch[K, V]) {
+	l.mu.Lock()
+	if b.closed {
+		l.mu.Unlock()
+		return
+	}
+	b.closed = true
+	if l.batch == b {
+		l.batch = nil
+	}
+	l.mu.Unlock()
+
+	values, err := l.fetch(context.WithoutCancel(ctx), b.keys)
+	for key, res := range b.results {
+		if err != nil {
+			res.err = err
+			l.forget(key)
+		} else {
+			res.value = values[key]
+		}
+		close(res.done)
+	}
+}
+
+// forget drops a failed key from the cache so a later Load can retry it.
+func (l *Loader[K, V]) forget(key K) {
+	l.mu.Lock()
+	delete(l.cache, key)
+	l.mu.Unlock()
+}
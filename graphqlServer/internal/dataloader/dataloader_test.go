@@ -0,0 +1,132 @@
+package dataloader_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/dataloader"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"sort"
+	"sync"
+	"testing"
+	"time"
+)
+
+type recorder struct {
+	mu      sync.Mutex
+	batches [][]string
+}
+
+func (r *recorder) fetch(err error) dataloader.BatchFunc[string, string] {
+	return func(ctx context.Context, keys []string) (map[string]string, error) {
+		r.mu.Lock()
+		batch := append([]string(nil), keys...)
+		sort.Strings(batch)
+		r.batches = append(r.batches, batch)
+		r.mu.Unlock()
+		if err != nil {
+			return nil, err
+		}
+		result := make(map[string]string, len(keys))
+		for _, key := range keys {
+			if key != "missing" {
+				result[key] = "value-" + key
+			}
+		}
+		return result, nil
+	}
+}
+
+func loadConcurrently(ctx context.Context, loader *dataloader.Loader[string, string], keys []string) ([]string, []error) {
+	values := make([]string, len(keys))
+	errs := make([]error, len(keys))
+	var wg sync.WaitGroup
+	for i, key := range keys {
+		wg.Add(1)
+		go func(i int, key string) {
+			defer wg.Done()
+			values[i], errs[i] = loader.Load(ctx, key)
+		}(i, key)
+	}
+	wg.Wait()
+	return values, errs
+}
+
+func TestLoader(t *testing.T) {
+	ctx := context.Background()
+	fetchErr := errors.New("fetch failed")
+
+	tests := []struct {
+		name            string
+		keys            []string
+		maxBatch        int
+		fetchErr        error
+		expectedValues  []string
+		expectedBatches [][]string
+		expectedErr     error
+	}{
+		{
+			name:            "concurrent loads are batched and deduplicated",
+			keys:            []string{"1", "2", "1", "3"},
+			maxBatch:        10,
+			expectedValues:  []string{"value-1", "value-2", "value-1", "value-3"},
+			expectedBatches: [][]string{{"1", "2", "3"}},
+		},
+		{
+			name:            "missing keys resolve to the zero value",
+			keys:            []string{"1", "missing"},
+			maxBatch:        10,
+			expectedValues:  []string{"value-1", ""},
+			expectedBatches: [][]string{{"1", "missing"}},
+		},
+		{
+			name:            "batches are split at the max batch size",
+			keys:            []string{"1", "2"},
+			maxBatch:        1,
+			expectedValues:  []string{"value-1", "value-2"},
+			expectedBatches: [][]string{{"1"}, {"2"}},
+		},
+		{
+			name:            "fetch errors are returned to every caller",
+			keys:            []string{"1", "2"},
+			maxBatch:        10,
+			fetchErr:        fetchErr,
+			expectedBatches: [][]string{{"1", "2"}},
+			expectedErr:     fetchErr,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rec := &recorder{}
+			loader := dataloader.New(rec.fetch(tt.fetchErr), 10*time.Millisecond, tt.maxBatch)
+
+			values, errs := loadConcurrently(ctx, loader, tt.keys)
+
+			for i := range tt.keys {
+				if tt.expectedErr != nil {
+					assert.ErrorIs(t, errs[i], tt.expectedErr)
+					continue
+				}
+				require.NoError(t, errs[i])
+				assert.Equal(t, tt.expectedValues[i], values[i])
+			}
+			assert.ElementsMatch(t, tt.expectedBatches, rec.batches)
+		})
+	}
+}
+
+func TestLoaderCachesResults(t *testing.T) {
+	ctx := context.Background()
+	rec := &recorder{}
+	loader := dataloader.New(rec.fetch(nil), time.Millisecond, 10)
+
+	first, err := loader.Load(ctx, "1")
+	require.NoError(t, err)
+	second, err := loader.LoadAll(ctx, []string{"1", "2"})
+	require.NoError(t, err)
+
+	assert.Equal(t, "value-1", first)
+	assert.Equal(t, []string{"value-1", "value-2"}, second)
+	assert.Equal(t, [][]string{{"1"}, {"2"}}, rec.batches)
+}
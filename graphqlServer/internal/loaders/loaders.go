@@ -0,0 +1,102 @@
+package loaders
+
+import (
+	"context"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/dataloader"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/pkg/todoclient"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/http"
+	"time"
+)
+
+type ctxKey string
+
+const (
+	loadersCtxKey ctxKey = "loaders"
+	batchWait            = 2 * time.Millisecond
+	maxBatchSize         = 100
+)
+
+type Loaders struct {
+	UserByID      *dataloader.Loader[string, *models.User]
+	ListByID      *dataloader.Loader[string, *models.List]
+	TodosByListID *dataloader.Loader[string, []models.Todo]
+}
+
+func NewLoaders(todoClient *todoclient.Client) *Loaders {
+	return &Loaders{
+		UserByID:      dataloader.New(usersByIDs(todoClient), batchWait, maxBatchSize),
+		ListByID:      dataloader.New(listsByIDs(todoClient), batchWait, maxBatchSize),
+		TodosByListID: dataloader.New(todosByListIDs(todoClient), batchWait, maxBatchSize),
+	}
+}
+
+// Middleware attaches a fresh set of loaders to every request so cached results never leak between users.
+func Middleware(todoClient *todoclient.Client, next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := context.WithValue(r.Context(), loadersCtxKey, NewLoaders(todoClient))
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
+
+func For(ctx context.Context) (*Loaders, error) {
+	l, ok := ctx.Value(loadersCtxKey).(*Loaders)
+	if !ok {
+		return nil, fmt.Errorf("loaders are missing from the context")
+	}
+	return l, nil
+}
+
+func WithLoaders(ctx context.Context, l *Loaders) context.Context {
+	return context.WithValue(ctx, loadersCtxKey, l)
+}
+
+func usersByIDs(todoClient *todoclient.Client) dataloader.BatchFunc[string, *models.User] {
+	return func(ctx context.Context, ids []string) (map[string]*models.User, error) {
+		log.C(ctx).Infof("batch loading %d users", len(ids))
+		users, err := todoClient.GetUsersByIDs(ctx, ids)
+		if err != nil {
+			log.C(ctx).Errorf("failed to batch load users: %v", err)
+			return nil, fmt.Errorf("error executing request: %w", err)
+		}
+		result := make(map[string]*models.User, len(users))
+		for _, user := range users {
+			result[user.ID] = user
+		}
+		return result, nil
+	}
+}
+
+func listsByIDs(todoClient *todoclient.Client) dataloader.BatchFunc[string, *models.List] {
+	return func(ctx context.Context, ids []string) (map[string]*models.List, error) {
+		log.C(ctx).Infof("batch loading %d lists", len(ids))
+		lists, err := todoClient.GetListsByIDs(ctx, ids)
+		if err != nil {
+			log.C(ctx).Errorf("failed to batch load lists: %v", err)
+			return nil, fmt.Errorf("error executing request: %w", err)
+		}
+		result := make(map[string]*models.List, len(lists))
+		for _, list := range lists {
+			result[list.ID] = list
+		}
+		return result, nil
+	}
+}
+
+func todosByListIDs(todoClient *todoclient.Client) dataloader.BatchFunc[string, []models.Todo] {
+	return func(ctx context.Context, listIDs []string) (map[string][]models.Todo, error) {
+		log.C(ctx).Infof("batch loading todos for %d lists", len(listIDs))
+		todos, err := todoClient.ListTodosByListIDs(ctx, listIDs)
+		if err != nil {
+			log.C(ctx).Errorf("failed to batch load todos: %v", err)
+			return nil, fmt.Errorf("error executing request: %w", err)
+		}
+		result := make(map[string][]models.Todo, len(listIDs))
+		for _, todo := range todos {
+			result[todo.ListID] = append(result[todo.ListID], *todo)
+		}
+		return result, nil
+	}
+}
@@ -0,0 +1,71 @@
+package loaders_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/loaders"
+	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/pkg/todoclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestTodosByListID(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		mockResp      []byte
+		mockErr       error
+		expectedTodos map[string][]string
+		expectError   bool
+	}{
+		{
+			name:     "todos are grouped by list",
+			mockResp: []byte(`[{"id": "1", "list_id": "a"}, {"id": "2", "list_id": "b"}, {"id": "3", "list_id": "a"}]`),
+			expectedTodos: map[string][]string{
+				"a": {"1", "3"},
+				"b": {"2"},
+			},
+		},
+		{
+			name:        "failed HTTP request",
+			mockErr:     errors.New("failed to fetch todos"),
+			expectError: true,
+		},
+		{
+			name:        "failed to unmarshal response",
+			mockResp:    []byte(`invalid JSON`),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+			mockClient.On("Do", mock.Anything, http.MethodGet, mock.MatchedBy(func(url string) bool {
+				return url == "/todos?list_ids=a,b" || url == "/todos?list_ids=b,a"
+			}), mock.Anything).Return(tt.mockResp, tt.mockErr).Once()
+
+			l := loaders.NewLoaders(todoclient.New(mockClient))
+			result, err := l.TodosByListID.LoadAll(ctx, []string{"a", "b"})
+
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				for i, listID := range []string{"a", "b"} {
+					var ids []string
+					for _, todo := range result[i] {
+						ids = append(ids, todo.ID)
+					}
+					assert.Equal(t, tt.expectedTodos[listID], ids)
+				}
+			}
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
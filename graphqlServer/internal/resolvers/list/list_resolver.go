@@ -1,27 +1,29 @@
 package list
 
 import (
+	"cmp"
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
-	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/loaders"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/pkg/todoclient"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
-	"net/http"
+	"slices"
+	"time"
 )
 
 type Resolver struct {
-	httpClient client.Client
+	todoClient *todoclient.Client
 	listConv   converters.ListConverter
 	userConv   converters.UserConverter
 }
 
-func NewResolver(client client.Client, listConverter converters.ListConverter, userConverter converters.UserConverter) *Resolver {
+func NewResolver(client *todoclient.Client, listConverter converters.ListConverter, userConverter converters.UserConverter) *Resolver {
 	return &Resolver{
-		httpClient: client,
+		todoClient: client,
 		listConv:   listConverter,
 		userConv:   userConverter,
 	}
@@ -29,41 +31,27 @@ func NewResolver(client client.Client, listConverter converters.ListConverter, u
 
 func (r *Resolver) ListsGlobal(ctx context.Context) ([]*graphql.List, error) {
 	log.C(ctx).Info("list resolver for getting all lists")
-	response, err := r.httpClient.Do(ctx, http.MethodGet, "/lists/all", nil)
+	lists, err := r.todoClient.ListAllLists(ctx)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch all lists: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	var lists []*models.List
-	if err = json.Unmarshal(response, &lists); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal list response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
+
 	result, err := r.listConv.ConvertMultipleListsToGraphQL(lists)
 	if err != nil {
 		log.C(ctx).Errorf("failed to convert multiple lists to graphql: %v", err)
 		return nil, fmt.Errorf("error while converting multiple lists to graphql: %w", err)
 	}
-	log.C(ctx).Debugf("converted lists: %v", result)
 	return result, nil
 }
 
-func (r *Resolver) Lists(ctx context.Context) ([]*graphql.List, error) {
+func (r *Resolver) Lists(ctx context.Context, includeArchived *bool) ([]*graphql.List, error) {
 	log.C(ctx).Info("list resolver for ListByUser")
-	url := "/lists/user/all"
-
-	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	lists, err := r.todoClient.ListUserLists(ctx, includeArchived != nil && *includeArchived)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch list response: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	log.C(ctx).Debugf("list response: %v", string(response))
-
-	var lists []*models.List
-	if err = json.Unmarshal(response, &lists); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal list response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
 
 	result, err := r.listConv.ConvertMultipleListsToGraphQL(lists)
 	if err != nil {
@@ -75,20 +63,11 @@ func (r *Resolver) Lists(ctx context.Context) ([]*graphql.List, error) {
 
 func (r *Resolver) ListsAccepted(ctx context.Context) ([]*graphql.List, error) {
 	log.C(ctx).Info("list resolver for accepted lists")
-	url := "/lists/user/accepted"
-
-	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	lists, err := r.todoClient.ListAcceptedLists(ctx)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch list response: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	log.C(ctx).Debugf("list response: %v", string(response))
-
-	var lists []*models.List
-	if err = json.Unmarshal(response, &lists); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal list response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
 
 	result, err := r.listConv.ConvertMultipleListsToGraphQL(lists)
 	if err != nil {
@@ -100,20 +79,11 @@ func (r *Resolver) ListsAccepted(ctx context.Context) ([]*graphql.List, error) {
 
 func (r *Resolver) ListsPending(ctx context.Context) ([]*graphql.List, error) {
 	log.C(ctx).Info("list resolver for pending list for a user")
-	url := "/lists/pending/all"
-
-	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	lists, err := r.todoClient.ListPendingLists(ctx)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch pending list response: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	log.C(ctx).Debugf("pending list response: %v", string(response))
-
-	var lists []*models.List
-	if err = json.Unmarshal(response, &lists); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal pending list response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
 
 	result, err := r.listConv.ConvertMultipleListsToGraphQL(lists)
 	if err != nil {
@@ -125,18 +95,12 @@ func (r *Resolver) ListsPending(ctx context.Context) ([]*graphql.List, error) {
 
 func (r *Resolver) List(ctx context.Context, id string) (*graphql.List, error) {
 	log.C(ctx).Info("list resolver for getting list by id")
-	url := fmt.Sprintf("/lists/%s", id)
-	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	l, err := r.todoClient.GetList(ctx, id)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch list by id: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	var l models.List
-	if err = json.Unmarshal(response, &l); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal list response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
-	return r.listConv.ConvertListToGraphQL(l)
+	return r.listConv.ConvertListToGraphQL(*l)
 }
 
 func (r *Resolver) Owner(ctx context.Context, obj *graphql.List) (*graphql.User, error) {
@@ -145,22 +109,11 @@ func (r *Resolver) Owner(ctx context.Context, obj *graphql.List) (*graphql.User,
 		log.C(ctx).Error("list is nil")
 		return nil, nil
 	}
-	var ownerID string
-	url := fmt.Sprintf("/lists/%s/owner", obj.ID)
-	body, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		log.C(ctx).Errorf("failed to fetch user by id: %v", err)
-		return nil, fmt.Errorf("error reading response body: %w", err)
+	if obj.Owner == nil || obj.Owner.ID == "" {
+		log.C(ctx).Errorf("list %s has no owner", obj.ID)
+		return nil, fmt.Errorf("list %s has no owner", obj.ID)
 	}
-
-	log.C(ctx).Debugf("user: %v", string(body))
-
-	if err = json.Unmarshal(body, &ownerID); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal user response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
-
-	return r.getUser(ctx, ownerID)
+	return r.getUser(ctx, obj.Owner.ID)
 }
 
 func (r *Resolver) Todos(ctx context.Context, obj *graphql.List) ([]*graphql.Todo, error) {
@@ -168,30 +121,70 @@ func (r *Resolver) Todos(ctx context.Context, obj *graphql.List) ([]*graphql.Tod
 	if obj == nil {
 		return nil, nil
 	}
-	url := fmt.Sprintf("/lists/%s/todos", obj.ID)
-	body, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	l, err := loaders.For(ctx)
 	if err != nil {
-		log.C(ctx).Errorf("failed to fetch todos: %v", err)
-		return nil, fmt.Errorf("error executing request: %w", err)
+		log.C(ctx).Errorf("failed to get loaders: %v", err)
+		return nil, err
 	}
-	log.C(ctx).Debugf("todos: %v", string(body))
-	var todos []models.Todo
-	if err = json.Unmarshal(body, &todos); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal todos: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	todos, err := l.TodosByListID.Load(ctx, obj.ID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to fetch todos: %v", err)
+		return nil, fmt.Errorf("error loading todos: %w", err)
 	}
 
-	var result []*graphql.Todo
+	result := make([]*graphql.Todo, 0, len(todos))
 	for _, el := range todos {
 		t, err := converters.NewConverterTodoGraphQL().ConvertTodoToGraphQL(el)
-
 		if err != nil {
 			log.C(ctx).Errorf("failed to convert todo: %v", err)
 			return nil, fmt.Errorf("error converting todo: %w", err)
 		}
 		result = append(result, t)
 	}
-	return result, err
+	return result, nil
+}
+
+// Columns groups the todos of the list by status, each column ordered by the position of its todos.
+func (r *Resolver) Columns(ctx context.Context, obj *graphql.List) ([]*graphql.Column, error) {
+	log.C(ctx).Info("list resolver for getting columns")
+	if obj == nil {
+		return nil, nil
+	}
+	statuses, err := r.todoClient.ListStatuses(ctx, obj.ID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to fetch statuses: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	todos, err := r.todoClient.ListTodosByList(ctx, obj.ID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to fetch todos: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	slices.SortStableFunc(todos, func(a, b *models.Todo) int {
+		return cmp.Compare(a.Position, b.Position)
+	})
+
+	result := make([]*graphql.Column, 0, len(statuses))
+	columns := make(map[string]*graphql.Column, len(statuses))
+	for _, status := range statuses {
+		column := &graphql.Column{Status: converters.ConvertStatusToGraphQL(*status), Todos: []*graphql.Todo{}}
+		columns[status.ID] = column
+		result = append(result, column)
+	}
+	for _, el := range todos {
+		column, ok := columns[el.StatusID]
+		if !ok {
+			log.C(ctx).Warnf("todo %s has status %s outside of list %s", el.ID, el.StatusID, obj.ID)
+			continue
+		}
+		t, err := converters.NewConverterTodoGraphQL().ConvertTodoToGraphQL(*el)
+		if err != nil {
+			log.C(ctx).Errorf("failed to convert todo: %v", err)
+			return nil, fmt.Errorf("error converting todo: %w", err)
+		}
+		column.Todos = append(column.Todos, t)
+	}
+	return result, nil
 }
 
 func (r *Resolver) Collaborators(ctx context.Context, obj *graphql.List) ([]*graphql.ListAccess, error) {
@@ -199,17 +192,11 @@ func (r *Resolver) Collaborators(ctx context.Context, obj *graphql.List) ([]*gra
 	if obj == nil {
 		return nil, nil
 	}
-	url := fmt.Sprintf("/lists/%s/users", obj.ID)
-	body, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	access, err := r.todoClient.ListCollaborators(ctx, obj.ID)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch collaborators: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	var access []models.Access
-	if err = json.Unmarshal(body, &access); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal collaborators: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
 
 	var result []*graphql.ListAccess
 	for _, el := range access {
@@ -250,50 +237,25 @@ func (r *Resolver) CreateList(ctx context.Context, input graphql.CreateListInput
 		return nil, fmt.Errorf("failed to get claim from the context")
 	}
 	httpInput, err := r.listConv.ConvertCreateListInput(input, claim.ID)
-
 	if err != nil {
 		log.C(ctx).Errorf("failed to convert create list: %v", err)
 		return nil, fmt.Errorf("error converting create list input to struct: %w", err)
 	}
-	body, err := json.Marshal(httpInput)
-	if err != nil {
-		log.C(ctx).Errorf("failed to marshal create list input: %v", err)
-		return nil, fmt.Errorf("error marshalling user: %v", err)
-	}
-	responsePostBody, err := r.httpClient.Do(ctx, http.MethodPost, "/lists/create", body)
-	log.C(ctx).Debugf("create list response: %v", responsePostBody)
+
+	id, err := r.todoClient.CreateList(ctx, httpInput)
 	if err != nil {
-		log.C(ctx).Errorf("failed to fetch create list response: %v", err)
+		log.C(ctx).Errorf("failed to create list: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-
-	var id string
-
-	if err = json.Unmarshal(responsePostBody, &id); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal create list response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
-
 	log.C(ctx).Debugf("create list id: %v", id)
 
-	url := fmt.Sprintf("/lists/%s", id)
-
-	responseGet, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	list, err := r.todoClient.GetList(ctx, id)
 	if err != nil {
-		log.C(ctx).Errorf("failed to fetch create list response: %v", err)
+		log.C(ctx).Errorf("failed to fetch created list: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 
-	var list models.List
-
-	if err = json.Unmarshal(responseGet, &list); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal create list response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
-
-	log.C(ctx).Debugf("create list id: %v", list.ID)
-
-	return r.listConv.ConvertListToGraphQL(list)
+	return r.listConv.ConvertListToGraphQL(*list)
 }
 
 func (r *Resolver) UpdateList(ctx context.Context, id string, input graphql.UpdateListInput) (*graphql.List, error) {
@@ -303,37 +265,20 @@ func (r *Resolver) UpdateList(ctx context.Context, id string, input graphql.Upda
 		log.C(ctx).Errorf("failed to convert update list: %v", err)
 		return nil, fmt.Errorf("error converting update list input to struct: %w", err)
 	}
-	body, err := json.Marshal(httpInput)
-	if err != nil {
-		log.C(ctx).Errorf("failed to marshal update list input: %v", err)
-		return nil, fmt.Errorf("error marshalling user: %v", err)
-	}
-
-	url := fmt.Sprintf("/lists/%s", id)
-	log.C(ctx).Debugf("update list response: %v", string(body))
 
-	_, err = r.httpClient.Do(ctx, http.MethodPut, url, body)
-	if err != nil {
-		log.C(ctx).Errorf("failed to fetch update list response: %v", err)
+	if err = r.todoClient.UpdateList(ctx, id, httpInput); err != nil {
+		log.C(ctx).Errorf("failed to update list: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 
-	responseGet, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	l, err := r.todoClient.GetList(ctx, id)
 	if err != nil {
-		log.C(ctx).Errorf("failed to fetch update list response: %v", err)
+		log.C(ctx).Errorf("failed to fetch updated list: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	log.C(ctx).Debugf("update list id: %v", id)
-
-	var l models.List
-
-	if err = json.Unmarshal(responseGet, &l); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal update list response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
 	log.C(ctx).Debugf("update list: %v", l)
 
-	return r.listConv.ConvertListToGraphQL(l)
+	return r.listConv.ConvertListToGraphQL(*l)
 }
 
 func (r *Resolver) AcceptList(ctx context.Context, listID string) (*bool, error) {
@@ -343,10 +288,7 @@ func (r *Resolver) AcceptList(ctx context.Context, listID string) (*bool, error)
 		log.C(ctx).Error("failed to get claim from the context")
 		return nil, fmt.Errorf("failed to get claim from the context")
 	}
-	url := fmt.Sprintf("/lists_access/%s/%s", listID, claim.ID)
-
-	_, err := r.httpClient.Do(ctx, http.MethodPost, url, nil)
-	if err != nil {
+	if err := r.todoClient.AcceptList(ctx, listID, claim.ID); err != nil {
 		log.C(ctx).Errorf("failed to accept list response: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
@@ -357,46 +299,27 @@ func (r *Resolver) AcceptList(ctx context.Context, listID string) (*bool, error)
 
 func (r *Resolver) DeleteList(ctx context.Context, id string) (*graphql.List, error) {
 	log.C(ctx).Info("delete list resolver")
-	url := fmt.Sprintf("/lists/%s", id)
-
-	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	l, err := r.todoClient.GetList(ctx, id)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch get list response: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	log.C(ctx).Debugf("delete list response: %v", string(response))
-
-	var l models.List
-	if err = json.Unmarshal(response, &l); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal delete list response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
 
-	_, err = r.httpClient.Do(ctx, http.MethodDelete, url, nil)
-	if err != nil {
-		log.C(ctx).Errorf("failed to fetch delete list response: %v", err)
+	if err = r.todoClient.DeleteList(ctx, id); err != nil {
+		log.C(ctx).Errorf("failed to delete list: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 
-	return r.listConv.ConvertListToGraphQL(l)
+	return r.listConv.ConvertListToGraphQL(*l)
 }
 
 func (r *Resolver) ListsByUser(ctx context.Context, id string) ([]*graphql.List, error) {
 	log.C(ctx).Info("list resolver for ListByUser")
-	url := fmt.Sprintf("/users/%s/lists", id)
-
-	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	lists, err := r.todoClient.ListListsByUser(ctx, id)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch list response: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	log.C(ctx).Debugf("list response: %v", string(response))
-
-	var lists []*models.List
-	if err = json.Unmarshal(response, &lists); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal list response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
 
 	result, err := r.listConv.ConvertMultipleListsToGraphQL(lists)
 	if err != nil {
@@ -408,21 +331,22 @@ func (r *Resolver) ListsByUser(ctx context.Context, id string) ([]*graphql.List,
 
 func (r *Resolver) getUser(ctx context.Context, id string) (*graphql.User, error) {
 	log.C(ctx).Info("get user resolver")
-	url := fmt.Sprintf("/users/%s", id)
-	body, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	l, err := loaders.For(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get loaders: %v", err)
+		return nil, err
+	}
+	u, err := l.UserByID.Load(ctx, id)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch user response: %v", err)
-		return nil, fmt.Errorf("error executing request: %w", err)
+		return nil, fmt.Errorf("error loading user: %w", err)
 	}
-
-	var u models.User
-	if err = json.Unmarshal(body, &u); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal user response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	if u == nil {
+		log.C(ctx).Errorf("user %s not found", id)
+		return nil, fmt.Errorf("user %s not found", id)
 	}
 
-	graphqlUser, err := r.userConv.ConvertUserToGraphQL(u)
-	log.C(ctx).Debugf("user grapjql: %v", graphqlUser)
+	graphqlUser, err := r.userConv.ConvertUserToGraphQL(*u)
 	if err != nil {
 		log.C(ctx).Errorf("failed to convert user response: %v", err)
 		return nil, fmt.Errorf("error converting user: %w", err)
@@ -437,28 +361,13 @@ func (r *Resolver) AddListAccess(ctx context.Context, input graphql.GrantListAcc
 		log.C(ctx).Errorf("failed to convert grant list access input to model: %v", err)
 		return nil, fmt.Errorf("error converting create list input to struct: %w", err)
 	}
-	body, err := json.Marshal(httpInput)
-	if err != nil {
-		log.C(ctx).Errorf("failed to marshal add list access input: %v", err)
-		return nil, fmt.Errorf("error marshalling user: %v", err)
-	}
-	log.C(ctx).Debugf("add list access response body: %v", string(body))
-	url := fmt.Sprintf("/lists_access/create/%s/%s", input.ListID, input.UserID)
 
-	response, err := r.httpClient.Do(ctx, http.MethodPost, url, body)
+	access, err := r.todoClient.GrantListAccess(ctx, httpInput)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch add list access response: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-
-	log.C(ctx).Debugf("add list access response: %v", string(response))
-
-	var access models.Access
-
-	if err = json.Unmarshal(response, &access); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal add list access response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
+	log.C(ctx).Debugf("add list access response: %v", access)
 	list, err := r.getList(ctx, access.ListID)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch add list access list: %v", err)
@@ -493,28 +402,19 @@ func (r *Resolver) RemoveListAccess(ctx context.Context, listID string) (*graphq
 		log.C(ctx).Error("failed to get claim from the context")
 		return nil, fmt.Errorf("failed to get claim from the context")
 	}
-	url := fmt.Sprintf("/lists_access/%s/%s", listID, claim.ID)
-
-	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	access, err := r.todoClient.GetListAccess(ctx, listID, claim.ID)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch remove list access response: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 
-	var access models.Access
-	if err = json.Unmarshal(response, &access); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal remove list access response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
-
 	list, err := r.getList(ctx, access.ListID)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch remove list access list: %v", err)
 		return nil, fmt.Errorf("error converting list: %w", err)
 	}
 
-	_, err = r.httpClient.Do(ctx, http.MethodDelete, url, nil)
-	if err != nil {
+	if err = r.todoClient.DeleteListAccess(ctx, listID, claim.ID); err != nil {
 		log.C(ctx).Errorf("failed to fetch remove list access response: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
@@ -543,102 +443,96 @@ func (r *Resolver) RemoveListAccess(ctx context.Context, listID string) (*graphq
 
 func (r *Resolver) getList(ctx context.Context, id string) (*graphql.List, error) {
 	log.C(ctx).Info("get list resolver")
-	url := fmt.Sprintf("/lists/%s", id)
-
-	body, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	l, err := loaders.For(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get loaders: %v", err)
+		return nil, err
+	}
+	list, err := l.ListByID.Load(ctx, id)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch list response: %v", err)
-		return nil, fmt.Errorf("error executing request: %w", err)
+		return nil, fmt.Errorf("error loading list: %w", err)
 	}
-	log.C(ctx).Debugf("get list response: %v", string(body))
-	var l models.List
-	if err = json.Unmarshal(body, &l); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal list response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	if list == nil {
+		log.C(ctx).Errorf("list %s not found", id)
+		return nil, fmt.Errorf("list %s not found", id)
 	}
-	return r.listConv.ConvertListToGraphQL(l)
+	return r.listConv.ConvertListToGraphQL(*list)
 }
 
 func (r *Resolver) UpdateListName(ctx context.Context, id string, name string) (*graphql.List, error) {
 	log.C(ctx).Info("update list name resolver")
-	url := fmt.Sprintf("/lists/%s/name", id)
-
-	var updateData struct {
-		Name string `json:"name"`
-	}
-
-	updateData.Name = name
-
-	body, err := json.Marshal(updateData)
+	l, err := r.todoClient.UpdateListName(ctx, id, name)
 	if err != nil {
-		log.C(ctx).Errorf("failed to marshal name for the list: %v", err)
-		return nil, fmt.Errorf("error marshalling name: %v", err)
-	}
-
-	response, err := r.httpClient.Do(ctx, http.MethodPatch, url, body)
-	if err != nil {
-		log.C(ctx).Errorf("failed to fetch list response: %v", err)
+		log.C(ctx).Errorf("failed to update list name: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	log.C(ctx).Debugf("list response: %v", string(response))
-
-	var l models.List
-	if err = json.Unmarshal(response, &l); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal list response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
-	return r.listConv.ConvertListToGraphQL(l)
+	return r.listConv.ConvertListToGraphQL(*l)
 }
 
 func (r *Resolver) UpdateListDescription(ctx context.Context, id string, description string) (*graphql.List, error) {
 	log.C(ctx).Info("update list description resolver")
-	url := fmt.Sprintf("/lists/%s/description", id)
-
-	var updateData struct {
-		Description string `json:"description"`
+	l, err := r.todoClient.UpdateListDescription(ctx, id, description)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update list description: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
+	return r.listConv.ConvertListToGraphQL(*l)
+}
 
-	updateData.Description = description
-
-	body, err := json.Marshal(updateData)
+// ReorderList puts the list right before the list beforeID and right after the list afterID among the
+// lists of the caller.
+func (r *Resolver) ReorderList(ctx context.Context, id string, beforeID *string, afterID *string) (*graphql.List, error) {
+	log.C(ctx).Info("reorder list resolver")
+	var before, after string
+	if beforeID != nil {
+		before = *beforeID
+	}
+	if afterID != nil {
+		after = *afterID
+	}
+	if err := r.todoClient.ReorderList(ctx, id, before, after); err != nil {
+		log.C(ctx).Errorf("failed to reorder list: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	l, err := r.todoClient.GetList(ctx, id)
 	if err != nil {
-		log.C(ctx).Errorf("failed to marshal description for the list: %v", err)
-		return nil, fmt.Errorf("error marshalling description: %v", err)
+		log.C(ctx).Errorf("failed to get reordered list: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
+	return r.listConv.ConvertListToGraphQL(*l)
+}
 
-	response, err := r.httpClient.Do(ctx, http.MethodPatch, url, body)
+// ArchiveList makes the list read-only. Archived lists are only returned by lists with includeArchived.
+func (r *Resolver) ArchiveList(ctx context.Context, id string) (*graphql.List, error) {
+	log.C(ctx).Info("archive list resolver")
+	l, err := r.todoClient.ArchiveList(ctx, id)
 	if err != nil {
-		log.C(ctx).Errorf("failed to fetch list response: %v", err)
+		log.C(ctx).Errorf("failed to archive list: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	log.C(ctx).Debugf("list response: %v", string(response))
+	return r.listConv.ConvertListToGraphQL(*l)
+}
 
-	var l models.List
-	if err = json.Unmarshal(response, &l); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal list response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+func (r *Resolver) UnarchiveList(ctx context.Context, id string) (*graphql.List, error) {
+	log.C(ctx).Info("unarchive list resolver")
+	l, err := r.todoClient.UnarchiveList(ctx, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to unarchive list: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	return r.listConv.ConvertListToGraphQL(l)
+	return r.listConv.ConvertListToGraphQL(*l)
 }
 
 func (r *Resolver) RemoveCollaborator(ctx context.Context, listID string, userID string) (*graphql.ListAccess, error) {
 	log.C(ctx).Info("remove collaborator resolver")
-	url := fmt.Sprintf("/lists_access/%s/%s", listID, userID)
-
-	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	access, err := r.todoClient.GetListAccess(ctx, listID, userID)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch remove collaborator response: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 
-	var access models.Access
-	if err = json.Unmarshal(response, &access); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal remove collaborator response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
-
-	_, err = r.httpClient.Do(ctx, http.MethodDelete, url, nil)
-	if err != nil {
+	if err = r.todoClient.DeleteListAccess(ctx, listID, userID); err != nil {
 		log.C(ctx).Errorf("failed to fetch remove collaborator response: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
@@ -672,20 +566,12 @@ func (r *Resolver) RemoveCollaborator(ctx context.Context, listID string, userID
 func (r *Resolver) GetListAccesses(ctx context.Context, listID string) ([]*graphql.ListAccess, error) {
 	log.C(ctx).Info("get list accesses byt list id resolver")
 
-	url := fmt.Sprintf("/lists_access/list/%s", listID)
-
-	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	accesses, err := r.todoClient.ListListAccesses(ctx, listID)
 	if err != nil {
-		log.C(ctx).Errorf("failed to fetch remove list access response: %v", err)
+		log.C(ctx).Errorf("failed to fetch list accesses: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 
-	var accesses []models.Access
-	if err = json.Unmarshal(response, &accesses); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal remove list access response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
-
 	var listAccesses []*graphql.ListAccess
 	for _, access := range accesses {
 		list, err := r.getList(ctx, access.ListID)
@@ -713,3 +599,146 @@ func (r *Resolver) GetListAccesses(ctx context.Context, listID string) ([]*graph
 
 	return listAccesses, nil
 }
+
+func (r *Resolver) Tags(ctx context.Context, listID string) ([]*graphql.Tag, error) {
+	log.C(ctx).Info("list resolver for tags of a list")
+	tags, err := r.todoClient.ListTags(ctx, listID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to fetch tags: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	result := make([]*graphql.Tag, 0, len(tags))
+	for _, tag := range tags {
+		result = append(result, converters.ConvertTagToGraphQL(*tag))
+	}
+	return result, nil
+}
+
+func (r *Resolver) CreateTag(ctx context.Context, input graphql.CreateTagInput) (*graphql.Tag, error) {
+	log.C(ctx).Info("list resolver for creating a tag")
+	tag := todoclient.Tag{ListID: input.ListID, Name: input.Name}
+	if input.Color != nil {
+		tag.Color = *input.Color
+	}
+	created, err := r.todoClient.CreateTag(ctx, tag)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create tag: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return converters.ConvertTagToGraphQL(*created), nil
+}
+
+func (r *Resolver) UpdateTag(ctx context.Context, listID string, id string, input graphql.UpdateTagInput) (*graphql.Tag, error) {
+	log.C(ctx).Info("list resolver for updating a tag")
+	updated, err := r.todoClient.UpdateTag(ctx, listID, id, todoclient.TagUpdate{Name: input.Name, Color: input.Color})
+	if err != nil {
+		log.C(ctx).Errorf("failed to update tag: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return converters.ConvertTagToGraphQL(*updated), nil
+}
+
+func (r *Resolver) DeleteTag(ctx context.Context, listID string, id string) (*bool, error) {
+	log.C(ctx).Info("list resolver for deleting a tag")
+	if err := r.todoClient.DeleteTag(ctx, listID, id); err != nil {
+		log.C(ctx).Errorf("failed to delete tag: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	success := true
+	return &success, nil
+}
+
+func (r *Resolver) CloneList(ctx context.Context, id string, input *graphql.CloneListInput) (*graphql.List, error) {
+	log.C(ctx).Info("list resolver for cloning a list")
+	var options todoclient.CloneOptions
+	if input != nil {
+		if input.Name != nil {
+			options.Name = *input.Name
+		}
+		options.IncludeCollaborators = input.IncludeCollaborators != nil && *input.IncludeCollaborators
+		options.ResetCompletion = input.ResetCompletion != nil && *input.ResetCompletion
+		anchorDate, err := parseAnchorDate(input.AnchorDate)
+		if err != nil {
+			return nil, err
+		}
+		options.AnchorDate = anchorDate
+	}
+
+	l, err := r.todoClient.CloneList(ctx, id, options)
+	if err != nil {
+		log.C(ctx).Errorf("failed to clone list: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.listConv.ConvertListToGraphQL(*l)
+}
+
+func (r *Resolver) SaveListAsTemplate(ctx context.Context, listID string, name string, description *string) (*graphql.ListTemplate, error) {
+	log.C(ctx).Info("list resolver for saving a list as template")
+	var desc string
+	if description != nil {
+		desc = *description
+	}
+	template, err := r.todoClient.SaveTemplate(ctx, listID, name, desc)
+	if err != nil {
+		log.C(ctx).Errorf("failed to save template: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return converters.ConvertTemplateToGraphQL(*template), nil
+}
+
+func (r *Resolver) Templates(ctx context.Context) ([]*graphql.ListTemplate, error) {
+	log.C(ctx).Info("list resolver for templates of the user")
+	templates, err := r.todoClient.ListTemplates(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("failed to fetch templates: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	result := make([]*graphql.ListTemplate, 0, len(templates))
+	for _, template := range templates {
+		result = append(result, converters.ConvertTemplateToGraphQL(*template))
+	}
+	return result, nil
+}
+
+func (r *Resolver) CreateListFromTemplate(ctx context.Context, templateID string, name *string, anchorDate *string) (*graphql.List, error) {
+	log.C(ctx).Info("list resolver for creating a list from template")
+	var listName string
+	if name != nil {
+		listName = *name
+	}
+	anchor, err := parseAnchorDate(anchorDate)
+	if err != nil {
+		return nil, err
+	}
+
+	l, err := r.todoClient.CreateListFromTemplate(ctx, templateID, listName, anchor)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create list from template: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.listConv.ConvertListToGraphQL(*l)
+}
+
+func (r *Resolver) DeleteTemplate(ctx context.Context, id string) (*bool, error) {
+	log.C(ctx).Info("list resolver for deleting a template")
+	if err := r.todoClient.DeleteTemplate(ctx, id); err != nil {
+		log.C(ctx).Errorf("failed to delete template: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	success := true
+	return &success, nil
+}
+
+// parseAnchorDate reads an optional RFC 3339 date.
+func parseAnchorDate(value *string) (*time.Time, error) {
+	if value == nil || *value == "" {
+		return nil, nil
+	}
+	anchorDate, err := time.Parse(time.RFC3339, *value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid anchor date %q: %w", *value, err)
+	}
+	return &anchorDate, nil
+}
@@ -7,6 +7,8 @@ import (
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters/automock"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/list"
 	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/pkg/todoclient"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -35,7 +37,7 @@ func TestLists_ListResolver(t *testing.T) {
 		{
 			name:        "successful lists fetch",
 			mockMethod:  "GET",
-			mockURL:     "lists/all",
+			mockURL:     "/lists/user/all",
 			mockResp:    []byte(`[{"ID": "1", "Title": "Test List"}]`),
 			mockErr:     nil,
 			expectError: false,
@@ -44,14 +46,14 @@ func TestLists_ListResolver(t *testing.T) {
 			},
 			listConverter: func() *automock.ListConverter {
 				listConverter := &automock.ListConverter{}
-				listConverter.EXPECT().ConvertListToGraphQL(inputList).Return(&expectedList, nil)
+				listConverter.EXPECT().ConvertMultipleListsToGraphQL([]*models.List{&inputList}).Return([]*graphql.List{&expectedList}, nil)
 				return listConverter
 			},
 		},
 		{
 			name:        "failed HTTP request",
 			mockMethod:  "GET",
-			mockURL:     "lists/all",
+			mockURL:     "/lists/user/all",
 			mockResp:    nil,
 			mockErr:     errors.New("failed to fetch lists"),
 			expectError: true,
@@ -63,7 +65,7 @@ func TestLists_ListResolver(t *testing.T) {
 		{
 			name:        "failed to unmarshal response",
 			mockMethod:  "GET",
-			mockURL:     "lists/all",
+			mockURL:     "/lists/user/all",
 			mockResp:    []byte(`invalid JSON`),
 			mockErr:     nil,
 			expectError: true,
@@ -82,9 +84,9 @@ func TestLists_ListResolver(t *testing.T) {
 
 			listConverter := tt.listConverter()
 
-			r := list.NewResolver(mockClient, listConverter, nil)
+			r := list.NewResolver(todoclient.New(mockClient), listConverter, nil)
 
-			result, err := r.Lists(context.Background())
+			result, err := r.Lists(context.Background(), nil)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -124,7 +126,7 @@ func TestList_ListResolver(t *testing.T) {
 			name:        "successful list fetch by id",
 			id:          "1",
 			mockMethod:  "GET",
-			mockURL:     "lists/1",
+			mockURL:     "/lists/1",
 			mockResp:    []byte(`{"ID": "1", "Title": "Test List"}`),
 			mockErr:     nil,
 			expectError: false,
@@ -139,7 +141,7 @@ func TestList_ListResolver(t *testing.T) {
 			name:        "failed HTTP request",
 			id:          "1",
 			mockMethod:  "GET",
-			mockURL:     "lists/1",
+			mockURL:     "/lists/1",
 			mockResp:    nil,
 			mockErr:     errors.New("failed to fetch list by id"),
 			expectError: true,
@@ -152,7 +154,7 @@ func TestList_ListResolver(t *testing.T) {
 			name:        "failed to unmarshal response",
 			id:          "1",
 			mockMethod:  "GET",
-			mockURL:     "lists/1",
+			mockURL:     "/lists/1",
 			mockResp:    []byte(`invalid JSON`),
 			mockErr:     nil,
 			expectError: true,
@@ -171,7 +173,7 @@ func TestList_ListResolver(t *testing.T) {
 
 			listConverter := tt.listConverter()
 
-			r := list.NewResolver(mockClient, listConverter, nil)
+			r := list.NewResolver(todoclient.New(mockClient), listConverter, nil)
 
 			result, err := r.List(context.Background(), tt.id)
 
@@ -221,8 +223,8 @@ func TestCreateList_ListResolver(t *testing.T) {
 			name:         "successful list creation",
 			input:        input,
 			mockMethod:   "POST",
-			mockURLPost:  "lists/create",
-			mockURLGet:   "lists/1",
+			mockURLPost:  "/lists/create",
+			mockURLGet:   "/lists/1",
 			mockRespPost: []byte(`"1"`),
 			mockRespGet:  []byte(`{"ID": "1", "Title": "Test List"}`),
 			mockErrPost:  nil,
@@ -231,7 +233,7 @@ func TestCreateList_ListResolver(t *testing.T) {
 			expectList:   &expectedList,
 			listConverter: func() *automock.ListConverter {
 				listConverter := &automock.ListConverter{}
-				listConverter.EXPECT().ConvertCreateListInput(input).Return(httpInput, nil)
+				listConverter.EXPECT().ConvertCreateListInput(input, "owner").Return(httpInput, nil)
 				listConverter.EXPECT().ConvertListToGraphQL(models.List{ID: "1"}).Return(&expectedList, nil)
 				return listConverter
 			},
@@ -240,7 +242,7 @@ func TestCreateList_ListResolver(t *testing.T) {
 			name:         "failed POST request",
 			input:        input,
 			mockMethod:   "POST",
-			mockURLPost:  "lists/create",
+			mockURLPost:  "/lists/create",
 			mockRespPost: nil,
 			mockRespGet:  nil,
 			mockErrPost:  errors.New("failed POST request"),
@@ -248,7 +250,7 @@ func TestCreateList_ListResolver(t *testing.T) {
 			expectList:   nil,
 			listConverter: func() *automock.ListConverter {
 				listConverter := &automock.ListConverter{}
-				listConverter.EXPECT().ConvertCreateListInput(input).Return(httpInput, nil)
+				listConverter.EXPECT().ConvertCreateListInput(input, "owner").Return(httpInput, nil)
 				return listConverter
 			},
 		},
@@ -256,8 +258,8 @@ func TestCreateList_ListResolver(t *testing.T) {
 			name:         "failed GET request after list creation",
 			input:        input,
 			mockMethod:   "POST",
-			mockURLPost:  "lists/create",
-			mockURLGet:   "lists/1",
+			mockURLPost:  "/lists/create",
+			mockURLGet:   "/lists/1",
 			mockRespPost: []byte(`"1"`),
 			mockRespGet:  nil,
 			mockErrPost:  nil,
@@ -266,7 +268,7 @@ func TestCreateList_ListResolver(t *testing.T) {
 			expectList:   nil,
 			listConverter: func() *automock.ListConverter {
 				listConverter := &automock.ListConverter{}
-				listConverter.EXPECT().ConvertCreateListInput(input).Return(httpInput, nil)
+				listConverter.EXPECT().ConvertCreateListInput(input, "owner").Return(httpInput, nil)
 				return listConverter
 			},
 		},
@@ -284,9 +286,10 @@ func TestCreateList_ListResolver(t *testing.T) {
 
 			listConverter := tt.listConverter()
 
-			r := list.NewResolver(mockClient, listConverter, nil)
+			r := list.NewResolver(todoclient.New(mockClient), listConverter, nil)
 
-			result, err := r.CreateList(context.Background(), tt.input)
+			ctx := context.WithValue(context.Background(), "user", &jwt.Claims{ID: "owner"})
+			result, err := r.CreateList(ctx, tt.input)
 
 			if tt.expectError {
 				assert.Error(t, err)
@@ -341,8 +344,8 @@ func TestUpdateList_ListResolver(t *testing.T) {
 			input:         input,
 			mockMethodPut: "PUT",
 			mockMethodGet: "GET",
-			mockURLPut:    "lists/1",
-			mockURLGet:    "lists/1",
+			mockURLPut:    "/lists/1",
+			mockURLGet:    "/lists/1",
 			mockRespPut:   nil,
 			mockRespGet:   []byte(`{"ID": "1", "Title": "Updated Test List"}`),
 			mockErrPut:    nil,
@@ -362,8 +365,8 @@ func TestUpdateList_ListResolver(t *testing.T) {
 			input:         input,
 			mockMethodPut: "PUT",
 			mockMethodGet: "GET",
-			mockURLPut:    "lists/1",
-			mockURLGet:    "lists/1",
+			mockURLPut:    "/lists/1",
+			mockURLGet:    "/lists/1",
 			mockRespPut:   nil,
 			mockRespGet:   nil,
 			mockErrPut:    nil,
@@ -382,8 +385,8 @@ func TestUpdateList_ListResolver(t *testing.T) {
 			input:         input,
 			mockMethodPut: "PUT",
 			mockMethodGet: "GET",
-			mockURLPut:    "lists/1",
-			mockURLGet:    "lists/1",
+			mockURLPut:    "/lists/1",
+			mockURLGet:    "/lists/1",
 			mockRespPut:   nil,
 			mockRespGet:   []byte(`invalid JSON`),
 			mockErrPut:    nil,
@@ -407,7 +410,7 @@ func TestUpdateList_ListResolver(t *testing.T) {
 
 			listConverter := tt.listConverter()
 
-			r := list.NewResolver(mockClient, listConverter, nil)
+			r := list.NewResolver(todoclient.New(mockClient), listConverter, nil)
 
 			result, err := r.UpdateList(context.Background(), tt.id, tt.input)
 
@@ -452,8 +455,8 @@ func TestDeleteList_ListResolver(t *testing.T) {
 			id:            "1",
 			mockMethodGet: "GET",
 			mockMethodDel: "DELETE",
-			mockURLGet:    "lists/1",
-			mockURLDel:    "lists/1",
+			mockURLGet:    "/lists/1",
+			mockURLDel:    "/lists/1",
 			mockRespGet:   []byte(`{"ID": "1", "Title": "Test List"}`),
 			mockRespDel:   nil,
 			mockErrGet:    nil,
@@ -471,8 +474,8 @@ func TestDeleteList_ListResolver(t *testing.T) {
 			id:            "1",
 			mockMethodGet: "GET",
 			mockMethodDel: "DELETE",
-			mockURLGet:    "lists/1",
-			mockURLDel:    "lists/1",
+			mockURLGet:    "/lists/1",
+			mockURLDel:    "/lists/1",
 			mockRespGet:   []byte(`{"ID": "1", "Title": "Test List"}`),
 			mockRespDel:   nil,
 			mockErrGet:    nil,
@@ -497,7 +500,7 @@ func TestDeleteList_ListResolver(t *testing.T) {
 
 			listConverter := tt.listConverter()
 
-			r := list.NewResolver(mockClient, listConverter, nil)
+			r := list.NewResolver(todoclient.New(mockClient), listConverter, nil)
 
 			result, err := r.DeleteList(context.Background(), tt.id)
 
@@ -515,3 +518,103 @@ func TestDeleteList_ListResolver(t *testing.T) {
 		})
 	}
 }
+
+func TestTags_ListResolver(t *testing.T) {
+	tests := []struct {
+		name        string
+		mockResp    []byte
+		mockErr     error
+		expectError bool
+		expectTags  []*graphql.Tag
+	}{
+		{
+			name:       "successful tags fetch",
+			mockResp:   []byte(`[{"id": "7", "list_id": "1", "name": "work", "color": "#9e9e9e"}]`),
+			expectTags: []*graphql.Tag{{ID: "7", ListID: "1", Name: "work", Color: "#9e9e9e"}},
+		},
+		{
+			name:        "failed HTTP request",
+			mockErr:     errors.New("failed to fetch tags"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+			mockClient.On("Do", mock.Anything, "GET", "/lists/1/tags", mock.Anything).Return(tt.mockResp, tt.mockErr)
+
+			r := list.NewResolver(todoclient.New(mockClient), nil, nil)
+
+			result, err := r.Tags(context.Background(), "1")
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectTags, result)
+			}
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestColumns_ListResolver(t *testing.T) {
+	statuses := []byte(`[{"id": "s1", "list_id": "1", "name": "To Do", "position": 0, "allowed_next": ["s2"]},
+		{"id": "s2", "list_id": "1", "name": "Done", "position": 1, "done": true, "allowed_next": []}]`)
+	todos := []byte(`[{"id": "3", "list_id": "1", "title": "Second", "priority": "low", "status_id": "s1", "position": "3V"},
+		{"id": "4", "list_id": "1", "title": "Shipped", "priority": "low", "status_id": "s2", "position": "2V", "completed": true},
+		{"id": "5", "list_id": "1", "title": "First", "priority": "low", "status_id": "s1", "position": "1V"}]`)
+
+	tests := []struct {
+		name          string
+		statusesErr   error
+		expectError   bool
+		expectColumns [][]string
+	}{
+		{
+			name:          "todos are grouped by status and ordered by position",
+			expectColumns: [][]string{{"5", "3"}, {"4"}},
+		},
+		{
+			name:        "failed HTTP request",
+			statusesErr: errors.New("failed to fetch statuses"),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+			if tt.statusesErr != nil {
+				mockClient.On("Do", mock.Anything, "GET", "/lists/1/statuses", mock.Anything).Return([]byte(nil), tt.statusesErr)
+			} else {
+				mockClient.On("Do", mock.Anything, "GET", "/lists/1/statuses", mock.Anything).Return(statuses, nil)
+				mockClient.On("Do", mock.Anything, "GET", "/lists/1/todos", mock.Anything).Return(todos, nil)
+			}
+
+			r := list.NewResolver(todoclient.New(mockClient), nil, nil)
+
+			result, err := r.Columns(context.Background(), &graphql.List{ID: "1"})
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				assert.NoError(t, err)
+				assert.Len(t, result, len(tt.expectColumns))
+				for i, column := range result {
+					ids := make([]string, 0, len(column.Todos))
+					for _, todo := range column.Todos {
+						ids = append(ids, todo.ID)
+					}
+					assert.Equal(t, tt.expectColumns[i], ids)
+				}
+				assert.Equal(t, &graphql.Status{ID: "s1", ListID: "1", Name: "To Do", AllowedNext: []string{"s2"}}, result[0].Status)
+				assert.True(t, result[1].Status.Done)
+			}
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
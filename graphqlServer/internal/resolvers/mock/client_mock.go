@@ -3,6 +3,7 @@ package mock
 import (
 	"context"
 	"github.com/stretchr/testify/mock"
+	"io"
 )
 
 type ClientMock struct {
@@ -13,3 +14,8 @@ func (m *ClientMock) Do(ctx context.Context, method, url string, body []byte) ([
 	args := m.Called(ctx, method, url, body)
 	return args.Get(0).([]byte), args.Error(1)
 }
+
+func (m *ClientMock) DoStream(ctx context.Context, method, url, contentType string, body io.Reader) ([]byte, error) {
+	args := m.Called(ctx, method, url, contentType, body)
+	return args.Get(0).([]byte), args.Error(1)
+}
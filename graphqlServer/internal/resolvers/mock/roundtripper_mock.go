@@ -5,20 +5,28 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"sync"
 )
 
 type HTTPResponseMock struct {
 	StatusCode int
 	Body       interface{}
+	Err        error
 }
 
 type RoundTripperMock struct {
 	URLMap map[string]HTTPResponseMock
+
+	mu        sync.Mutex
+	sequences map[string][]HTTPResponseMock
+	calls     map[string]int
 }
 
 func NewRoundTripperMock() *RoundTripperMock {
 	return &RoundTripperMock{
-		URLMap: make(map[string]HTTPResponseMock),
+		URLMap:    make(map[string]HTTPResponseMock),
+		sequences: make(map[string][]HTTPResponseMock),
+		calls:     make(map[string]int),
 	}
 }
 
@@ -26,8 +34,25 @@ func (m *RoundTripperMock) SetResponse(url string, response HTTPResponseMock) {
 	m.URLMap[url] = response
 }
 
+// SetResponseSequence answers consecutive requests to url with responses in order, then falls back to URLMap.
+func (m *RoundTripperMock) SetResponseSequence(url string, responses ...HTTPResponseMock) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.sequences[url] = responses
+}
+
+func (m *RoundTripperMock) Calls(url string) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls[url]
+}
+
 func (m *RoundTripperMock) RoundTrip(req *http.Request) (*http.Response, error) {
-	if mockResponse, found := m.URLMap[req.URL.Path]; found {
+	mockResponse, found := m.next(req.URL.Path)
+	if found {
+		if mockResponse.Err != nil {
+			return nil, mockResponse.Err
+		}
 		bodyBytes, _ := json.Marshal(mockResponse.Body)
 		return &http.Response{
 			StatusCode: mockResponse.StatusCode,
@@ -39,3 +64,15 @@ func (m *RoundTripperMock) RoundTrip(req *http.Request) (*http.Response, error)
 		Body:       ioutil.NopCloser(bytes.NewBuffer([]byte("not found"))),
 	}, nil
 }
+
+func (m *RoundTripperMock) next(url string) (HTTPResponseMock, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.calls[url]++
+	if sequence := m.sequences[url]; len(sequence) > 0 {
+		m.sequences[url] = sequence[1:]
+		return sequence[0], true
+	}
+	mockResponse, found := m.URLMap[url]
+	return mockResponse, found
+}
@@ -2,6 +2,7 @@ package resolvers
 
 import (
 	"context"
+	gqlgen "github.com/99designs/gqlgen/graphql"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 )
@@ -40,6 +41,16 @@ func (r *mutationResolver) DeleteList(ctx context.Context, id string) (*graphql.
 	return r.list.DeleteList(ctx, id)
 }
 
+func (r *mutationResolver) ArchiveList(ctx context.Context, id string) (*graphql.List, error) {
+	log.C(ctx).Info("archiving list mutation resolver")
+	return r.list.ArchiveList(ctx, id)
+}
+
+func (r *mutationResolver) UnarchiveList(ctx context.Context, id string) (*graphql.List, error) {
+	log.C(ctx).Info("unarchiving list mutation resolver")
+	return r.list.UnarchiveList(ctx, id)
+}
+
 func (r *mutationResolver) CreateTodo(ctx context.Context, input graphql.CreateTodoInput) (*graphql.Todo, error) {
 	log.C(ctx).Info("creating todo mutation resolver")
 	return r.todo.CreateTodo(ctx, input)
@@ -55,6 +66,56 @@ func (r *mutationResolver) DeleteTodo(ctx context.Context, id string) (*graphql.
 	return r.todo.DeleteTodo(ctx, id)
 }
 
+func (r *mutationResolver) AddTodoTag(ctx context.Context, id string, tagID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("adding todo tag mutation resolver")
+	return r.todo.AddTodoTag(ctx, id, tagID)
+}
+
+func (r *mutationResolver) RemoveTodoTag(ctx context.Context, id string, tagID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("removing todo tag mutation resolver")
+	return r.todo.RemoveTodoTag(ctx, id, tagID)
+}
+
+func (r *mutationResolver) AddTodoDependency(ctx context.Context, todoID string, blockedByID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("adding todo dependency mutation resolver")
+	return r.todo.AddTodoDependency(ctx, todoID, blockedByID)
+}
+
+func (r *mutationResolver) RemoveTodoDependency(ctx context.Context, todoID string, blockedByID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("removing todo dependency mutation resolver")
+	return r.todo.RemoveTodoDependency(ctx, todoID, blockedByID)
+}
+
+func (r *mutationResolver) AddComment(ctx context.Context, todoID string, body string) (*graphql.Comment, error) {
+	log.C(ctx).Info("adding comment mutation resolver")
+	return r.todo.AddComment(ctx, todoID, body)
+}
+
+func (r *mutationResolver) UploadAttachment(ctx context.Context, todoID string, file gqlgen.Upload) (*graphql.Attachment, error) {
+	log.C(ctx).Info("uploading attachment mutation resolver")
+	return r.todo.UploadAttachment(ctx, todoID, file)
+}
+
+func (r *mutationResolver) DeleteAttachment(ctx context.Context, todoID string, id string) (*bool, error) {
+	log.C(ctx).Info("deleting attachment mutation resolver")
+	return r.todo.DeleteAttachment(ctx, todoID, id)
+}
+
+func (r *mutationResolver) CreateTag(ctx context.Context, input graphql.CreateTagInput) (*graphql.Tag, error) {
+	log.C(ctx).Info("creating tag mutation resolver")
+	return r.list.CreateTag(ctx, input)
+}
+
+func (r *mutationResolver) UpdateTag(ctx context.Context, listID string, id string, input graphql.UpdateTagInput) (*graphql.Tag, error) {
+	log.C(ctx).Info("updating tag mutation resolver")
+	return r.list.UpdateTag(ctx, listID, id, input)
+}
+
+func (r *mutationResolver) DeleteTag(ctx context.Context, listID string, id string) (*bool, error) {
+	log.C(ctx).Info("deleting tag mutation resolver")
+	return r.list.DeleteTag(ctx, listID, id)
+}
+
 func (r *mutationResolver) AddListAccess(ctx context.Context, input graphql.GrantListAccessInput) (*graphql.ListAccess, error) {
 	log.C(ctx).Info("adding list access mutation resolver")
 	return r.list.AddListAccess(ctx, input)
@@ -100,6 +161,61 @@ func (r *mutationResolver) CompleteTodo(ctx context.Context, id string) (*graphq
 	return r.todo.CompleteTodo(ctx, id)
 }
 
+func (r *mutationResolver) MoveTodo(ctx context.Context, todoID string, statusID string, position *int) (*graphql.Todo, error) {
+	log.C(ctx).Info("moving todo mutation resolver")
+	return r.todo.MoveTodo(ctx, todoID, statusID, position)
+}
+
+func (r *mutationResolver) ReorderTodo(ctx context.Context, id string, beforeID *string, afterID *string) (*graphql.Todo, error) {
+	log.C(ctx).Info("reordering todo mutation resolver")
+	return r.todo.ReorderTodo(ctx, id, beforeID, afterID)
+}
+
+func (r *mutationResolver) MoveTodoToList(ctx context.Context, id string, listID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("moving todo to list mutation resolver")
+	return r.todo.MoveTodoToList(ctx, id, listID)
+}
+
+func (r *mutationResolver) MoveTodosToList(ctx context.Context, ids []string, listID string) ([]*graphql.Todo, error) {
+	log.C(ctx).Info("moving todos to list mutation resolver")
+	return r.todo.MoveTodosToList(ctx, ids, listID)
+}
+
+func (r *mutationResolver) BulkUpdateTodos(ctx context.Context, operations []*graphql.BulkTodoOperation) ([]*graphql.BulkTodoResult, error) {
+	log.C(ctx).Info("bulk updating todos mutation resolver")
+	return r.todo.BulkUpdateTodos(ctx, operations)
+}
+
+func (r *mutationResolver) CopyTodo(ctx context.Context, id string, listID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("copying todo mutation resolver")
+	return r.todo.CopyTodo(ctx, id, listID)
+}
+
+func (r *mutationResolver) ReorderList(ctx context.Context, id string, beforeID *string, afterID *string) (*graphql.List, error) {
+	log.C(ctx).Info("reordering list mutation resolver")
+	return r.list.ReorderList(ctx, id, beforeID, afterID)
+}
+
+func (r *mutationResolver) CloneList(ctx context.Context, id string, input *graphql.CloneListInput) (*graphql.List, error) {
+	log.C(ctx).Info("cloning list mutation resolver")
+	return r.list.CloneList(ctx, id, input)
+}
+
+func (r *mutationResolver) SaveListAsTemplate(ctx context.Context, listID string, name string, description *string) (*graphql.ListTemplate, error) {
+	log.C(ctx).Info("saving list as template mutation resolver")
+	return r.list.SaveListAsTemplate(ctx, listID, name, description)
+}
+
+func (r *mutationResolver) CreateListFromTemplate(ctx context.Context, templateID string, name *string, anchorDate *string) (*graphql.List, error) {
+	log.C(ctx).Info("creating list from template mutation resolver")
+	return r.list.CreateListFromTemplate(ctx, templateID, name, anchorDate)
+}
+
+func (r *mutationResolver) DeleteTemplate(ctx context.Context, id string) (*bool, error) {
+	log.C(ctx).Info("deleting template mutation resolver")
+	return r.list.DeleteTemplate(ctx, id)
+}
+
 func (r *mutationResolver) AcceptList(ctx context.Context, listID string) (*bool, error) {
 	log.C(ctx).Info("accepting list access mutation resolver")
 	return r.list.AcceptList(ctx, listID)
@@ -41,9 +41,9 @@ func (r *queryResolver) UserByEmail(ctx context.Context) (*graphql.User, error)
 	return r.user.UserByEmail(ctx)
 }
 
-func (r *queryResolver) Lists(ctx context.Context) ([]*graphql.List, error) {
+func (r *queryResolver) Lists(ctx context.Context, includeArchived *bool) ([]*graphql.List, error) {
 	log.C(ctx).Info("queryResolver lists")
-	return r.list.Lists(ctx)
+	return r.list.Lists(ctx, includeArchived)
 }
 
 func (r *queryResolver) List(ctx context.Context, id string) (*graphql.List, error) {
@@ -61,9 +61,9 @@ func (r *queryResolver) Todo(ctx context.Context, id string) (*graphql.Todo, err
 	return r.todo.Todo(ctx, id)
 }
 
-func (r *queryResolver) TodosByList(ctx context.Context, id string) ([]*graphql.Todo, error) {
+func (r *queryResolver) TodosByList(ctx context.Context, id string, tagID *string) ([]*graphql.Todo, error) {
 	log.C(ctx).Infof("queryResolver todos by list with id %s", id)
-	return r.todo.TodosByList(ctx, id)
+	return r.todo.TodosByList(ctx, id, tagID)
 }
 
 func (r *queryResolver) ListsPending(ctx context.Context) ([]*graphql.List, error) {
@@ -85,3 +85,13 @@ func (r *queryResolver) ListsAccepted(ctx context.Context) ([]*graphql.List, err
 	log.C(ctx).Info("queryResolve ListsAccepted")
 	return r.list.ListsAccepted(ctx)
 }
+
+func (r *queryResolver) Tags(ctx context.Context, listID string) ([]*graphql.Tag, error) {
+	log.C(ctx).Info("queryResolver tags")
+	return r.list.Tags(ctx, listID)
+}
+
+func (r *queryResolver) Templates(ctx context.Context) ([]*graphql.ListTemplate, error) {
+	log.C(ctx).Info("queryResolver templates")
+	return r.list.Templates(ctx)
+}
@@ -4,11 +4,11 @@ import (
 	"context"
 	graph "github.com/Victor-Uzunov/devops-project/graphqlServer/generated"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
-	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/list"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/todo"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/user"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/pkg/todoclient"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 )
 
@@ -20,7 +20,7 @@ type RootResolver struct {
 	todo *todo.Resolver
 }
 
-func NewRootResolver(todoService client.Client) *RootResolver {
+func NewRootResolver(todoService *todoclient.Client) *RootResolver {
 	listConverter := converters.NewConverterListGraphQL()
 	todoConverter := converters.NewConverterTodoGraphQL()
 	userConverter := converters.NewConverterUserGraphQL()
@@ -48,6 +48,10 @@ func (r *RootResolver) Todo() graph.TodoResolver {
 	return &todoResolver{r}
 }
 
+func (r *RootResolver) Comment() graph.CommentResolver {
+	return &commentResolver{r}
+}
+
 type todoResolver struct {
 	*RootResolver
 }
@@ -57,6 +61,26 @@ func (r *todoResolver) AssignedTo(ctx context.Context, obj *graphql.Todo) (*grap
 	return r.todo.AssignedTo(ctx, obj)
 }
 
+func (r *todoResolver) Attachments(ctx context.Context, obj *graphql.Todo) ([]*graphql.Attachment, error) {
+	log.C(ctx).Info("todoResolver.Attachments")
+	return r.todo.Attachments(ctx, obj)
+}
+
+func (r *todoResolver) BlockedBy(ctx context.Context, obj *graphql.Todo) ([]*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver.BlockedBy")
+	return r.todo.BlockedBy(ctx, obj)
+}
+
+func (r *todoResolver) Blocks(ctx context.Context, obj *graphql.Todo) ([]*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver.Blocks")
+	return r.todo.Blocks(ctx, obj)
+}
+
+func (r *todoResolver) Comments(ctx context.Context, obj *graphql.Todo, limit *int, offset *int) (*graphql.CommentPage, error) {
+	log.C(ctx).Info("todoResolver.Comments")
+	return r.todo.Comments(ctx, obj, limit, offset)
+}
+
 func (r *todoResolver) List(ctx context.Context, obj *graphql.Todo) (*graphql.List, error) {
 	log.C(ctx).Info("todoResolver.List")
 	return r.todo.List(ctx, obj)
@@ -76,7 +100,26 @@ func (l *listResolver) Todos(ctx context.Context, obj *graphql.List) ([]*graphql
 	return l.list.Todos(ctx, obj)
 }
 
+func (l *listResolver) Columns(ctx context.Context, obj *graphql.List) ([]*graphql.Column, error) {
+	log.C(ctx).Info("listResolver.Columns")
+	return l.list.Columns(ctx, obj)
+}
+
 func (l *listResolver) Collaborators(ctx context.Context, obj *graphql.List) ([]*graphql.ListAccess, error) {
 	log.C(ctx).Info("listResolver.Collaborators")
 	return l.list.Collaborators(ctx, obj)
 }
+
+type commentResolver struct {
+	*RootResolver
+}
+
+func (c *commentResolver) Author(ctx context.Context, obj *graphql.Comment) (*graphql.User, error) {
+	log.C(ctx).Info("commentResolver.Author")
+	return c.todo.CommentAuthor(ctx, obj)
+}
+
+func (c *commentResolver) Mentions(ctx context.Context, obj *graphql.Comment) ([]*graphql.User, error) {
+	log.C(ctx).Info("commentResolver.Mentions")
+	return c.todo.CommentMentions(ctx, obj)
+}
@@ -2,31 +2,31 @@ package todo
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
+	gqlgen "github.com/99designs/gqlgen/graphql"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
-	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/loaders"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/pkg/todoclient"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
-	"net/http"
 )
 
 type Resolver struct {
-	httpClient client.Client
+	todoClient *todoclient.Client
 	todoConv   converters.TodoConverter
 	listConv   converters.ListConverter
 	userConv   converters.UserConverter
 }
 
 func NewResolver(
-	client client.Client,
+	client *todoclient.Client,
 	converter converters.TodoConverter,
 	listConverter converters.ListConverter,
 	userConverter converters.UserConverter,
 ) *Resolver {
 	return &Resolver{
-		httpClient: client,
+		todoClient: client,
 		todoConv:   converter,
 		listConv:   listConverter,
 		userConv:   userConverter,
@@ -35,19 +35,14 @@ func NewResolver(
 
 func (r *Resolver) TodosGlobal(ctx context.Context) ([]*graphql.Todo, error) {
 	log.C(ctx).Info("todoResolver called todos global")
-	response, err := r.httpClient.Do(ctx, http.MethodGet, "/todos/all", nil)
+	todos, err := r.todoClient.ListAllTodos(ctx)
 	if err != nil {
 		log.C(ctx).Errorf("error getting todos: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 
-	var todos []*models.Todo
-	if err = json.Unmarshal(response, &todos); err != nil {
-		log.C(ctx).Errorf("error unmarshalling todos: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
-	var result, errConverting = r.todoConv.ConvertMultipleTodoToGraphQL(todos)
-	if errConverting != nil {
+	result, err := r.todoConv.ConvertMultipleTodoToGraphQL(todos)
+	if err != nil {
 		log.C(ctx).Errorf("failed converting multiple todos to graphql: %v", err)
 		return nil, fmt.Errorf("error while converting multiple todos to graphql: %w", err)
 	}
@@ -56,19 +51,14 @@ func (r *Resolver) TodosGlobal(ctx context.Context) ([]*graphql.Todo, error) {
 
 func (r *Resolver) Todos(ctx context.Context) ([]*graphql.Todo, error) {
 	log.C(ctx).Info("todoResolver called todos")
-	response, err := r.httpClient.Do(ctx, http.MethodGet, "/todos/user/all", nil)
+	todos, err := r.todoClient.ListUserTodos(ctx)
 	if err != nil {
 		log.C(ctx).Errorf("error getting todos: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 
-	var todos []*models.Todo
-	if err = json.Unmarshal(response, &todos); err != nil {
-		log.C(ctx).Errorf("error unmarshalling todos: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
-	var result, errConverting = r.todoConv.ConvertMultipleTodoToGraphQL(todos)
-	if errConverting != nil {
+	result, err := r.todoConv.ConvertMultipleTodoToGraphQL(todos)
+	if err != nil {
 		log.C(ctx).Errorf("failed converting multiple todos to graphql: %v", err)
 		return nil, fmt.Errorf("error while converting multiple todos to graphql: %w", err)
 	}
@@ -77,27 +67,13 @@ func (r *Resolver) Todos(ctx context.Context) ([]*graphql.Todo, error) {
 
 func (r *Resolver) Todo(ctx context.Context, id string) (*graphql.Todo, error) {
 	log.C(ctx).Info("todoResolver called todo")
-	url := fmt.Sprintf("/todos/%s", id)
-
-	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	todo, err := r.todoClient.GetTodo(ctx, id)
 	if err != nil {
 		log.C(ctx).Errorf("error getting todo: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 
-	var todo models.Todo
-	if err = json.Unmarshal(response, &todo); err != nil {
-		log.C(ctx).Errorf("error unmarshalling response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
-
-	graphTodo, err := r.todoConv.ConvertTodoToGraphQL(todo)
-	log.C(ctx).Debugf("converted todo: %v", graphTodo)
-	if err != nil {
-		log.C(ctx).Errorf("error converting todos: %v", err)
-		return nil, fmt.Errorf("error converting todo: %w", err)
-	}
-	return graphTodo, nil
+	return r.convertTodo(ctx, todo)
 }
 
 func (r *Resolver) List(ctx context.Context, obj *graphql.Todo) (*graphql.List, error) {
@@ -105,63 +81,33 @@ func (r *Resolver) List(ctx context.Context, obj *graphql.Todo) (*graphql.List,
 	if obj == nil {
 		return nil, nil
 	}
-	todo, err := r.getTodo(ctx, obj.ID)
+	if obj.List == nil || obj.List.ID == "" {
+		log.C(ctx).Errorf("todo %s has no list", obj.ID)
+		return nil, fmt.Errorf("todo %s has no list", obj.ID)
+	}
+	l, err := loaders.For(ctx)
 	if err != nil {
-		log.C(ctx).Errorf("error getting todo: %v", err)
+		log.C(ctx).Errorf("error getting loaders: %v", err)
 		return nil, err
 	}
-	url := fmt.Sprintf("/lists/%s", todo.ListID)
-	body, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
-	log.C(ctx).Debugf("body: %v", string(body))
+	list, err := l.ListByID.Load(ctx, obj.List.ID)
 	if err != nil {
-		log.C(ctx).Errorf("error getting todo: %v", err)
-		return nil, fmt.Errorf("error executing request: %w", err)
+		log.C(ctx).Errorf("error loading list: %v", err)
+		return nil, fmt.Errorf("error loading list: %w", err)
 	}
-	var l models.List
-
-	if err = json.Unmarshal(body, &l); err != nil {
-		log.C(ctx).Errorf("error unmarshalling response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	if list == nil {
+		log.C(ctx).Errorf("list %s not found", obj.List.ID)
+		return nil, fmt.Errorf("list %s not found", obj.List.ID)
 	}
-	return r.listConv.ConvertListToGraphQL(l)
+	return r.listConv.ConvertListToGraphQL(*list)
 }
 
 func (r *Resolver) AssignedTo(ctx context.Context, obj *graphql.Todo) (*graphql.User, error) {
 	log.C(ctx).Info("todoResolver called for assigned to")
-	if obj == nil {
-		return nil, nil
-	}
-	todo, err := r.getTodo(ctx, obj.ID)
-	if err != nil {
-		log.C(ctx).Errorf("error getting todo: %v", err)
-		return nil, err
-	}
-	if todo.AssignedTo == nil {
+	if obj == nil || obj.AssignedTo == nil {
 		return nil, nil
 	}
-	log.C(ctx).Debugf("todo: %v", todo)
-	url := fmt.Sprintf("/users/%s", *todo.AssignedTo)
-	body, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
-	if err != nil {
-		log.C(ctx).Errorf("error getting todo: %v", err)
-		return nil, fmt.Errorf("error executing request: %w", err)
-	}
-	log.C(ctx).Debugf("body: %v", string(body))
-
-	var u models.User
-	if err = json.Unmarshal(body, &u); err != nil {
-		log.C(ctx).Errorf("error unmarshalling response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
-
-	graphqlUser, err := r.userConv.ConvertUserToGraphQL(u)
-	if err != nil {
-		log.C(ctx).Errorf("error converting users: %v", err)
-		return nil, fmt.Errorf("error converting user: %w", err)
-	}
-	log.C(ctx).Debugf("converted user to graphql model: %v", graphqlUser)
-
-	return graphqlUser, nil
+	return r.loadUser(ctx, obj.AssignedTo.ID)
 }
 
 func (r *Resolver) CreateTodo(ctx context.Context, input graphql.CreateTodoInput) (*graphql.Todo, error) {
@@ -171,44 +117,21 @@ func (r *Resolver) CreateTodo(ctx context.Context, input graphql.CreateTodoInput
 		log.C(ctx).Errorf("error converting todos: %v", err)
 		return nil, fmt.Errorf("error converting create todo input to struct: %w", err)
 	}
-	body, err := json.Marshal(httpInput)
-	if err != nil {
-		log.C(ctx).Errorf("error marshalling response: %v", err)
-		return nil, fmt.Errorf("error marshalling user: %v", err)
-	}
-	log.C(ctx).Debugf("body: %v", string(body))
 
-	response, err := r.httpClient.Do(ctx, http.MethodPost, "/todos/create", body)
+	id, err := r.todoClient.CreateTodo(ctx, todoclient.TodoInput{Todo: httpInput, TagIDs: input.TagIds})
 	if err != nil {
 		log.C(ctx).Errorf("error executing request: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	log.C(ctx).Debugf("todo: %v", response)
-
-	var id string
-
-	if err = json.Unmarshal(response, &id); err != nil {
-		log.C(ctx).Errorf("error unmarshalling response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
-
-	url := fmt.Sprintf("/todos/%s", id)
 	log.C(ctx).Debugf("id of the todo: %s", id)
 
-	responseGet, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	todo, err := r.todoClient.GetTodo(ctx, id)
 	if err != nil {
 		log.C(ctx).Errorf("error getting todo: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	log.C(ctx).Debugf("todo response get: %v", responseGet)
 
-	var todo models.Todo
-	if err = json.Unmarshal(responseGet, &todo); err != nil {
-		log.C(ctx).Errorf("error unmarshalling response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
-
-	return r.todoConv.ConvertTodoToGraphQL(todo)
+	return r.todoConv.ConvertTodoToGraphQL(*todo)
 }
 
 func (r *Resolver) UpdateTodo(ctx context.Context, id string, input graphql.UpdateTodoInput) (*graphql.Todo, error) {
@@ -218,78 +141,53 @@ func (r *Resolver) UpdateTodo(ctx context.Context, id string, input graphql.Upda
 		log.C(ctx).Errorf("error converting todos: %v", err)
 		return nil, fmt.Errorf("error converting update todo input to struct: %w", err)
 	}
-	body, err := json.Marshal(httpInput)
-	if err != nil {
-		log.C(ctx).Errorf("error marshalling response: %v", err)
-		return nil, fmt.Errorf("error marshalling user: %v", err)
-	}
-	log.C(ctx).Debugf("body: %v", string(body))
-
-	url := fmt.Sprintf("/todos/%s", id)
 
-	_, err = r.httpClient.Do(ctx, http.MethodPut, url, body)
-	if err != nil {
+	if err = r.todoClient.UpdateTodo(ctx, id, todoclient.TodoInput{Todo: httpInput, TagIDs: input.TagIds}); err != nil {
 		log.C(ctx).Errorf("error executing request: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 
-	responseGet, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	todo, err := r.todoClient.GetTodo(ctx, id)
 	if err != nil {
 		log.C(ctx).Errorf("error getting todo: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	log.C(ctx).Debugf("todo response get: %v", responseGet)
-
-	var t models.Todo
+	log.C(ctx).Debugf("todo response get: %v", todo)
 
-	if err = json.Unmarshal(responseGet, &t); err != nil {
-		log.C(ctx).Errorf("error unmarshalling response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
-
-	return r.todoConv.ConvertTodoToGraphQL(t)
+	return r.todoConv.ConvertTodoToGraphQL(*todo)
 }
 
 func (r *Resolver) DeleteTodo(ctx context.Context, id string) (*graphql.Todo, error) {
 	log.C(ctx).Info("todoResolver called delete")
-	url := fmt.Sprintf("/todos/%s", id)
-
-	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	todo, err := r.todoClient.GetTodo(ctx, id)
 	if err != nil {
 		log.C(ctx).Errorf("error getting todo: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	log.C(ctx).Debugf("todo response: %v", response)
-
-	var t models.Todo
-	if err = json.Unmarshal(response, &t); err != nil {
-		log.C(ctx).Errorf("error unmarshalling response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
+	log.C(ctx).Debugf("todo response: %v", todo)
 
-	_, err = r.httpClient.Do(ctx, http.MethodDelete, url, nil)
-	if err != nil {
+	if err = r.todoClient.DeleteTodo(ctx, id); err != nil {
 		log.C(ctx).Errorf("error executing request: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 
-	return r.todoConv.ConvertTodoToGraphQL(t)
+	return r.todoConv.ConvertTodoToGraphQL(*todo)
 }
 
-func (r *Resolver) TodosByList(ctx context.Context, id string) ([]*graphql.Todo, error) {
+// TodosByList returns the todos of the list, only those tagged with tagID when it is set.
+func (r *Resolver) TodosByList(ctx context.Context, id string, tagID *string) ([]*graphql.Todo, error) {
 	log.C(ctx).Infof("todoResolver for TodoByList is called")
-	url := fmt.Sprintf("/lists/%s/todos", id)
-
-	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	var todos []*models.Todo
+	var err error
+	if tagID != nil {
+		todos, err = r.todoClient.ListTodosByTag(ctx, id, *tagID)
+	} else {
+		todos, err = r.todoClient.ListTodosByList(ctx, id)
+	}
 	if err != nil {
 		log.C(ctx).Errorf("error getting todos for listID %s: %v", id, err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	var todos []*models.Todo
-	if err = json.Unmarshal(response, &todos); err != nil {
-		log.C(ctx).Errorf("error unmarshalling response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
 	result, err := r.todoConv.ConvertMultipleTodoToGraphQL(todos)
 	if err != nil {
 		log.C(ctx).Errorf("failed converting multiple todos to graphql: %v", err)
@@ -300,107 +198,237 @@ func (r *Resolver) TodosByList(ctx context.Context, id string) ([]*graphql.Todo,
 
 func (r *Resolver) UpdateTodoTitle(ctx context.Context, id string, title string) (*graphql.Todo, error) {
 	log.C(ctx).Info("todoResolver called update todo title")
-	url := fmt.Sprintf("/todos/%s/title", id)
-
-	var updateData struct {
-		Title string `json:"title"`
+	todo, err := r.todoClient.UpdateTodoTitle(ctx, id, title)
+	if err != nil {
+		log.C(ctx).Errorf("error updating todo title: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
+	return r.convertTodo(ctx, todo)
+}
 
-	updateData.Title = title
+func (r *Resolver) UpdateTodoDescription(ctx context.Context, id string, description string) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called update todo description")
+	todo, err := r.todoClient.UpdateTodoDescription(ctx, id, description)
+	if err != nil {
+		log.C(ctx).Errorf("error updating todo description: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.convertTodo(ctx, todo)
+}
 
-	body, err := json.Marshal(updateData)
+func (r *Resolver) UpdateTodoPriority(ctx context.Context, id string, priority graphql.Priority) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called update todo priority")
+	level, err := converters.ConvertPriorityFromGraphQL(priority)
 	if err != nil {
-		log.C(ctx).Errorf("failed to marshal title for the todo: %v", err)
-		return nil, fmt.Errorf("error marshalling title: %v", err)
+		log.C(ctx).Errorf("error converting priority: %v", err)
+		return nil, fmt.Errorf("error converting priority: %w", err)
 	}
 
-	response, err := r.httpClient.Do(ctx, http.MethodPatch, url, body)
+	todo, err := r.todoClient.UpdateTodoPriority(ctx, id, level)
 	if err != nil {
-		log.C(ctx).Errorf("error getting todo: %v", err)
+		log.C(ctx).Errorf("error updating todo priority: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
+	return r.convertTodo(ctx, todo)
+}
 
-	var todo models.Todo
-	if err = json.Unmarshal(response, &todo); err != nil {
-		log.C(ctx).Errorf("error unmarshalling response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+func (r *Resolver) UpdateTodoAssignTo(ctx context.Context, id string, userID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called update todo assigned to user")
+	todo, err := r.todoClient.AssignTodo(ctx, id, userID)
+	if err != nil {
+		log.C(ctx).Errorf("error assigning todo: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
+	return r.convertTodo(ctx, todo)
+}
 
-	graphTodo, err := r.todoConv.ConvertTodoToGraphQL(todo)
+func (r *Resolver) CompleteTodo(ctx context.Context, id string) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called complete todo")
+	todo, err := r.todoClient.CompleteTodo(ctx, id)
 	if err != nil {
-		log.C(ctx).Errorf("error converting todos: %v", err)
-		return nil, fmt.Errorf("error converting todo: %w", err)
+		log.C(ctx).Errorf("error completing todo: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	log.C(ctx).Debugf("converted todo: %v", graphTodo)
-	return graphTodo, nil
+	return r.convertTodo(ctx, todo)
 }
 
-func (r *Resolver) UpdateTodoDescription(ctx context.Context, id string, description string) (*graphql.Todo, error) {
-	log.C(ctx).Info("todoResolver called update todo description")
-	url := fmt.Sprintf("/todos/%s/description", id)
+// MoveTodo moves the todo to another status of its list, or reorders it within its status.
+func (r *Resolver) MoveTodo(ctx context.Context, todoID string, statusID string, position *int) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called move todo")
+	todo, err := r.todoClient.MoveTodo(ctx, todoID, statusID, position)
+	if err != nil {
+		log.C(ctx).Errorf("error moving todo: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.convertTodo(ctx, todo)
+}
 
-	var updateData struct {
-		Description string `json:"description"`
+// ReorderTodo puts the todo right before the todo beforeID and right after the todo afterID of its list.
+func (r *Resolver) ReorderTodo(ctx context.Context, id string, beforeID *string, afterID *string) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called reorder todo")
+	var before, after string
+	if beforeID != nil {
+		before = *beforeID
 	}
+	if afterID != nil {
+		after = *afterID
+	}
+	todo, err := r.todoClient.ReorderTodo(ctx, id, before, after)
+	if err != nil {
+		log.C(ctx).Errorf("error reordering todo: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.convertTodo(ctx, todo)
+}
 
-	updateData.Description = description
+func (r *Resolver) MoveTodoToList(ctx context.Context, id string, listID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called move todo to list")
+	todo, err := r.todoClient.MoveTodoToList(ctx, id, listID)
+	if err != nil {
+		log.C(ctx).Errorf("error moving todo to list: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.convertTodo(ctx, todo)
+}
 
-	body, err := json.Marshal(updateData)
+func (r *Resolver) MoveTodosToList(ctx context.Context, ids []string, listID string) ([]*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called move todos to list")
+	todos, err := r.todoClient.MoveTodosToList(ctx, ids, listID)
 	if err != nil {
-		log.C(ctx).Errorf("failed to marshal description for the todo: %v", err)
-		return nil, fmt.Errorf("error marshalling description: %v", err)
+		log.C(ctx).Errorf("error moving todos to list: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.convertTodos(ctx, todos)
+}
+
+// BulkUpdateTodos applies the operations in one request. A failed operation is reported in its result with
+// the status and the error it got, the others are applied regardless.
+func (r *Resolver) BulkUpdateTodos(ctx context.Context, operations []*graphql.BulkTodoOperation) ([]*graphql.BulkTodoResult, error) {
+	log.C(ctx).Info("todoResolver called bulk update todos")
+	request := make([]todoclient.BulkOperation, 0, len(operations))
+	for _, operation := range operations {
+		converted, err := convertBulkOperation(operation)
+		if err != nil {
+			log.C(ctx).Errorf("error converting bulk operation: %v", err)
+			return nil, err
+		}
+		request = append(request, converted)
 	}
 
-	response, err := r.httpClient.Do(ctx, http.MethodPatch, url, body)
+	results, err := r.todoClient.BulkUpdateTodos(ctx, request)
 	if err != nil {
-		log.C(ctx).Errorf("error getting todo: %v", err)
+		log.C(ctx).Errorf("error bulk updating todos: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 
-	var todo models.Todo
-	if err = json.Unmarshal(response, &todo); err != nil {
-		log.C(ctx).Errorf("error unmarshalling response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	response := make([]*graphql.BulkTodoResult, 0, len(results))
+	for _, result := range results {
+		action, err := converters.ConvertBulkActionToGraphQL(result.Action)
+		if err != nil {
+			return nil, err
+		}
+		item := &graphql.BulkTodoResult{
+			Action:  action,
+			TodoID:  result.TodoID,
+			Success: result.Error == "",
+			Status:  result.Status,
+		}
+		if result.Error != "" {
+			item.Error = &result.Error
+		}
+		if result.Todo != nil {
+			if item.Todo, err = r.convertTodo(ctx, result.Todo); err != nil {
+				return nil, err
+			}
+		}
+		response = append(response, item)
+	}
+	return response, nil
+}
+
+func (r *Resolver) CopyTodo(ctx context.Context, id string, listID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called copy todo")
+	todo, err := r.todoClient.CopyTodo(ctx, id, listID)
+	if err != nil {
+		log.C(ctx).Errorf("error copying todo: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
+	return r.convertTodo(ctx, todo)
+}
 
-	graphTodo, err := r.todoConv.ConvertTodoToGraphQL(todo)
+func (r *Resolver) AddTodoTag(ctx context.Context, id string, tagID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called add todo tag")
+	todo, err := r.todoClient.AddTodoTag(ctx, id, tagID)
 	if err != nil {
-		log.C(ctx).Errorf("error converting todos: %v", err)
-		return nil, fmt.Errorf("error converting todo: %w", err)
+		log.C(ctx).Errorf("error adding todo tag: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	log.C(ctx).Debugf("converted todo: %v", graphTodo)
-	return graphTodo, nil
+	return r.convertTodo(ctx, todo)
 }
 
-func (r *Resolver) UpdateTodoPriority(ctx context.Context, id string, priority graphql.Priority) (*graphql.Todo, error) {
-	log.C(ctx).Info("todoResolver called update todo priority")
-	url := fmt.Sprintf("/todos/%s/priority", id)
+func (r *Resolver) RemoveTodoTag(ctx context.Context, id string, tagID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called remove todo tag")
+	todo, err := r.todoClient.RemoveTodoTag(ctx, id, tagID)
+	if err != nil {
+		log.C(ctx).Errorf("error removing todo tag: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.convertTodo(ctx, todo)
+}
 
-	var updateData struct {
-		Priority graphql.Priority `json:"priority"`
+func (r *Resolver) AddTodoDependency(ctx context.Context, todoID string, blockedByID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called add todo dependency")
+	todo, err := r.todoClient.AddTodoDependency(ctx, todoID, blockedByID)
+	if err != nil {
+		log.C(ctx).Errorf("error adding todo dependency: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
+	return r.convertTodo(ctx, todo)
+}
 
-	updateData.Priority = priority
+func (r *Resolver) RemoveTodoDependency(ctx context.Context, todoID string, blockedByID string) (*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called remove todo dependency")
+	todo, err := r.todoClient.RemoveTodoDependency(ctx, todoID, blockedByID)
+	if err != nil {
+		log.C(ctx).Errorf("error removing todo dependency: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+	return r.convertTodo(ctx, todo)
+}
 
-	body, err := json.Marshal(updateData)
+// BlockedBy resolves the todos that the todo waits for.
+func (r *Resolver) BlockedBy(ctx context.Context, obj *graphql.Todo) ([]*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called blocked by")
+	todos, err := r.todoClient.ListTodoBlockers(ctx, obj.ID)
 	if err != nil {
-		log.C(ctx).Errorf("failed to marshal priority for the todo: %v", err)
-		return nil, fmt.Errorf("error marshalling priority: %v", err)
+		log.C(ctx).Errorf("error getting todo blockers: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
+	return r.convertTodos(ctx, todos)
+}
 
-	response, err := r.httpClient.Do(ctx, http.MethodPatch, url, body)
+// Blocks resolves the todos that wait for the todo.
+func (r *Resolver) Blocks(ctx context.Context, obj *graphql.Todo) ([]*graphql.Todo, error) {
+	log.C(ctx).Info("todoResolver called blocks")
+	todos, err := r.todoClient.ListBlockedTodos(ctx, obj.ID)
 	if err != nil {
-		log.C(ctx).Errorf("error getting todo: %v", err)
+		log.C(ctx).Errorf("error getting blocked todos: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
+	return r.convertTodos(ctx, todos)
+}
 
-	var todo models.Todo
-	if err = json.Unmarshal(response, &todo); err != nil {
-		log.C(ctx).Errorf("error unmarshalling response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+func (r *Resolver) convertTodos(ctx context.Context, todos []*models.Todo) ([]*graphql.Todo, error) {
+	result, err := r.todoConv.ConvertMultipleTodoToGraphQL(todos)
+	if err != nil {
+		log.C(ctx).Errorf("failed converting multiple todos to graphql: %v", err)
+		return nil, fmt.Errorf("error while converting multiple todos to graphql: %w", err)
 	}
+	return result, nil
+}
 
-	graphTodo, err := r.todoConv.ConvertTodoToGraphQL(todo)
+func (r *Resolver) convertTodo(ctx context.Context, todo *models.Todo) (*graphql.Todo, error) {
+	graphTodo, err := r.todoConv.ConvertTodoToGraphQL(*todo)
 	if err != nil {
 		log.C(ctx).Errorf("error converting todos: %v", err)
 		return nil, fmt.Errorf("error converting todo: %w", err)
@@ -409,80 +437,134 @@ func (r *Resolver) UpdateTodoPriority(ctx context.Context, id string, priority g
 	return graphTodo, nil
 }
 
-func (r *Resolver) UpdateTodoAssignTo(ctx context.Context, id string, userID string) (*graphql.Todo, error) {
-	log.C(ctx).Info("todoResolver called update todo assigned to user")
-	url := fmt.Sprintf("/todos/%s/assign_to", id)
-
-	var updateData struct {
-		UserID string `json:"user_id"`
+// Comments returns a page of the comments of the todo, oldest first.
+func (r *Resolver) Comments(ctx context.Context, obj *graphql.Todo, limit *int, offset *int) (*graphql.CommentPage, error) {
+	log.C(ctx).Info("todoResolver called comments")
+	var pageLimit, pageOffset int
+	if limit != nil {
+		pageLimit = *limit
 	}
-
-	updateData.UserID = userID
-
-	body, err := json.Marshal(updateData)
+	if offset != nil {
+		pageOffset = *offset
+	}
+	page, err := r.todoClient.ListComments(ctx, obj.ID, pageLimit, pageOffset)
 	if err != nil {
-		log.C(ctx).Errorf("failed to marshal userID for the todo: %v", err)
-		return nil, fmt.Errorf("error marshalling userID: %v", err)
+		log.C(ctx).Errorf("error getting comments: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
+	return converters.ConvertCommentPageToGraphQL(*page), nil
+}
 
-	response, err := r.httpClient.Do(ctx, http.MethodPatch, url, body)
+func (r *Resolver) AddComment(ctx context.Context, todoID string, body string) (*graphql.Comment, error) {
+	log.C(ctx).Info("todoResolver called add comment")
+	comment, err := r.todoClient.AddComment(ctx, todoID, body)
 	if err != nil {
-		log.C(ctx).Errorf("error getting todo: %v", err)
+		log.C(ctx).Errorf("error adding comment: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
+	return converters.ConvertCommentToGraphQL(*comment), nil
+}
 
-	var todo models.Todo
-	if err = json.Unmarshal(response, &todo); err != nil {
-		log.C(ctx).Errorf("error unmarshalling response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+func (r *Resolver) Attachments(ctx context.Context, obj *graphql.Todo) ([]*graphql.Attachment, error) {
+	log.C(ctx).Info("todoResolver called attachments")
+	attachments, err := r.todoClient.ListAttachments(ctx, obj.ID)
+	if err != nil {
+		log.C(ctx).Errorf("error getting attachments: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
+	result := make([]*graphql.Attachment, 0, len(attachments))
+	for _, attachment := range attachments {
+		result = append(result, converters.ConvertAttachmentToGraphQL(*attachment))
+	}
+	return result, nil
+}
 
-	graphTodo, err := r.todoConv.ConvertTodoToGraphQL(todo)
+// UploadAttachment forwards the uploaded file to the todo service, which checks its size and content type.
+func (r *Resolver) UploadAttachment(ctx context.Context, todoID string, file gqlgen.Upload) (*graphql.Attachment, error) {
+	log.C(ctx).Info("todoResolver called upload attachment")
+	attachment, err := r.todoClient.UploadAttachment(ctx, todoID, file.Filename, file.File)
 	if err != nil {
-		log.C(ctx).Errorf("error converting todos: %v", err)
-		return nil, fmt.Errorf("error converting todo: %w", err)
+		log.C(ctx).Errorf("error uploading attachment: %v", err)
+		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	log.C(ctx).Debugf("converted todo: %v", graphTodo)
-	return graphTodo, nil
+	return converters.ConvertAttachmentToGraphQL(*attachment), nil
 }
 
-func (r *Resolver) CompleteTodo(ctx context.Context, id string) (*graphql.Todo, error) {
-	log.C(ctx).Info("todoResolver called complete todo")
-	url := fmt.Sprintf("/todos/%s/complete", id)
-
-	response, err := r.httpClient.Do(ctx, http.MethodPatch, url, nil)
-	if err != nil {
-		log.C(ctx).Errorf("error getting todo: %v", err)
+func (r *Resolver) DeleteAttachment(ctx context.Context, todoID string, id string) (*bool, error) {
+	log.C(ctx).Info("todoResolver called delete attachment")
+	if err := r.todoClient.DeleteAttachment(ctx, todoID, id); err != nil {
+		log.C(ctx).Errorf("error deleting attachment: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
+	success := true
+	return &success, nil
+}
 
-	var todo models.Todo
-	if err = json.Unmarshal(response, &todo); err != nil {
-		log.C(ctx).Errorf("error unmarshalling response: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+func (r *Resolver) CommentAuthor(ctx context.Context, obj *graphql.Comment) (*graphql.User, error) {
+	log.C(ctx).Info("todoResolver called for comment author")
+	return r.loadUser(ctx, obj.Author.ID)
+}
+
+// CommentMentions skips mentioned users that were deleted after the comment was written.
+func (r *Resolver) CommentMentions(ctx context.Context, obj *graphql.Comment) ([]*graphql.User, error) {
+	log.C(ctx).Info("todoResolver called for comment mentions")
+	result := make([]*graphql.User, 0, len(obj.Mentions))
+	for _, mention := range obj.Mentions {
+		u, err := r.loadUser(ctx, mention.ID)
+		if err != nil {
+			return nil, err
+		}
+		if u != nil {
+			result = append(result, u)
+		}
 	}
+	return result, nil
+}
 
-	graphTodo, err := r.todoConv.ConvertTodoToGraphQL(todo)
+func (r *Resolver) loadUser(ctx context.Context, id string) (*graphql.User, error) {
+	l, err := loaders.For(ctx)
 	if err != nil {
-		log.C(ctx).Errorf("error converting todos: %v", err)
-		return nil, fmt.Errorf("error converting todo: %w", err)
+		log.C(ctx).Errorf("error getting loaders: %v", err)
+		return nil, err
 	}
-	log.C(ctx).Debugf("converted todo: %v", graphTodo)
-	return graphTodo, nil
+	u, err := l.UserByID.Load(ctx, id)
+	if err != nil {
+		log.C(ctx).Errorf("error loading user: %v", err)
+		return nil, fmt.Errorf("error loading user: %w", err)
+	}
+	if u == nil {
+		return nil, nil
+	}
+
+	graphqlUser, err := r.userConv.ConvertUserToGraphQL(*u)
+	if err != nil {
+		log.C(ctx).Errorf("error converting users: %v", err)
+		return nil, fmt.Errorf("error converting user: %w", err)
+	}
+	log.C(ctx).Debugf("converted user to graphql model: %v", graphqlUser)
+
+	return graphqlUser, nil
 }
 
-func (r *Resolver) getTodo(ctx context.Context, todoID string) (models.Todo, error) {
-	log.C(ctx).Info("todoResolver called get")
-	url := fmt.Sprintf("/todos/%s", todoID)
-	body, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+func convertBulkOperation(operation *graphql.BulkTodoOperation) (todoclient.BulkOperation, error) {
+	action, err := converters.ConvertBulkActionFromGraphQL(operation.Action)
 	if err != nil {
-		log.C(ctx).Errorf("error getting todo: %v", err)
-		return models.Todo{}, fmt.Errorf("error executing request: %w", err)
+		return todoclient.BulkOperation{}, err
+	}
+	converted := todoclient.BulkOperation{Action: action, TodoID: operation.TodoID}
+	if operation.AssignedTo != nil {
+		converted.AssignedTo = *operation.AssignedTo
+	}
+	if operation.Priority != nil {
+		if converted.Priority, err = converters.ConvertPriorityFromGraphQL(*operation.Priority); err != nil {
+			return todoclient.BulkOperation{}, err
+		}
+	}
+	if operation.ListID != nil {
+		converted.ListID = *operation.ListID
 	}
-	var todo models.Todo
-	if err = json.Unmarshal(body, &todo); err != nil {
-		log.C(ctx).Errorf("error unmarshalling response: %v", err)
-		return models.Todo{}, fmt.Errorf("error unmarshalling response: %w", err)
+	if operation.TagID != nil {
+		converted.TagID = *operation.TagID
 	}
-	return todo, nil
+	return converted, nil
 }
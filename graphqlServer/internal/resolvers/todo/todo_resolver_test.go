@@ -4,13 +4,17 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
+	gqlgen "github.com/99designs/gqlgen/graphql"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters/automock"
 	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/pkg/todoclient"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/todo"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"io"
+	"strings"
 	"testing"
 )
 
@@ -37,7 +41,7 @@ func TestTodos_TodoResolver(t *testing.T) {
 		{
 			name:        "successful todos fetch",
 			mockMethod:  "GET",
-			mockURL:     "todos/all",
+			mockURL:     "/todos/user/all",
 			mockResp:    []byte(`[{"ID": "1", "Title": "Test Todo"}]`),
 			mockErr:     nil,
 			expectError: false,
@@ -46,14 +50,14 @@ func TestTodos_TodoResolver(t *testing.T) {
 			},
 			todoConverter: func() *automock.TodoConverter {
 				todoConverter := &automock.TodoConverter{}
-				todoConverter.EXPECT().ConvertTodoToGraphQL(inputTodo).Return(&expectedTodo, nil)
+				todoConverter.EXPECT().ConvertMultipleTodoToGraphQL([]*models.Todo{&inputTodo}).Return([]*graphql.Todo{&expectedTodo}, nil)
 				return todoConverter
 			},
 		},
 		{
 			name:        "failed HTTP request",
 			mockMethod:  "GET",
-			mockURL:     "todos/all",
+			mockURL:     "/todos/user/all",
 			mockResp:    nil,
 			mockErr:     errors.New("failed to fetch todos"),
 			expectError: true,
@@ -65,7 +69,7 @@ func TestTodos_TodoResolver(t *testing.T) {
 		{
 			name:        "failed to unmarshal response",
 			mockMethod:  "GET",
-			mockURL:     "todos/all",
+			mockURL:     "/todos/user/all",
 			mockResp:    []byte(`invalid JSON`),
 			mockErr:     nil,
 			expectError: true,
@@ -84,7 +88,7 @@ func TestTodos_TodoResolver(t *testing.T) {
 
 			todoConverter := tt.todoConverter()
 
-			r := todo.NewResolver(mockClient, todoConverter, nil, nil)
+			r := todo.NewResolver(todoclient.New(mockClient), todoConverter, nil, nil)
 
 			result, err := r.Todos(context.Background())
 
@@ -125,7 +129,7 @@ func TestTodo_TodoResolver(t *testing.T) {
 		{
 			name:        "successful todo fetch",
 			mockMethod:  "GET",
-			mockURL:     "todos/1",
+			mockURL:     "/todos/1",
 			mockResp:    []byte(`{"ID": "1", "Title": "Test Todo"}`),
 			mockErr:     nil,
 			expectError: false,
@@ -139,7 +143,7 @@ func TestTodo_TodoResolver(t *testing.T) {
 		{
 			name:        "failed http request",
 			mockMethod:  "GET",
-			mockURL:     "todos/1",
+			mockURL:     "/todos/1",
 			mockResp:    nil,
 			mockErr:     errors.New("failed to fetch todo"),
 			expectError: true,
@@ -151,7 +155,7 @@ func TestTodo_TodoResolver(t *testing.T) {
 		{
 			name:        "failed to unmarshal response",
 			mockMethod:  "GET",
-			mockURL:     "todos/1",
+			mockURL:     "/todos/1",
 			mockResp:    []byte(`invalid JSON`),
 			mockErr:     nil,
 			expectError: true,
@@ -163,7 +167,7 @@ func TestTodo_TodoResolver(t *testing.T) {
 		{
 			name:        "failed to convert todo",
 			mockMethod:  "GET",
-			mockURL:     "todos/1",
+			mockURL:     "/todos/1",
 			mockResp:    []byte(`{"ID": "1", "Title": "Test Todo"}`),
 			mockErr:     nil,
 			expectError: true,
@@ -182,7 +186,7 @@ func TestTodo_TodoResolver(t *testing.T) {
 			mockClient.On("Do", mock.Anything, tt.mockMethod, tt.mockURL, mock.Anything).Return(tt.mockResp, tt.mockErr)
 
 			todoConverter := tt.todoConverter()
-			r := todo.NewResolver(mockClient, todoConverter, nil, nil)
+			r := todo.NewResolver(todoclient.New(mockClient), todoConverter, nil, nil)
 
 			result, err := r.Todo(context.Background(), "1")
 
@@ -216,14 +220,15 @@ func TestCreateTodo_TodoResolver(t *testing.T) {
 	}
 
 	createTodoInput := graphql.CreateTodoInput{
-		Title: "New Todo",
+		Title:  "New Todo",
+		TagIds: []string{"7"},
 	}
 
 	httpInput := models.Todo{
 		Title: "New Todo",
 	}
 
-	data, _ := json.Marshal(inputTodo)
+	data, _ := json.Marshal(todoclient.TodoInput{Todo: inputTodo, TagIDs: []string{"7"}})
 
 	tests := []struct {
 		name        string
@@ -247,8 +252,8 @@ func TestCreateTodo_TodoResolver(t *testing.T) {
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "POST", "todos/create", data).Return([]byte(`"1"`), nil)
-				mockClient.On("Do", mock.Anything, "GET", "todos/1", mock.Anything).Return([]byte(`{"ID": "1", "Title": "New Todo"}`), nil)
+				mockClient.On("Do", mock.Anything, "POST", "/todos/create", data).Return([]byte(`"1"`), nil)
+				mockClient.On("Do", mock.Anything, "GET", "/todos/1", mock.Anything).Return([]byte(`{"ID": "1", "Title": "New Todo"}`), nil)
 				return mockClient
 			},
 			mockRespID:  []byte(`"1"`),
@@ -285,8 +290,8 @@ func TestCreateTodo_TodoResolver(t *testing.T) {
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "POST", "todos/create", data).Return([]byte(`"1"`), nil)
-				mockClient.On("Do", mock.Anything, "GET", "todos/1", mock.Anything).Return(data, errors.New("failed to fetch todo"))
+				mockClient.On("Do", mock.Anything, "POST", "/todos/create", data).Return([]byte(`"1"`), nil)
+				mockClient.On("Do", mock.Anything, "GET", "/todos/1", mock.Anything).Return(data, errors.New("failed to fetch todo"))
 				return mockClient
 			},
 			mockRespID:  []byte(`"1"`),
@@ -302,7 +307,7 @@ func TestCreateTodo_TodoResolver(t *testing.T) {
 			mockClient := tt.mockDo()
 			todoConverter := tt.mockConvert()
 
-			r := todo.NewResolver(mockClient, todoConverter, nil, nil)
+			r := todo.NewResolver(todoclient.New(mockClient), todoConverter, nil, nil)
 
 			result, err := r.CreateTodo(context.Background(), tt.input)
 
@@ -341,7 +346,7 @@ func TestUpdateTodo_TodoResolver(t *testing.T) {
 		Title: "Updated Todo",
 	}
 
-	data, _ := json.Marshal(inputTodo)
+	data, _ := json.Marshal(todoclient.TodoInput{Todo: inputTodo})
 
 	tests := []struct {
 		name        string
@@ -365,8 +370,8 @@ func TestUpdateTodo_TodoResolver(t *testing.T) {
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "PUT", "todos/1", data).Return([]byte(`"1"`), nil)
-				mockClient.On("Do", mock.Anything, "GET", "todos/1", mock.Anything).Return([]byte(`{"ID": "1", "Title": "Updated Todo"}`), nil)
+				mockClient.On("Do", mock.Anything, "PUT", "/todos/1", data).Return([]byte(`"1"`), nil)
+				mockClient.On("Do", mock.Anything, "GET", "/todos/1", mock.Anything).Return([]byte(`{"ID": "1", "Title": "Updated Todo"}`), nil)
 				return mockClient
 			},
 			mockErr:     nil,
@@ -400,7 +405,7 @@ func TestUpdateTodo_TodoResolver(t *testing.T) {
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "PUT", "todos/1", data).Return([]byte(`"1"`), errors.New("failed to update todo"))
+				mockClient.On("Do", mock.Anything, "PUT", "/todos/1", data).Return([]byte(`"1"`), errors.New("failed to update todo"))
 				return mockClient
 			},
 			mockErr:     errors.New("failed to update todo"),
@@ -419,8 +424,8 @@ func TestUpdateTodo_TodoResolver(t *testing.T) {
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "PUT", "todos/1", data).Return([]byte(`"1"`), nil)
-				mockClient.On("Do", mock.Anything, "GET", "todos/1", mock.Anything).Return([]byte(`"1"`), errors.New("failed to fetch todo"))
+				mockClient.On("Do", mock.Anything, "PUT", "/todos/1", data).Return([]byte(`"1"`), nil)
+				mockClient.On("Do", mock.Anything, "GET", "/todos/1", mock.Anything).Return([]byte(`"1"`), errors.New("failed to fetch todo"))
 				return mockClient
 			},
 			mockErr:     errors.New("failed to fetch todo"),
@@ -434,7 +439,7 @@ func TestUpdateTodo_TodoResolver(t *testing.T) {
 			mockClient := tt.mockDo()
 			todoConverter := tt.mockConvert()
 
-			r := todo.NewResolver(mockClient, todoConverter, nil, nil)
+			r := todo.NewResolver(todoclient.New(mockClient), todoConverter, nil, nil)
 
 			result, err := r.UpdateTodo(context.Background(), tt.id, tt.input)
 
@@ -482,8 +487,8 @@ func TestDeleteTodo_TodoResolver(t *testing.T) {
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "GET", "todos/1", mock.Anything).Return([]byte(`{"ID": "1", "Title": "Test Todo"}`), nil)
-				mockClient.On("Do", mock.Anything, "DELETE", "todos/1", mock.Anything).Return([]byte(`"1"`), nil)
+				mockClient.On("Do", mock.Anything, "GET", "/todos/1", mock.Anything).Return([]byte(`{"ID": "1", "Title": "Test Todo"}`), nil)
+				mockClient.On("Do", mock.Anything, "DELETE", "/todos/1", mock.Anything).Return([]byte(`"1"`), nil)
 				return mockClient
 			},
 			mockRespGet: []byte(`{"ID": "1", "Title": "Test Todo"}`),
@@ -499,7 +504,7 @@ func TestDeleteTodo_TodoResolver(t *testing.T) {
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "GET", "todos/1", mock.Anything).Return([]byte(`"1"`), errors.New("failed to fetch todo"))
+				mockClient.On("Do", mock.Anything, "GET", "/todos/1", mock.Anything).Return([]byte(`"1"`), errors.New("failed to fetch todo"))
 				return mockClient
 			},
 			mockRespGet: nil,
@@ -517,8 +522,8 @@ func TestDeleteTodo_TodoResolver(t *testing.T) {
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "GET", "todos/1", mock.Anything).Return([]byte(`{"ID": "1", "Title": "Test Todo"}`), nil)
-				mockClient.On("Do", mock.Anything, "DELETE", "todos/1", mock.Anything).Return([]byte(`"1"`), errors.New("failed to delete todo"))
+				mockClient.On("Do", mock.Anything, "GET", "/todos/1", mock.Anything).Return([]byte(`{"ID": "1", "Title": "Test Todo"}`), nil)
+				mockClient.On("Do", mock.Anything, "DELETE", "/todos/1", mock.Anything).Return([]byte(`"1"`), errors.New("failed to delete todo"))
 				return mockClient
 			},
 			mockRespGet: []byte(`{"ID": "1", "Title": "Test Todo"}`),
@@ -534,7 +539,7 @@ func TestDeleteTodo_TodoResolver(t *testing.T) {
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "GET", "todos/1", mock.Anything).Return([]byte(`invalid_json`), nil)
+				mockClient.On("Do", mock.Anything, "GET", "/todos/1", mock.Anything).Return([]byte(`invalid_json`), nil)
 				return mockClient
 			},
 			mockRespGet: []byte(`invalid_json`),
@@ -548,7 +553,7 @@ func TestDeleteTodo_TodoResolver(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockClient := tt.mockDo()
 			todoConverter := tt.mockConvert()
-			r := todo.NewResolver(mockClient, todoConverter, nil, nil)
+			r := todo.NewResolver(todoclient.New(mockClient), todoConverter, nil, nil)
 
 			result, err := r.DeleteTodo(context.Background(), tt.id)
 
@@ -564,3 +569,120 @@ func TestDeleteTodo_TodoResolver(t *testing.T) {
 		})
 	}
 }
+
+func TestTodosByList_TodoResolver(t *testing.T) {
+	expectedTodo := graphql.Todo{ID: "1", Title: "Test Todo"}
+	inputTodo := models.Todo{ID: "1", Title: "Test Todo"}
+	tagID := "7"
+
+	tests := []struct {
+		name    string
+		tagID   *string
+		mockURL string
+	}{
+		{
+			name:    "all todos of the list",
+			mockURL: "/lists/1/todos",
+		},
+		{
+			name:    "todos of the list with a tag",
+			tagID:   &tagID,
+			mockURL: "/lists/1/todos?tag_id=7",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+			mockClient.On("Do", mock.Anything, "GET", tt.mockURL, mock.Anything).Return([]byte(`[{"ID": "1", "Title": "Test Todo"}]`), nil)
+
+			todoConverter := &automock.TodoConverter{}
+			todoConverter.EXPECT().ConvertMultipleTodoToGraphQL([]*models.Todo{&inputTodo}).Return([]*graphql.Todo{&expectedTodo}, nil)
+
+			r := todo.NewResolver(todoclient.New(mockClient), todoConverter, nil, nil)
+
+			result, err := r.TodosByList(context.Background(), "1", tt.tagID)
+
+			assert.NoError(t, err)
+			assert.Equal(t, []*graphql.Todo{&expectedTodo}, result)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestComments_TodoResolver(t *testing.T) {
+	limit, offset := 1, 1
+
+	tests := []struct {
+		name     string
+		limit    *int
+		offset   *int
+		mockURL  string
+		mockResp string
+		expected *graphql.CommentPage
+	}{
+		{
+			name:     "first page with the default size",
+			mockURL:  "/todos/1/comments?limit=0&offset=0",
+			mockResp: `{"comments":[{"id":"9","todo_id":"1","author_id":"2","body":"hi @bob@example.com","mentions":[{"user_id":"3","email":"bob@example.com"}]}],"total":1,"limit":20,"offset":0}`,
+			expected: &graphql.CommentPage{
+				Comments: []*graphql.Comment{{
+					ID: "9", TodoID: "1", Author: &graphql.User{ID: "2"}, Body: "hi @bob@example.com",
+					Mentions:  []*graphql.User{{ID: "3", Email: "bob@example.com"}},
+					CreatedAt: "0001-01-01T00:00:00Z", UpdatedAt: "0001-01-01T00:00:00Z",
+				}},
+				TotalCount: 1,
+			},
+		},
+		{
+			name:     "page with more comments after it",
+			limit:    &limit,
+			offset:   &offset,
+			mockURL:  "/todos/1/comments?limit=1&offset=1",
+			mockResp: `{"comments":[{"id":"9","todo_id":"1","author_id":"2","body":"hi","mentions":[]}],"total":3,"limit":1,"offset":1}`,
+			expected: &graphql.CommentPage{
+				Comments: []*graphql.Comment{{
+					ID: "9", TodoID: "1", Author: &graphql.User{ID: "2"}, Body: "hi", Mentions: []*graphql.User{},
+					CreatedAt: "0001-01-01T00:00:00Z", UpdatedAt: "0001-01-01T00:00:00Z",
+				}},
+				TotalCount:  3,
+				HasNextPage: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+			mockClient.On("Do", mock.Anything, "GET", tt.mockURL, mock.Anything).Return([]byte(tt.mockResp), nil)
+
+			r := todo.NewResolver(todoclient.New(mockClient), nil, nil, nil)
+
+			result, err := r.Comments(context.Background(), &graphql.Todo{ID: "1"}, tt.limit, tt.offset)
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.expected, result)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestUploadAttachment_TodoResolver(t *testing.T) {
+	mockClient := new(mock2.ClientMock)
+	mockClient.On("DoStream", mock.Anything, "POST", "/todos/1/attachments", mock.MatchedBy(func(contentType string) bool {
+		return strings.HasPrefix(contentType, "multipart/form-data; boundary=")
+	}), mock.Anything).Run(func(args mock.Arguments) {
+		_, _ = io.Copy(io.Discard, args.Get(4).(io.Reader))
+	}).Return([]byte(`{"id":"3","todo_id":"1","filename":"notes.txt","content_type":"text/plain","size":5}`), nil)
+
+	r := todo.NewResolver(todoclient.New(mockClient), nil, nil, nil)
+
+	result, err := r.UploadAttachment(context.Background(), "1", gqlgen.Upload{File: strings.NewReader("hello"), Filename: "notes.txt", Size: 5})
+
+	assert.NoError(t, err)
+	assert.Equal(t, &graphql.Attachment{
+		ID: "3", TodoID: "1", Filename: "notes.txt", ContentType: "text/plain", Size: 5,
+		DownloadPath: "/todos/1/attachments/3", CreatedAt: "0001-01-01T00:00:00Z",
+	}, result)
+	mockClient.AssertExpectations(t)
+}
@@ -2,26 +2,23 @@ package user
 
 import (
 	"context"
-	"encoding/json"
 	"fmt"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
-	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/pkg/todoclient"
 	jwts "github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
-	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
-	"net/http"
 )
 
 type Resolver struct {
-	httpClient client.Client
+	todoClient *todoclient.Client
 	userConv   converters.UserConverter
 	listConv   converters.ListConverter
 }
 
-func NewResolver(client client.Client, converter converters.UserConverter, listConverter converters.ListConverter) *Resolver {
+func NewResolver(client *todoclient.Client, converter converters.UserConverter, listConverter converters.ListConverter) *Resolver {
 	return &Resolver{
-		httpClient: client,
+		todoClient: client,
 		userConv:   converter,
 		listConv:   listConverter,
 	}
@@ -29,18 +26,12 @@ func NewResolver(client client.Client, converter converters.UserConverter, listC
 
 func (r *Resolver) Users(ctx context.Context) ([]*graphql.User, error) {
 	log.C(ctx).Info("users resolver users")
-	response, err := r.httpClient.Do(ctx, http.MethodGet, "/users/all", nil)
+	users, err := r.todoClient.ListUsers(ctx)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch users: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 
-	var users []*models.User
-	if err = json.Unmarshal(response, &users); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal users: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
-
 	var result []*graphql.User
 	for _, el := range users {
 		u, err := r.userConv.ConvertUserToGraphQL(*el)
@@ -57,21 +48,13 @@ func (r *Resolver) Users(ctx context.Context) ([]*graphql.User, error) {
 
 func (r *Resolver) User(ctx context.Context, id string) (*graphql.User, error) {
 	log.C(ctx).Info("users resolver user", id)
-	url := fmt.Sprintf("/users/%s", id)
-
-	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	u, err := r.todoClient.GetUser(ctx, id)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch user: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	log.C(ctx).Debugf("user response: %+v", response)
-	var u models.User
-	if err = json.Unmarshal(response, &u); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal user: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
 
-	graphqlUser, err := r.userConv.ConvertUserToGraphQL(u)
+	graphqlUser, err := r.userConv.ConvertUserToGraphQL(*u)
 	if err != nil {
 		log.C(ctx).Errorf("failed to convert user: %v", err)
 		return nil, fmt.Errorf("error converting user: %w", err)
@@ -88,21 +71,13 @@ func (r *Resolver) UserByEmail(ctx context.Context) (*graphql.User, error) {
 		return nil, fmt.Errorf("unable to extract claims from context")
 	}
 
-	url := fmt.Sprintf("/users/email/%s", claims.Email)
-
-	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	u, err := r.todoClient.GetUserByEmail(ctx, claims.Email)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch user: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	log.C(ctx).Debugf("user response: %+v", response)
-	var u models.User
-	if err = json.Unmarshal(response, &u); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal user: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
 
-	graphqlUser, err := r.userConv.ConvertUserToGraphQL(u)
+	graphqlUser, err := r.userConv.ConvertUserToGraphQL(*u)
 	if err != nil {
 		log.C(ctx).Errorf("failed to convert user: %v", err)
 		return nil, fmt.Errorf("error converting user: %w", err)
@@ -119,42 +94,21 @@ func (r *Resolver) CreateUser(ctx context.Context, input graphql.CreateUserInput
 		log.C(ctx).Errorf("failed to convert create user: %v", err)
 		return nil, fmt.Errorf("convert create user input to struct: %w", err)
 	}
-	body, err := json.Marshal(httpInput)
-	if err != nil {
-		log.C(ctx).Errorf("failed to marshal create user: %v", err)
-		return nil, fmt.Errorf("error marshalling user: %v", err)
-	}
 
-	responseID, err := r.httpClient.Do(ctx, http.MethodPost, "/users/create", body)
+	id, err := r.todoClient.CreateUser(ctx, httpInput)
 	if err != nil {
-		log.C(ctx).Errorf("failed to fetch user: %v", err)
+		log.C(ctx).Errorf("failed to create user: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	log.C(ctx).Debugf("user response id: %+v", responseID)
-
-	var id string
+	log.C(ctx).Debugf("user response id: %+v", id)
 
-	if err = json.Unmarshal(responseID, &id); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal user: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
-
-	url := fmt.Sprintf("/users/%s", id)
-
-	responseGet, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	user, err := r.todoClient.GetUser(ctx, id)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch user: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	log.C(ctx).Debugf("user response get: %+v", responseGet)
-
-	var user models.User
-	if err = json.Unmarshal(responseGet, &user); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal user: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
 
-	return r.userConv.ConvertUserToGraphQL(user)
+	return r.userConv.ConvertUserToGraphQL(*user)
 }
 
 func (r *Resolver) UpdateUser(ctx context.Context, id string, input graphql.UpdateUserInput) (*graphql.User, error) {
@@ -164,113 +118,67 @@ func (r *Resolver) UpdateUser(ctx context.Context, id string, input graphql.Upda
 		log.C(ctx).Errorf("failed to convert update user: %v", err)
 		return nil, fmt.Errorf("convert update user input to struct: %w", err)
 	}
-	body, err := json.Marshal(httpInput)
-	if err != nil {
-		log.C(ctx).Errorf("failed to marshal update user: %v", err)
-		return nil, fmt.Errorf("error marshalling user: %v", err)
-	}
-	log.C(ctx).Debugf("body input: %+v", body)
 
-	url := fmt.Sprintf("/users/%s", id)
-	_, err = r.httpClient.Do(ctx, http.MethodPut, url, body)
-	if err != nil {
-		log.C(ctx).Errorf("failed to fetch user: %v", err)
+	if err = r.todoClient.UpdateUser(ctx, id, httpInput); err != nil {
+		log.C(ctx).Errorf("failed to update user: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	responseGet, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	user, err := r.todoClient.GetUser(ctx, id)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch user: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 
-	log.C(ctx).Debugf("response get: %+v", responseGet)
-
-	var user models.User
-	if err = json.Unmarshal(responseGet, &user); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal user: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
-
 	log.C(ctx).Debugf("user response get: %+v", user)
 
-	return r.userConv.ConvertUserToGraphQL(user)
+	return r.userConv.ConvertUserToGraphQL(*user)
 }
 
 func (r *Resolver) DeleteUser(ctx context.Context, id string) (*graphql.User, error) {
 	log.C(ctx).Infof("users resolver delete user with id %s", id)
-	url := fmt.Sprintf("/users/%s", id)
-
-	response, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	u, err := r.todoClient.GetUser(ctx, id)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch user: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	var u models.User
-	if err = json.Unmarshal(response, &u); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal user: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
 	log.C(ctx).Infof("user response user: %+v", u)
-	_, err = r.httpClient.Do(ctx, http.MethodDelete, url, nil)
-	if err != nil {
-		log.C(ctx).Errorf("failed to fetch user: %v", err)
+
+	if err = r.todoClient.DeleteUser(ctx, id); err != nil {
+		log.C(ctx).Errorf("failed to delete user: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
 
-	return r.userConv.ConvertUserToGraphQL(u)
+	return r.userConv.ConvertUserToGraphQL(*u)
 }
 
 func (r *Resolver) GetList(ctx context.Context, id string) (*graphql.List, error) {
 	log.C(ctx).Infof("lists resolver get list with id %s", id)
-	url := fmt.Sprintf("/lists/%s", id)
-
-	body, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	l, err := r.todoClient.GetList(ctx, id)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch list: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	var l models.List
-
-	if err = json.Unmarshal(body, &l); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal list: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
-	return r.listConv.ConvertListToGraphQL(l)
+	return r.listConv.ConvertListToGraphQL(*l)
 }
 
 func (r *Resolver) GetUser(ctx context.Context, id string) (*graphql.User, error) {
 	log.C(ctx).Infof("users resolver get user with id %s", id)
-	url := fmt.Sprintf("/users/%s", id)
-
-	body, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	u, err := r.todoClient.GetUser(ctx, id)
 	if err != nil {
 		log.C(ctx).Errorf("failed to fetch user: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	var u models.User
-
-	if err = json.Unmarshal(body, &u); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal user: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
 	log.C(ctx).Debugf("user response user: %+v", u)
-	return r.userConv.ConvertUserToGraphQL(u)
+	return r.userConv.ConvertUserToGraphQL(*u)
 }
 
 func (r *Resolver) UsersByList(ctx context.Context, id string) ([]*graphql.User, error) {
 	log.C(ctx).Infof("users resolver get user for a list with id %s", id)
-	url := fmt.Sprintf("/lists/%s/users", id)
-
-	body, err := r.httpClient.Do(ctx, http.MethodGet, url, nil)
+	access, err := r.todoClient.ListCollaborators(ctx, id)
 	if err != nil {
-		log.C(ctx).Errorf("failed to fetch user: %v", err)
+		log.C(ctx).Errorf("failed to fetch collaborators: %v", err)
 		return nil, fmt.Errorf("error executing request: %w", err)
 	}
-	var access []models.Access
-	if err = json.Unmarshal(body, &access); err != nil {
-		log.C(ctx).Errorf("failed to unmarshal collaborators: %v", err)
-		return nil, fmt.Errorf("error unmarshalling response: %w", err)
-	}
 
 	var result []*graphql.User
 	for _, el := range access {
@@ -283,5 +191,5 @@ func (r *Resolver) UsersByList(ctx context.Context, id string) ([]*graphql.User,
 
 		result = append(result, user)
 	}
-	return result, err
+	return result, nil
 }
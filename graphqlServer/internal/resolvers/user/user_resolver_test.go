@@ -8,6 +8,7 @@ import (
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/generated/graphql"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/converters/automock"
 	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/pkg/todoclient"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/user"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
 	"github.com/stretchr/testify/mock"
@@ -40,7 +41,7 @@ func TestUsers_UserResolver(t *testing.T) {
 		{
 			name:        "successful users fetch",
 			mockMethod:  "GET",
-			mockURL:     "users/all",
+			mockURL:     "/users/all",
 			mockResp:    []byte(`[{"ID": "1", "Email": "victor"}]`),
 			mockErr:     nil,
 			expectError: false,
@@ -59,7 +60,7 @@ func TestUsers_UserResolver(t *testing.T) {
 		{
 			name:        "failed http request",
 			mockMethod:  "GET",
-			mockURL:     "users/all",
+			mockURL:     "/users/all",
 			mockResp:    nil,
 			mockErr:     errors.New("failed to fetch users"),
 			expectError: true,
@@ -74,7 +75,7 @@ func TestUsers_UserResolver(t *testing.T) {
 		{
 			name:        "failed to unmarshal response",
 			mockMethod:  "GET",
-			mockURL:     "users/all",
+			mockURL:     "/users/all",
 			mockResp:    []byte(`invalid JSON`),
 			mockErr:     nil,
 			expectError: true,
@@ -95,7 +96,7 @@ func TestUsers_UserResolver(t *testing.T) {
 			mockClient.On("Do", mock.Anything, tt.mockMethod, tt.mockURL, mock.Anything).Return(tt.mockResp, tt.mockErr)
 			userConverter := tt.userConverter()
 			listConverter := tt.listConverter()
-			r := user.NewResolver(mockClient, userConverter, listConverter)
+			r := user.NewResolver(todoclient.New(mockClient), userConverter, listConverter)
 
 			result, err := r.Users(context.Background())
 
@@ -140,7 +141,7 @@ func TestUser_UserResolver(t *testing.T) {
 			name:        "successful user fetch",
 			userID:      "1",
 			mockMethod:  "GET",
-			mockURL:     "users/1",
+			mockURL:     "/users/1",
 			mockResp:    []byte(`{"ID": "1", "Email": "victor"}`),
 			mockErr:     nil,
 			expectError: false,
@@ -158,7 +159,7 @@ func TestUser_UserResolver(t *testing.T) {
 			name:        "failed http request",
 			userID:      "1",
 			mockMethod:  "GET",
-			mockURL:     "users/1",
+			mockURL:     "/users/1",
 			mockResp:    nil,
 			mockErr:     errors.New("failed to fetch user"),
 			expectError: true,
@@ -174,7 +175,7 @@ func TestUser_UserResolver(t *testing.T) {
 			name:        "failed to unmarshal response",
 			userID:      "1",
 			mockMethod:  "GET",
-			mockURL:     "users/1",
+			mockURL:     "/users/1",
 			mockResp:    []byte(`invalid JSON`),
 			mockErr:     nil,
 			expectError: true,
@@ -190,7 +191,7 @@ func TestUser_UserResolver(t *testing.T) {
 			name:        "failed to convert user",
 			userID:      "1",
 			mockMethod:  "GET",
-			mockURL:     "users/1",
+			mockURL:     "/users/1",
 			mockResp:    []byte(`{"ID": "1", "Email": "victor"}`),
 			mockErr:     nil,
 			expectError: true,
@@ -215,7 +216,7 @@ func TestUser_UserResolver(t *testing.T) {
 			userConverter := tt.userConverter()
 			listConverter := tt.listConverter()
 
-			r := user.NewResolver(mockClient, userConverter, listConverter)
+			r := user.NewResolver(todoclient.New(mockClient), userConverter, listConverter)
 
 			result, err := r.User(context.Background(), tt.userID)
 
@@ -284,8 +285,8 @@ func TestCreateUser_UserResolver(t *testing.T) {
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "POST", "users/create", data).Return([]byte(`"1"`), nil)
-				mockClient.On("Do", mock.Anything, "GET", "users/1", mock.Anything).Return([]byte(`{"ID": "1", "Email": "victor"}`), nil)
+				mockClient.On("Do", mock.Anything, "POST", "/users/create", data).Return([]byte(`"1"`), nil)
+				mockClient.On("Do", mock.Anything, "GET", "/users/1", mock.Anything).Return([]byte(`{"ID": "1", "Email": "victor"}`), nil)
 				return mockClient
 			},
 			mockRespID:  []byte(`"1"`),
@@ -328,8 +329,8 @@ func TestCreateUser_UserResolver(t *testing.T) {
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "POST", "users/create", data).Return([]byte(`"1"`), nil)
-				mockClient.On("Do", mock.Anything, "GET", "users/1", mock.Anything).Return(data, errors.New("failed to fetch user"))
+				mockClient.On("Do", mock.Anything, "POST", "/users/create", data).Return([]byte(`"1"`), nil)
+				mockClient.On("Do", mock.Anything, "GET", "/users/1", mock.Anything).Return(data, errors.New("failed to fetch user"))
 				return mockClient
 			},
 			mockRespID:  []byte(`"1"`),
@@ -345,7 +346,7 @@ func TestCreateUser_UserResolver(t *testing.T) {
 			mockClient := tt.mockDo()
 			userConverter := tt.mockConvert()
 			listConverter := tt.mockListConverter()
-			r := user.NewResolver(mockClient, userConverter, listConverter)
+			r := user.NewResolver(todoclient.New(mockClient), userConverter, listConverter)
 
 			result, err := r.CreateUser(context.Background(), tt.input)
 
@@ -411,8 +412,8 @@ func TestUpdateUser_UserResolver(t *testing.T) {
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "PUT", "users/1", data).Return([]byte(`"1"`), nil)
-				mockClient.On("Do", mock.Anything, "GET", "users/1", mock.Anything).Return([]byte(`{"ID": "1", "Email": "updated_victor"}`), nil)
+				mockClient.On("Do", mock.Anything, "PUT", "/users/1", data).Return([]byte(`"1"`), nil)
+				mockClient.On("Do", mock.Anything, "GET", "/users/1", mock.Anything).Return([]byte(`{"ID": "1", "Email": "updated_victor"}`), nil)
 				return mockClient
 			},
 			mockRespGet: []byte(`{"ID": "1", "Email": "updated_victor"}`),
@@ -454,7 +455,7 @@ func TestUpdateUser_UserResolver(t *testing.T) {
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "PUT", "users/1", data).Return([]byte(`"1"`), errors.New("failed to update user"))
+				mockClient.On("Do", mock.Anything, "PUT", "/users/1", data).Return([]byte(`"1"`), errors.New("failed to update user"))
 				return mockClient
 			},
 			mockRespGet: nil,
@@ -476,8 +477,8 @@ func TestUpdateUser_UserResolver(t *testing.T) {
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "PUT", "users/1", data).Return([]byte(`"1"`), nil)
-				mockClient.On("Do", mock.Anything, "GET", "users/1", mock.Anything).Return([]byte(`"1"`), errors.New("failed to fetch user"))
+				mockClient.On("Do", mock.Anything, "PUT", "/users/1", data).Return([]byte(`"1"`), nil)
+				mockClient.On("Do", mock.Anything, "GET", "/users/1", mock.Anything).Return([]byte(`"1"`), errors.New("failed to fetch user"))
 				return mockClient
 			},
 			mockRespGet: nil,
@@ -492,7 +493,7 @@ func TestUpdateUser_UserResolver(t *testing.T) {
 			mockClient := tt.mockDo()
 			userConverter := tt.mockConvert()
 			listConverter := tt.mockListConverter()
-			r := user.NewResolver(mockClient, userConverter, listConverter)
+			r := user.NewResolver(todoclient.New(mockClient), userConverter, listConverter)
 
 			result, err := r.UpdateUser(context.Background(), tt.id, tt.input)
 
@@ -540,8 +541,8 @@ func TestDeleteUser_UserResolver(t *testing.T) {
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "GET", "users/1", mock.Anything).Return([]byte(`{"ID": "1", "Email": "victor"}`), nil)
-				mockClient.On("Do", mock.Anything, "DELETE", "users/1", mock.Anything).Return([]byte(`"1"`), nil)
+				mockClient.On("Do", mock.Anything, "GET", "/users/1", mock.Anything).Return([]byte(`{"ID": "1", "Email": "victor"}`), nil)
+				mockClient.On("Do", mock.Anything, "DELETE", "/users/1", mock.Anything).Return([]byte(`"1"`), nil)
 				return mockClient
 			},
 			mockRespGet: []byte(`{"ID": "1", "Email": "victor"}`),
@@ -557,7 +558,7 @@ func TestDeleteUser_UserResolver(t *testing.T) {
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "GET", "users/1", mock.Anything).Return([]byte(`"1"`), errors.New("failed to fetch user"))
+				mockClient.On("Do", mock.Anything, "GET", "/users/1", mock.Anything).Return([]byte(`"1"`), errors.New("failed to fetch user"))
 				return mockClient
 			},
 			mockRespGet: nil,
@@ -575,8 +576,8 @@ func TestDeleteUser_UserResolver(t *testing.T) {
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "GET", "users/1", mock.Anything).Return([]byte(`{"ID": "1", "Email": "victor"}`), nil)
-				mockClient.On("Do", mock.Anything, "DELETE", "users/1", mock.Anything).Return([]byte(`"1"`), errors.New("failed to delete user"))
+				mockClient.On("Do", mock.Anything, "GET", "/users/1", mock.Anything).Return([]byte(`{"ID": "1", "Email": "victor"}`), nil)
+				mockClient.On("Do", mock.Anything, "DELETE", "/users/1", mock.Anything).Return([]byte(`"1"`), errors.New("failed to delete user"))
 				return mockClient
 			},
 			mockRespGet: []byte(`{"ID": "1", "Email": "victor"}`),
@@ -592,7 +593,7 @@ func TestDeleteUser_UserResolver(t *testing.T) {
 			},
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "GET", "users/1", mock.Anything).Return([]byte(`invalid_json`), nil)
+				mockClient.On("Do", mock.Anything, "GET", "/users/1", mock.Anything).Return([]byte(`invalid_json`), nil)
 				return mockClient
 			},
 			mockRespGet: []byte(`invalid_json`),
@@ -606,7 +607,7 @@ func TestDeleteUser_UserResolver(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			mockClient := tt.mockDo()
 			userConverter := tt.mockConvert()
-			r := user.NewResolver(mockClient, userConverter, nil)
+			r := user.NewResolver(todoclient.New(mockClient), userConverter, nil)
 
 			result, err := r.DeleteUser(context.Background(), tt.id)
 
@@ -650,7 +651,7 @@ func TestGetList_UserResolver(t *testing.T) {
 			listID: listID,
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "GET", fmt.Sprintf("lists/%s", listID), mock.Anything).Return([]byte(`{"ID": "101", "Name": "Test List"}`), nil)
+				mockClient.On("Do", mock.Anything, "GET", fmt.Sprintf("/lists/%s", listID), mock.Anything).Return([]byte(`{"ID": "101", "Name": "Test List"}`), nil)
 				return mockClient
 			},
 			mockConvert: func() *automock.ListConverter {
@@ -668,7 +669,7 @@ func TestGetList_UserResolver(t *testing.T) {
 			listID: listID,
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "GET", fmt.Sprintf("lists/%s", listID), mock.Anything).Return([]byte(`"1"`), errors.New("failed to fetch list"))
+				mockClient.On("Do", mock.Anything, "GET", fmt.Sprintf("/lists/%s", listID), mock.Anything).Return([]byte(`"1"`), errors.New("failed to fetch list"))
 				return mockClient
 			},
 			mockConvert: func() *automock.ListConverter {
@@ -684,7 +685,7 @@ func TestGetList_UserResolver(t *testing.T) {
 			listID: listID,
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "GET", fmt.Sprintf("lists/%s", listID), mock.Anything).Return([]byte(`invalid_json`), nil)
+				mockClient.On("Do", mock.Anything, "GET", fmt.Sprintf("/lists/%s", listID), mock.Anything).Return([]byte(`invalid_json`), nil)
 				return mockClient
 			},
 			mockConvert: func() *automock.ListConverter {
@@ -702,7 +703,7 @@ func TestGetList_UserResolver(t *testing.T) {
 			mockClient := tt.mockDo()
 			mockListConverter := tt.mockConvert()
 
-			r := user.NewResolver(mockClient, nil, mockListConverter)
+			r := user.NewResolver(todoclient.New(mockClient), nil, mockListConverter)
 
 			result, err := r.GetList(context.Background(), tt.listID)
 
@@ -746,7 +747,7 @@ func TestGetUser_UserResolver(t *testing.T) {
 			userID: userID,
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "GET", fmt.Sprintf("users/%s", userID), mock.Anything).Return([]byte(`{"ID": "1", "Email": "test@example.com"}`), nil)
+				mockClient.On("Do", mock.Anything, "GET", fmt.Sprintf("/users/%s", userID), mock.Anything).Return([]byte(`{"ID": "1", "Email": "test@example.com"}`), nil)
 				return mockClient
 			},
 			mockConvert: func() *automock.UserConverter {
@@ -764,7 +765,7 @@ func TestGetUser_UserResolver(t *testing.T) {
 			userID: userID,
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "GET", fmt.Sprintf("users/%s", userID), mock.Anything).Return([]byte(`"1"`), errors.New("failed to fetch user"))
+				mockClient.On("Do", mock.Anything, "GET", fmt.Sprintf("/users/%s", userID), mock.Anything).Return([]byte(`"1"`), errors.New("failed to fetch user"))
 				return mockClient
 			},
 			mockConvert: func() *automock.UserConverter {
@@ -780,7 +781,7 @@ func TestGetUser_UserResolver(t *testing.T) {
 			userID: userID,
 			mockDo: func() *mock2.ClientMock {
 				mockClient := new(mock2.ClientMock)
-				mockClient.On("Do", mock.Anything, "GET", fmt.Sprintf("users/%s", userID), mock.Anything).Return([]byte(`invalid_json`), nil)
+				mockClient.On("Do", mock.Anything, "GET", fmt.Sprintf("/users/%s", userID), mock.Anything).Return([]byte(`invalid_json`), nil)
 				return mockClient
 			},
 			mockConvert: func() *automock.UserConverter {
@@ -798,7 +799,7 @@ func TestGetUser_UserResolver(t *testing.T) {
 			mockClient := tt.mockDo()
 			mockUserConverter := tt.mockConvert()
 
-			r := user.NewResolver(mockClient, mockUserConverter, nil)
+			r := user.NewResolver(todoclient.New(mockClient), mockUserConverter, nil)
 
 			result, err := r.GetUser(context.Background(), tt.userID)
 
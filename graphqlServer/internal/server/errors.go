@@ -0,0 +1,48 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/pkg/todoclient"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/problem"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"net/http"
+)
+
+// internalMessage replaces the messages of todo service failures, which may carry internal details.
+const internalMessage = "internal server error"
+
+// presentError exposes todo service failures as GraphQL errors carrying the service message and extensions.code.
+// The code is the one sent by the service, derived from the status code for plain text errors,
+// and the message of 5xx responses is replaced by a generic one.
+func presentError(ctx context.Context, err error) *gqlerror.Error {
+	gqlErr := graphql.DefaultErrorPresenter(ctx, err)
+
+	var apiErr *todoclient.Error
+	if !errors.As(err, &apiErr) {
+		return gqlErr
+	}
+
+	switch {
+	case apiErr.StatusCode >= http.StatusInternalServerError:
+		gqlErr.Message = internalMessage
+	case apiErr.Message != "":
+		gqlErr.Message = apiErr.Message
+	}
+	if gqlErr.Extensions == nil {
+		gqlErr.Extensions = map[string]interface{}{}
+	}
+	code := apiErr.Code
+	if code == "" {
+		code = problem.CodeForStatus(apiErr.StatusCode)
+	}
+	gqlErr.Extensions["code"] = code
+	if apiErr.RequestID != "" {
+		gqlErr.Extensions["requestId"] = apiErr.RequestID
+	}
+	if len(apiErr.Fields) > 0 {
+		gqlErr.Extensions["fields"] = apiErr.Fields
+	}
+	return gqlErr
+}
@@ -0,0 +1,115 @@
+package server
+
+import (
+	"errors"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	graph "github.com/Victor-Uzunov/devops-project/graphqlServer/generated"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers"
+	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/pkg/todoclient"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/problem"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"testing"
+)
+
+func TestPresentError(t *testing.T) {
+	tests := []struct {
+		name               string
+		mockErr            error
+		expectedMessage    string
+		expectedExtensions map[string]interface{}
+	}{
+		{
+			name:            "not found",
+			mockErr:         &todoclient.Error{StatusCode: http.StatusNotFound, Code: "NOT_FOUND", Message: "list not found", RequestID: "req-1"},
+			expectedMessage: "list not found",
+			expectedExtensions: map[string]interface{}{
+				"code":      problem.CodeNotFound,
+				"requestId": "req-1",
+			},
+		},
+		{
+			name:            "forbidden",
+			mockErr:         &todoclient.Error{StatusCode: http.StatusForbidden, Message: "Forbidden"},
+			expectedMessage: "Forbidden",
+			expectedExtensions: map[string]interface{}{
+				"code": problem.CodeForbidden,
+			},
+		},
+		{
+			name: "validation failed with field errors",
+			mockErr: &todoclient.Error{
+				StatusCode: http.StatusBadRequest,
+				Message:    "Name cannot be empty",
+				Fields:     []todoclient.FieldError{{Field: "name", Message: "cannot be empty"}},
+			},
+			expectedMessage: "Name cannot be empty",
+			expectedExtensions: map[string]interface{}{
+				"code":   problem.CodeValidationFailed,
+				"fields": []interface{}{map[string]interface{}{"field": "name", "message": "cannot be empty"}},
+			},
+		},
+		{
+			name:            "conflict",
+			mockErr:         &todoclient.Error{StatusCode: http.StatusConflict, Message: "list already exists"},
+			expectedMessage: "list already exists",
+			expectedExtensions: map[string]interface{}{
+				"code": problem.CodeConflict,
+			},
+		},
+		{
+			name:            "code sent by the service",
+			mockErr:         &todoclient.Error{StatusCode: http.StatusRequestEntityTooLarge, Code: problem.CodeTooLarge, Message: "attachment is too large"},
+			expectedMessage: "attachment is too large",
+			expectedExtensions: map[string]interface{}{
+				"code": problem.CodeTooLarge,
+			},
+		},
+		{
+			name:            "unsupported media type without a code",
+			mockErr:         &todoclient.Error{StatusCode: http.StatusUnsupportedMediaType, Message: "unsupported content type"},
+			expectedMessage: "unsupported content type",
+			expectedExtensions: map[string]interface{}{
+				"code": problem.CodeUnsupportedMedia,
+			},
+		},
+		{
+			name:            "internal errors hide the service message",
+			mockErr:         &todoclient.Error{StatusCode: http.StatusInternalServerError, Code: problem.CodeInternal, Message: "pq: relation \"lists\" does not exist", RequestID: "req-2"},
+			expectedMessage: "internal server error",
+			expectedExtensions: map[string]interface{}{
+				"code":      problem.CodeInternal,
+				"requestId": "req-2",
+			},
+		},
+		{
+			name:            "transport errors keep the default presentation",
+			mockErr:         errors.New("connection refused"),
+			expectedMessage: "error executing request: connection refused",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+			mockClient.On("Do", mock.Anything, http.MethodGet, "/lists/1", mock.Anything).Return([]byte(nil), tt.mockErr)
+
+			srv := handler.New(graph.NewExecutableSchema(graph.Config{
+				Resolvers: resolvers.NewRootResolver(todoclient.New(mockClient)),
+			}))
+			srv.AddTransport(transport.POST{})
+			srv.SetErrorPresenter(presentError)
+
+			status, resp := doQuery(t, srv, `{ list(id: "1") { id } }`)
+
+			assert.Equal(t, http.StatusOK, status)
+			require.Len(t, resp.Errors, 1)
+			assert.Equal(t, tt.expectedMessage, resp.Errors[0].Message)
+			assert.Equal(t, tt.expectedExtensions, resp.Errors[0].Extensions)
+		})
+	}
+}
@@ -0,0 +1,146 @@
+package server
+
+import (
+	"context"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/99designs/gqlgen/graphql/errcode"
+	graph "github.com/Victor-Uzunov/devops-project/graphqlServer/generated"
+	"github.com/vektah/gqlparser/v2/ast"
+	"github.com/vektah/gqlparser/v2/gqlerror"
+	"strings"
+)
+
+const (
+	errDepthLimit      = "DEPTH_LIMIT_EXCEEDED"
+	depthLimitName     = "DepthLimit"
+	resolverFieldCost  = 2
+	introspectionField = "__"
+)
+
+type GraphQLConfig struct {
+	ComplexityLimit  int `envconfig:"APP_GRAPHQL_COMPLEXITY_LIMIT" default:"1000"`
+	DepthLimit       int `envconfig:"APP_GRAPHQL_DEPTH_LIMIT" default:"8"`
+	ListSizeEstimate int `envconfig:"APP_GRAPHQL_LIST_SIZE_ESTIMATE" default:"5"`
+	QueryCacheSize   int `envconfig:"APP_GRAPHQL_QUERY_CACHE_SIZE" default:"1000"`
+	APQCacheSize     int `envconfig:"APP_GRAPHQL_APQ_CACHE_SIZE" default:"100"`
+	// MaxUploadSize caps multipart requests, the todo service enforces the size limit of the attachments themselves.
+	MaxUploadSize int64 `envconfig:"APP_GRAPHQL_MAX_UPLOAD_SIZE" default:"11534336"`
+}
+
+// DepthLimit rejects operations whose selection sets are nested deeper than Limit.
+// Introspection fields are not counted so the playground keeps working.
+type DepthLimit struct {
+	Limit int
+}
+
+var _ interface {
+	graphql.OperationContextMutator
+	graphql.HandlerExtension
+} = DepthLimit{}
+
+func (d DepthLimit) ExtensionName() string {
+	return depthLimitName
+}
+
+func (d DepthLimit) Validate(_ graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (d DepthLimit) MutateOperationContext(_ context.Context, rc *graphql.OperationContext) *gqlerror.Error {
+	op := rc.Doc.Operations.ForName(rc.OperationName)
+	if op == nil {
+		return nil
+	}
+	depth := selectionSetDepth(op.SelectionSet)
+	if depth > d.Limit {
+		err := gqlerror.Errorf("operation has depth %d, which exceeds the limit of %d", depth, d.Limit)
+		errcode.Set(err, errDepthLimit)
+		return err
+	}
+	return nil
+}
+
+func selectionSetDepth(selectionSet ast.SelectionSet) int {
+	maxDepth := 0
+	for _, selection := range selectionSet {
+		depth := 0
+		switch s := selection.(type) {
+		case *ast.Field:
+			if strings.HasPrefix(s.Name, introspectionField) {
+				continue
+			}
+			depth = 1 + selectionSetDepth(s.SelectionSet)
+		case *ast.InlineFragment:
+			depth = selectionSetDepth(s.SelectionSet)
+		case *ast.FragmentSpread:
+			if s.Definition != nil {
+				depth = selectionSetDepth(s.Definition.SelectionSet)
+			}
+		}
+		if depth > maxDepth {
+			maxDepth = depth
+		}
+	}
+	return maxDepth
+}
+
+// newComplexityRoot assigns a cost to every field that triggers a call to the todo service.
+// Fields returning lists are multiplied by the estimated list size, so nested lists grow quickly.
+func newComplexityRoot(config GraphQLConfig) graph.ComplexityRoot {
+	listCost := func(childComplexity int) int {
+		return resolverFieldCost + childComplexity*config.ListSizeEstimate
+	}
+	listCostByID := func(childComplexity int, _ string) int {
+		return listCost(childComplexity)
+	}
+	listCostByTag := func(childComplexity int, _ string, _ *string) int {
+		return listCost(childComplexity)
+	}
+	listCostWithArchived := func(childComplexity int, _ *bool) int {
+		return listCost(childComplexity)
+	}
+	resolverCost := func(childComplexity int) int {
+		return resolverFieldCost + childComplexity
+	}
+	resolverCostByID := func(childComplexity int, _ string) int {
+		return resolverCost(childComplexity)
+	}
+	pageCost := func(childComplexity int, _ *int, _ *int) int {
+		return resolverCost(childComplexity)
+	}
+
+	var c graph.ComplexityRoot
+
+	c.List.Todos = listCost
+	c.List.Columns = listCost
+	c.List.Collaborators = listCost
+	c.List.Owner = resolverCost
+	c.Todo.List = resolverCost
+	c.Todo.AssignedTo = resolverCost
+	c.Todo.Comments = pageCost
+	c.Todo.Attachments = listCost
+	c.Todo.BlockedBy = listCost
+	c.Todo.Blocks = listCost
+	c.CommentPage.Comments = listCost
+	c.Comment.Author = resolverCost
+	c.Comment.Mentions = listCost
+
+	c.Query.Users = listCost
+	c.Query.UsersByList = listCostByID
+	c.Query.ListsGlobal = listCost
+	c.Query.ListsPending = listCost
+	c.Query.Lists = listCostWithArchived
+	c.Query.ListsAccepted = listCost
+	c.Query.TodosGlobal = listCost
+	c.Query.TodosByList = listCostByTag
+	c.Query.Todos = listCost
+	c.Query.GetListAccesses = listCostByID
+	c.Query.Tags = listCostByID
+	c.Query.Templates = listCost
+	c.Query.User = resolverCostByID
+	c.Query.UserByEmail = resolverCost
+	c.Query.List = resolverCostByID
+	c.Query.Todo = resolverCostByID
+
+	return c
+}
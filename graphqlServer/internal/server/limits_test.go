@@ -0,0 +1,101 @@
+package server
+
+import (
+	"encoding/json"
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	graph "github.com/Victor-Uzunov/devops-project/graphqlServer/generated"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+type gqlResponse struct {
+	Errors []struct {
+		Message    string                 `json:"message"`
+		Extensions map[string]interface{} `json:"extensions"`
+	} `json:"errors"`
+}
+
+func newLimitedHandler(config GraphQLConfig) http.Handler {
+	srv := handler.New(graph.NewExecutableSchema(graph.Config{
+		Resolvers:  resolvers.NewRootResolver(nil),
+		Complexity: newComplexityRoot(config),
+	}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(extension.Introspection{})
+	srv.Use(DepthLimit{Limit: config.DepthLimit})
+	srv.Use(extension.FixedComplexityLimit(config.ComplexityLimit))
+	return srv
+}
+
+func doQuery(t *testing.T, h http.Handler, query string) (int, gqlResponse) {
+	body, err := json.Marshal(map[string]string{"query": query})
+	require.NoError(t, err)
+	req := httptest.NewRequest(http.MethodPost, "/query", strings.NewReader(string(body)))
+	req.Header.Set("Content-Type", "application/json")
+	rr := httptest.NewRecorder()
+	h.ServeHTTP(rr, req)
+
+	var resp gqlResponse
+	require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &resp))
+	return rr.Code, resp
+}
+
+func TestLimits(t *testing.T) {
+	config := GraphQLConfig{
+		ComplexityLimit:  100,
+		DepthLimit:       4,
+		ListSizeEstimate: 5,
+	}
+
+	tests := []struct {
+		name          string
+		query         string
+		expectedCode  string
+		expectedError string
+	}{
+		{
+			name:          "depth limit exceeded",
+			query:         `{ lists { todos { list { todos { id } } } } }`,
+			expectedCode:  errDepthLimit,
+			expectedError: "operation has depth 5, which exceeds the limit of 4",
+		},
+		{
+			name:          "depth limit exceeded through fragment",
+			query:         `{ lists { ...L } } fragment L on List { todos { list { todos { id } } } }`,
+			expectedCode:  errDepthLimit,
+			expectedError: "operation has depth 5, which exceeds the limit of 4",
+		},
+		{
+			name:          "complexity limit exceeded",
+			query:         `{ lists { collaborators { accessLevel } todos { assignedTo { id } } } }`,
+			expectedCode:  "COMPLEXITY_LIMIT_EXCEEDED",
+			expectedError: "operation has complexity 122, which exceeds the limit of 100",
+		},
+		{
+			name:  "introspection is not counted towards depth",
+			query: `{ __schema { types { fields { type { ofType { ofType { name } } } } } } }`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			code, resp := doQuery(t, newLimitedHandler(config), tt.query)
+
+			assert.Equal(t, http.StatusOK, code)
+			if tt.expectedError == "" {
+				assert.Empty(t, resp.Errors)
+				return
+			}
+			require.Len(t, resp.Errors, 1)
+			assert.Equal(t, tt.expectedError, resp.Errors[0].Message)
+			assert.Equal(t, tt.expectedCode, resp.Errors[0].Extensions["code"])
+		})
+	}
+}
@@ -0,0 +1,85 @@
+package server
+
+import (
+	"context"
+	"errors"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+	"time"
+)
+
+const (
+	metricsName            = "Metrics"
+	adminReadHeaderTimeout = 5 * time.Second
+)
+
+var (
+	resolverDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "graphql_resolver_duration_seconds",
+		Help:    "Latency of GraphQL field resolvers by object and field.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"object", "field"})
+
+	resolverErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "graphql_resolver_errors_total",
+		Help: "Number of GraphQL field resolvers that returned an error, by object and field.",
+	}, []string{"object", "field"})
+)
+
+type AdminConfig struct {
+	Port string `envconfig:"APP_ADMIN_PORT" default:"9091"`
+}
+
+// Metrics records the latency and errors of every field backed by a resolver.
+type Metrics struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.FieldInterceptor
+} = Metrics{}
+
+func (m Metrics) ExtensionName() string {
+	return metricsName
+}
+
+func (m Metrics) Validate(_ graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (m Metrics) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+
+	start := time.Now()
+	res, err := next(ctx)
+	resolverDuration.WithLabelValues(fc.Object, fc.Field.Name).Observe(time.Since(start).Seconds())
+	if err != nil {
+		resolverErrors.WithLabelValues(fc.Object, fc.Field.Name).Inc()
+	}
+	return res, err
+}
+
+// startAdminServer serves /metrics on the admin port so that it is never exposed next to the GraphQL endpoint.
+func startAdminServer(port string) *http.Server {
+	router := http.NewServeMux()
+	router.Handle("/metrics", promhttp.Handler())
+	server := &http.Server{
+		Addr:              ":" + port,
+		Handler:           router,
+		ReadHeaderTimeout: adminReadHeaderTimeout,
+	}
+
+	go func() {
+		log.C(context.Background()).Infof("serving metrics on admin port %s", port)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.C(context.Background()).Errorf("admin server stopped: %v", err)
+		}
+	}()
+	return server
+}
@@ -0,0 +1,32 @@
+package server
+
+import (
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	graph "github.com/Victor-Uzunov/devops-project/graphqlServer/generated"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers"
+	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/pkg/todoclient"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"testing"
+)
+
+func TestMetrics(t *testing.T) {
+	mockClient := new(mock2.ClientMock)
+	mockClient.On("Do", mock.Anything, http.MethodGet, "/lists/1", mock.Anything).
+		Return([]byte(nil), &todoclient.Error{StatusCode: http.StatusNotFound, Message: "list not found"})
+
+	srv := handler.New(graph.NewExecutableSchema(graph.Config{
+		Resolvers: resolvers.NewRootResolver(todoclient.New(mockClient)),
+	}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(Metrics{})
+
+	doQuery(t, srv, `{ list(id: "1") { id } }`)
+
+	assert.Equal(t, float64(1), testutil.ToFloat64(resolverErrors.WithLabelValues("Query", "list")))
+	assert.Equal(t, 1, testutil.CollectAndCount(resolverDuration, "graphql_resolver_duration_seconds"))
+}
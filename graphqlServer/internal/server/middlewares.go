@@ -3,13 +3,64 @@ package server
 import (
 	"context"
 	"encoding/json"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/pkg/todoclient"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	jwts "github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 	"github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
 	"net/http"
+	"regexp"
+	"time"
 )
 
+var requestIDPattern = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,128}$`)
+
+type statusRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func (r *statusRecorder) WriteHeader(status int) {
+	r.status = status
+	r.ResponseWriter.WriteHeader(status)
+}
+
+// RequestLoggingMiddleware assigns every request an X-Request-ID that is forwarded to the todo service,
+// adds it to the context logger and writes one access log line per request.
+func RequestLoggingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		requestID := r.Header.Get(todoclient.RequestIDHeader)
+		if !requestIDPattern.MatchString(requestID) {
+			requestID = uuid.New().String()
+		}
+		w.Header().Set(todoclient.RequestIDHeader, requestID)
+
+		fields := logrus.Fields{
+			"request_id": requestID,
+			"method":     r.Method,
+			"route":      r.URL.Path,
+		}
+		if spanContext := trace.SpanContextFromContext(r.Context()); spanContext.IsValid() {
+			fields["trace_id"] = spanContext.TraceID().String()
+		}
+		ctx := todoclient.WithRequestID(r.Context(), requestID)
+		ctx = log.ContextWithLogger(ctx, log.C(ctx).WithFields(fields))
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		next.ServeHTTP(recorder, r.WithContext(ctx))
+
+		log.C(ctx).WithFields(logrus.Fields{
+			"status":      recorder.status,
+			"duration_ms": time.Since(start).Milliseconds(),
+		}).Info("request completed")
+	})
+}
+
 func JWTMiddleware(next http.Handler) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		log.C(r.Context()).Info("JWTMiddleware")
@@ -23,14 +74,14 @@ func JWTMiddleware(next http.Handler) http.Handler {
 			return
 		}
 		claims := &jwts.Claims{}
-		token, _ := jwt.ParseWithClaims(cookie.Value, claims, func(token *jwt.Token) (interface{}, error) {
+		_, _ = jwt.ParseWithClaims(cookie.Value, claims, func(token *jwt.Token) (interface{}, error) {
 			return nil, nil
 		})
-		log.C(r.Context()).Debugf("claims: %v", claims)
-		log.C(r.Context()).Debugf("token: %v", token)
+		log.C(r.Context()).Debugf("parsed token for user %s with role %s", claims.ID, claims.Role)
 
 		ctx := context.WithValue(r.Context(), constants.TokenCtxKey, cookie.Value)
 		ctx = context.WithValue(ctx, "user", claims)
+		ctx = log.ContextWithLogger(ctx, log.C(ctx).WithField("user_id", claims.ID))
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
@@ -0,0 +1,7 @@
+package server
+
+const (
+	// playgroundPath serves GraphiQL, which loads its bundle from jsdelivr and bootstraps it with an inline script.
+	playgroundPath           = "/"
+	playgroundSecurityPolicy = "default-src 'self'; script-src 'self' 'unsafe-inline' https://cdn.jsdelivr.net; style-src 'self' 'unsafe-inline' https://cdn.jsdelivr.net; img-src 'self' data: https://cdn.jsdelivr.net; font-src 'self' https://cdn.jsdelivr.net; connect-src 'self' ws: wss:; frame-ancestors 'none'"
+)
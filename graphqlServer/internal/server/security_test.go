@@ -0,0 +1,94 @@
+package server
+
+import (
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/httpserver"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func testHandler(t *testing.T) http.Handler {
+	router := mux.NewRouter()
+	router.HandleFunc(playgroundPath, func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	})
+	router.HandleFunc("/query", func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodOptions {
+			t.Fatal("preflight must not reach the GraphQL handler")
+		}
+		w.WriteHeader(http.StatusOK)
+	})
+	s := &Server{Router: router}
+	return s.Handler(httpserver.Config{
+		CORSConfig: httpserver.CORSConfig{
+			AllowedOrigins:   []string{"http://localhost:8000"},
+			AllowedMethods:   []string{http.MethodGet, http.MethodPost},
+			AllowedHeaders:   []string{"Authorization", "Content-Type"},
+			AllowCredentials: true,
+			MaxAge:           time.Minute,
+		},
+		SecurityHeadersConfig: httpserver.SecurityHeadersConfig{HSTSMaxAge: time.Hour},
+	})
+}
+
+func TestHandler_Preflight(t *testing.T) {
+	tests := []struct {
+		name          string
+		origin        string
+		method        string
+		expectedAllow string
+	}{
+		{
+			name:          "Allowed origin",
+			origin:        "http://localhost:8000",
+			method:        http.MethodPost,
+			expectedAllow: "http://localhost:8000",
+		},
+		{
+			name:   "Unknown origin",
+			origin: "http://localhost:4000",
+			method: http.MethodPost,
+		},
+		{
+			name:   "Method not allowed",
+			origin: "http://localhost:8000",
+			method: http.MethodDelete,
+		},
+	}
+	handler := testHandler(t)
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodOptions, "/query", nil)
+			req.Header.Set("Origin", tt.origin)
+			req.Header.Set("Access-Control-Request-Method", tt.method)
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusNoContent, rr.Code)
+			assert.Equal(t, tt.expectedAllow, rr.Header().Get("Access-Control-Allow-Origin"))
+			assert.Equal(t, "nosniff", rr.Header().Get("X-Content-Type-Options"))
+		})
+	}
+}
+
+func TestHandler_SecurityHeaders(t *testing.T) {
+	handler := testHandler(t)
+
+	req := httptest.NewRequest(http.MethodGet, playgroundPath, nil)
+	req.Header.Set("X-Forwarded-Proto", "https")
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, playgroundSecurityPolicy, rr.Header().Get("Content-Security-Policy"))
+	assert.Equal(t, "max-age=3600; includeSubDomains", rr.Header().Get("Strict-Transport-Security"))
+	assert.Equal(t, "DENY", rr.Header().Get("X-Frame-Options"))
+
+	req = httptest.NewRequest(http.MethodPost, "/query", nil)
+	rr = httptest.NewRecorder()
+	handler.ServeHTTP(rr, req)
+	assert.Equal(t, httpserver.APIContentSecurityPolicy, rr.Header().Get("Content-Security-Policy"))
+	assert.Empty(t, rr.Header().Get("Strict-Transport-Security"))
+}
@@ -1,67 +1,116 @@
 package server
 
 import (
+	"context"
+	"fmt"
 	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/extension"
+	"github.com/99designs/gqlgen/graphql/handler/lru"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
 	"github.com/99designs/gqlgen/graphql/playground"
 	graph "github.com/Victor-Uzunov/devops-project/graphqlServer/generated"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/client"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/loaders"
 	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/pkg/todoclient"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/health"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/httpserver"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 	"github.com/gorilla/mux"
-	"github.com/rs/cors"
-	"log"
+	"net"
 	"net/http"
+	"time"
 )
 
 type Server struct {
-	Port   string
-	Router http.Handler
+	Port      string
+	AdminPort string
+	Router    http.Handler
+	Health    *health.Health
 }
 
-func NewServer(config client.APIConfig) *Server {
-	todoServiceClient := client.NewTodoServiceClient(&http.Client{}, config)
+func NewServer(config client.APIConfig, gqlConfig GraphQLConfig, adminConfig AdminConfig, healthChecks *health.Health) *Server {
+	todoServiceClient := client.NewTodoServiceClient(http.DefaultTransport, config)
+	todoClient := todoclient.New(todoServiceClient)
 	directives := resolvers.NewDirective(todoServiceClient)
 
 	rootResolver := resolvers.NewRootResolver(
-		todoServiceClient,
+		todoClient,
 	)
 	gqlCfg := graph.Config{
 		Resolvers: rootResolver,
 		Directives: graph.DirectiveRoot{
 			Validate: directives.ValidateDirective,
 		},
+		Complexity: newComplexityRoot(gqlConfig),
 	}
 
-	srv := handler.NewDefaultServer(graph.NewExecutableSchema(gqlCfg))
-	router := mux.NewRouter()
-	router.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("OK"))
+	srv := handler.New(graph.NewExecutableSchema(gqlCfg))
+	srv.AddTransport(transport.Websocket{
+		KeepAlivePingInterval: 10 * time.Second,
 	})
+	srv.AddTransport(transport.Options{})
+	srv.AddTransport(transport.GET{})
+	srv.AddTransport(transport.POST{})
+	srv.AddTransport(transport.MultipartForm{MaxUploadSize: gqlConfig.MaxUploadSize})
 
-	router.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("Ready"))
+	srv.SetQueryCache(lru.New(gqlConfig.QueryCacheSize))
+	srv.SetErrorPresenter(presentError)
 
+	srv.Use(Tracing{})
+	srv.Use(Metrics{})
+	srv.Use(extension.Introspection{})
+	srv.Use(extension.AutomaticPersistedQuery{
+		Cache: lru.New(gqlConfig.APQCacheSize),
 	})
-	corsRouter := router.PathPrefix("").Subrouter()
-	corsRouter.Use(cors.New(cors.Options{
-		AllowedOrigins:   []string{"http://localhost:4000"},
-		AllowCredentials: true,
-		AllowedMethods:   []string{"POST", "GET", "OPTIONS"},
-		AllowedHeaders:   []string{"Content-Type", "Authorization"},
-		Debug:            true,
-	}).Handler)
+	srv.Use(DepthLimit{Limit: gqlConfig.DepthLimit})
+	srv.Use(extension.FixedComplexityLimit(gqlConfig.ComplexityLimit))
 
-	corsRouter.HandleFunc("/", playground.Handler("GraphQL playground", "/query"))
-	corsRouter.Handle("/query", JWTMiddleware(srv))
+	router := mux.NewRouter()
+	router.HandleFunc("/healthz", healthChecks.LivenessHandler).Methods(http.MethodGet)
+	router.HandleFunc("/readyz", healthChecks.ReadinessHandler).Methods(http.MethodGet)
+	router.HandleFunc(playgroundPath, playground.Handler("GraphQL playground", "/query"))
+	router.Handle("/query", TracingMiddleware(RequestLoggingMiddleware(JWTMiddleware(loaders.Middleware(todoClient, srv)))))
 
 	return &Server{
-		Port:   config.Port,
-		Router: router,
+		Port:      config.Port,
+		AdminPort: adminConfig.Port,
+		Router:    router,
+		Health:    healthChecks,
+	}
+}
+
+// Run serves the GraphQL API on the configured address, APP_TODO_SERVICE_PORT by default, until ctx is
+// cancelled, see httpserver.Serve. The admin server is stopped afterwards.
+func (s *Server) Run(ctx context.Context, config httpserver.Config) error {
+	if err := config.Validate(); err != nil {
+		return err
 	}
+	address := config.Address
+	if address == "" {
+		address = ":" + s.Port
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("error listening on %s: %w", address, err)
+	}
+
+	adminServer := startAdminServer(s.AdminPort)
+	defer func() {
+		shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), config.ShutdownTimeout)
+		defer cancel()
+		if err := adminServer.Shutdown(shutdownCtx); err != nil {
+			log.C(ctx).Errorf("error shutting down admin server: %v", err)
+		}
+	}()
+
+	log.C(ctx).Infof("connect to http://localhost%s/ for GraphQL playground", address)
+	return httpserver.Serve(ctx, "graphql server", listener, s.Handler(config), config, s.Health)
 }
 
-func (s *Server) Start() {
-	log.Printf("connect to http://localhost:%s/ for GraphQL playground", s.Port)
-	log.Fatal(http.ListenAndServe(":"+s.Port, s.Router))
+// Handler returns the router of the facade wrapped with CORS handling and security headers.
+func (s *Server) Handler(config httpserver.Config) http.Handler {
+	cors := httpserver.NewCORS(config.CORSConfig, []string{http.MethodGet, http.MethodPost})
+	policies := map[string]string{playgroundPath: playgroundSecurityPolicy}
+	return httpserver.SecurityHeaders(config.SecurityHeadersConfig, policies, cors.Handler(s.Router))
 }
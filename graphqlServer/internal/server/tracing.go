@@ -0,0 +1,82 @@
+package server
+
+import (
+	"context"
+	"fmt"
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/tracing"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+)
+
+const tracingName = "Tracing"
+
+// Tracing opens a span for every GraphQL operation and a child span for every field backed by a resolver.
+// Plain struct fields are skipped, they are resolved in memory and would only add noise to the trace.
+type Tracing struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.ResponseInterceptor
+	graphql.FieldInterceptor
+} = Tracing{}
+
+func (t Tracing) ExtensionName() string {
+	return tracingName
+}
+
+func (t Tracing) Validate(_ graphql.ExecutableSchema) error {
+	return nil
+}
+
+func (t Tracing) InterceptResponse(ctx context.Context, next graphql.ResponseHandler) *graphql.Response {
+	name := "graphql.operation"
+	var attrs []attribute.KeyValue
+	if graphql.HasOperationContext(ctx) {
+		oc := graphql.GetOperationContext(ctx)
+		if oc.Operation != nil {
+			attrs = append(attrs, attribute.String("graphql.operation.type", string(oc.Operation.Operation)))
+			if oc.Operation.Name != "" {
+				name = fmt.Sprintf("graphql.operation %s", oc.Operation.Name)
+				attrs = append(attrs, attribute.String("graphql.operation.name", oc.Operation.Name))
+			}
+		}
+	}
+
+	ctx, span := tracing.Start(ctx, name, attrs...)
+	defer span.End()
+
+	response := next(ctx)
+	if response != nil && len(response.Errors) > 0 {
+		span.SetStatus(codes.Error, response.Errors.Error())
+	}
+	return response
+}
+
+func (t Tracing) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+
+	ctx, span := tracing.Start(ctx, fmt.Sprintf("%s.%s", fc.Object, fc.Field.Name),
+		attribute.String("graphql.field.path", fc.Path().String()),
+	)
+	res, err := next(ctx)
+	tracing.End(span, err)
+	return res, err
+}
+
+// TracingMiddleware continues a trace started by the caller of the GraphQL endpoint.
+func TracingMiddleware(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := otel.GetTextMapPropagator().Extract(r.Context(), propagation.HeaderCarrier(r.Header))
+		ctx, span := tracing.Tracer().Start(ctx, fmt.Sprintf("%s %s", r.Method, r.URL.Path), trace.WithSpanKind(trace.SpanKindServer))
+		defer span.End()
+		next.ServeHTTP(w, r.WithContext(ctx))
+	})
+}
@@ -0,0 +1,46 @@
+package server
+
+import (
+	"github.com/99designs/gqlgen/graphql/handler"
+	"github.com/99designs/gqlgen/graphql/handler/transport"
+	graph "github.com/Victor-Uzunov/devops-project/graphqlServer/generated"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers"
+	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/pkg/todoclient"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"net/http"
+	"testing"
+)
+
+func TestTracing(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+
+	mockClient := new(mock2.ClientMock)
+	mockClient.On("Do", mock.Anything, http.MethodGet, "/lists/1", mock.Anything).
+		Return([]byte(nil), &todoclient.Error{StatusCode: http.StatusNotFound, Message: "list not found"})
+
+	srv := handler.New(graph.NewExecutableSchema(graph.Config{
+		Resolvers: resolvers.NewRootResolver(todoclient.New(mockClient)),
+	}))
+	srv.AddTransport(transport.POST{})
+	srv.Use(Tracing{})
+
+	doQuery(t, srv, `query GetList { list(id: "1") { id } }`)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 2)
+
+	field, operation := spans[0], spans[1]
+	assert.Equal(t, "Query.list", field.Name())
+	assert.Equal(t, codes.Error, field.Status().Code)
+	assert.Equal(t, "graphql.operation GetList", operation.Name())
+	assert.Equal(t, operation.SpanContext().SpanID(), field.Parent().SpanID())
+	assert.Equal(t, codes.Error, operation.Status().Code)
+}
@@ -0,0 +1,42 @@
+package todoclient
+
+import (
+	"context"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/http"
+)
+
+// AcceptList accepts a pending invitation of userID to the list.
+func (c *Client) AcceptList(ctx context.Context, listID, userID string) error {
+	return c.call(ctx, http.MethodPost, pathf("/lists_access/%s/%s", listID, userID), nil, nil)
+}
+
+// GrantListAccess shares access.ListID with access.UserID and returns the stored access entry.
+func (c *Client) GrantListAccess(ctx context.Context, access models.Access) (*models.Access, error) {
+	var created models.Access
+	path := pathf("/lists_access/create/%s/%s", access.ListID, access.UserID)
+	if err := c.call(ctx, http.MethodPost, path, access, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+func (c *Client) GetListAccess(ctx context.Context, listID, userID string) (*models.Access, error) {
+	var access models.Access
+	if err := c.call(ctx, http.MethodGet, pathf("/lists_access/%s/%s", listID, userID), nil, &access); err != nil {
+		return nil, err
+	}
+	return &access, nil
+}
+
+func (c *Client) ListListAccesses(ctx context.Context, listID string) ([]models.Access, error) {
+	var accesses []models.Access
+	if err := c.call(ctx, http.MethodGet, pathf("/lists_access/list/%s", listID), nil, &accesses); err != nil {
+		return nil, err
+	}
+	return accesses, nil
+}
+
+func (c *Client) DeleteListAccess(ctx context.Context, listID, userID string) error {
+	return c.call(ctx, http.MethodDelete, pathf("/lists_access/%s/%s", listID, userID), nil, nil)
+}
@@ -0,0 +1,70 @@
+package todoclient
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"io"
+	"mime/multipart"
+	"net/http"
+)
+
+// StreamDoer is implemented by doers that can send request bodies other than JSON, such as file uploads.
+type StreamDoer interface {
+	DoStream(ctx context.Context, method, path, contentType string, body io.Reader) ([]byte, error)
+}
+
+// Attachment describes a file attached to a todo, the content is served by the todo service at DownloadPath.
+type Attachment = models.Attachment
+
+// DownloadPath is the todo service path streaming the content of the attachment.
+func DownloadPath(attachment *Attachment) string {
+	return pathf("/todos/%s/attachments/%s", attachment.TodoID, attachment.ID)
+}
+
+func (c *Client) ListAttachments(ctx context.Context, todoID string) ([]*Attachment, error) {
+	var attachments []*Attachment
+	if err := c.call(ctx, http.MethodGet, pathf("/todos/%s/attachments", todoID), nil, &attachments); err != nil {
+		return nil, err
+	}
+	return attachments, nil
+}
+
+// UploadAttachment streams content to the todo service as a multipart/form-data upload.
+// Uploads are never retried since the content can only be read once.
+func (c *Client) UploadAttachment(ctx context.Context, todoID, filename string, content io.Reader) (*Attachment, error) {
+	streamer, ok := c.doer.(StreamDoer)
+	if !ok {
+		return nil, errors.New("the todo service client does not support uploads")
+	}
+
+	body, writer := io.Pipe()
+	defer body.Close()
+	form := multipart.NewWriter(writer)
+	go func() {
+		part, err := form.CreateFormFile("file", filename)
+		if err == nil {
+			_, err = io.Copy(part, content)
+		}
+		if err == nil {
+			err = form.Close()
+		}
+		writer.CloseWithError(err)
+	}()
+
+	response, err := streamer.DoStream(ctx, http.MethodPost, pathf("/todos/%s/attachments", todoID), form.FormDataContentType(), body)
+	if err != nil {
+		return nil, err
+	}
+	var created Attachment
+	if err = json.Unmarshal(response, &created); err != nil {
+		return nil, fmt.Errorf("error unmarshalling response: %w", err)
+	}
+	return &created, nil
+}
+
+func (c *Client) DeleteAttachment(ctx context.Context, todoID, id string) error {
+	return c.call(ctx, http.MethodDelete, pathf("/todos/%s/attachments/%s", todoID, id), nil, nil)
+}
@@ -0,0 +1,31 @@
+package todoclient
+
+import (
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+// The actions of a BulkOperation.
+const (
+	BulkComplete    = models.BulkComplete
+	BulkDelete      = models.BulkDelete
+	BulkReassign    = models.BulkReassign
+	BulkSetPriority = models.BulkSetPriority
+	BulkMove        = models.BulkMove
+	BulkAddTag      = models.BulkAddTag
+)
+
+type BulkAction = models.BulkAction
+
+// BulkOperation is one item of BulkUpdateTodos. Only the field of the action is read: AssignedTo for reassign,
+// Priority for set_priority, ListID for move and TagID for add_tag.
+type BulkOperation = models.BulkOperation
+
+// BulkResult is the outcome of the operation at the same index. Status is the HTTP status the operation would
+// have got from its own endpoint, Error is set when it failed and Todo when it succeeded without a delete.
+type BulkResult struct {
+	Action BulkAction   `json:"action"`
+	TodoID string       `json:"todo_id"`
+	Status int          `json:"status"`
+	Error  string       `json:"error"`
+	Todo   *models.Todo `json:"todo"`
+}
@@ -0,0 +1,194 @@
+// Package todoclient is a typed client for the todo service REST API.
+package todoclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+)
+
+const (
+	instrumentationName = "github.com/Victor-Uzunov/devops-project/graphqlServer/pkg/todoclient"
+
+	// RequestIDHeader correlates a facade request with the todo service requests it fans out to.
+	RequestIDHeader = "X-Request-ID"
+)
+
+type requestIDKey struct{}
+
+// WithRequestID stores the request id that is forwarded to the todo service.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestIDFromContext returns the request id stored by WithRequestID, or an empty string.
+func RequestIDFromContext(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// Doer sends a single request to the todo service and returns the raw response body.
+type Doer interface {
+	Do(ctx context.Context, method, path string, body []byte) ([]byte, error)
+}
+
+type Client struct {
+	doer Doer
+}
+
+func New(doer Doer) *Client {
+	return &Client{
+		doer: doer,
+	}
+}
+
+// NewHTTPClient returns a Client talking to the todo service at endpoint.
+// The bearer token is taken from the request context, see WithToken.
+func NewHTTPClient(httpClient *http.Client, endpoint string) *Client {
+	return New(NewHTTPDoer(httpClient, endpoint))
+}
+
+// WithToken stores the bearer token that is forwarded to the todo service.
+func WithToken(ctx context.Context, token string) context.Context {
+	return context.WithValue(ctx, constants.TokenCtxKey, token)
+}
+
+type httpDoer struct {
+	httpClient *http.Client
+	endpoint   string
+}
+
+func NewHTTPDoer(httpClient *http.Client, endpoint string) Doer {
+	return &httpDoer{
+		httpClient: httpClient,
+		endpoint:   strings.TrimSuffix(endpoint, "/"),
+	}
+}
+
+var _ StreamDoer = &httpDoer{}
+
+// Do sends a JSON request, an empty object when body is nil.
+func (d *httpDoer) Do(ctx context.Context, method, path string, body []byte) ([]byte, error) {
+	requestBody := []byte("{}")
+	if body != nil {
+		requestBody = body
+	}
+	return d.send(ctx, method, path, constants.ContentTypeJSON, bytes.NewBuffer(requestBody))
+}
+
+// DoStream sends body as is with the given content type.
+func (d *httpDoer) DoStream(ctx context.Context, method, path, contentType string, body io.Reader) ([]byte, error) {
+	return d.send(ctx, method, path, contentType, body)
+}
+
+// send sends the request inside a client span and propagates it to the todo service in the traceparent header.
+func (d *httpDoer) send(ctx context.Context, method, path, contentType string, body io.Reader) (response []byte, err error) {
+	log.C(ctx).Infof("do in client for url %s and method %s", path, method)
+	ctx, span := otel.Tracer(instrumentationName).Start(ctx, fmt.Sprintf("%s %s", method, path),
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(semconv.HTTPRequestMethodKey.String(method), semconv.URLPath(path)),
+	)
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+			span.SetStatus(codes.Error, err.Error())
+		}
+		span.End()
+	}()
+
+	endpoint := d.endpoint + path
+
+	token, ok := ctx.Value(constants.TokenCtxKey).(string)
+	if !ok || token == "" {
+		log.C(ctx).Error("authorization token missing or invalid in context")
+		return nil, fmt.Errorf("authorization token missing or invalid")
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, endpoint, body)
+	if err != nil {
+		log.C(ctx).Errorf("error creating request for url %s and method %s", endpoint, method)
+		return nil, err
+	}
+	req.Header.Set("Content-Type", contentType)
+	req.Header.Set(constants.AuthorizationHeader, fmt.Sprintf("Bearer %s", token))
+	if requestID := RequestIDFromContext(ctx); requestID != "" {
+		req.Header.Set(RequestIDHeader, requestID)
+	}
+	otel.GetTextMapPropagator().Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	resp, err := d.httpClient.Do(req)
+	if err != nil {
+		log.C(ctx).Errorf("error doing request for url %s and method %s", endpoint, method)
+		return nil, fmt.Errorf("error executing request: %w", err)
+	}
+
+	log.C(ctx).Debugf("response status code: %d", resp.StatusCode)
+	span.SetAttributes(semconv.HTTPResponseStatusCode(resp.StatusCode))
+
+	defer func() {
+		if closeErr := resp.Body.Close(); closeErr != nil {
+			log.C(ctx).Errorf("error closing response body for url %s and method %s", endpoint, method)
+			return
+		}
+	}()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		log.C(ctx).Errorf("error reading response body for url %s and method %s", endpoint, method)
+		return nil, fmt.Errorf("error reading response body: %w", err)
+	}
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return nil, newError(resp.StatusCode, respBody)
+	}
+	return respBody, nil
+}
+
+// call marshals in (when not nil) as the request body and unmarshals the response into out (when not nil).
+func (c *Client) call(ctx context.Context, method, path string, in, out interface{}) error {
+	var body []byte
+	if in != nil {
+		var err error
+		if body, err = json.Marshal(in); err != nil {
+			return fmt.Errorf("error marshalling request: %w", err)
+		}
+	}
+
+	response, err := c.doer.Do(ctx, method, path, body)
+	if err != nil {
+		return err
+	}
+	if out == nil {
+		return nil
+	}
+	if err = json.Unmarshal(response, out); err != nil {
+		return fmt.Errorf("error unmarshalling response: %w", err)
+	}
+	return nil
+}
+
+func pathf(format string, ids ...string) string {
+	escaped := make([]interface{}, len(ids))
+	for i, id := range ids {
+		escaped[i] = url.PathEscape(id)
+	}
+	return fmt.Sprintf(format, escaped...)
+}
+
+func joinIDs(ids []string) string {
+	escaped := make([]string, len(ids))
+	for i, id := range ids {
+		escaped[i] = url.QueryEscape(id)
+	}
+	return strings.Join(escaped, ",")
+}
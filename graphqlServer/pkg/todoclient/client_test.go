@@ -0,0 +1,561 @@
+package todoclient_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	mock2 "github.com/Victor-Uzunov/devops-project/graphqlServer/internal/resolvers/mock"
+	"github.com/Victor-Uzunov/devops-project/graphqlServer/pkg/todoclient"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHTTPDoer(t *testing.T) {
+	tests := []struct {
+		name           string
+		token          string
+		statusCode     int
+		responseBody   string
+		expectedBody   []byte
+		expectedStatus int
+		expectError    bool
+	}{
+		{
+			name:         "successful request",
+			token:        "token",
+			statusCode:   http.StatusOK,
+			responseBody: `{"id": "1"}`,
+			expectedBody: []byte(`{"id": "1"}`),
+		},
+		{
+			name:           "non 2xx response is returned as structured error",
+			token:          "token",
+			statusCode:     http.StatusNotFound,
+			responseBody:   "list not found\n",
+			expectedStatus: http.StatusNotFound,
+			expectError:    true,
+		},
+		{
+			name:        "missing token",
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				assert.Equal(t, http.MethodPatch, r.Method)
+				assert.Equal(t, "/lists/1/name", r.URL.Path)
+				assert.Equal(t, "Bearer "+tt.token, r.Header.Get(constants.AuthorizationHeader))
+				body, err := io.ReadAll(r.Body)
+				assert.NoError(t, err)
+				assert.JSONEq(t, `{"name": "new"}`, string(body))
+				w.WriteHeader(tt.statusCode)
+				_, _ = w.Write([]byte(tt.responseBody))
+			}))
+			defer server.Close()
+
+			doer := todoclient.NewHTTPDoer(server.Client(), server.URL+"/")
+			ctx := todoclient.WithToken(context.Background(), tt.token)
+
+			result, err := doer.Do(ctx, http.MethodPatch, "/lists/1/name", []byte(`{"name": "new"}`))
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+				assert.Equal(t, tt.expectedStatus, todoclient.StatusCode(err))
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expectedBody, result)
+			}
+		})
+	}
+}
+
+func TestHTTPDoer_ProblemDetails(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/problem+json")
+		w.WriteHeader(http.StatusBadRequest)
+		_, _ = w.Write([]byte(`{"status": 400, "code": "VALIDATION_FAILED", "message": "Title cannot be empty", "fields": [{"field": "title", "message": "cannot be empty"}], "request_id": "req-1"}`))
+	}))
+	defer server.Close()
+
+	doer := todoclient.NewHTTPDoer(server.Client(), server.URL)
+	_, err := doer.Do(todoclient.WithToken(context.Background(), "token"), http.MethodPatch, "/todos/1/title", nil)
+
+	var apiErr *todoclient.Error
+	require.ErrorAs(t, err, &apiErr)
+	assert.Equal(t, &todoclient.Error{
+		StatusCode: http.StatusBadRequest,
+		Code:       "VALIDATION_FAILED",
+		Message:    "Title cannot be empty",
+		Fields:     []todoclient.FieldError{{Field: "title", Message: "cannot be empty"}},
+		RequestID:  "req-1",
+	}, apiErr)
+}
+
+func TestHTTPDoer_PropagatesTraceContext(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	var traceparent string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		traceparent = r.Header.Get("traceparent")
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	doer := todoclient.NewHTTPDoer(server.Client(), server.URL)
+	_, err := doer.Do(todoclient.WithToken(context.Background(), "token"), http.MethodGet, "/lists/1", nil)
+	require.NoError(t, err)
+
+	spans := recorder.Ended()
+	require.Len(t, spans, 1)
+	assert.Equal(t, "GET /lists/1", spans[0].Name())
+	assert.Equal(t, trace.SpanKindClient, spans[0].SpanKind())
+	expected := fmt.Sprintf("00-%s-%s-01", spans[0].SpanContext().TraceID(), spans[0].SpanContext().SpanID())
+	assert.Equal(t, expected, traceparent)
+}
+
+func TestHTTPDoer_ForwardsRequestID(t *testing.T) {
+	var requestID string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestID = r.Header.Get(todoclient.RequestIDHeader)
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	ctx := todoclient.WithRequestID(todoclient.WithToken(context.Background(), "token"), "req-42")
+	_, err := todoclient.NewHTTPDoer(server.Client(), server.URL).Do(ctx, http.MethodGet, "/lists/1", nil)
+
+	require.NoError(t, err)
+	assert.Equal(t, "req-42", requestID)
+}
+
+func TestError(t *testing.T) {
+	err := &todoclient.Error{StatusCode: http.StatusForbidden, Message: "access denied"}
+	wrapped := errors.Join(errors.New("error executing request"), err)
+
+	assert.Equal(t, "todo service responded with status code 403: access denied", err.Error())
+	assert.True(t, todoclient.IsForbidden(wrapped))
+	assert.False(t, todoclient.IsNotFound(wrapped))
+	assert.Equal(t, 0, todoclient.StatusCode(errors.New("connection refused")))
+}
+
+func TestClient_GetList(t *testing.T) {
+	tests := []struct {
+		name         string
+		mockResp     []byte
+		mockErr      error
+		expectedList *models.List
+		expectError  bool
+	}{
+		{
+			name:         "successful fetch",
+			mockResp:     []byte(`{"id": "1", "name": "Groceries", "owner_id": "2"}`),
+			expectedList: &models.List{ID: "1", Name: "Groceries", OwnerID: "2"},
+		},
+		{
+			name:        "failed request",
+			mockErr:     &todoclient.Error{StatusCode: http.StatusNotFound},
+			expectError: true,
+		},
+		{
+			name:        "invalid response",
+			mockResp:    []byte(`invalid JSON`),
+			expectError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+			mockClient.On("Do", mock.Anything, http.MethodGet, "/lists/1", []byte(nil)).Return(tt.mockResp, tt.mockErr)
+
+			result, err := todoclient.New(mockClient).GetList(context.Background(), "1")
+
+			if tt.expectError {
+				assert.Error(t, err)
+				assert.Nil(t, result)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedList, result)
+			}
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_Mutations(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name         string
+		method       string
+		path         string
+		expectedBody []byte
+		mockResp     []byte
+		call         func(c *todoclient.Client) error
+	}{
+		{
+			name:         "create todo returns the new id",
+			method:       http.MethodPost,
+			path:         "/todos/create",
+			expectedBody: []byte(`{"id":"","list_id":"1","title":"Buy milk","description":"","tags":null,"completed":false,"status_id":"","position":"","due_date":null,"start_date":null,"priority":"","creation_date":"0001-01-01T00:00:00Z","last_update_date":"0001-01-01T00:00:00Z","assigned_to":null,"tag_ids":["7"]}`),
+			mockResp:     []byte(`"5"`),
+			call: func(c *todoclient.Client) error {
+				id, err := c.CreateTodo(ctx, todoclient.TodoInput{Todo: models.Todo{ListID: "1", Title: "Buy milk"}, TagIDs: []string{"7"}})
+				assert.Equal(t, "5", id)
+				return err
+			},
+		},
+		{
+			name:         "update tag sends only the changed fields",
+			method:       http.MethodPatch,
+			path:         "/lists/1/tags/7",
+			expectedBody: []byte(`{"name":"home"}`),
+			mockResp:     []byte(`{"id":"7","list_id":"1","name":"home","color":"#9e9e9e"}`),
+			call: func(c *todoclient.Client) error {
+				name := "home"
+				tag, err := c.UpdateTag(ctx, "1", "7", todoclient.TagUpdate{Name: &name})
+				assert.Equal(t, &todoclient.Tag{ID: "7", ListID: "1", Name: "home", Color: "#9e9e9e"}, tag)
+				return err
+			},
+		},
+		{
+			name:         "add comment sends the body",
+			method:       http.MethodPost,
+			path:         "/todos/5/comments",
+			expectedBody: []byte(`{"body":"ping @bob@example.com"}`),
+			mockResp:     []byte(`{"id":"9","todo_id":"5","author_id":"1","body":"ping @bob@example.com","mentions":[{"user_id":"2","email":"bob@example.com"}]}`),
+			call: func(c *todoclient.Client) error {
+				comment, err := c.AddComment(ctx, "5", "ping @bob@example.com")
+				assert.Equal(t, &todoclient.Comment{ID: "9", TodoID: "5", AuthorID: "1", Body: "ping @bob@example.com",
+					Mentions: []todoclient.Mention{{UserID: "2", Email: "bob@example.com"}}}, comment)
+				return err
+			},
+		},
+		{
+			name:     "list comments sends the page in the query",
+			method:   http.MethodGet,
+			path:     "/todos/5/comments?limit=10&offset=20",
+			mockResp: []byte(`{"comments":[{"id":"9"}],"total":21,"limit":10,"offset":20}`),
+			call: func(c *todoclient.Client) error {
+				page, err := c.ListComments(ctx, "5", 10, 20)
+				assert.Equal(t, &todoclient.CommentPage{Comments: []todoclient.Comment{{ID: "9"}}, Total: 21, Limit: 10, Offset: 20}, page)
+				return err
+			},
+		},
+		{
+			name:     "list attachments",
+			method:   http.MethodGet,
+			path:     "/todos/5/attachments",
+			mockResp: []byte(`[{"id":"3","todo_id":"5","filename":"notes.txt","content_type":"text/plain","size":5}]`),
+			call: func(c *todoclient.Client) error {
+				attachments, err := c.ListAttachments(ctx, "5")
+				assert.Equal(t, []*todoclient.Attachment{{ID: "3", TodoID: "5", Filename: "notes.txt", ContentType: "text/plain", Size: 5}}, attachments)
+				assert.Equal(t, "/todos/5/attachments/3", todoclient.DownloadPath(attachments[0]))
+				return err
+			},
+		},
+		{
+			name:         "update priority sends the service priority level",
+			method:       http.MethodPatch,
+			path:         "/todos/5/priority",
+			expectedBody: []byte(`{"priority":"high"}`),
+			mockResp:     []byte(`{"id": "5", "priority": "high"}`),
+			call: func(c *todoclient.Client) error {
+				todo, err := c.UpdateTodoPriority(ctx, "5", constants.PriorityHigh)
+				assert.Equal(t, &models.Todo{ID: "5", Priority: constants.PriorityHigh}, todo)
+				return err
+			},
+		},
+		{
+			name:         "move todo sends the status and the position",
+			method:       http.MethodPatch,
+			path:         "/todos/5/status",
+			expectedBody: []byte(`{"status_id":"s2","position":0}`),
+			mockResp:     []byte(`{"id": "5", "completed": true}`),
+			call: func(c *todoclient.Client) error {
+				position := 0
+				todo, err := c.MoveTodo(ctx, "5", "s2", &position)
+				assert.Equal(t, &models.Todo{ID: "5", Completed: true}, todo)
+				return err
+			},
+		},
+		{
+			name:         "move todo without a position appends it",
+			method:       http.MethodPatch,
+			path:         "/todos/5/status",
+			expectedBody: []byte(`{"status_id":"s2"}`),
+			mockResp:     []byte(`{"id": "5"}`),
+			call: func(c *todoclient.Client) error {
+				_, err := c.MoveTodo(ctx, "5", "s2", nil)
+				return err
+			},
+		},
+		{
+			name:         "reorder todo sends the given neighbours",
+			method:       http.MethodPost,
+			path:         "/todos/5/move",
+			expectedBody: []byte(`{"after_id":"4"}`),
+			mockResp:     []byte(`{"id": "5"}`),
+			call: func(c *todoclient.Client) error {
+				todo, err := c.ReorderTodo(ctx, "5", "", "4")
+				assert.Equal(t, &models.Todo{ID: "5"}, todo)
+				return err
+			},
+		},
+		{
+			name:     "get todo keeps its status, position and tags",
+			method:   http.MethodGet,
+			path:     "/todos/5",
+			mockResp: []byte(`{"id": "5", "status_id": "s2", "position": "n", "tags": [{"id": "7", "list_id": "1", "name": "work", "color": "#9e9e9e"}]}`),
+			call: func(c *todoclient.Client) error {
+				todo, err := c.GetTodo(ctx, "5")
+				assert.Equal(t, &models.Todo{ID: "5", StatusID: "s2", Position: "n",
+					Tags: []models.Tag{{ID: "7", ListID: "1", Name: "work", Color: "#9e9e9e"}}}, todo)
+				return err
+			},
+		},
+		{
+			name:         "move todo to list sends the list",
+			method:       http.MethodPost,
+			path:         "/todos/5/move",
+			expectedBody: []byte(`{"list_id":"2"}`),
+			mockResp:     []byte(`{"id": "5", "list_id": "2"}`),
+			call: func(c *todoclient.Client) error {
+				todo, err := c.MoveTodoToList(ctx, "5", "2")
+				assert.Equal(t, &models.Todo{ID: "5", ListID: "2"}, todo)
+				return err
+			},
+		},
+		{
+			name:         "move todos to list sends the todos in order",
+			method:       http.MethodPost,
+			path:         "/todos/move",
+			expectedBody: []byte(`{"ids":["5","4"],"list_id":"2"}`),
+			mockResp:     []byte(`[{"id": "5"}, {"id": "4"}]`),
+			call: func(c *todoclient.Client) error {
+				todos, err := c.MoveTodosToList(ctx, []string{"5", "4"}, "2")
+				assert.Equal(t, []*models.Todo{{ID: "5"}, {ID: "4"}}, todos)
+				return err
+			},
+		},
+		{
+			name:         "bulk update todos sends only the fields of each action",
+			method:       http.MethodPost,
+			path:         "/todos/bulk",
+			expectedBody: []byte(`{"operations":[{"action":"set_priority","todo_id":"5","priority":"high"},{"action":"delete","todo_id":"4"}]}`),
+			mockResp:     []byte(`{"results": [{"action": "set_priority", "todo_id": "5", "status": 200, "todo": {"id": "5"}}, {"action": "delete", "todo_id": "4", "status": 404, "error": "todo 4 not found"}]}`),
+			call: func(c *todoclient.Client) error {
+				results, err := c.BulkUpdateTodos(ctx, []todoclient.BulkOperation{
+					{Action: todoclient.BulkSetPriority, TodoID: "5", Priority: constants.PriorityHigh},
+					{Action: todoclient.BulkDelete, TodoID: "4"},
+				})
+				assert.Equal(t, []todoclient.BulkResult{
+					{Action: todoclient.BulkSetPriority, TodoID: "5", Status: http.StatusOK, Todo: &models.Todo{ID: "5"}},
+					{Action: todoclient.BulkDelete, TodoID: "4", Status: http.StatusNotFound, Error: "todo 4 not found"},
+				}, results)
+				return err
+			},
+		},
+		{
+			name:         "copy todo",
+			method:       http.MethodPost,
+			path:         "/todos/5/copy",
+			expectedBody: []byte(`{"list_id":"2"}`),
+			mockResp:     []byte(`{"id": "6", "list_id": "2"}`),
+			call: func(c *todoclient.Client) error {
+				todo, err := c.CopyTodo(ctx, "5", "2")
+				assert.Equal(t, &models.Todo{ID: "6", ListID: "2"}, todo)
+				return err
+			},
+		},
+		{
+			name:     "add todo dependency",
+			method:   http.MethodPut,
+			path:     "/todos/5/blocked_by/4",
+			mockResp: []byte(`{"id": "5"}`),
+			call: func(c *todoclient.Client) error {
+				todo, err := c.AddTodoDependency(ctx, "5", "4")
+				assert.Equal(t, &models.Todo{ID: "5"}, todo)
+				return err
+			},
+		},
+		{
+			name:     "list todo blockers",
+			method:   http.MethodGet,
+			path:     "/todos/5/blocked_by",
+			mockResp: []byte(`[{"id": "4"}]`),
+			call: func(c *todoclient.Client) error {
+				todos, err := c.ListTodoBlockers(ctx, "5")
+				assert.Equal(t, []*models.Todo{{ID: "4"}}, todos)
+				return err
+			},
+		},
+		{
+			name:         "reorder list sends both neighbours",
+			method:       http.MethodPost,
+			path:         "/lists/1/move",
+			expectedBody: []byte(`{"before_id":"3","after_id":"2"}`),
+			call: func(c *todoclient.Client) error {
+				return c.ReorderList(ctx, "1", "3", "2")
+			},
+		},
+		{
+			name:     "list user lists with archived lists",
+			method:   http.MethodGet,
+			path:     "/lists/user/all?include_archived=true",
+			mockResp: []byte(`[{"id": "1"}]`),
+			call: func(c *todoclient.Client) error {
+				lists, err := c.ListUserLists(ctx, true)
+				assert.Equal(t, []*models.List{{ID: "1"}}, lists)
+				return err
+			},
+		},
+		{
+			name:     "archive list",
+			method:   http.MethodPost,
+			path:     "/lists/1/archive",
+			mockResp: []byte(`{"id": "1", "name": "Trip", "tags": [{"id": "7", "name": "work"}], "archived_at": "2026-02-01T00:00:00Z"}`),
+			call: func(c *todoclient.Client) error {
+				list, err := c.ArchiveList(ctx, "1")
+				archivedAt := time.Date(2026, 2, 1, 0, 0, 0, 0, time.UTC)
+				assert.Equal(t, &models.List{ID: "1", Name: "Trip", Tags: []models.Tag{{ID: "7", Name: "work"}}, ArchivedAt: &archivedAt}, list)
+				return err
+			},
+		},
+		{
+			name:     "unarchive list",
+			method:   http.MethodPost,
+			path:     "/lists/1/unarchive",
+			mockResp: []byte(`{"id": "1", "name": "Trip"}`),
+			call: func(c *todoclient.Client) error {
+				list, err := c.UnarchiveList(ctx, "1")
+				assert.Equal(t, &models.List{ID: "1", Name: "Trip"}, list)
+				return err
+			},
+		},
+		{
+			name:         "clone list sends the options",
+			method:       http.MethodPost,
+			path:         "/lists/1/clone",
+			expectedBody: []byte(`{"name":"","include_collaborators":true,"reset_completion":false,"anchor_date":"2026-02-01T00:00:00Z"}`),
+			mockResp:     []byte(`{"id": "2", "name": "Trip (copy)"}`),
+			call: func(c *todoclient.Client) error {
+				anchor := time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)
+				list, err := c.CloneList(ctx, "1", todoclient.CloneOptions{IncludeCollaborators: true, AnchorDate: &anchor})
+				assert.Equal(t, &models.List{ID: "2", Name: "Trip (copy)"}, list)
+				return err
+			},
+		},
+		{
+			name:         "save list as template",
+			method:       http.MethodPost,
+			path:         "/lists/1/template",
+			expectedBody: []byte(`{"name":"Sprint","description":""}`),
+			mockResp:     []byte(`{"id": "t1", "name": "Sprint", "blueprint": {"statuses": [{"name": "To Do"}], "todos": [{"title": "Plan"}]}}`),
+			call: func(c *todoclient.Client) error {
+				template, err := c.SaveTemplate(ctx, "1", "Sprint", "")
+				assert.Equal(t, &todoclient.Template{ID: "t1", Name: "Sprint", Blueprint: todoclient.Blueprint{
+					Statuses: []todoclient.BlueprintStatus{{Name: "To Do"}},
+					Todos:    []todoclient.BlueprintTodo{{Title: "Plan"}},
+				}}, template)
+				return err
+			},
+		},
+		{
+			name:         "create list from template without an anchor date",
+			method:       http.MethodPost,
+			path:         "/templates/t1/lists",
+			expectedBody: []byte(`{"name":"Sprint 12"}`),
+			mockResp:     []byte(`{"id": "2"}`),
+			call: func(c *todoclient.Client) error {
+				list, err := c.CreateListFromTemplate(ctx, "t1", "Sprint 12", nil)
+				assert.Equal(t, &models.List{ID: "2"}, list)
+				return err
+			},
+		},
+		{
+			name:   "delete template",
+			method: http.MethodDelete,
+			path:   "/templates/t1",
+			call: func(c *todoclient.Client) error {
+				return c.DeleteTemplate(ctx, "t1")
+			},
+		},
+		{
+			name:   "accept list",
+			method: http.MethodPost,
+			path:   "/lists_access/1/2",
+			call: func(c *todoclient.Client) error {
+				return c.AcceptList(ctx, "1", "2")
+			},
+		},
+		{
+			name:     "batch ids are joined in the query",
+			method:   http.MethodGet,
+			path:     "/users?ids=1,2",
+			mockResp: []byte(`[{"id": "1"}, {"id": "2"}]`),
+			call: func(c *todoclient.Client) error {
+				users, err := c.GetUsersByIDs(ctx, []string{"1", "2"})
+				assert.Len(t, users, 2)
+				return err
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockClient := new(mock2.ClientMock)
+			mockClient.On("Do", mock.Anything, tt.method, tt.path, tt.expectedBody).Return(tt.mockResp, nil)
+
+			err := tt.call(todoclient.New(mockClient))
+
+			assert.NoError(t, err)
+			mockClient.AssertExpectations(t)
+		})
+	}
+}
+
+func TestClient_UploadAttachment(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "/todos/5/attachments", r.URL.Path)
+		assert.Equal(t, "Bearer token", r.Header.Get(constants.AuthorizationHeader))
+
+		file, header, err := r.FormFile("file")
+		require.NoError(t, err)
+		defer file.Close()
+		content, err := io.ReadAll(file)
+		require.NoError(t, err)
+		assert.Equal(t, "notes.txt", header.Filename)
+		assert.Equal(t, "hello", string(content))
+
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":"3","todo_id":"5","filename":"notes.txt","content_type":"text/plain","size":5}`))
+	}))
+	defer server.Close()
+
+	client := todoclient.NewHTTPClient(server.Client(), server.URL)
+	attachment, err := client.UploadAttachment(todoclient.WithToken(context.Background(), "token"), "5", "notes.txt", strings.NewReader("hello"))
+
+	require.NoError(t, err)
+	assert.Equal(t, &todoclient.Attachment{ID: "3", TodoID: "5", Filename: "notes.txt", ContentType: "text/plain", Size: 5}, attachment)
+}
@@ -0,0 +1,38 @@
+package todoclient
+
+import (
+	"context"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/http"
+	"strconv"
+)
+
+// Comment is a markdown comment on a todo with the users mentioned in it.
+type Comment = models.Comment
+
+type Mention = models.Mention
+
+// CommentPage is a page of the comments of a todo, oldest first. Total counts all comments of the todo.
+type CommentPage = models.CommentPage
+
+// ListComments returns limit comments of the todo starting at offset. A zero limit uses the page size of the service.
+func (c *Client) ListComments(ctx context.Context, todoID string, limit, offset int) (*CommentPage, error) {
+	var page CommentPage
+	path := pathf("/todos/%s/comments", todoID) + "?limit=" + strconv.Itoa(limit) + "&offset=" + strconv.Itoa(offset)
+	if err := c.call(ctx, http.MethodGet, path, nil, &page); err != nil {
+		return nil, err
+	}
+	return &page, nil
+}
+
+// AddComment comments on the todo as the current user.
+func (c *Client) AddComment(ctx context.Context, todoID, body string) (*Comment, error) {
+	var created Comment
+	input := struct {
+		Body string `json:"body"`
+	}{Body: body}
+	if err := c.call(ctx, http.MethodPost, pathf("/todos/%s/comments", todoID), input, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
@@ -0,0 +1,66 @@
+package todoclient
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+// Error is returned when the todo service answers with a non-2xx status code.
+// Code, Fields and RequestID are filled from the problem-details body when the service sends one.
+type Error struct {
+	StatusCode int          `json:"status"`
+	Code       string       `json:"code"`
+	Message    string       `json:"message"`
+	Fields     []FieldError `json:"fields,omitempty"`
+	RequestID  string       `json:"request_id,omitempty"`
+}
+
+func (e *Error) Error() string {
+	if e.Message == "" {
+		return fmt.Sprintf("todo service responded with status code %d", e.StatusCode)
+	}
+	return fmt.Sprintf("todo service responded with status code %d: %s", e.StatusCode, e.Message)
+}
+
+// newError builds an Error from a failed response, falling back to the raw body for plain text errors.
+func newError(statusCode int, body []byte) *Error {
+	var apiErr Error
+	if err := json.Unmarshal(body, &apiErr); err != nil || apiErr.Code == "" {
+		apiErr = Error{Message: strings.TrimSpace(string(body))}
+	}
+	apiErr.StatusCode = statusCode
+	return &apiErr
+}
+
+// StatusCode returns the status code carried by err, or 0 if err is not a todo service error.
+func StatusCode(err error) int {
+	var apiErr *Error
+	if errors.As(err, &apiErr) {
+		return apiErr.StatusCode
+	}
+	return 0
+}
+
+func IsNotFound(err error) bool {
+	return StatusCode(err) == http.StatusNotFound
+}
+
+func IsForbidden(err error) bool {
+	return StatusCode(err) == http.StatusForbidden
+}
+
+func IsBadRequest(err error) bool {
+	return StatusCode(err) == http.StatusBadRequest
+}
+
+func IsConflict(err error) bool {
+	return StatusCode(err) == http.StatusConflict
+}
@@ -0,0 +1,129 @@
+package todoclient
+
+import (
+	"context"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/http"
+)
+
+// ListAllLists returns every list in the service and is restricted to admins.
+func (c *Client) ListAllLists(ctx context.Context) ([]*models.List, error) {
+	return c.lists(ctx, "/lists/all")
+}
+
+// ListUserLists returns the lists owned by or shared with the caller. Archived lists are only returned
+// when includeArchived is set.
+func (c *Client) ListUserLists(ctx context.Context, includeArchived bool) ([]*models.List, error) {
+	if includeArchived {
+		return c.lists(ctx, "/lists/user/all?include_archived=true")
+	}
+	return c.lists(ctx, "/lists/user/all")
+}
+
+func (c *Client) ListAcceptedLists(ctx context.Context) ([]*models.List, error) {
+	return c.lists(ctx, "/lists/user/accepted")
+}
+
+func (c *Client) ListPendingLists(ctx context.Context) ([]*models.List, error) {
+	return c.lists(ctx, "/lists/pending/all")
+}
+
+func (c *Client) ListListsByUser(ctx context.Context, userID string) ([]*models.List, error) {
+	return c.lists(ctx, pathf("/users/%s/lists", userID))
+}
+
+// GetListsByIDs fetches up to constants.MaxBatchIDs lists in a single request. Unknown ids are skipped.
+func (c *Client) GetListsByIDs(ctx context.Context, ids []string) ([]*models.List, error) {
+	return c.lists(ctx, "/lists?ids="+joinIDs(ids))
+}
+
+func (c *Client) GetList(ctx context.Context, id string) (*models.List, error) {
+	var list models.List
+	if err := c.call(ctx, http.MethodGet, pathf("/lists/%s", id), nil, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// CreateList creates the list and returns its id.
+func (c *Client) CreateList(ctx context.Context, list models.List) (string, error) {
+	var id string
+	if err := c.call(ctx, http.MethodPost, "/lists/create", list, &id); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+func (c *Client) UpdateList(ctx context.Context, id string, list models.List) error {
+	return c.call(ctx, http.MethodPut, pathf("/lists/%s", id), list, nil)
+}
+
+func (c *Client) UpdateListName(ctx context.Context, id, name string) (*models.List, error) {
+	body := struct {
+		Name string `json:"name"`
+	}{Name: name}
+	return c.patchList(ctx, pathf("/lists/%s/name", id), body)
+}
+
+func (c *Client) UpdateListDescription(ctx context.Context, id, description string) (*models.List, error) {
+	body := struct {
+		Description string `json:"description"`
+	}{Description: description}
+	return c.patchList(ctx, pathf("/lists/%s/description", id), body)
+}
+
+// ReorderList puts the list right before the list beforeID and right after the list afterID among the
+// lists of the caller. Either id may be empty, but not both.
+func (c *Client) ReorderList(ctx context.Context, id, beforeID, afterID string) error {
+	body := struct {
+		BeforeID string `json:"before_id,omitempty"`
+		AfterID  string `json:"after_id,omitempty"`
+	}{BeforeID: beforeID, AfterID: afterID}
+	return c.call(ctx, http.MethodPost, pathf("/lists/%s/move", id), body, nil)
+}
+
+// ArchiveList makes the list read-only and hides it from the lists of its users. Only the owner may archive it.
+func (c *Client) ArchiveList(ctx context.Context, id string) (*models.List, error) {
+	var list models.List
+	if err := c.call(ctx, http.MethodPost, pathf("/lists/%s/archive", id), nil, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+func (c *Client) UnarchiveList(ctx context.Context, id string) (*models.List, error) {
+	var list models.List
+	if err := c.call(ctx, http.MethodPost, pathf("/lists/%s/unarchive", id), nil, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+func (c *Client) DeleteList(ctx context.Context, id string) error {
+	return c.call(ctx, http.MethodDelete, pathf("/lists/%s", id), nil, nil)
+}
+
+// ListCollaborators returns the access entries of every user the list is shared with.
+func (c *Client) ListCollaborators(ctx context.Context, listID string) ([]models.Access, error) {
+	var access []models.Access
+	if err := c.call(ctx, http.MethodGet, pathf("/lists/%s/users", listID), nil, &access); err != nil {
+		return nil, err
+	}
+	return access, nil
+}
+
+func (c *Client) lists(ctx context.Context, path string) ([]*models.List, error) {
+	var lists []*models.List
+	if err := c.call(ctx, http.MethodGet, path, nil, &lists); err != nil {
+		return nil, err
+	}
+	return lists, nil
+}
+
+func (c *Client) patchList(ctx context.Context, path string, body interface{}) (*models.List, error) {
+	var list models.List
+	if err := c.call(ctx, http.MethodPatch, path, body, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
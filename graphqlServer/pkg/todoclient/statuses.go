@@ -0,0 +1,30 @@
+package todoclient
+
+import (
+	"context"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/http"
+)
+
+// Status is a workflow column of a list. AllowedNext lists the statuses its todos may move to,
+// every status of the list when it is empty.
+type Status = models.Status
+
+// ListStatuses returns the statuses of the list ordered by position.
+func (c *Client) ListStatuses(ctx context.Context, listID string) ([]*Status, error) {
+	var statuses []*Status
+	if err := c.call(ctx, http.MethodGet, pathf("/lists/%s/statuses", listID), nil, &statuses); err != nil {
+		return nil, err
+	}
+	return statuses, nil
+}
+
+// MoveTodo moves the todo to position in statusID, or to the end of the status when position is nil.
+// The service rejects moves that the transitions of the current status do not allow.
+func (c *Client) MoveTodo(ctx context.Context, id, statusID string, position *int) (*models.Todo, error) {
+	body := struct {
+		StatusID string `json:"status_id"`
+		Position *int   `json:"position,omitempty"`
+	}{StatusID: statusID, Position: position}
+	return c.patchTodo(ctx, pathf("/todos/%s/status", id), body)
+}
@@ -0,0 +1,47 @@
+package todoclient
+
+import (
+	"context"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/http"
+)
+
+// Tag is a tag defined in a list. Todos and lists carry their tags as a JSON array of tags.
+type Tag = models.Tag
+
+// TagUpdate renames or recolors a tag; nil fields keep their current value.
+type TagUpdate struct {
+	Name  *string `json:"name,omitempty"`
+	Color *string `json:"color,omitempty"`
+}
+
+func (c *Client) ListTags(ctx context.Context, listID string) ([]*Tag, error) {
+	var tags []*Tag
+	if err := c.call(ctx, http.MethodGet, pathf("/lists/%s/tags", listID), nil, &tags); err != nil {
+		return nil, err
+	}
+	return tags, nil
+}
+
+// CreateTag creates the tag in tag.ListID and returns it with its id and color set.
+func (c *Client) CreateTag(ctx context.Context, tag Tag) (*Tag, error) {
+	var created Tag
+	if err := c.call(ctx, http.MethodPost, pathf("/lists/%s/tags", tag.ListID), tag, &created); err != nil {
+		return nil, err
+	}
+	return &created, nil
+}
+
+// UpdateTag changes the tag on every todo tagged with it.
+func (c *Client) UpdateTag(ctx context.Context, listID, id string, update TagUpdate) (*Tag, error) {
+	var updated Tag
+	if err := c.call(ctx, http.MethodPatch, pathf("/lists/%s/tags/%s", listID, id), update, &updated); err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// DeleteTag deletes the tag and removes it from every todo.
+func (c *Client) DeleteTag(ctx context.Context, listID, id string) error {
+	return c.call(ctx, http.MethodDelete, pathf("/lists/%s/tags/%s", listID, id), nil, nil)
+}
@@ -0,0 +1,73 @@
+package todoclient
+
+import (
+	"context"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/http"
+	"time"
+)
+
+// Template is a list saved for reuse by its owner. The blueprint holds the statuses, tags and todos that
+// every list created from the template gets.
+type Template = models.Template
+
+type Blueprint = models.Blueprint
+
+type BlueprintStatus = models.BlueprintStatus
+
+type BlueprintTag = models.BlueprintTag
+
+type BlueprintTodo = models.BlueprintTodo
+
+// CloneOptions select what CloneList copies. An empty Name keeps the name of the list with a " (copy)"
+// suffix, and when AnchorDate is set the dates of the todos are shifted so that the earliest of them falls on it.
+type CloneOptions = models.CloneOptions
+
+// CloneList copies the list with its statuses, tags, todos and dependencies into a new list of the caller.
+func (c *Client) CloneList(ctx context.Context, id string, options CloneOptions) (*models.List, error) {
+	var list models.List
+	if err := c.call(ctx, http.MethodPost, pathf("/lists/%s/clone", id), options, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+// SaveTemplate saves the content of the list as a template of the caller. Assignees are not kept.
+func (c *Client) SaveTemplate(ctx context.Context, listID, name, description string) (*Template, error) {
+	body := struct {
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	}{Name: name, Description: description}
+	var template Template
+	if err := c.call(ctx, http.MethodPost, pathf("/lists/%s/template", listID), body, &template); err != nil {
+		return nil, err
+	}
+	return &template, nil
+}
+
+// ListTemplates returns the templates of the caller, newest first.
+func (c *Client) ListTemplates(ctx context.Context) ([]*Template, error) {
+	var templates []*Template
+	if err := c.call(ctx, http.MethodGet, "/templates", nil, &templates); err != nil {
+		return nil, err
+	}
+	return templates, nil
+}
+
+// CreateListFromTemplate creates a list from the template with all todos open. An empty name keeps the name
+// of the template and a nil anchorDate starts the dates of the todos today.
+func (c *Client) CreateListFromTemplate(ctx context.Context, id, name string, anchorDate *time.Time) (*models.List, error) {
+	body := struct {
+		Name       string     `json:"name,omitempty"`
+		AnchorDate *time.Time `json:"anchor_date,omitempty"`
+	}{Name: name, AnchorDate: anchorDate}
+	var list models.List
+	if err := c.call(ctx, http.MethodPost, pathf("/templates/%s/lists", id), body, &list); err != nil {
+		return nil, err
+	}
+	return &list, nil
+}
+
+func (c *Client) DeleteTemplate(ctx context.Context, id string) error {
+	return c.call(ctx, http.MethodDelete, pathf("/templates/%s", id), nil, nil)
+}
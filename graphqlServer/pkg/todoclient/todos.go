@@ -0,0 +1,226 @@
+package todoclient
+
+import (
+	"context"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/http"
+	"net/url"
+)
+
+// TodoInput is the body of a todo create or update. TagIDs replaces the tags of the todo;
+// nil leaves them untouched and an empty slice removes them all. It shadows models.Todo.TagIDs,
+// which is omitted when empty and so could never clear the tags.
+type TodoInput struct {
+	models.Todo
+	TagIDs []string `json:"tag_ids"`
+}
+
+// ListAllTodos returns every todo in the service and is restricted to admins.
+func (c *Client) ListAllTodos(ctx context.Context) ([]*models.Todo, error) {
+	return c.todos(ctx, "/todos/all")
+}
+
+// ListUserTodos returns the todos of every list the caller can access.
+func (c *Client) ListUserTodos(ctx context.Context) ([]*models.Todo, error) {
+	return c.todos(ctx, "/todos/user/all")
+}
+
+func (c *Client) ListTodosByList(ctx context.Context, listID string) ([]*models.Todo, error) {
+	return c.todos(ctx, pathf("/lists/%s/todos", listID))
+}
+
+// ListTodosByTag returns the todos of the list that are tagged with tagID.
+func (c *Client) ListTodosByTag(ctx context.Context, listID, tagID string) ([]*models.Todo, error) {
+	return c.todos(ctx, pathf("/lists/%s/todos", listID)+"?tag_id="+url.QueryEscape(tagID))
+}
+
+// ListTodosByListIDs fetches the todos of up to constants.MaxBatchIDs lists in a single request.
+func (c *Client) ListTodosByListIDs(ctx context.Context, listIDs []string) ([]*models.Todo, error) {
+	return c.todos(ctx, "/todos?list_ids="+joinIDs(listIDs))
+}
+
+func (c *Client) GetTodo(ctx context.Context, id string) (*models.Todo, error) {
+	var todo models.Todo
+	if err := c.call(ctx, http.MethodGet, pathf("/todos/%s", id), nil, &todo); err != nil {
+		return nil, err
+	}
+	return &todo, nil
+}
+
+// CreateTodo creates the todo and returns its id.
+func (c *Client) CreateTodo(ctx context.Context, todo TodoInput) (string, error) {
+	var id string
+	if err := c.call(ctx, http.MethodPost, "/todos/create", todo, &id); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+func (c *Client) UpdateTodo(ctx context.Context, id string, todo TodoInput) error {
+	return c.call(ctx, http.MethodPut, pathf("/todos/%s", id), todo, nil)
+}
+
+func (c *Client) UpdateTodoTitle(ctx context.Context, id, title string) (*models.Todo, error) {
+	body := struct {
+		Title string `json:"title"`
+	}{Title: title}
+	return c.patchTodo(ctx, pathf("/todos/%s/title", id), body)
+}
+
+func (c *Client) UpdateTodoDescription(ctx context.Context, id, description string) (*models.Todo, error) {
+	body := struct {
+		Description string `json:"description"`
+	}{Description: description}
+	return c.patchTodo(ctx, pathf("/todos/%s/description", id), body)
+}
+
+func (c *Client) UpdateTodoPriority(ctx context.Context, id string, priority constants.PriorityLevel) (*models.Todo, error) {
+	body := struct {
+		Priority constants.PriorityLevel `json:"priority"`
+	}{Priority: priority}
+	return c.patchTodo(ctx, pathf("/todos/%s/priority", id), body)
+}
+
+func (c *Client) AssignTodo(ctx context.Context, id, userID string) (*models.Todo, error) {
+	body := struct {
+		UserID string `json:"user_id"`
+	}{UserID: userID}
+	return c.patchTodo(ctx, pathf("/todos/%s/assign_to", id), body)
+}
+
+func (c *Client) CompleteTodo(ctx context.Context, id string) (*models.Todo, error) {
+	return c.patchTodo(ctx, pathf("/todos/%s/complete", id), nil)
+}
+
+// ReorderTodo puts the todo right before the todo beforeID and right after the todo afterID of its list.
+// Either id may be empty, but not both.
+func (c *Client) ReorderTodo(ctx context.Context, id, beforeID, afterID string) (*models.Todo, error) {
+	body := struct {
+		BeforeID string `json:"before_id,omitempty"`
+		AfterID  string `json:"after_id,omitempty"`
+	}{BeforeID: beforeID, AfterID: afterID}
+	var todo models.Todo
+	if err := c.call(ctx, http.MethodPost, pathf("/todos/%s/move", id), body, &todo); err != nil {
+		return nil, err
+	}
+	return &todo, nil
+}
+
+// MoveTodoToList appends the todo to another list, see MoveTodosToList.
+func (c *Client) MoveTodoToList(ctx context.Context, id, listID string) (*models.Todo, error) {
+	body := struct {
+		ListID string `json:"list_id"`
+	}{ListID: listID}
+	var todo models.Todo
+	if err := c.call(ctx, http.MethodPost, pathf("/todos/%s/move", id), body, &todo); err != nil {
+		return nil, err
+	}
+	return &todo, nil
+}
+
+// MoveTodosToList appends the todos to another list in the given order. The todos keep their status and
+// tags when the list has ones with the same names, lose their dependencies and are unassigned when their
+// assignee has no access to the list.
+func (c *Client) MoveTodosToList(ctx context.Context, ids []string, listID string) ([]*models.Todo, error) {
+	body := struct {
+		IDs    []string `json:"ids"`
+		ListID string   `json:"list_id"`
+	}{IDs: ids, ListID: listID}
+	var todos []*models.Todo
+	if err := c.call(ctx, http.MethodPost, "/todos/move", body, &todos); err != nil {
+		return nil, err
+	}
+	return todos, nil
+}
+
+// BulkUpdateTodos applies the operations in one transaction. Every operation is authorized on its own and a
+// failed one is undone and reported in its result without affecting the others.
+func (c *Client) BulkUpdateTodos(ctx context.Context, operations []BulkOperation) ([]BulkResult, error) {
+	body := struct {
+		Operations []BulkOperation `json:"operations"`
+	}{Operations: operations}
+	var response struct {
+		Results []BulkResult `json:"results"`
+	}
+	if err := c.call(ctx, http.MethodPost, "/todos/bulk", body, &response); err != nil {
+		return nil, err
+	}
+	return response.Results, nil
+}
+
+// CopyTodo appends a copy of the todo without its comments, attachments and dependencies to a list.
+func (c *Client) CopyTodo(ctx context.Context, id, listID string) (*models.Todo, error) {
+	body := struct {
+		ListID string `json:"list_id"`
+	}{ListID: listID}
+	var todo models.Todo
+	if err := c.call(ctx, http.MethodPost, pathf("/todos/%s/copy", id), body, &todo); err != nil {
+		return nil, err
+	}
+	return &todo, nil
+}
+
+// AddTodoTag tags the todo with a tag of its list.
+func (c *Client) AddTodoTag(ctx context.Context, id, tagID string) (*models.Todo, error) {
+	var todo models.Todo
+	if err := c.call(ctx, http.MethodPut, pathf("/todos/%s/tags/%s", id, tagID), nil, &todo); err != nil {
+		return nil, err
+	}
+	return &todo, nil
+}
+
+func (c *Client) RemoveTodoTag(ctx context.Context, id, tagID string) (*models.Todo, error) {
+	var todo models.Todo
+	if err := c.call(ctx, http.MethodDelete, pathf("/todos/%s/tags/%s", id, tagID), nil, &todo); err != nil {
+		return nil, err
+	}
+	return &todo, nil
+}
+
+// ListTodoBlockers returns the todos that the todo waits for.
+func (c *Client) ListTodoBlockers(ctx context.Context, id string) ([]*models.Todo, error) {
+	return c.todos(ctx, pathf("/todos/%s/blocked_by", id))
+}
+
+// ListBlockedTodos returns the todos that wait for the todo.
+func (c *Client) ListBlockedTodos(ctx context.Context, id string) ([]*models.Todo, error) {
+	return c.todos(ctx, pathf("/todos/%s/blocks", id))
+}
+
+// AddTodoDependency makes the todo wait for the todo blockedByID of the same list.
+func (c *Client) AddTodoDependency(ctx context.Context, id, blockedByID string) (*models.Todo, error) {
+	var todo models.Todo
+	if err := c.call(ctx, http.MethodPut, pathf("/todos/%s/blocked_by/%s", id, blockedByID), nil, &todo); err != nil {
+		return nil, err
+	}
+	return &todo, nil
+}
+
+func (c *Client) RemoveTodoDependency(ctx context.Context, id, blockedByID string) (*models.Todo, error) {
+	var todo models.Todo
+	if err := c.call(ctx, http.MethodDelete, pathf("/todos/%s/blocked_by/%s", id, blockedByID), nil, &todo); err != nil {
+		return nil, err
+	}
+	return &todo, nil
+}
+
+func (c *Client) DeleteTodo(ctx context.Context, id string) error {
+	return c.call(ctx, http.MethodDelete, pathf("/todos/%s", id), nil, nil)
+}
+
+func (c *Client) todos(ctx context.Context, path string) ([]*models.Todo, error) {
+	var todos []*models.Todo
+	if err := c.call(ctx, http.MethodGet, path, nil, &todos); err != nil {
+		return nil, err
+	}
+	return todos, nil
+}
+
+func (c *Client) patchTodo(ctx context.Context, path string, body interface{}) (*models.Todo, error) {
+	var todo models.Todo
+	if err := c.call(ctx, http.MethodPatch, path, body, &todo); err != nil {
+		return nil, err
+	}
+	return &todo, nil
+}
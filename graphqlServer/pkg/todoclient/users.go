@@ -0,0 +1,57 @@
+package todoclient
+
+import (
+	"context"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"net/http"
+)
+
+func (c *Client) ListUsers(ctx context.Context) ([]*models.User, error) {
+	var users []*models.User
+	if err := c.call(ctx, http.MethodGet, "/users/all", nil, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+func (c *Client) GetUser(ctx context.Context, id string) (*models.User, error) {
+	var user models.User
+	if err := c.call(ctx, http.MethodGet, pathf("/users/%s", id), nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+func (c *Client) GetUserByEmail(ctx context.Context, email string) (*models.User, error) {
+	var user models.User
+	if err := c.call(ctx, http.MethodGet, pathf("/users/email/%s", email), nil, &user); err != nil {
+		return nil, err
+	}
+	return &user, nil
+}
+
+// GetUsersByIDs fetches up to constants.MaxBatchIDs users in a single request. Unknown ids are skipped.
+func (c *Client) GetUsersByIDs(ctx context.Context, ids []string) ([]*models.User, error) {
+	var users []*models.User
+	if err := c.call(ctx, http.MethodGet, "/users?ids="+joinIDs(ids), nil, &users); err != nil {
+		return nil, err
+	}
+	return users, nil
+}
+
+// CreateUser creates the user and returns its id.
+func (c *Client) CreateUser(ctx context.Context, user models.User) (string, error) {
+	var id string
+	if err := c.call(ctx, http.MethodPost, "/users/create", user, &id); err != nil {
+		return "", err
+	}
+	return id, nil
+}
+
+func (c *Client) UpdateUser(ctx context.Context, id string, user models.User) error {
+	return c.call(ctx, http.MethodPut, pathf("/users/%s", id), user, nil)
+}
+
+func (c *Client) DeleteUser(ctx context.Context, id string) error {
+	return c.call(ctx, http.MethodDelete, pathf("/users/%s", id), nil, nil)
+}
@@ -3,12 +3,23 @@ package main
 import (
 	"context"
 	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/attachments"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/blob"
 	database "github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/metrics"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/todos"
+	"github.com/Victor-Uzunov/devops-project/todoservice/migrations"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/health"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/httpserver"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/tracing"
 	"github.com/joho/godotenv"
 	"github.com/kelseyhightower/envconfig"
+	"os"
+	"os/signal"
+	"syscall"
 )
 
 func main() {
@@ -26,21 +37,143 @@ func main() {
 		fmt.Printf("Error on setup databse config %+v", err)
 		return
 	}
-	ctx := context.Background()
+	var serverConfig httpserver.Config
+	if err := envconfig.Process("", &serverConfig); err != nil {
+		fmt.Printf("Error on setup server config %+v", err)
+		return
+	}
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
 	ctx, err := log.SetupLogger(ctx, cfg)
 	if err != nil {
 		fmt.Printf("Error on setup %+v", err)
 		return
 	}
+	var tracingConfig tracing.Config
+	if err = envconfig.Process("", &tracingConfig); err != nil {
+		fmt.Printf("Error on setup tracing config %+v", err)
+		return
+	}
+	shutdownTracing, err := tracing.Setup(ctx, tracingConfig, "todoservice", "github.com/Victor-Uzunov/devops-project/todoservice")
+	if err != nil {
+		log.C(ctx).Fatal(err)
+		return
+	}
+
+	if err = dbConfig.TransactionConfig.Validate(); err != nil {
+		log.C(ctx).Fatal(err)
+		return
+	}
+
 	db, err := database.Create(ctx, dbConfig)
 	if err != nil {
 		log.C(ctx).Fatal(err)
 		return
 	}
+	migrator, err := database.NewMigrator(db, migrations.FS)
+	if err != nil {
+		log.C(ctx).Fatal(err)
+		return
+	}
+	if len(os.Args) > 1 && os.Args[1] == "migrate" {
+		err = runMigrate(ctx, migrator, os.Args[2:])
+		db.Close()
+		if err != nil {
+			log.C(ctx).Fatal(err)
+		}
+		return
+	}
+	if dbConfig.AutoMigrate {
+		if err = migrator.Up(ctx); err != nil {
+			log.C(ctx).Fatal(err)
+			return
+		}
+	}
+	migrationVersion := dbConfig.MigrationVersion
+	if migrationVersion == 0 {
+		migrationVersion = migrator.Latest()
+	}
+	if err = database.CheckSchema(ctx, db, migrationVersion); err != nil {
+		log.C(ctx).Fatalf("refusing to start on an outdated schema, run todoapp migrate up or set APP_DB_AUTO_MIGRATE: %v", err)
+		return
+	}
+	var metricsConfig metrics.Config
+	if err = envconfig.Process("", &metricsConfig); err != nil {
+		fmt.Printf("Error on setup metrics config %+v", err)
+		return
+	}
+	if err = metrics.RegisterDB(db); err != nil {
+		log.C(ctx).Fatal(err)
+		return
+	}
+	adminServer := metrics.StartAdminServer(ctx, metricsConfig)
+
 	var oauth2Config jwt.ConfigOAuth2
 	if err = envconfig.Process("", &oauth2Config); err != nil {
 		fmt.Printf("Error on setup oauth2 config %+v", err)
 	}
-	restServer := http.NewServer(db, oauth2Config)
-	restServer.Start()
+	var healthConfig health.Config
+	if err = envconfig.Process("", &healthConfig); err != nil {
+		fmt.Printf("Error on setup health config %+v", err)
+		return
+	}
+	healthChecks := health.New(healthConfig)
+	healthChecks.Register(database.NewPingChecker(db))
+	healthChecks.Register(database.NewMigrationChecker(db, migrationVersion))
+
+	var blobConfig blob.Config
+	if err = envconfig.Process("", &blobConfig); err != nil {
+		fmt.Printf("Error on setup blob config %+v", err)
+		return
+	}
+	if err = blobConfig.Validate(); err != nil {
+		log.C(ctx).Fatal(err)
+		return
+	}
+	store, err := blob.New(blobConfig)
+	if err != nil {
+		log.C(ctx).Fatal(err)
+		return
+	}
+	var attachmentConfig attachments.Config
+	if err = envconfig.Process("", &attachmentConfig); err != nil {
+		fmt.Printf("Error on setup attachment config %+v", err)
+		return
+	}
+	if err = attachmentConfig.Validate(); err != nil {
+		log.C(ctx).Fatal(err)
+		return
+	}
+	var todoConfig todos.Config
+	if err = envconfig.Process("", &todoConfig); err != nil {
+		fmt.Printf("Error on setup todo config %+v", err)
+		return
+	}
+
+	restServer := http.NewServer(db, oauth2Config, healthChecks, dbConfig.TransactionConfig, serverConfig.MaxBodyBytes, store, attachmentConfig, todoConfig)
+	sweeperCtx, stopSweeper := context.WithCancel(ctx)
+	sweeperDone := make(chan struct{})
+	go func() {
+		defer close(sweeperDone)
+		restServer.BlobSweeper.Run(sweeperCtx)
+	}()
+
+	if err = restServer.Run(ctx, serverConfig); err != nil {
+		log.C(ctx).Errorf("rest server failed: %v", err)
+	}
+	stopSweeper()
+	<-sweeperDone
+
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), serverConfig.ShutdownTimeout)
+	defer cancel()
+	if err = adminServer.Shutdown(shutdownCtx); err != nil {
+		log.C(ctx).Errorf("error shutting down admin server: %v", err)
+	}
+	if err = db.Close(); err != nil {
+		log.C(ctx).Errorf("error closing database: %v", err)
+	}
+	if err = shutdownTracing(shutdownCtx); err != nil {
+		log.C(ctx).Errorf("error flushing traces: %v", err)
+	}
+	log.C(ctx).Info("todoservice stopped")
 }
@@ -0,0 +1,53 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	database "github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"os"
+	"strconv"
+)
+
+const migrateUsage = "usage: todoapp migrate up|down [steps]|status|goto <version>"
+
+// runMigrate executes the migrate subcommand; down reverts a single migration unless steps is given.
+func runMigrate(ctx context.Context, migrator *database.Migrator, args []string) error {
+	if len(args) == 0 {
+		return errors.New(migrateUsage)
+	}
+	switch args[0] {
+	case "up":
+		return migrator.Up(ctx)
+	case "down":
+		steps := 1
+		if len(args) > 1 {
+			var err error
+			if steps, err = strconv.Atoi(args[1]); err != nil {
+				return fmt.Errorf("invalid number of steps %q: %w", args[1], err)
+			}
+		}
+		return migrator.Down(ctx, steps)
+	case "goto":
+		if len(args) < 2 {
+			return errors.New(migrateUsage)
+		}
+		version, err := strconv.ParseInt(args[1], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid version %q: %w", args[1], err)
+		}
+		return migrator.Goto(ctx, version)
+	case "status":
+		status, err := migrator.Status(ctx)
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(os.Stdout, "version: %d\ndirty: %t\nlatest: %d\n", status.Version, status.Dirty, status.Latest)
+		for _, migration := range status.Pending {
+			fmt.Fprintf(os.Stdout, "pending: %d_%s\n", migration.Version, migration.Name)
+		}
+		return nil
+	default:
+		return errors.New(migrateUsage)
+	}
+}
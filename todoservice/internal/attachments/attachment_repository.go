@@ -0,0 +1,178 @@
+package attachments
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
+)
+
+//go:generate mockery --name=AttachmentRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type AttachmentRepository interface {
+	Create(ctx context.Context, attachment models.Attachment, storageKey string) (string, error)
+	Get(ctx context.Context, todoID string, id string) (models.Attachment, string, error)
+	GetAllByTodoID(ctx context.Context, todoID string) ([]models.Attachment, error)
+	Delete(ctx context.Context, todoID string, id string) error
+	GetDeletedBlobKeys(ctx context.Context, limit int) ([]string, error)
+	ForgetDeletedBlobKeys(ctx context.Context, keys []string) error
+}
+
+type SQLXAttachmentRepository struct {
+	converter *Converter
+}
+
+var _ AttachmentRepository = &SQLXAttachmentRepository{}
+
+func NewSQLXAttachmentRepository() AttachmentRepository {
+	return &SQLXAttachmentRepository{converter: NewConverter()}
+}
+
+func (r *SQLXAttachmentRepository) Create(ctx context.Context, attachment models.Attachment, storageKey string) (string, error) {
+	log.C(ctx).Info("creating attachment repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return "", err
+	}
+
+	entity := r.converter.ConvertAttachmentToEntity(attachment, storageKey)
+	query := `
+		INSERT INTO todo_attachments (id, todo_id, uploader_id, filename, content_type, size, storage_key)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
+		RETURNING id
+	`
+
+	var id string
+	err = tx.QueryRowContext(ctx, query, entity.ID, entity.TodoID, entity.UploaderID, entity.Filename,
+		entity.ContentType, entity.Size, entity.StorageKey).Scan(&id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create attachment: %v", err)
+		return "", fmt.Errorf("failed to create attachment: %w", err)
+	}
+	log.C(ctx).Debugf("created attachment with ID: %v", id)
+	return id, nil
+}
+
+// Get returns the attachment together with the key of its content in the blob store.
+func (r *SQLXAttachmentRepository) Get(ctx context.Context, todoID string, id string) (models.Attachment, string, error) {
+	log.C(ctx).Info("getting attachment repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return models.Attachment{}, "", err
+	}
+
+	query := `
+		SELECT id, todo_id, uploader_id, filename, content_type, size, storage_key, created_at
+		FROM todo_attachments
+		WHERE todo_id = $1 AND id = $2
+	`
+	var entity Entity
+	err = tx.GetContext(ctx, &entity, query, todoID, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get attachment: %v", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Attachment{}, "", fmt.Errorf("attachment not found: %w", err)
+		}
+		return models.Attachment{}, "", fmt.Errorf("failed to get attachment: %w", err)
+	}
+	return r.converter.ConvertAttachmentToModel(entity), entity.StorageKey, nil
+}
+
+func (r *SQLXAttachmentRepository) GetAllByTodoID(ctx context.Context, todoID string) ([]models.Attachment, error) {
+	log.C(ctx).Info("getting all attachments for a todo repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	query := `
+		SELECT id, todo_id, uploader_id, filename, content_type, size, storage_key, created_at
+		FROM todo_attachments
+		WHERE todo_id = $1
+		ORDER BY created_at, id
+	`
+	var entities []Entity
+	err = tx.SelectContext(ctx, &entities, query, todoID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get attachments: %v", err)
+		return nil, fmt.Errorf("failed to get attachments: %w", err)
+	}
+
+	result := make([]models.Attachment, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, r.converter.ConvertAttachmentToModel(entity))
+	}
+	return result, nil
+}
+
+func (r *SQLXAttachmentRepository) Delete(ctx context.Context, todoID string, id string) error {
+	log.C(ctx).Info("deleting attachment repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM todo_attachments WHERE todo_id = $1 AND id = $2`, todoID, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to delete attachment: %v", err)
+		return fmt.Errorf("failed to delete attachment: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("attachment %s not found: %w", id, pkg.ErrNotFound)
+	}
+	return nil
+}
+
+// GetDeletedBlobKeys returns the keys of up to limit blobs whose attachments were deleted, oldest first.
+// The rows are locked until the transaction ends and skipped by concurrent sweepers.
+func (r *SQLXAttachmentRepository) GetDeletedBlobKeys(ctx context.Context, limit int) ([]string, error) {
+	log.C(ctx).Info("getting deleted attachment blob keys repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	query := `
+		SELECT storage_key
+		FROM attachment_blob_deletions
+		ORDER BY deleted_at, storage_key
+		LIMIT $1
+		FOR UPDATE SKIP LOCKED
+	`
+	var keys []string
+	if err = tx.SelectContext(ctx, &keys, query, limit); err != nil {
+		log.C(ctx).Errorf("failed to get deleted attachment blob keys: %v", err)
+		return nil, fmt.Errorf("failed to get deleted attachment blob keys: %w", err)
+	}
+	return keys, nil
+}
+
+// ForgetDeletedBlobKeys removes keys whose blobs were deleted from the queue.
+func (r *SQLXAttachmentRepository) ForgetDeletedBlobKeys(ctx context.Context, keys []string) error {
+	log.C(ctx).Info("forgetting deleted attachment blob keys repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	_, err = tx.ExecContext(ctx, `DELETE FROM attachment_blob_deletions WHERE storage_key = ANY($1)`, pq.Array(keys))
+	if err != nil {
+		log.C(ctx).Errorf("failed to forget deleted attachment blob keys: %v", err)
+		return fmt.Errorf("failed to forget deleted attachment blob keys: %w", err)
+	}
+	return nil
+}
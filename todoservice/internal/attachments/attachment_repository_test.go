@@ -0,0 +1,220 @@
+package attachments_test
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/attachments"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+	"time"
+)
+
+var attachmentColumns = []string{"id", "todo_id", "uploader_id", "filename", "content_type", "size", "storage_key", "created_at"}
+
+func TestSQLXAttachmentRepositoryCreate(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := attachments.NewSQLXAttachmentRepository()
+	attachment := models.Attachment{ID: "1", TodoID: "todo1", UploaderID: "user1", Filename: "a.png", ContentType: "image/png", Size: 3}
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedID    string
+		expectedError string
+	}{
+		{
+			name: "Successful creation",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery(`^INSERT INTO todo_attachments`).WithArgs("1", "todo1", "user1", "a.png", "image/png", int64(3), "todo1/1").
+					WillReturnRows(sqlxmock.NewRows([]string{"id"}).AddRow("1"))
+				mockDB.ExpectCommit()
+			},
+			expectedID: "1",
+		},
+		{
+			name: "Failed creation due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery(`^INSERT INTO todo_attachments`).WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: "failed to create attachment: db error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			id, err := repo.Create(ctx, attachment, "todo1/1")
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				require.NoError(t, tx.Rollback())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedID, id)
+				require.NoError(t, tx.Commit())
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSQLXAttachmentRepositoryGet(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := attachments.NewSQLXAttachmentRepository()
+	createdAt := time.Date(2026, 10, 19, 11, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expected      models.Attachment
+		expectedKey   string
+		expectedError error
+	}{
+		{
+			name: "Attachment of a deleted uploader",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery(`^SELECT (.+) FROM todo_attachments WHERE todo_id = \$1 AND id = \$2`).WithArgs("todo1", "1").
+					WillReturnRows(sqlxmock.NewRows(attachmentColumns).AddRow("1", "todo1", nil, "a.png", "image/png", 3, "todo1/1", createdAt))
+				mockDB.ExpectCommit()
+			},
+			expected:    models.Attachment{ID: "1", TodoID: "todo1", Filename: "a.png", ContentType: "image/png", Size: 3, CreatedAt: createdAt},
+			expectedKey: "todo1/1",
+		},
+		{
+			name: "Attachment not found",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery(`^SELECT (.+) FROM todo_attachments`).WillReturnError(sql.ErrNoRows)
+				mockDB.ExpectRollback()
+			},
+			expectedError: sql.ErrNoRows,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			attachment, key, err := repo.Get(ctx, "todo1", "1")
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				require.NoError(t, tx.Rollback())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, attachment)
+				assert.Equal(t, tc.expectedKey, key)
+				require.NoError(t, tx.Commit())
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSQLXAttachmentRepositoryDelete(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := attachments.NewSQLXAttachmentRepository()
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedError error
+	}{
+		{
+			name: "Successful deletion",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^DELETE FROM todo_attachments WHERE todo_id = \$1 AND id = \$2`).WithArgs("todo1", "1").
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "Attachment not found",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^DELETE FROM todo_attachments`).WithArgs("todo1", "1").
+					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectRollback()
+			},
+			expectedError: pkg.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			err = repo.Delete(ctx, "todo1", "1")
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				require.NoError(t, tx.Rollback())
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, tx.Commit())
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSQLXAttachmentRepositoryDeletedBlobKeys(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := attachments.NewSQLXAttachmentRepository()
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectQuery(`^SELECT storage_key\s+FROM attachment_blob_deletions .+ LIMIT \$1\s+FOR UPDATE SKIP LOCKED`).WithArgs(10).
+		WillReturnRows(sqlxmock.NewRows([]string{"storage_key"}).AddRow("todo1/1").AddRow("todo1/2"))
+	mockDB.ExpectExec(`^DELETE FROM attachment_blob_deletions WHERE storage_key = ANY\(\$1\)`).
+		WithArgs(pq.Array([]string{"todo1/1", "todo1/2"})).
+		WillReturnResult(sqlxmock.NewResult(0, 2))
+	mockDB.ExpectCommit()
+
+	ctx := context.Background()
+	tx, err := database.BeginTxx(ctx, nil)
+	require.NoError(t, err)
+	ctx = db.SaveToContext(ctx, tx)
+
+	keys, err := repo.GetDeletedBlobKeys(ctx, 10)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"todo1/1", "todo1/2"}, keys)
+	require.NoError(t, repo.ForgetDeletedBlobKeys(ctx, keys))
+	require.NoError(t, tx.Commit())
+
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
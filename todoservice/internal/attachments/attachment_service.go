@@ -0,0 +1,230 @@
+package attachments
+
+import (
+	"bufio"
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/blob"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"io"
+	"mime"
+	"net/http"
+	"strings"
+	"time"
+	"unicode"
+	"unicode/utf8"
+)
+
+// DefaultMaxSize is the default of APP_ATTACHMENT_MAX_SIZE.
+const DefaultMaxSize = 10 << 20
+
+const (
+	defaultFilename   = "attachment"
+	maxFilenameLength = 255
+	sniffLength       = 512
+)
+
+type Config struct {
+	MaxSize int64 `envconfig:"APP_ATTACHMENT_MAX_SIZE" default:"10485760"`
+	// ContentTypes lists the media types accepted for uploads, matched against the type detected from the content.
+	ContentTypes []string `envconfig:"APP_ATTACHMENT_CONTENT_TYPES" default:"image/png,image/jpeg,image/gif,image/webp,application/pdf,text/plain"`
+	// SweepInterval is how often the BlobSweeper deletes the blobs of deleted attachments, SweepBatchSize
+	// how many blobs it deletes per transaction.
+	SweepInterval  time.Duration `envconfig:"APP_ATTACHMENT_SWEEP_INTERVAL" default:"1m"`
+	SweepBatchSize int           `envconfig:"APP_ATTACHMENT_SWEEP_BATCH_SIZE" default:"100"`
+}
+
+func (c Config) Validate() error {
+	if c.SweepInterval <= 0 {
+		return errors.New("APP_ATTACHMENT_SWEEP_INTERVAL must be positive")
+	}
+	if c.SweepBatchSize < 1 {
+		return errors.New("APP_ATTACHMENT_SWEEP_BATCH_SIZE must be at least 1")
+	}
+	return nil
+}
+
+//go:generate mockery --name=AttachmentService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type AttachmentService interface {
+	Upload(ctx context.Context, attachment models.Attachment, content io.Reader) (models.Attachment, error)
+	ListAttachments(ctx context.Context, todoID string) ([]models.Attachment, error)
+	OpenAttachment(ctx context.Context, todoID, id string) (models.Attachment, io.ReadCloser, error)
+	DeleteAttachment(ctx context.Context, todoID, id string) error
+	PurgeDeletedBlobs(ctx context.Context, limit int) (int, error)
+}
+
+//go:generate mockery --name=UUIDService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type UUIDService interface {
+	Generate() string
+}
+
+var _ AttachmentService = &service{}
+
+type service struct {
+	repo         AttachmentRepository
+	store        blob.BlobStore
+	uuidService  UUIDService
+	maxSize      int64
+	contentTypes map[string]struct{}
+}
+
+func NewService(repo AttachmentRepository, store blob.BlobStore, uuidService UUIDService, config Config) AttachmentService {
+	contentTypes := make(map[string]struct{}, len(config.ContentTypes))
+	for _, contentType := range config.ContentTypes {
+		contentTypes[strings.ToLower(strings.TrimSpace(contentType))] = struct{}{}
+	}
+	return &service{
+		repo:         repo,
+		store:        store,
+		uuidService:  uuidService,
+		maxSize:      config.MaxSize,
+		contentTypes: contentTypes,
+	}
+}
+
+// Upload streams content to the blob store and records it as an attachment of attachment.TodoID.
+// The content type is sniffed from the first bytes so that clients cannot smuggle e.g. HTML as an image.
+// When the content is stored but the transaction recording the attachment does not commit, the content is
+// deleted again.
+func (s *service) Upload(ctx context.Context, attachment models.Attachment, content io.Reader) (models.Attachment, error) {
+	log.C(ctx).Info("uploading attachment service")
+	buffered := bufio.NewReaderSize(content, sniffLength)
+	head, err := buffered.Peek(sniffLength)
+	if err != nil && err != io.EOF && err != bufio.ErrBufferFull {
+		return models.Attachment{}, fmt.Errorf("error reading attachment: %w", err)
+	}
+	if len(head) == 0 {
+		return models.Attachment{}, fmt.Errorf("attachment cannot be empty: %w", pkg.ErrBadRequest)
+	}
+	contentType, _, err := mime.ParseMediaType(http.DetectContentType(head))
+	if err != nil {
+		return models.Attachment{}, fmt.Errorf("error detecting the content type: %w", err)
+	}
+	if _, ok := s.contentTypes[contentType]; !ok {
+		return models.Attachment{}, fmt.Errorf("attachments of type %s are not allowed: %w", contentType, pkg.ErrUnsupported)
+	}
+
+	attachment.ID = s.uuidService.Generate()
+	attachment.Filename = sanitizeFilename(attachment.Filename)
+	attachment.ContentType = contentType
+	key := attachment.TodoID + "/" + attachment.ID
+
+	attachment.Size, err = s.store.Put(ctx, key, &limitedReader{r: buffered, remaining: s.maxSize, limit: s.maxSize})
+	if err != nil {
+		return models.Attachment{}, err
+	}
+	db.AfterTransaction(ctx, func(committed bool) {
+		if !committed {
+			s.deleteBlob(ctx, key)
+		}
+	})
+	if _, err = s.repo.Create(ctx, attachment, key); err != nil {
+		return models.Attachment{}, err
+	}
+	created, _, err := s.repo.Get(ctx, attachment.TodoID, attachment.ID)
+	return created, err
+}
+
+func (s *service) ListAttachments(ctx context.Context, todoID string) ([]models.Attachment, error) {
+	log.C(ctx).Info("listing attachments service")
+	return s.repo.GetAllByTodoID(ctx, todoID)
+}
+
+// OpenAttachment returns the attachment and its content, which the caller must close.
+func (s *service) OpenAttachment(ctx context.Context, todoID, id string) (models.Attachment, io.ReadCloser, error) {
+	log.C(ctx).Info("opening attachment service")
+	attachment, key, err := s.repo.Get(ctx, todoID, id)
+	if err != nil {
+		return models.Attachment{}, nil, err
+	}
+	content, err := s.store.Open(ctx, key)
+	if err != nil {
+		return models.Attachment{}, nil, err
+	}
+	return attachment, content, nil
+}
+
+// DeleteAttachment deletes the attachment record. Its blob is left in place until the deletion has committed,
+// the database queues it for PurgeDeletedBlobs.
+func (s *service) DeleteAttachment(ctx context.Context, todoID, id string) error {
+	log.C(ctx).Info("deleting attachment service")
+	return s.repo.Delete(ctx, todoID, id)
+}
+
+// PurgeDeletedBlobs deletes up to limit blobs of attachments whose deletion has committed, including those
+// deleted together with their todo, list or user, and returns how many were deleted. Blobs that cannot be
+// deleted stay queued and are retried by the next purge.
+func (s *service) PurgeDeletedBlobs(ctx context.Context, limit int) (int, error) {
+	log.C(ctx).Info("purging deleted attachment blobs service")
+	keys, err := s.repo.GetDeletedBlobKeys(ctx, limit)
+	if err != nil {
+		return 0, err
+	}
+	purged := make([]string, 0, len(keys))
+	for _, key := range keys {
+		if err = s.store.Delete(ctx, key); err != nil {
+			log.C(ctx).Errorf("error deleting blob %s: %v", key, err)
+			continue
+		}
+		purged = append(purged, key)
+	}
+	if len(purged) == 0 {
+		return 0, nil
+	}
+	if err = s.repo.ForgetDeletedBlobKeys(ctx, purged); err != nil {
+		return 0, err
+	}
+	return len(purged), nil
+}
+
+// deleteBlob removes the content of an upload that was not committed. Failures are only logged since
+// the upload fails either way.
+func (s *service) deleteBlob(ctx context.Context, key string) {
+	if err := s.store.Delete(ctx, key); err != nil {
+		log.C(ctx).Errorf("error deleting blob %s: %v", key, err)
+	}
+}
+
+// sanitizeFilename keeps the last path element of the name sent by the client and drops control characters.
+func sanitizeFilename(name string) string {
+	if i := strings.LastIndexAny(name, `/\`); i >= 0 {
+		name = name[i+1:]
+	}
+	name = strings.TrimSpace(strings.Map(func(r rune) rune {
+		if unicode.IsControl(r) {
+			return -1
+		}
+		return r
+	}, name))
+	if name == "" || name == "." || name == ".." {
+		return defaultFilename
+	}
+	for utf8.RuneCountInString(name) > maxFilenameLength {
+		_, size := utf8.DecodeLastRuneInString(name)
+		name = name[:len(name)-size]
+	}
+	return name
+}
+
+// limitedReader fails with pkg.ErrTooLarge as soon as more than limit bytes are read.
+type limitedReader struct {
+	r         io.Reader
+	remaining int64
+	limit     int64
+}
+
+func (l *limitedReader) Read(p []byte) (int, error) {
+	if int64(len(p)) > l.remaining+1 {
+		p = p[:l.remaining+1]
+	}
+	n, err := l.r.Read(p)
+	l.remaining -= int64(n)
+	if l.remaining < 0 {
+		return n, fmt.Errorf("attachments cannot be larger than %d bytes: %w", l.limit, pkg.ErrTooLarge)
+	}
+	return n, err
+}
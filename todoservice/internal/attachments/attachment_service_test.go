@@ -0,0 +1,420 @@
+package attachments_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/attachments"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/attachments/automock"
+	blobautomock "github.com/Victor-Uzunov/devops-project/todoservice/internal/blob/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"io"
+	"strings"
+	"testing"
+)
+
+var config = attachments.Config{MaxSize: 16, ContentTypes: []string{"text/plain", "image/png"}}
+
+// readAll consumes the uploaded content like a real store would, so that size limits are enforced.
+func readAll(_ context.Context, _ string, r io.Reader) (int64, error) {
+	content, err := io.ReadAll(r)
+	return int64(len(content)), err
+}
+
+func TestServiceUpload(t *testing.T) {
+	id := "1"
+	ctx := context.Background()
+	stored := models.Attachment{ID: id, TodoID: "todo1", UploaderID: "user1", Filename: "notes.txt", ContentType: "text/plain", Size: 5}
+
+	tests := []struct {
+		name          string
+		input         models.Attachment
+		content       string
+		uuidService   func() *automock.UUIDService
+		store         func() *blobautomock.BlobStore
+		repo          func() *automock.AttachmentRepository
+		expected      models.Attachment
+		expectedError error
+	}{
+		{
+			name:    "Upload with the path stripped from the filename",
+			input:   models.Attachment{TodoID: "todo1", UploaderID: "user1", Filename: `..\..\notes.txt`},
+			content: "hello",
+			uuidService: func() *automock.UUIDService {
+				uuidService := &automock.UUIDService{}
+				uuidService.EXPECT().Generate().Return(id).Once()
+				return uuidService
+			},
+			store: func() *blobautomock.BlobStore {
+				store := &blobautomock.BlobStore{}
+				store.EXPECT().Put(ctx, "todo1/1", mock.Anything).RunAndReturn(readAll).Once()
+				return store
+			},
+			repo: func() *automock.AttachmentRepository {
+				repo := &automock.AttachmentRepository{}
+				repo.EXPECT().Create(ctx, stored, "todo1/1").Return(id, nil).Once()
+				repo.EXPECT().Get(ctx, "todo1", id).Return(stored, "todo1/1", nil).Once()
+				return repo
+			},
+			expected: stored,
+		},
+		{
+			name:    "Error when the content is empty",
+			input:   models.Attachment{TodoID: "todo1", UploaderID: "user1", Filename: "notes.txt"},
+			content: "",
+			uuidService: func() *automock.UUIDService {
+				return &automock.UUIDService{}
+			},
+			store: func() *blobautomock.BlobStore {
+				return &blobautomock.BlobStore{}
+			},
+			repo: func() *automock.AttachmentRepository {
+				return &automock.AttachmentRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+		{
+			name:    "Error when the detected content type is not allowed",
+			input:   models.Attachment{TodoID: "todo1", UploaderID: "user1", Filename: "notes.txt"},
+			content: "<html><body>hi</body></html>",
+			uuidService: func() *automock.UUIDService {
+				return &automock.UUIDService{}
+			},
+			store: func() *blobautomock.BlobStore {
+				return &blobautomock.BlobStore{}
+			},
+			repo: func() *automock.AttachmentRepository {
+				return &automock.AttachmentRepository{}
+			},
+			expectedError: pkg.ErrUnsupported,
+		},
+		{
+			name:    "Error when the content is too large",
+			input:   models.Attachment{TodoID: "todo1", UploaderID: "user1", Filename: "notes.txt"},
+			content: strings.Repeat("a", 17),
+			uuidService: func() *automock.UUIDService {
+				uuidService := &automock.UUIDService{}
+				uuidService.EXPECT().Generate().Return(id).Once()
+				return uuidService
+			},
+			store: func() *blobautomock.BlobStore {
+				store := &blobautomock.BlobStore{}
+				store.EXPECT().Put(ctx, "todo1/1", mock.Anything).RunAndReturn(readAll).Once()
+				return store
+			},
+			repo: func() *automock.AttachmentRepository {
+				return &automock.AttachmentRepository{}
+			},
+			expectedError: pkg.ErrTooLarge,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uuidService := tt.uuidService()
+			store := tt.store()
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, uuidService, store, repo)
+
+			svc := attachments.NewService(repo, store, uuidService, config)
+			attachment, err := svc.Upload(ctx, tt.input, strings.NewReader(tt.content))
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, attachment)
+			}
+		})
+	}
+}
+
+func TestServiceUploadTransaction(t *testing.T) {
+	id := "1"
+	err := errors.New("error")
+	stored := models.Attachment{ID: id, TodoID: "todo1", UploaderID: "user1", Filename: "notes.txt", ContentType: "text/plain", Size: 5}
+
+	tests := []struct {
+		name          string
+		mockDatabase  func(mockDatabase sqlxmock.Sqlmock)
+		store         func() *blobautomock.BlobStore
+		repo          func() *automock.AttachmentRepository
+		expectedError error
+	}{
+		{
+			name: "Stored content is kept when the attachment is committed",
+			mockDatabase: func(mockDatabase sqlxmock.Sqlmock) {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectCommit()
+			},
+			store: func() *blobautomock.BlobStore {
+				store := &blobautomock.BlobStore{}
+				store.EXPECT().Put(mock.Anything, "todo1/1", mock.Anything).RunAndReturn(readAll).Once()
+				return store
+			},
+			repo: func() *automock.AttachmentRepository {
+				repo := &automock.AttachmentRepository{}
+				repo.EXPECT().Create(mock.Anything, stored, "todo1/1").Return(id, nil).Once()
+				repo.EXPECT().Get(mock.Anything, "todo1", id).Return(stored, "todo1/1", nil).Once()
+				return repo
+			},
+		},
+		{
+			name: "Stored content is deleted when repo create fails",
+			mockDatabase: func(mockDatabase sqlxmock.Sqlmock) {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectRollback()
+			},
+			store: func() *blobautomock.BlobStore {
+				store := &blobautomock.BlobStore{}
+				store.EXPECT().Put(mock.Anything, "todo1/1", mock.Anything).RunAndReturn(readAll).Once()
+				store.EXPECT().Delete(mock.Anything, "todo1/1").Return(nil).Once()
+				return store
+			},
+			repo: func() *automock.AttachmentRepository {
+				repo := &automock.AttachmentRepository{}
+				repo.EXPECT().Create(mock.Anything, stored, "todo1/1").Return("", err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+		{
+			name: "Stored content is deleted when the commit fails",
+			mockDatabase: func(mockDatabase sqlxmock.Sqlmock) {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectCommit().WillReturnError(err)
+			},
+			store: func() *blobautomock.BlobStore {
+				store := &blobautomock.BlobStore{}
+				store.EXPECT().Put(mock.Anything, "todo1/1", mock.Anything).RunAndReturn(readAll).Once()
+				store.EXPECT().Delete(mock.Anything, "todo1/1").Return(nil).Once()
+				return store
+			},
+			repo: func() *automock.AttachmentRepository {
+				repo := &automock.AttachmentRepository{}
+				repo.EXPECT().Create(mock.Anything, stored, "todo1/1").Return(id, nil).Once()
+				repo.EXPECT().Get(mock.Anything, "todo1", id).Return(stored, "todo1/1", nil).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database, mockDatabase, err := sqlxmock.Newx()
+			require.NoError(t, err)
+			tt.mockDatabase(mockDatabase)
+			uuidService := &automock.UUIDService{}
+			uuidService.EXPECT().Generate().Return(id).Once()
+			store := tt.store()
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, uuidService, store, repo)
+
+			svc := attachments.NewService(repo, store, uuidService, config)
+			err = db.Transact(context.Background(), database, nil, db.TransactionConfig{MaxAttempts: 1}, func(ctx context.Context) error {
+				input := models.Attachment{TodoID: "todo1", UploaderID: "user1", Filename: "notes.txt"}
+				_, err := svc.Upload(ctx, input, strings.NewReader("hello"))
+				return err
+			})
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mockDatabase.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestServiceOpenAttachment(t *testing.T) {
+	ctx := context.Background()
+	stored := models.Attachment{ID: "1", TodoID: "todo1", Filename: "notes.txt", ContentType: "text/plain", Size: 5}
+
+	tests := []struct {
+		name          string
+		store         func() *blobautomock.BlobStore
+		repo          func() *automock.AttachmentRepository
+		expectedError error
+	}{
+		{
+			name: "Open attachment",
+			store: func() *blobautomock.BlobStore {
+				store := &blobautomock.BlobStore{}
+				store.EXPECT().Open(ctx, "todo1/1").Return(io.NopCloser(strings.NewReader("hello")), nil).Once()
+				return store
+			},
+			repo: func() *automock.AttachmentRepository {
+				repo := &automock.AttachmentRepository{}
+				repo.EXPECT().Get(ctx, "todo1", "1").Return(stored, "todo1/1", nil).Once()
+				return repo
+			},
+		},
+		{
+			name: "Error when the content is missing",
+			store: func() *blobautomock.BlobStore {
+				store := &blobautomock.BlobStore{}
+				store.EXPECT().Open(ctx, "todo1/1").Return(nil, pkg.ErrNotFound).Once()
+				return store
+			},
+			repo: func() *automock.AttachmentRepository {
+				repo := &automock.AttachmentRepository{}
+				repo.EXPECT().Get(ctx, "todo1", "1").Return(stored, "todo1/1", nil).Once()
+				return repo
+			},
+			expectedError: pkg.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := tt.store()
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, store, repo)
+
+			svc := attachments.NewService(repo, store, &automock.UUIDService{}, config)
+			attachment, content, err := svc.OpenAttachment(ctx, "todo1", "1")
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				defer content.Close()
+				assert.Equal(t, stored, attachment)
+				body, err := io.ReadAll(content)
+				require.NoError(t, err)
+				assert.Equal(t, "hello", string(body))
+			}
+		})
+	}
+}
+
+func TestServiceDeleteAttachment(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		repo          func() *automock.AttachmentRepository
+		expectedError error
+	}{
+		{
+			name: "Delete only the record, the blob is purged once the deletion commits",
+			repo: func() *automock.AttachmentRepository {
+				repo := &automock.AttachmentRepository{}
+				repo.EXPECT().Delete(ctx, "todo1", "1").Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			name: "Error when the attachment does not exist",
+			repo: func() *automock.AttachmentRepository {
+				repo := &automock.AttachmentRepository{}
+				repo.EXPECT().Delete(ctx, "todo1", "1").Return(pkg.ErrNotFound).Once()
+				return repo
+			},
+			expectedError: pkg.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := &blobautomock.BlobStore{}
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, store, repo)
+
+			svc := attachments.NewService(repo, store, &automock.UUIDService{}, config)
+			err := svc.DeleteAttachment(ctx, "todo1", "1")
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestServicePurgeDeletedBlobs(t *testing.T) {
+	ctx := context.Background()
+	testErr := errors.New("error")
+
+	tests := []struct {
+		name          string
+		store         func() *blobautomock.BlobStore
+		repo          func() *automock.AttachmentRepository
+		expected      int
+		expectedError error
+	}{
+		{
+			name: "Delete the queued blobs and forget them",
+			store: func() *blobautomock.BlobStore {
+				store := &blobautomock.BlobStore{}
+				store.EXPECT().Delete(ctx, "todo1/1").Return(nil).Once()
+				store.EXPECT().Delete(ctx, "todo1/2").Return(nil).Once()
+				return store
+			},
+			repo: func() *automock.AttachmentRepository {
+				repo := &automock.AttachmentRepository{}
+				repo.EXPECT().GetDeletedBlobKeys(ctx, 10).Return([]string{"todo1/1", "todo1/2"}, nil).Once()
+				repo.EXPECT().ForgetDeletedBlobKeys(ctx, []string{"todo1/1", "todo1/2"}).Return(nil).Once()
+				return repo
+			},
+			expected: 2,
+		},
+		{
+			name: "Keep the blobs that cannot be deleted queued",
+			store: func() *blobautomock.BlobStore {
+				store := &blobautomock.BlobStore{}
+				store.EXPECT().Delete(ctx, "todo1/1").Return(testErr).Once()
+				store.EXPECT().Delete(ctx, "todo1/2").Return(nil).Once()
+				return store
+			},
+			repo: func() *automock.AttachmentRepository {
+				repo := &automock.AttachmentRepository{}
+				repo.EXPECT().GetDeletedBlobKeys(ctx, 10).Return([]string{"todo1/1", "todo1/2"}, nil).Once()
+				repo.EXPECT().ForgetDeletedBlobKeys(ctx, []string{"todo1/2"}).Return(nil).Once()
+				return repo
+			},
+			expected: 1,
+		},
+		{
+			name: "Nothing to purge",
+			store: func() *blobautomock.BlobStore {
+				return &blobautomock.BlobStore{}
+			},
+			repo: func() *automock.AttachmentRepository {
+				repo := &automock.AttachmentRepository{}
+				repo.EXPECT().GetDeletedBlobKeys(ctx, 10).Return(nil, nil).Once()
+				return repo
+			},
+		},
+		{
+			name: "Error when the queue cannot be read",
+			store: func() *blobautomock.BlobStore {
+				return &blobautomock.BlobStore{}
+			},
+			repo: func() *automock.AttachmentRepository {
+				repo := &automock.AttachmentRepository{}
+				repo.EXPECT().GetDeletedBlobKeys(ctx, 10).Return(nil, testErr).Once()
+				return repo
+			},
+			expectedError: testErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			store := tt.store()
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, store, repo)
+
+			svc := attachments.NewService(repo, store, &automock.UUIDService{}, config)
+			purged, err := svc.PurgeDeletedBlobs(ctx, 10)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, purged)
+			}
+		})
+	}
+}
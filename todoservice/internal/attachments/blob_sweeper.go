@@ -0,0 +1,70 @@
+package attachments
+
+import (
+	"context"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// BlobSweeper periodically runs PurgeDeletedBlobs, so that the blobs of deleted attachments are removed
+// from the blob store only after their deletion has committed.
+type BlobSweeper struct {
+	database  *sqlx.DB
+	service   AttachmentService
+	txConfig  db.TransactionConfig
+	interval  time.Duration
+	batchSize int
+}
+
+func NewBlobSweeper(database *sqlx.DB, service AttachmentService, txConfig db.TransactionConfig, config Config) *BlobSweeper {
+	return &BlobSweeper{
+		database:  database,
+		service:   service,
+		txConfig:  txConfig,
+		interval:  config.SweepInterval,
+		batchSize: config.SweepBatchSize,
+	}
+}
+
+// Run sweeps every interval until ctx is cancelled.
+func (s *BlobSweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if _, err := s.Sweep(ctx); err != nil {
+				log.C(ctx).Errorf("error sweeping deleted attachment blobs: %v", err)
+			}
+		}
+	}
+}
+
+// Sweep purges batches of deleted blobs, each in its own transaction, until a batch comes back short,
+// and returns how many blobs were deleted. Deleting a blob twice is harmless, so a transaction that is
+// retried or rolled back after its blobs were deleted only repeats the deletions.
+func (s *BlobSweeper) Sweep(ctx context.Context) (int, error) {
+	total := 0
+	for {
+		var purged int
+		err := db.Transact(ctx, s.database, nil, s.txConfig, func(ctx context.Context) error {
+			var err error
+			purged, err = s.service.PurgeDeletedBlobs(ctx, s.batchSize)
+			return err
+		})
+		if err != nil {
+			return total, err
+		}
+		total += purged
+		if purged < s.batchSize {
+			if total > 0 {
+				log.C(ctx).Infof("deleted %d blobs of deleted attachments", total)
+			}
+			return total, nil
+		}
+	}
+}
@@ -0,0 +1,73 @@
+package attachments_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/attachments"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/attachments/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+)
+
+func TestBlobSweeperSweep(t *testing.T) {
+	testErr := errors.New("error")
+	sweepConfig := attachments.Config{SweepBatchSize: 2}
+
+	tests := []struct {
+		name          string
+		batches       []int
+		err           error
+		expected      int
+		expectedError error
+	}{
+		{
+			name:     "Sweep batches until one comes back short",
+			batches:  []int{2, 1},
+			expected: 3,
+		},
+		{
+			name:    "Nothing to sweep",
+			batches: []int{0},
+		},
+		{
+			name:          "Stop at the first failing batch",
+			batches:       []int{2},
+			err:           testErr,
+			expected:      2,
+			expectedError: testErr,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database, mockDB, err := sqlxmock.Newx()
+			require.NoError(t, err)
+
+			svc := &automock.AttachmentService{}
+			defer mock.AssertExpectationsForObjects(t, svc)
+			for _, purged := range tt.batches {
+				mockDB.ExpectBegin()
+				mockDB.ExpectCommit()
+				svc.EXPECT().PurgeDeletedBlobs(mock.Anything, 2).Return(purged, nil).Once()
+			}
+			if tt.err != nil {
+				mockDB.ExpectBegin()
+				mockDB.ExpectRollback()
+				svc.EXPECT().PurgeDeletedBlobs(mock.Anything, 2).Return(0, tt.err).Once()
+			}
+
+			sweeper := attachments.NewBlobSweeper(database, svc, db.TransactionConfig{MaxAttempts: 1}, sweepConfig)
+			total, err := sweeper.Sweep(context.Background())
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expected, total)
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,37 @@
+package attachments
+
+import (
+	"database/sql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+type Converter struct{}
+
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) ConvertAttachmentToModel(entity Entity) models.Attachment {
+	return models.Attachment{
+		ID:          entity.ID,
+		TodoID:      entity.TodoID,
+		UploaderID:  entity.UploaderID.String,
+		Filename:    entity.Filename,
+		ContentType: entity.ContentType,
+		Size:        entity.Size,
+		CreatedAt:   entity.CreatedAt,
+	}
+}
+
+func (c *Converter) ConvertAttachmentToEntity(attachment models.Attachment, storageKey string) Entity {
+	return Entity{
+		ID:          attachment.ID,
+		TodoID:      attachment.TodoID,
+		UploaderID:  sql.NullString{String: attachment.UploaderID, Valid: attachment.UploaderID != ""},
+		Filename:    attachment.Filename,
+		ContentType: attachment.ContentType,
+		Size:        attachment.Size,
+		StorageKey:  storageKey,
+		CreatedAt:   attachment.CreatedAt,
+	}
+}
@@ -0,0 +1,17 @@
+package attachments
+
+import (
+	"database/sql"
+	"time"
+)
+
+type Entity struct {
+	ID          string         `db:"id"`
+	TodoID      string         `db:"todo_id"`
+	UploaderID  sql.NullString `db:"uploader_id"`
+	Filename    string         `db:"filename"`
+	ContentType string         `db:"content_type"`
+	Size        int64          `db:"size"`
+	StorageKey  string         `db:"storage_key"`
+	CreatedAt   time.Time      `db:"created_at"`
+}
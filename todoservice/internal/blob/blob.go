@@ -0,0 +1,79 @@
+// Package blob stores the content of attachments outside of the database.
+package blob
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+)
+
+const (
+	BackendLocal = "local"
+	BackendS3    = "s3"
+)
+
+// BlobStore keeps opaque content under keys such as <todo id>/<attachment id>.
+// Put reads r until EOF and returns the number of bytes stored; a failed Put leaves nothing behind under key.
+// Open returns an error wrapping pkg.ErrNotFound for keys that were never stored or were deleted.
+// Delete of a missing key succeeds.
+//
+//go:generate mockery --name=BlobStore --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type BlobStore interface {
+	Put(ctx context.Context, key string, r io.Reader) (int64, error)
+	Open(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+}
+
+type Config struct {
+	Backend  string `envconfig:"APP_BLOB_BACKEND" default:"local"`
+	LocalDir string `envconfig:"APP_BLOB_LOCAL_DIR" default:"data/attachments"`
+
+	S3Endpoint  string `envconfig:"APP_BLOB_S3_ENDPOINT"`
+	S3Region    string `envconfig:"APP_BLOB_S3_REGION" default:"us-east-1"`
+	S3Bucket    string `envconfig:"APP_BLOB_S3_BUCKET"`
+	S3AccessKey string `envconfig:"APP_BLOB_S3_ACCESS_KEY"`
+	S3SecretKey string `envconfig:"APP_BLOB_S3_SECRET_KEY"`
+	// S3Timeout bounds every request to the object storage, including reading the body of a download.
+	S3Timeout time.Duration `envconfig:"APP_BLOB_S3_TIMEOUT" default:"5m"`
+}
+
+func (c Config) Validate() error {
+	switch c.Backend {
+	case BackendLocal:
+		if c.LocalDir == "" {
+			return fmt.Errorf("APP_BLOB_LOCAL_DIR is required for the %s blob backend", BackendLocal)
+		}
+	case BackendS3:
+		if c.S3Endpoint == "" || c.S3Bucket == "" || c.S3AccessKey == "" || c.S3SecretKey == "" {
+			return fmt.Errorf("APP_BLOB_S3_ENDPOINT, APP_BLOB_S3_BUCKET, APP_BLOB_S3_ACCESS_KEY and APP_BLOB_S3_SECRET_KEY are required for the %s blob backend", BackendS3)
+		}
+		if c.S3Timeout <= 0 {
+			return fmt.Errorf("APP_BLOB_S3_TIMEOUT must be positive for the %s blob backend", BackendS3)
+		}
+	default:
+		return fmt.Errorf("unknown blob backend %q, expected %s or %s", c.Backend, BackendLocal, BackendS3)
+	}
+	return nil
+}
+
+// New returns the store selected by config.Backend. config is expected to be validated.
+func New(config Config) (BlobStore, error) {
+	switch config.Backend {
+	case BackendS3:
+		return NewS3Store(config), nil
+	default:
+		return NewLocalStore(config.LocalDir)
+	}
+}
+
+// cleanKey rejects keys that would escape the root of the store.
+func cleanKey(key string) (string, error) {
+	cleaned := path.Clean("/" + key)[1:]
+	if cleaned == "" || cleaned != key || strings.Contains(key, "\\") {
+		return "", fmt.Errorf("invalid blob key %q", key)
+	}
+	return cleaned, nil
+}
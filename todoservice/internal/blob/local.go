@@ -0,0 +1,98 @@
+package blob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+)
+
+// LocalStore keeps every blob in a file below root.
+type LocalStore struct {
+	root string
+}
+
+var _ BlobStore = &LocalStore{}
+
+func NewLocalStore(root string) (*LocalStore, error) {
+	if err := os.MkdirAll(root, 0o750); err != nil {
+		return nil, fmt.Errorf("error creating blob directory %s: %w", root, err)
+	}
+	return &LocalStore{root: root}, nil
+}
+
+// Put writes to a temporary file that is renamed once complete, so readers never see partial content.
+func (s *LocalStore) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	log.C(ctx).Infof("storing blob %s", key)
+	target, err := s.path(key)
+	if err != nil {
+		return 0, err
+	}
+	if err = os.MkdirAll(filepath.Dir(target), 0o750); err != nil {
+		return 0, fmt.Errorf("error creating blob directory: %w", err)
+	}
+
+	tmp, err := os.CreateTemp(filepath.Dir(target), ".upload-*")
+	if err != nil {
+		return 0, fmt.Errorf("error creating blob file: %w", err)
+	}
+	defer os.Remove(tmp.Name())
+
+	written, err := io.Copy(tmp, r)
+	if err != nil {
+		_ = tmp.Close()
+		return 0, fmt.Errorf("error writing blob %s: %w", key, err)
+	}
+	if err = tmp.Sync(); err != nil {
+		_ = tmp.Close()
+		return 0, fmt.Errorf("error syncing blob %s: %w", key, err)
+	}
+	if err = tmp.Close(); err != nil {
+		return 0, fmt.Errorf("error closing blob %s: %w", key, err)
+	}
+	if err = os.Rename(tmp.Name(), target); err != nil {
+		return 0, fmt.Errorf("error storing blob %s: %w", key, err)
+	}
+	return written, nil
+}
+
+func (s *LocalStore) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	log.C(ctx).Infof("opening blob %s", key)
+	target, err := s.path(key)
+	if err != nil {
+		return nil, err
+	}
+	file, err := os.Open(target)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, fmt.Errorf("blob %s: %w", key, pkg.ErrNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error opening blob %s: %w", key, err)
+	}
+	return file, nil
+}
+
+func (s *LocalStore) Delete(ctx context.Context, key string) error {
+	log.C(ctx).Infof("deleting blob %s", key)
+	target, err := s.path(key)
+	if err != nil {
+		return err
+	}
+	if err = os.Remove(target); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("error deleting blob %s: %w", key, err)
+	}
+	return nil
+}
+
+func (s *LocalStore) path(key string) (string, error) {
+	cleaned, err := cleanKey(key)
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(s.root, filepath.FromSlash(cleaned)), nil
+}
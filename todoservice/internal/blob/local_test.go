@@ -0,0 +1,73 @@
+package blob_test
+
+import (
+	"context"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/blob"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+func TestLocalStore(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	store, err := blob.NewLocalStore(root)
+	require.NoError(t, err)
+
+	size, err := store.Put(ctx, "todo1/attachment1", strings.NewReader("%PDF-1.7"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(8), size)
+
+	reader, err := store.Open(ctx, "todo1/attachment1")
+	require.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Equal(t, "%PDF-1.7", string(content))
+
+	entries, err := os.ReadDir(filepath.Join(root, "todo1"))
+	require.NoError(t, err)
+	assert.Len(t, entries, 1, "the temporary upload file is renamed")
+
+	require.NoError(t, store.Delete(ctx, "todo1/attachment1"))
+	require.NoError(t, store.Delete(ctx, "todo1/attachment1"))
+	_, err = store.Open(ctx, "todo1/attachment1")
+	assert.ErrorIs(t, err, pkg.ErrNotFound)
+}
+
+func TestLocalStoreFailedPut(t *testing.T) {
+	ctx := context.Background()
+	root := t.TempDir()
+	store, err := blob.NewLocalStore(root)
+	require.NoError(t, err)
+
+	_, err = store.Put(ctx, "todo1/attachment1", io.MultiReader(strings.NewReader("partial"), &failingReader{}))
+	require.Error(t, err)
+
+	_, err = store.Open(ctx, "todo1/attachment1")
+	assert.ErrorIs(t, err, pkg.ErrNotFound)
+	entries, err := os.ReadDir(filepath.Join(root, "todo1"))
+	require.NoError(t, err)
+	assert.Empty(t, entries)
+}
+
+func TestLocalStoreRejectsKeysOutsideOfTheRoot(t *testing.T) {
+	store, err := blob.NewLocalStore(t.TempDir())
+	require.NoError(t, err)
+
+	for _, key := range []string{"../secret", "todo1/../../secret", "/etc/passwd", "", `todo1\..\secret`} {
+		_, err = store.Put(context.Background(), key, strings.NewReader("x"))
+		assert.Error(t, err, key)
+	}
+}
+
+type failingReader struct{}
+
+func (f *failingReader) Read([]byte) (int, error) {
+	return 0, io.ErrUnexpectedEOF
+}
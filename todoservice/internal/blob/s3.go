@@ -0,0 +1,212 @@
+package blob
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	s3Service       = "s3"
+	s3Algorithm     = "AWS4-HMAC-SHA256"
+	amzDateFormat   = "20060102T150405Z"
+	amzDayFormat    = "20060102"
+	emptyBodySHA256 = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+)
+
+// S3Store keeps blobs in a bucket of an S3 compatible object storage such as AWS S3 or MinIO.
+// Objects are addressed path-style, <endpoint>/<bucket>/<key>, and requests are signed with AWS signature version 4.
+type S3Store struct {
+	httpClient *http.Client
+	endpoint   string
+	region     string
+	bucket     string
+	accessKey  string
+	secretKey  string
+	now        func() time.Time
+}
+
+var _ BlobStore = &S3Store{}
+
+func NewS3Store(config Config) *S3Store {
+	return &S3Store{
+		httpClient: &http.Client{Timeout: config.S3Timeout},
+		endpoint:   strings.TrimSuffix(config.S3Endpoint, "/"),
+		region:     config.S3Region,
+		bucket:     config.S3Bucket,
+		accessKey:  config.S3AccessKey,
+		secretKey:  config.S3SecretKey,
+		now:        time.Now,
+	}
+}
+
+// Put spools r to a temporary file first: S3 needs the length and the signature needs the hash of the content.
+func (s *S3Store) Put(ctx context.Context, key string, r io.Reader) (int64, error) {
+	log.C(ctx).Infof("storing blob %s in s3", key)
+	tmp, err := os.CreateTemp("", "blob-*")
+	if err != nil {
+		return 0, fmt.Errorf("error creating spool file: %w", err)
+	}
+	defer func() {
+		_ = tmp.Close()
+		_ = os.Remove(tmp.Name())
+	}()
+
+	hash := sha256.New()
+	size, err := io.Copy(io.MultiWriter(tmp, hash), r)
+	if err != nil {
+		return 0, fmt.Errorf("error writing blob %s: %w", key, err)
+	}
+	if _, err = tmp.Seek(0, io.SeekStart); err != nil {
+		return 0, fmt.Errorf("error rewinding spool file: %w", err)
+	}
+
+	req, err := s.newRequest(ctx, http.MethodPut, key, io.NopCloser(tmp), hex.EncodeToString(hash.Sum(nil)))
+	if err != nil {
+		return 0, err
+	}
+	req.ContentLength = size
+
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("error storing blob %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return 0, fmt.Errorf("error storing blob %s: s3 responded with status code %d", key, resp.StatusCode)
+	}
+	return size, nil
+}
+
+func (s *S3Store) Open(ctx context.Context, key string) (io.ReadCloser, error) {
+	log.C(ctx).Infof("opening blob %s in s3", key)
+	req, err := s.newRequest(ctx, http.MethodGet, key, nil, emptyBodySHA256)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error opening blob %s: %w", key, err)
+	}
+	switch resp.StatusCode {
+	case http.StatusOK:
+		return resp.Body, nil
+	case http.StatusNotFound:
+		resp.Body.Close()
+		return nil, fmt.Errorf("blob %s: %w", key, pkg.ErrNotFound)
+	default:
+		resp.Body.Close()
+		return nil, fmt.Errorf("error opening blob %s: s3 responded with status code %d", key, resp.StatusCode)
+	}
+}
+
+func (s *S3Store) Delete(ctx context.Context, key string) error {
+	log.C(ctx).Infof("deleting blob %s in s3", key)
+	req, err := s.newRequest(ctx, http.MethodDelete, key, nil, emptyBodySHA256)
+	if err != nil {
+		return err
+	}
+	resp, err := s.httpClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("error deleting blob %s: %w", key, err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusNoContent && resp.StatusCode != http.StatusOK && resp.StatusCode != http.StatusNotFound {
+		return fmt.Errorf("error deleting blob %s: s3 responded with status code %d", key, resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *S3Store) newRequest(ctx context.Context, method, key string, body io.ReadCloser, payloadHash string) (*http.Request, error) {
+	cleaned, err := cleanKey(key)
+	if err != nil {
+		return nil, err
+	}
+	objectURL, err := url.Parse(s.endpoint + "/" + url.PathEscape(s.bucket) + "/" + escapePath(cleaned))
+	if err != nil {
+		return nil, fmt.Errorf("invalid s3 endpoint: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, objectURL.String(), body)
+	if err != nil {
+		return nil, fmt.Errorf("error creating s3 request: %w", err)
+	}
+	s.sign(req, payloadHash)
+	return req, nil
+}
+
+// sign adds the AWS signature version 4 headers, signing the host and the x-amz-* headers.
+func (s *S3Store) sign(req *http.Request, payloadHash string) {
+	now := s.now().UTC()
+	amzDate := now.Format(amzDateFormat)
+	scope := strings.Join([]string{now.Format(amzDayFormat), s.region, s3Service, "aws4_request"}, "/")
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	signedHeaders := "host;x-amz-content-sha256;x-amz-date"
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		canonicalQuery(req.URL.Query()),
+		"host:" + req.URL.Host + "\n" +
+			"x-amz-content-sha256:" + payloadHash + "\n" +
+			"x-amz-date:" + amzDate + "\n",
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join([]string{s3Algorithm, amzDate, scope, hex.EncodeToString(canonicalHash[:])}, "\n")
+
+	key := hmacSHA256([]byte("AWS4"+s.secretKey), now.Format(amzDayFormat))
+	key = hmacSHA256(key, s.region)
+	key = hmacSHA256(key, s3Service)
+	key = hmacSHA256(key, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(key, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.accessKey, scope, signedHeaders, signature))
+}
+
+// canonicalQuery sorts the query parameters by name and value and escapes them the way AWS expects,
+// keeping the "=" of parameters without a value.
+func canonicalQuery(query url.Values) string {
+	pairs := make([]string, 0, len(query))
+	for name, values := range query {
+		for _, value := range values {
+			pairs = append(pairs, awsEscape(name)+"="+awsEscape(value))
+		}
+	}
+	sort.Strings(pairs)
+	return strings.Join(pairs, "&")
+}
+
+// awsEscape percent-encodes everything but the unreserved characters of RFC 3986.
+func awsEscape(s string) string {
+	return strings.ReplaceAll(url.QueryEscape(s), "+", "%20")
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
+
+// escapePath escapes every segment of key the way S3 expects in the canonical URI.
+func escapePath(key string) string {
+	segments := strings.Split(key, "/")
+	for i, segment := range segments {
+		segments[i] = url.PathEscape(segment)
+	}
+	return strings.Join(segments, "/")
+}
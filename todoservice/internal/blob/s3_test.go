@@ -0,0 +1,107 @@
+package blob_test
+
+import (
+	"context"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/blob"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeS3 keeps objects in memory and checks that every request is signed.
+type fakeS3 struct {
+	mu      sync.Mutex
+	objects map[string][]byte
+}
+
+func (f *fakeS3) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if !strings.HasPrefix(r.Header.Get("Authorization"), "AWS4-HMAC-SHA256 Credential=access/") ||
+		!strings.Contains(r.Header.Get("Authorization"), "SignedHeaders=host;x-amz-content-sha256;x-amz-date, Signature=") ||
+		r.Header.Get("X-Amz-Date") == "" || r.Header.Get("X-Amz-Content-Sha256") == "" {
+		w.WriteHeader(http.StatusForbidden)
+		return
+	}
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	switch r.Method {
+	case http.MethodPut:
+		if r.ContentLength < 0 {
+			w.WriteHeader(http.StatusLengthRequired)
+			return
+		}
+		body, _ := io.ReadAll(r.Body)
+		f.objects[r.URL.Path] = body
+	case http.MethodGet:
+		body, ok := f.objects[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(body)
+	case http.MethodDelete:
+		delete(f.objects, r.URL.Path)
+		w.WriteHeader(http.StatusNoContent)
+	}
+}
+
+func TestS3Store(t *testing.T) {
+	ctx := context.Background()
+	fake := &fakeS3{objects: map[string][]byte{}}
+	server := httptest.NewServer(fake)
+	defer server.Close()
+
+	store := blob.NewS3Store(blob.Config{
+		S3Endpoint:  server.URL,
+		S3Region:    "eu-central-1",
+		S3Bucket:    "attachments",
+		S3AccessKey: "access",
+		S3SecretKey: "secret",
+	})
+
+	size, err := store.Put(ctx, "todo1/attachment1", strings.NewReader("hello"))
+	require.NoError(t, err)
+	assert.Equal(t, int64(5), size)
+	assert.Equal(t, []byte("hello"), fake.objects["/attachments/todo1/attachment1"])
+
+	reader, err := store.Open(ctx, "todo1/attachment1")
+	require.NoError(t, err)
+	content, err := io.ReadAll(reader)
+	require.NoError(t, err)
+	require.NoError(t, reader.Close())
+	assert.Equal(t, "hello", string(content))
+
+	require.NoError(t, store.Delete(ctx, "todo1/attachment1"))
+	_, err = store.Open(ctx, "todo1/attachment1")
+	assert.ErrorIs(t, err, pkg.ErrNotFound)
+}
+
+func TestConfigValidate(t *testing.T) {
+	tests := []struct {
+		name        string
+		config      blob.Config
+		expectError bool
+	}{
+		{name: "local backend", config: blob.Config{Backend: blob.BackendLocal, LocalDir: "data"}},
+		{name: "s3 backend", config: blob.Config{Backend: blob.BackendS3, S3Endpoint: "http://minio:9000", S3Bucket: "b", S3AccessKey: "a", S3SecretKey: "s", S3Timeout: time.Minute}},
+		{name: "s3 backend without timeout", config: blob.Config{Backend: blob.BackendS3, S3Endpoint: "http://minio:9000", S3Bucket: "b", S3AccessKey: "a", S3SecretKey: "s"}, expectError: true},
+		{name: "s3 backend without credentials", config: blob.Config{Backend: blob.BackendS3, S3Endpoint: "http://minio:9000", S3Bucket: "b"}, expectError: true},
+		{name: "unknown backend", config: blob.Config{Backend: "gcs"}, expectError: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.config.Validate()
+			if tt.expectError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,209 @@
+package comments
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
+)
+
+//go:generate mockery --name=CommentRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type CommentRepository interface {
+	Create(ctx context.Context, comment models.Comment) (string, error)
+	Get(ctx context.Context, todoID string, id string) (models.Comment, error)
+	GetAllByTodoID(ctx context.Context, todoID string, limit, offset int) ([]models.Comment, error)
+	CountByTodoID(ctx context.Context, todoID string) (int, error)
+	UpdateBody(ctx context.Context, todoID string, id string, body string) error
+	Delete(ctx context.Context, todoID string, id string) error
+	SetMentions(ctx context.Context, id string, emails []string) error
+}
+
+type SQLXCommentRepository struct {
+	converter *Converter
+}
+
+var _ CommentRepository = &SQLXCommentRepository{}
+
+func NewSQLXCommentRepository() CommentRepository {
+	return &SQLXCommentRepository{converter: NewConverter()}
+}
+
+func (r *SQLXCommentRepository) Create(ctx context.Context, comment models.Comment) (string, error) {
+	log.C(ctx).Info("creating comment repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return "", err
+	}
+
+	entity := r.converter.ConvertCommentToEntity(comment)
+	query := `
+		INSERT INTO todo_comments (id, todo_id, author_id, body)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`
+
+	var id string
+	err = tx.QueryRowContext(ctx, query, entity.ID, entity.TodoID, entity.AuthorID, entity.Body).Scan(&id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create comment: %v", err)
+		return "", fmt.Errorf("failed to create comment: %w", err)
+	}
+	log.C(ctx).Debugf("created comment with ID: %v", id)
+	return id, nil
+}
+
+func (r *SQLXCommentRepository) Get(ctx context.Context, todoID string, id string) (models.Comment, error) {
+	log.C(ctx).Info("getting comment repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return models.Comment{}, err
+	}
+
+	query := `
+		SELECT id, todo_id, author_id, body, created_at, updated_at, ` + mentionsColumn + `
+		FROM todo_comments
+		WHERE todo_id = $1 AND id = $2
+	`
+	var entity Entity
+	err = tx.GetContext(ctx, &entity, query, todoID, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get comment: %v", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Comment{}, fmt.Errorf("comment not found: %w", err)
+		}
+		return models.Comment{}, fmt.Errorf("failed to get comment: %w", err)
+	}
+	return r.converter.ConvertCommentToModel(entity), nil
+}
+
+// GetAllByTodoID returns a page of the comments of the todo, oldest first.
+func (r *SQLXCommentRepository) GetAllByTodoID(ctx context.Context, todoID string, limit, offset int) ([]models.Comment, error) {
+	log.C(ctx).Info("getting all comments for a todo repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	query := `
+		SELECT id, todo_id, author_id, body, created_at, updated_at, ` + mentionsColumn + `
+		FROM todo_comments
+		WHERE todo_id = $1
+		ORDER BY created_at, id
+		LIMIT $2 OFFSET $3
+	`
+	var entities []Entity
+	err = tx.SelectContext(ctx, &entities, query, todoID, limit, offset)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get comments: %v", err)
+		return nil, fmt.Errorf("failed to get comments: %w", err)
+	}
+
+	result := make([]models.Comment, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, r.converter.ConvertCommentToModel(entity))
+	}
+	return result, nil
+}
+
+func (r *SQLXCommentRepository) CountByTodoID(ctx context.Context, todoID string) (int, error) {
+	log.C(ctx).Info("counting comments for a todo repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return 0, err
+	}
+
+	var count int
+	if err = tx.GetContext(ctx, &count, `SELECT COUNT(*) FROM todo_comments WHERE todo_id = $1`, todoID); err != nil {
+		log.C(ctx).Errorf("failed to count comments: %v", err)
+		return 0, fmt.Errorf("failed to count comments: %w", err)
+	}
+	return count, nil
+}
+
+func (r *SQLXCommentRepository) UpdateBody(ctx context.Context, todoID string, id string, body string) error {
+	log.C(ctx).Info("updating comment repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `UPDATE todo_comments SET body = $1 WHERE todo_id = $2 AND id = $3`, body, todoID, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update comment: %v", err)
+		return fmt.Errorf("failed to update comment: %w", err)
+	}
+	return requireAffected(result, id)
+}
+
+func (r *SQLXCommentRepository) Delete(ctx context.Context, todoID string, id string) error {
+	log.C(ctx).Info("deleting comment repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM todo_comments WHERE todo_id = $1 AND id = $2`, todoID, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to delete comment: %v", err)
+		return fmt.Errorf("failed to delete comment: %w", err)
+	}
+	return requireAffected(result, id)
+}
+
+// SetMentions replaces the mentions of the comment with the members of the todo's list having one of the emails.
+// Emails that do not belong to the owner or a user who accepted their invitation are ignored, they stay plain
+// text in the body.
+func (r *SQLXCommentRepository) SetMentions(ctx context.Context, id string, emails []string) error {
+	log.C(ctx).Info("setting comment mentions repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM todo_comment_mentions WHERE comment_id = $1`, id); err != nil {
+		log.C(ctx).Errorf("failed to clear comment mentions: %v", err)
+		return fmt.Errorf("failed to clear comment mentions: %w", err)
+	}
+	if len(emails) == 0 {
+		return nil
+	}
+
+	insertQuery := `
+		INSERT INTO todo_comment_mentions (comment_id, user_id)
+		SELECT c.id, u.id
+		FROM todo_comments c
+		JOIN todos t ON t.id = c.todo_id
+		JOIN list_access la ON la.list_id = t.list_id
+		JOIN users u ON u.id = la.user_id
+		WHERE c.id = $1 AND lower(u.email) = ANY($2) AND la.status <> 'pending'
+		ON CONFLICT DO NOTHING
+	`
+	if _, err = tx.ExecContext(ctx, insertQuery, id, pq.Array(emails)); err != nil {
+		log.C(ctx).Errorf("failed to set comment mentions: %v", err)
+		return fmt.Errorf("failed to set comment mentions: %w", err)
+	}
+	return nil
+}
+
+func requireAffected(result sql.Result, id string) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("comment %s not found: %w", id, pkg.ErrNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,178 @@
+package comments_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/comments"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+	"time"
+)
+
+func TestSQLXCommentRepositoryCreate(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := comments.NewSQLXCommentRepository()
+	comment := models.Comment{ID: "1", TodoID: "todo1", AuthorID: "user1", Body: "**done**"}
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedID    string
+		expectedError string
+	}{
+		{
+			name: "Successful creation",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery(`^INSERT INTO todo_comments`).WithArgs("1", "todo1", "user1", "**done**").
+					WillReturnRows(sqlxmock.NewRows([]string{"id"}).AddRow("1"))
+				mockDB.ExpectCommit()
+			},
+			expectedID: "1",
+		},
+		{
+			name: "Failed creation due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery(`^INSERT INTO todo_comments`).WithArgs("1", "todo1", "user1", "**done**").WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: "failed to create comment: db error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			id, err := repo.Create(ctx, comment)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				require.NoError(t, tx.Rollback())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedID, id)
+				require.NoError(t, tx.Commit())
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSQLXCommentRepositoryGetAllByTodoID(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := comments.NewSQLXCommentRepository()
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectQuery("SELECT id, todo_id, author_id, body, created_at, updated_at, .* AS mentions FROM todo_comments").WithArgs("todo1", 2, 0).
+		WillReturnRows(sqlxmock.NewRows([]string{"id", "todo_id", "author_id", "body", "created_at", "updated_at", "mentions"}).
+			AddRow("1", "todo1", "user1", "ping @bob@example.com", time.Time{}, time.Time{}, `[{"user_id":"user2","email":"bob@example.com"}]`).
+			AddRow("2", "todo1", "user2", "on it", time.Time{}, time.Time{}, "[]"))
+	mockDB.ExpectCommit()
+
+	ctx := context.Background()
+	tx, err := database.BeginTxx(ctx, nil)
+	require.NoError(t, err)
+
+	result, err := repo.GetAllByTodoID(db.SaveToContext(ctx, tx), "todo1", 2, 0)
+	require.NoError(t, err)
+	assert.Equal(t, []models.Comment{
+		{ID: "1", TodoID: "todo1", AuthorID: "user1", Body: "ping @bob@example.com", Mentions: []models.Mention{{UserID: "user2", Email: "bob@example.com"}}},
+		{ID: "2", TodoID: "todo1", AuthorID: "user2", Body: "on it", Mentions: []models.Mention{}},
+	}, result)
+	require.NoError(t, tx.Commit())
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestSQLXCommentRepositorySetMentions(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := comments.NewSQLXCommentRepository()
+	emails := []string{"bob@example.com"}
+
+	testCases := []struct {
+		name          string
+		emails        []string
+		setupMocks    func()
+		expectedError string
+	}{
+		{
+			name:   "Successful replace of the mentions",
+			emails: emails,
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^DELETE FROM todo_comment_mentions`).WithArgs("1").WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectExec(`^INSERT INTO todo_comment_mentions`).WithArgs("1", pq.Array(emails)).WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name:   "Successful clear of the mentions",
+			emails: []string{},
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^DELETE FROM todo_comment_mentions`).WithArgs("1").WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name:   "Failed because of a database error",
+			emails: emails,
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^DELETE FROM todo_comment_mentions`).WithArgs("1").WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectExec(`^INSERT INTO todo_comment_mentions`).WithArgs("1", pq.Array(emails)).WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: "failed to set comment mentions: db error",
+		},
+		{
+			name:   "Successful ignore of the emails of users without access to the list",
+			emails: []string{"mallory@example.com"},
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^DELETE FROM todo_comment_mentions`).WithArgs("1").WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectExec(`^INSERT INTO todo_comment_mentions .+ JOIN list_access la ON la.list_id = t.list_id .+ AND la.status <> 'pending'`).
+					WithArgs("1", pq.Array([]string{"mallory@example.com"})).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectCommit()
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			err = repo.SetMentions(ctx, "1", tc.emails)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				require.NoError(t, tx.Rollback())
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, tx.Commit())
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
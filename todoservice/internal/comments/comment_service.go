@@ -0,0 +1,173 @@
+package comments
+
+import (
+	"context"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	DefaultPageSize = 20
+	MaxPageSize     = 100
+	maxBodyLength   = 10000
+)
+
+var (
+	// mentionPattern matches @email that is not part of a longer word, e.g. not the domain of another email.
+	mentionPattern = regexp.MustCompile(`(?:^|[^\w.@+-])@([A-Za-z0-9._%+-]+@[A-Za-z0-9.-]+\.[A-Za-z]{2,})`)
+	codeBlock      = regexp.MustCompile("(?s)```.*?```")
+	codeSpan       = regexp.MustCompile("`[^`\n]*`")
+)
+
+// Actor is the user changing a comment. Only the author of a comment or an admin may edit or delete it.
+type Actor struct {
+	UserID string
+	Admin  bool
+}
+
+//go:generate mockery --name=CommentService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type CommentService interface {
+	AddComment(ctx context.Context, comment models.Comment) (models.Comment, error)
+	GetComment(ctx context.Context, todoID, id string) (models.Comment, error)
+	ListComments(ctx context.Context, todoID string, limit, offset int) (models.CommentPage, error)
+	UpdateComment(ctx context.Context, actor Actor, todoID, id, body string) (models.Comment, error)
+	DeleteComment(ctx context.Context, actor Actor, todoID, id string) error
+}
+
+//go:generate mockery --name=UUIDService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type UUIDService interface {
+	Generate() string
+}
+
+var _ CommentService = &service{}
+
+type service struct {
+	repo        CommentRepository
+	uuidService UUIDService
+}
+
+func NewService(repo CommentRepository, uuidService UUIDService) CommentService {
+	return &service{repo: repo, uuidService: uuidService}
+}
+
+// AddComment stores the comment of comment.AuthorID and mentions the users whose emails appear in the body.
+func (s *service) AddComment(ctx context.Context, comment models.Comment) (models.Comment, error) {
+	log.C(ctx).Info("adding comment service")
+	body, err := normalizeBody(comment.Body)
+	if err != nil {
+		return models.Comment{}, err
+	}
+
+	comment.ID = s.uuidService.Generate()
+	comment.Body = body
+	if _, err = s.repo.Create(ctx, comment); err != nil {
+		return models.Comment{}, err
+	}
+	if err = s.repo.SetMentions(ctx, comment.ID, ParseMentions(body)); err != nil {
+		return models.Comment{}, err
+	}
+	return s.repo.Get(ctx, comment.TodoID, comment.ID)
+}
+
+func (s *service) GetComment(ctx context.Context, todoID, id string) (models.Comment, error) {
+	log.C(ctx).Info("getting comment service")
+	return s.repo.Get(ctx, todoID, id)
+}
+
+// ListComments returns the comments of the todo oldest first. A zero limit means DefaultPageSize.
+func (s *service) ListComments(ctx context.Context, todoID string, limit, offset int) (models.CommentPage, error) {
+	log.C(ctx).Info("listing comments service")
+	if limit == 0 {
+		limit = DefaultPageSize
+	}
+	if limit < 0 || limit > MaxPageSize {
+		return models.CommentPage{}, fmt.Errorf("limit must be between 1 and %d: %w", MaxPageSize, pkg.ErrBadRequest)
+	}
+	if offset < 0 {
+		return models.CommentPage{}, fmt.Errorf("offset cannot be negative: %w", pkg.ErrBadRequest)
+	}
+
+	comments, err := s.repo.GetAllByTodoID(ctx, todoID, limit, offset)
+	if err != nil {
+		return models.CommentPage{}, err
+	}
+	total, err := s.repo.CountByTodoID(ctx, todoID)
+	if err != nil {
+		return models.CommentPage{}, err
+	}
+	return models.CommentPage{Comments: comments, Total: total, Limit: limit, Offset: offset}, nil
+}
+
+func (s *service) UpdateComment(ctx context.Context, actor Actor, todoID, id, body string) (models.Comment, error) {
+	log.C(ctx).Info("updating comment service")
+	body, err := normalizeBody(body)
+	if err != nil {
+		return models.Comment{}, err
+	}
+	if err = s.authorize(ctx, actor, todoID, id); err != nil {
+		return models.Comment{}, err
+	}
+
+	if err = s.repo.UpdateBody(ctx, todoID, id, body); err != nil {
+		return models.Comment{}, err
+	}
+	if err = s.repo.SetMentions(ctx, id, ParseMentions(body)); err != nil {
+		return models.Comment{}, err
+	}
+	return s.repo.Get(ctx, todoID, id)
+}
+
+func (s *service) DeleteComment(ctx context.Context, actor Actor, todoID, id string) error {
+	log.C(ctx).Info("deleting comment service")
+	if err := s.authorize(ctx, actor, todoID, id); err != nil {
+		return err
+	}
+	return s.repo.Delete(ctx, todoID, id)
+}
+
+func (s *service) authorize(ctx context.Context, actor Actor, todoID, id string) error {
+	comment, err := s.repo.Get(ctx, todoID, id)
+	if err != nil {
+		return err
+	}
+	if !actor.Admin && comment.AuthorID != actor.UserID {
+		log.C(ctx).Errorf("user %s is not the author of comment %s", actor.UserID, id)
+		return fmt.Errorf("only the author can change comment %s: %w", id, pkg.ErrForbidden)
+	}
+	return nil
+}
+
+func normalizeBody(body string) (string, error) {
+	body = strings.TrimSpace(body)
+	if body == "" {
+		return "", fmt.Errorf("comment body cannot be empty: %w", pkg.ErrBadRequest)
+	}
+	if utf8.RuneCountInString(body) > maxBodyLength {
+		return "", fmt.Errorf("comment body cannot be longer than %d characters: %w", maxBodyLength, pkg.ErrBadRequest)
+	}
+	return body, nil
+}
+
+// ParseMentions returns the distinct lowercased emails mentioned with @email in a markdown body,
+// ignoring code blocks and code spans.
+func ParseMentions(body string) []string {
+	body = codeBlock.ReplaceAllString(body, " ")
+	body = codeSpan.ReplaceAllString(body, " ")
+
+	seen := make(map[string]struct{})
+	result := make([]string, 0)
+	for _, match := range mentionPattern.FindAllStringSubmatch(body, -1) {
+		email := strings.ToLower(match[1])
+		if _, ok := seen[email]; ok {
+			continue
+		}
+		seen[email] = struct{}{}
+		result = append(result, email)
+	}
+	return result
+}
@@ -0,0 +1,311 @@
+package comments_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/comments"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/comments/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestServiceAddComment(t *testing.T) {
+	id := "1"
+	err := errors.New("error")
+	ctx := context.Background()
+	stored := models.Comment{ID: id, TodoID: "todo1", AuthorID: "user1", Body: "ping @Bob@example.com",
+		Mentions: []models.Mention{{UserID: "user2", Email: "bob@example.com"}}}
+
+	tests := []struct {
+		name          string
+		input         models.Comment
+		uuidService   func() *automock.UUIDService
+		repo          func() *automock.CommentRepository
+		expected      models.Comment
+		expectedError error
+	}{
+		{
+			name:  "Add comment with a mention",
+			input: models.Comment{TodoID: "todo1", AuthorID: "user1", Body: "  ping @Bob@example.com\n"},
+			uuidService: func() *automock.UUIDService {
+				uuidService := &automock.UUIDService{}
+				uuidService.EXPECT().Generate().Return(id).Once()
+				return uuidService
+			},
+			repo: func() *automock.CommentRepository {
+				repo := &automock.CommentRepository{}
+				repo.EXPECT().Create(ctx, models.Comment{ID: id, TodoID: "todo1", AuthorID: "user1", Body: "ping @Bob@example.com"}).Return(id, nil).Once()
+				repo.EXPECT().SetMentions(ctx, id, []string{"bob@example.com"}).Return(nil).Once()
+				repo.EXPECT().Get(ctx, "todo1", id).Return(stored, nil).Once()
+				return repo
+			},
+			expected: stored,
+		},
+		{
+			name:  "Error when the body is empty",
+			input: models.Comment{TodoID: "todo1", AuthorID: "user1", Body: " \n "},
+			uuidService: func() *automock.UUIDService {
+				return &automock.UUIDService{}
+			},
+			repo: func() *automock.CommentRepository {
+				return &automock.CommentRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+		{
+			name:  "Error when the body is too long",
+			input: models.Comment{TodoID: "todo1", AuthorID: "user1", Body: strings.Repeat("a", 10001)},
+			uuidService: func() *automock.UUIDService {
+				return &automock.UUIDService{}
+			},
+			repo: func() *automock.CommentRepository {
+				return &automock.CommentRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+		{
+			name:  "Error when repo create fails",
+			input: models.Comment{TodoID: "todo1", AuthorID: "user1", Body: "done"},
+			uuidService: func() *automock.UUIDService {
+				uuidService := &automock.UUIDService{}
+				uuidService.EXPECT().Generate().Return(id).Once()
+				return uuidService
+			},
+			repo: func() *automock.CommentRepository {
+				repo := &automock.CommentRepository{}
+				repo.EXPECT().Create(ctx, models.Comment{ID: id, TodoID: "todo1", AuthorID: "user1", Body: "done"}).Return("", err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uuidService := tt.uuidService()
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, uuidService, repo)
+
+			svc := comments.NewService(repo, uuidService)
+			comment, err := svc.AddComment(ctx, tt.input)
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, comment)
+			}
+		})
+	}
+}
+
+func TestServiceListComments(t *testing.T) {
+	ctx := context.Background()
+	page := []models.Comment{{ID: "1", TodoID: "todo1", AuthorID: "user1", Body: "first"}}
+
+	tests := []struct {
+		name          string
+		limit         int
+		offset        int
+		repo          func() *automock.CommentRepository
+		expected      models.CommentPage
+		expectedError error
+	}{
+		{
+			name: "List with the default page size",
+			repo: func() *automock.CommentRepository {
+				repo := &automock.CommentRepository{}
+				repo.EXPECT().GetAllByTodoID(ctx, "todo1", comments.DefaultPageSize, 0).Return(page, nil).Once()
+				repo.EXPECT().CountByTodoID(ctx, "todo1").Return(3, nil).Once()
+				return repo
+			},
+			expected: models.CommentPage{Comments: page, Total: 3, Limit: comments.DefaultPageSize},
+		},
+		{
+			name:   "List the second page",
+			limit:  1,
+			offset: 1,
+			repo: func() *automock.CommentRepository {
+				repo := &automock.CommentRepository{}
+				repo.EXPECT().GetAllByTodoID(ctx, "todo1", 1, 1).Return(page, nil).Once()
+				repo.EXPECT().CountByTodoID(ctx, "todo1").Return(3, nil).Once()
+				return repo
+			},
+			expected: models.CommentPage{Comments: page, Total: 3, Limit: 1, Offset: 1},
+		},
+		{
+			name:  "Error when the limit is too big",
+			limit: comments.MaxPageSize + 1,
+			repo: func() *automock.CommentRepository {
+				return &automock.CommentRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+		{
+			name:   "Error when the offset is negative",
+			offset: -1,
+			repo: func() *automock.CommentRepository {
+				return &automock.CommentRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer repo.AssertExpectations(t)
+
+			svc := comments.NewService(repo, &automock.UUIDService{})
+			result, err := svc.ListComments(ctx, "todo1", tt.limit, tt.offset)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceUpdateComment(t *testing.T) {
+	ctx := context.Background()
+	existing := models.Comment{ID: "1", TodoID: "todo1", AuthorID: "author", Body: "first"}
+	updated := models.Comment{ID: "1", TodoID: "todo1", AuthorID: "author", Body: "edited", Mentions: []models.Mention{}}
+
+	tests := []struct {
+		name          string
+		actor         comments.Actor
+		repo          func() *automock.CommentRepository
+		expectedError error
+	}{
+		{
+			name:  "Author edits the comment",
+			actor: comments.Actor{UserID: "author"},
+			repo: func() *automock.CommentRepository {
+				repo := &automock.CommentRepository{}
+				repo.EXPECT().Get(ctx, "todo1", "1").Return(existing, nil).Once()
+				repo.EXPECT().UpdateBody(ctx, "todo1", "1", "edited").Return(nil).Once()
+				repo.EXPECT().SetMentions(ctx, "1", []string{}).Return(nil).Once()
+				repo.EXPECT().Get(ctx, "todo1", "1").Return(updated, nil).Once()
+				return repo
+			},
+		},
+		{
+			name:  "Admin edits the comment of another user",
+			actor: comments.Actor{UserID: "admin", Admin: true},
+			repo: func() *automock.CommentRepository {
+				repo := &automock.CommentRepository{}
+				repo.EXPECT().Get(ctx, "todo1", "1").Return(existing, nil).Once()
+				repo.EXPECT().UpdateBody(ctx, "todo1", "1", "edited").Return(nil).Once()
+				repo.EXPECT().SetMentions(ctx, "1", []string{}).Return(nil).Once()
+				repo.EXPECT().Get(ctx, "todo1", "1").Return(updated, nil).Once()
+				return repo
+			},
+		},
+		{
+			name:  "Error when another user edits the comment",
+			actor: comments.Actor{UserID: "other"},
+			repo: func() *automock.CommentRepository {
+				repo := &automock.CommentRepository{}
+				repo.EXPECT().Get(ctx, "todo1", "1").Return(existing, nil).Once()
+				return repo
+			},
+			expectedError: pkg.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer repo.AssertExpectations(t)
+
+			svc := comments.NewService(repo, &automock.UUIDService{})
+			result, err := svc.UpdateComment(ctx, tt.actor, "todo1", "1", "edited")
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, updated, result)
+			}
+		})
+	}
+}
+
+func TestServiceDeleteComment(t *testing.T) {
+	ctx := context.Background()
+	existing := models.Comment{ID: "1", TodoID: "todo1", AuthorID: "author", Body: "first"}
+
+	tests := []struct {
+		name          string
+		actor         comments.Actor
+		repo          func() *automock.CommentRepository
+		expectedError error
+	}{
+		{
+			name:  "Author deletes the comment",
+			actor: comments.Actor{UserID: "author"},
+			repo: func() *automock.CommentRepository {
+				repo := &automock.CommentRepository{}
+				repo.EXPECT().Get(ctx, "todo1", "1").Return(existing, nil).Once()
+				repo.EXPECT().Delete(ctx, "todo1", "1").Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			name:  "Error when another user deletes the comment",
+			actor: comments.Actor{UserID: "other"},
+			repo: func() *automock.CommentRepository {
+				repo := &automock.CommentRepository{}
+				repo.EXPECT().Get(ctx, "todo1", "1").Return(existing, nil).Once()
+				return repo
+			},
+			expectedError: pkg.ErrForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer repo.AssertExpectations(t)
+
+			svc := comments.NewService(repo, &automock.UUIDService{})
+			err := svc.DeleteComment(ctx, tt.actor, "todo1", "1")
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestParseMentions(t *testing.T) {
+	tests := []struct {
+		name     string
+		body     string
+		expected []string
+	}{
+		{
+			name:     "Mentions are lowercased and deduplicated",
+			body:     "@Ann@example.com can you check? cc @bob@example.org, @ann@example.com.",
+			expected: []string{"ann@example.com", "bob@example.org"},
+		},
+		{
+			name:     "Plain emails are not mentions",
+			body:     "write to ann@example.com",
+			expected: []string{},
+		},
+		{
+			name:     "Mentions in code are ignored",
+			body:     "run `notify @ann@example.com`\n```\n@bob@example.org\n```\n**@carl@example.net**",
+			expected: []string{"carl@example.net"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, comments.ParseMentions(tt.body))
+		})
+	}
+}
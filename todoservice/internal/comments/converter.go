@@ -0,0 +1,55 @@
+package comments
+
+import (
+	"database/sql"
+	"encoding/json"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+// mentionsColumn selects the users mentioned in the current row of todo_comments as a JSON array.
+const mentionsColumn = `COALESCE((
+			SELECT json_agg(json_build_object('user_id', u.id, 'email', u.email) ORDER BY u.email)
+			FROM todo_comment_mentions m JOIN users u ON u.id = m.user_id
+			WHERE m.comment_id = todo_comments.id
+		), '[]') AS mentions`
+
+type Converter struct{}
+
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) ConvertCommentToModel(entity Entity) models.Comment {
+	return models.Comment{
+		ID:        entity.ID,
+		TodoID:    entity.TodoID,
+		AuthorID:  entity.AuthorID,
+		Body:      entity.Body,
+		Mentions:  mentionsFromJSON(entity.Mentions),
+		CreatedAt: entity.CreatedAt,
+		UpdatedAt: entity.UpdatedAt,
+	}
+}
+
+func (c *Converter) ConvertCommentToEntity(comment models.Comment) Entity {
+	return Entity{
+		ID:        comment.ID,
+		TodoID:    comment.TodoID,
+		AuthorID:  comment.AuthorID,
+		Body:      comment.Body,
+		CreatedAt: comment.CreatedAt,
+		UpdatedAt: comment.UpdatedAt,
+	}
+}
+
+// mentionsFromJSON never returns nil so that comments without mentions are encoded with an empty array.
+func mentionsFromJSON(column sql.NullString) []models.Mention {
+	result := make([]models.Mention, 0)
+	if !column.Valid {
+		return result
+	}
+	if err := json.Unmarshal([]byte(column.String), &result); err != nil {
+		return make([]models.Mention, 0)
+	}
+	return result
+}
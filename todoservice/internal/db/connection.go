@@ -18,19 +18,25 @@ type Config struct {
 	SSLMode    string        `envconfig:"APP_DB_SSLMODE"`
 	RetryCount int           `envconfig:"APP_DB_RETRYCOUNT"`
 	Duration   time.Duration `envconfig:"APP_DB_DURATION"`
+	// MigrationVersion is the schema version the service requires, defaults to the newest embedded migration.
+	MigrationVersion int64 `envconfig:"APP_DB_MIGRATION_VERSION"`
+	// AutoMigrate applies pending migrations on start instead of refusing to serve an outdated schema.
+	AutoMigrate bool `envconfig:"APP_DB_AUTO_MIGRATE" default:"false"`
+
+	TransactionConfig
 }
 
 func Create(ctx context.Context, config Config) (*sqlx.DB, error) {
 	conStr := fmt.Sprintf("postgres://%s:%s@%s:%s/%s?sslmode=%s", config.User, config.Password,
 		config.Host, config.Port, config.Database, config.SSLMode)
-	log.C(ctx).Info("connecting to postgresql", conStr)
+	log.C(ctx).Infof("connecting to postgresql at %s:%s/%s", config.Host, config.Port, config.Database)
 
 	var persistence *sqlx.DB
 	var err error
 	for i := 0; i < config.RetryCount; i++ {
-		persistence, err = sqlx.Open("postgres", conStr)
+		persistence, err = sqlx.Open(TracedDriverName, conStr)
 		if err != nil {
-			log.C(ctx).Debugf("postgresql connection failed after %d retries for url: %s", config.RetryCount, conStr)
+			log.C(ctx).Debugf("postgresql connection failed after %d retries for %s:%s/%s", config.RetryCount, config.Host, config.Port, config.Database)
 			time.Sleep(config.Duration)
 			continue
 		}
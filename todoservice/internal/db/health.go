@@ -0,0 +1,21 @@
+package db
+
+import (
+	"context"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/health"
+	"github.com/jmoiron/sqlx"
+)
+
+// NewPingChecker reports the database as unavailable when it cannot be pinged.
+func NewPingChecker(database *sqlx.DB) health.Checker {
+	return health.CheckerFunc("database", func(ctx context.Context) error {
+		return database.PingContext(ctx)
+	})
+}
+
+// NewMigrationChecker reports the database as not ready while the schema is dirty or older than expected, see CheckSchema.
+func NewMigrationChecker(database *sqlx.DB, expected int64) health.Checker {
+	return health.CheckerFunc("migrations", func(ctx context.Context) error {
+		return CheckSchema(ctx, database, expected)
+	})
+}
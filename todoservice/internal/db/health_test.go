@@ -0,0 +1,98 @@
+package db_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+)
+
+func TestPingChecker(t *testing.T) {
+	database, mock, err := sqlxmock.Newx(sqlxmock.MonitorPingsOption(true))
+	require.NoError(t, err)
+	defer database.Close()
+
+	mock.ExpectPing().WillReturnError(errors.New("connection refused"))
+
+	checker := db.NewPingChecker(database)
+	assert.Equal(t, "database", checker.Name())
+	assert.EqualError(t, checker.Check(context.Background()), "connection refused")
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+const expectedMigrationVersion = 20241017111456
+
+func TestMigrationChecker(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockDatabase  func(mock sqlxmock.Sqlmock)
+		expectedError string
+	}{
+		{
+			name: "Up to date",
+			mockDatabase: func(mock sqlxmock.Sqlmock) {
+				mock.ExpectQuery("SELECT version, dirty FROM schema_migrations").
+					WillReturnRows(sqlxmock.NewRows([]string{"version", "dirty"}).AddRow(expectedMigrationVersion, false))
+			},
+		},
+		{
+			name: "Behind",
+			mockDatabase: func(mock sqlxmock.Sqlmock) {
+				mock.ExpectQuery("SELECT version, dirty FROM schema_migrations").
+					WillReturnRows(sqlxmock.NewRows([]string{"version", "dirty"}).AddRow(20240819110300, false))
+			},
+			expectedError: "migration version is 20240819110300, expected 20241017111456",
+		},
+		{
+			name: "Ahead",
+			mockDatabase: func(mock sqlxmock.Sqlmock) {
+				mock.ExpectQuery("SELECT version, dirty FROM schema_migrations").
+					WillReturnRows(sqlxmock.NewRows([]string{"version", "dirty"}).AddRow(20250101000000, false))
+			},
+		},
+		{
+			name: "Never migrated",
+			mockDatabase: func(mock sqlxmock.Sqlmock) {
+				mock.ExpectQuery("SELECT version, dirty FROM schema_migrations").
+					WillReturnRows(sqlxmock.NewRows([]string{"version", "dirty"}))
+			},
+			expectedError: "migration version is 0, expected 20241017111456",
+		},
+		{
+			name: "Dirty",
+			mockDatabase: func(mock sqlxmock.Sqlmock) {
+				mock.ExpectQuery("SELECT version, dirty FROM schema_migrations").
+					WillReturnRows(sqlxmock.NewRows([]string{"version", "dirty"}).AddRow(expectedMigrationVersion, true))
+			},
+			expectedError: "migration 20241017111456 is dirty",
+		},
+		{
+			name: "Missing table",
+			mockDatabase: func(mock sqlxmock.Sqlmock) {
+				mock.ExpectQuery("SELECT version, dirty FROM schema_migrations").
+					WillReturnError(errors.New(`relation "schema_migrations" does not exist`))
+			},
+			expectedError: `error reading migration version: relation "schema_migrations" does not exist`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database, mock, err := sqlxmock.Newx()
+			require.NoError(t, err)
+			defer database.Close()
+			tt.mockDatabase(mock)
+
+			err = db.NewMigrationChecker(database, expectedMigrationVersion).Check(context.Background())
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,315 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/jmoiron/sqlx"
+	"io/fs"
+	"regexp"
+	"sort"
+	"strconv"
+)
+
+// migrationLockID is the postgres advisory lock held while migrations run so that replicas
+// starting at the same time do not apply the same migration twice.
+const migrationLockID int64 = 7_261_204_817
+
+// NoVersion is reported when no migration has been applied yet.
+const NoVersion int64 = 0
+
+var migrationFileName = regexp.MustCompile(`^(\d+)_(.+)\.(up|down)\.sql$`)
+
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+type MigrationStatus struct {
+	Version int64
+	Dirty   bool
+	Latest  int64
+	Pending []Migration
+}
+
+// Migrator applies the migrations of a source to the database and records the schema version in
+// schema_migrations, compatible with databases previously migrated by golang-migrate.
+type Migrator struct {
+	database   *sqlx.DB
+	migrations []Migration
+}
+
+func NewMigrator(database *sqlx.DB, source fs.FS) (*Migrator, error) {
+	migrations, err := LoadMigrations(source)
+	if err != nil {
+		return nil, err
+	}
+	if len(migrations) == 0 {
+		return nil, errors.New("no migrations found")
+	}
+	return &Migrator{database: database, migrations: migrations}, nil
+}
+
+// LoadMigrations reads every <version>_<name>.(up|down).sql file at the root of source, ordered by version.
+func LoadMigrations(source fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(source, ".")
+	if err != nil {
+		return nil, fmt.Errorf("error reading migrations: %w", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		match := migrationFileName.FindStringSubmatch(entry.Name())
+		if entry.IsDir() || match == nil {
+			continue
+		}
+		version, err := strconv.ParseInt(match[1], 10, 64)
+		if err != nil || version <= NoVersion {
+			return nil, fmt.Errorf("invalid migration version in %s", entry.Name())
+		}
+		body, err := fs.ReadFile(source, entry.Name())
+		if err != nil {
+			return nil, fmt.Errorf("error reading migration %s: %w", entry.Name(), err)
+		}
+
+		migration, ok := byVersion[version]
+		if !ok {
+			migration = &Migration{Version: version, Name: match[2]}
+			byVersion[version] = migration
+		}
+		if migration.Name != match[2] {
+			return nil, fmt.Errorf("migration %d has conflicting names %s and %s", version, migration.Name, match[2])
+		}
+		if match[3] == "up" {
+			migration.Up = string(body)
+		} else {
+			migration.Down = string(body)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, migration := range byVersion {
+		if migration.Up == "" || migration.Down == "" {
+			return nil, fmt.Errorf("migration %d_%s must have both an up and a down file", migration.Version, migration.Name)
+		}
+		migrations = append(migrations, *migration)
+	}
+	sort.Slice(migrations, func(i, j int) bool {
+		return migrations[i].Version < migrations[j].Version
+	})
+	return migrations, nil
+}
+
+// Latest returns the version of the newest migration, which is the version the service expects the schema to be at.
+func (m *Migrator) Latest() int64 {
+	return m.migrations[len(m.migrations)-1].Version
+}
+
+func (m *Migrator) Status(ctx context.Context) (MigrationStatus, error) {
+	log.C(ctx).Info("reading migration status")
+	var status MigrationStatus
+	err := m.withLock(ctx, func(conn *sqlx.Conn) error {
+		version, dirty, err := readVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		status = MigrationStatus{Version: version, Dirty: dirty, Latest: m.Latest()}
+		for _, migration := range m.migrations {
+			if migration.Version > version {
+				status.Pending = append(status.Pending, migration)
+			}
+		}
+		return nil
+	})
+	return status, err
+}
+
+// Up applies every pending migration.
+func (m *Migrator) Up(ctx context.Context) error {
+	log.C(ctx).Info("applying pending migrations")
+	return m.migrate(ctx, m.Latest())
+}
+
+// Down reverts the last steps applied migrations.
+func (m *Migrator) Down(ctx context.Context, steps int) error {
+	log.C(ctx).Infof("reverting %d migrations", steps)
+	if steps <= 0 {
+		return fmt.Errorf("invalid number of steps %d", steps)
+	}
+	return m.withLock(ctx, func(conn *sqlx.Conn) error {
+		version, err := readCleanVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		index := m.indexOf(version)
+		if version != NoVersion && index < 0 {
+			return fmt.Errorf("schema version %d is not a known migration", version)
+		}
+		target := NoVersion
+		if index-steps >= 0 {
+			target = m.migrations[index-steps].Version
+		}
+		return m.migrateLocked(ctx, conn, version, target)
+	})
+}
+
+// Goto migrates up or down until the schema is at version.
+func (m *Migrator) Goto(ctx context.Context, version int64) error {
+	log.C(ctx).Infof("migrating to version %d", version)
+	if version != NoVersion && m.indexOf(version) < 0 {
+		return fmt.Errorf("migration %d does not exist", version)
+	}
+	return m.migrate(ctx, version)
+}
+
+// CheckSchema returns an error when the schema is dirty or older than expected. A newer schema is accepted
+// so that instances of the previous release keep serving while a rollout migrates the database.
+func CheckSchema(ctx context.Context, database sqlx.QueryerContext, expected int64) error {
+	version, dirty, err := readVersion(ctx, database)
+	if err != nil {
+		return err
+	}
+	if dirty {
+		return fmt.Errorf("migration %d is dirty", version)
+	}
+	if version < expected {
+		return fmt.Errorf("migration version is %d, expected %d", version, expected)
+	}
+	return nil
+}
+
+func (m *Migrator) migrate(ctx context.Context, target int64) error {
+	return m.withLock(ctx, func(conn *sqlx.Conn) error {
+		version, err := readCleanVersion(ctx, conn)
+		if err != nil {
+			return err
+		}
+		return m.migrateLocked(ctx, conn, version, target)
+	})
+}
+
+func (m *Migrator) migrateLocked(ctx context.Context, conn *sqlx.Conn, version, target int64) error {
+	if version == target {
+		log.C(ctx).Infof("schema is already at version %d", version)
+		return nil
+	}
+
+	for _, migration := range m.migrations {
+		if target > version && migration.Version > version && migration.Version <= target {
+			if err := apply(ctx, conn, migration.Version, migration.Up); err != nil {
+				return fmt.Errorf("error applying migration %d_%s: %w", migration.Version, migration.Name, err)
+			}
+			log.C(ctx).Infof("applied migration %d_%s", migration.Version, migration.Name)
+		}
+	}
+	for i := len(m.migrations) - 1; i >= 0 && target < version; i-- {
+		migration := m.migrations[i]
+		if migration.Version > version || migration.Version <= target {
+			continue
+		}
+		previous := NoVersion
+		if i > 0 {
+			previous = m.migrations[i-1].Version
+		}
+		if err := apply(ctx, conn, previous, migration.Down); err != nil {
+			return fmt.Errorf("error reverting migration %d_%s: %w", migration.Version, migration.Name, err)
+		}
+		log.C(ctx).Infof("reverted migration %d_%s", migration.Version, migration.Name)
+	}
+	return nil
+}
+
+func (m *Migrator) indexOf(version int64) int {
+	for i, migration := range m.migrations {
+		if migration.Version == version {
+			return i
+		}
+	}
+	return -1
+}
+
+// withLock runs fn on a single connection holding the migration advisory lock.
+func (m *Migrator) withLock(ctx context.Context, fn func(conn *sqlx.Conn) error) error {
+	conn, err := m.database.Connx(ctx)
+	if err != nil {
+		return fmt.Errorf("error acquiring connection: %w", err)
+	}
+	defer conn.Close()
+
+	if _, err = conn.ExecContext(ctx, `SELECT pg_advisory_lock($1)`, migrationLockID); err != nil {
+		return fmt.Errorf("error acquiring migration lock: %w", err)
+	}
+	defer func() {
+		if _, err := conn.ExecContext(context.WithoutCancel(ctx), `SELECT pg_advisory_unlock($1)`, migrationLockID); err != nil {
+			log.C(ctx).Errorf("error releasing migration lock: %v", err)
+		}
+	}()
+
+	if _, err = conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS schema_migrations (version BIGINT NOT NULL PRIMARY KEY, dirty BOOLEAN NOT NULL)`); err != nil {
+		return fmt.Errorf("error creating schema_migrations: %w", err)
+	}
+	return fn(conn)
+}
+
+// apply marks the schema dirty at version, runs body and marks it clean again, the same way golang-migrate does,
+// so a migration that fails half way is visible to operators and blocks further migrations.
+func apply(ctx context.Context, conn *sqlx.Conn, version int64, body string) error {
+	if err := setVersion(ctx, conn, version, true); err != nil {
+		return err
+	}
+	if _, err := conn.ExecContext(ctx, body); err != nil {
+		return err
+	}
+	return setVersion(ctx, conn, version, false)
+}
+
+func setVersion(ctx context.Context, conn *sqlx.Conn, version int64, dirty bool) error {
+	tx, err := conn.BeginTxx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM schema_migrations`); err != nil {
+		return fmt.Errorf("error clearing schema version: %w", err)
+	}
+	if version != NoVersion || dirty {
+		if _, err = tx.ExecContext(ctx, `INSERT INTO schema_migrations (version, dirty) VALUES ($1, $2)`, version, dirty); err != nil {
+			return fmt.Errorf("error recording schema version: %w", err)
+		}
+	}
+	if err = tx.Commit(); err != nil {
+		return fmt.Errorf("error committing schema version: %w", err)
+	}
+	return nil
+}
+
+func readVersion(ctx context.Context, database sqlx.QueryerContext) (int64, bool, error) {
+	var migration struct {
+		Version int64 `db:"version"`
+		Dirty   bool  `db:"dirty"`
+	}
+	err := sqlx.GetContext(ctx, database, &migration, `SELECT version, dirty FROM schema_migrations LIMIT 1`)
+	if errors.Is(err, sql.ErrNoRows) {
+		return NoVersion, false, nil
+	}
+	if err != nil {
+		return NoVersion, false, fmt.Errorf("error reading migration version: %w", err)
+	}
+	return migration.Version, migration.Dirty, nil
+}
+
+func readCleanVersion(ctx context.Context, conn *sqlx.Conn) (int64, error) {
+	version, dirty, err := readVersion(ctx, conn)
+	if err != nil {
+		return NoVersion, err
+	}
+	if dirty {
+		return NoVersion, fmt.Errorf("migration %d is dirty, fix the schema and reset schema_migrations by hand", version)
+	}
+	return version, nil
+}
@@ -0,0 +1,205 @@
+package db_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/migrations"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"regexp"
+	"testing"
+	"testing/fstest"
+)
+
+var testMigrations = fstest.MapFS{
+	"1_first.up.sql":    {Data: []byte("CREATE TABLE first ()")},
+	"1_first.down.sql":  {Data: []byte("DROP TABLE first")},
+	"2_second.up.sql":   {Data: []byte("CREATE TABLE second ()")},
+	"2_second.down.sql": {Data: []byte("DROP TABLE second")},
+	"README.md":         {Data: []byte("not a migration")},
+}
+
+func TestLoadMigrations(t *testing.T) {
+	loaded, err := db.LoadMigrations(testMigrations)
+	require.NoError(t, err)
+	assert.Equal(t, []db.Migration{
+		{Version: 1, Name: "first", Up: "CREATE TABLE first ()", Down: "DROP TABLE first"},
+		{Version: 2, Name: "second", Up: "CREATE TABLE second ()", Down: "DROP TABLE second"},
+	}, loaded)
+
+	_, err = db.LoadMigrations(fstest.MapFS{"1_first.up.sql": {Data: []byte("CREATE TABLE first ()")}})
+	assert.EqualError(t, err, "migration 1_first must have both an up and a down file")
+}
+
+func TestEmbeddedMigrations(t *testing.T) {
+	migrator, err := db.NewMigrator(nil, migrations.FS)
+	require.NoError(t, err)
+	assert.GreaterOrEqual(t, migrator.Latest(), int64(expectedMigrationVersion))
+}
+
+func expectLock(mock sqlxmock.Sqlmock) {
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_advisory_lock($1)`)).WillReturnResult(sqlxmock.NewResult(0, 0))
+	mock.ExpectExec("CREATE TABLE IF NOT EXISTS schema_migrations").WillReturnResult(sqlxmock.NewResult(0, 0))
+}
+
+func expectUnlock(mock sqlxmock.Sqlmock) {
+	mock.ExpectExec(regexp.QuoteMeta(`SELECT pg_advisory_unlock($1)`)).WillReturnResult(sqlxmock.NewResult(0, 0))
+}
+
+func expectVersion(mock sqlxmock.Sqlmock, version int64, dirty bool) {
+	rows := sqlxmock.NewRows([]string{"version", "dirty"})
+	if version != db.NoVersion {
+		rows.AddRow(version, dirty)
+	}
+	mock.ExpectQuery("SELECT version, dirty FROM schema_migrations").WillReturnRows(rows)
+}
+
+func expectSetVersion(mock sqlxmock.Sqlmock, version int64, dirty bool) {
+	mock.ExpectBegin()
+	mock.ExpectExec("DELETE FROM schema_migrations").WillReturnResult(sqlxmock.NewResult(0, 1))
+	if version != db.NoVersion || dirty {
+		mock.ExpectExec("INSERT INTO schema_migrations").WithArgs(version, dirty).WillReturnResult(sqlxmock.NewResult(0, 1))
+	}
+	mock.ExpectCommit()
+}
+
+func expectApply(mock sqlxmock.Sqlmock, body string, version int64) {
+	expectSetVersion(mock, version, true)
+	mock.ExpectExec(regexp.QuoteMeta(body)).WillReturnResult(sqlxmock.NewResult(0, 0))
+	expectSetVersion(mock, version, false)
+}
+
+func TestMigrator(t *testing.T) {
+	tests := []struct {
+		name          string
+		migrate       func(ctx context.Context, migrator *db.Migrator) error
+		mockDatabase  func(mock sqlxmock.Sqlmock)
+		expectedError string
+	}{
+		{
+			name: "Up from an empty database",
+			migrate: func(ctx context.Context, migrator *db.Migrator) error {
+				return migrator.Up(ctx)
+			},
+			mockDatabase: func(mock sqlxmock.Sqlmock) {
+				expectLock(mock)
+				expectVersion(mock, db.NoVersion, false)
+				expectApply(mock, "CREATE TABLE first ()", 1)
+				expectApply(mock, "CREATE TABLE second ()", 2)
+				expectUnlock(mock)
+			},
+		},
+		{
+			name: "Up when up to date",
+			migrate: func(ctx context.Context, migrator *db.Migrator) error {
+				return migrator.Up(ctx)
+			},
+			mockDatabase: func(mock sqlxmock.Sqlmock) {
+				expectLock(mock)
+				expectVersion(mock, 2, false)
+				expectUnlock(mock)
+			},
+		},
+		{
+			name: "Down one step",
+			migrate: func(ctx context.Context, migrator *db.Migrator) error {
+				return migrator.Down(ctx, 1)
+			},
+			mockDatabase: func(mock sqlxmock.Sqlmock) {
+				expectLock(mock)
+				expectVersion(mock, 2, false)
+				expectApply(mock, "DROP TABLE second", 1)
+				expectUnlock(mock)
+			},
+		},
+		{
+			name: "Goto the empty schema",
+			migrate: func(ctx context.Context, migrator *db.Migrator) error {
+				return migrator.Goto(ctx, db.NoVersion)
+			},
+			mockDatabase: func(mock sqlxmock.Sqlmock) {
+				expectLock(mock)
+				expectVersion(mock, 2, false)
+				expectApply(mock, "DROP TABLE second", 1)
+				expectApply(mock, "DROP TABLE first", db.NoVersion)
+				expectUnlock(mock)
+			},
+		},
+		{
+			name: "Goto an unknown version",
+			migrate: func(ctx context.Context, migrator *db.Migrator) error {
+				return migrator.Goto(ctx, 3)
+			},
+			mockDatabase:  func(mock sqlxmock.Sqlmock) {},
+			expectedError: "migration 3 does not exist",
+		},
+		{
+			name: "Dirty schema",
+			migrate: func(ctx context.Context, migrator *db.Migrator) error {
+				return migrator.Up(ctx)
+			},
+			mockDatabase: func(mock sqlxmock.Sqlmock) {
+				expectLock(mock)
+				expectVersion(mock, 1, true)
+				expectUnlock(mock)
+			},
+			expectedError: "migration 1 is dirty, fix the schema and reset schema_migrations by hand",
+		},
+		{
+			name: "Failing migration",
+			migrate: func(ctx context.Context, migrator *db.Migrator) error {
+				return migrator.Up(ctx)
+			},
+			mockDatabase: func(mock sqlxmock.Sqlmock) {
+				expectLock(mock)
+				expectVersion(mock, 1, false)
+				expectSetVersion(mock, 2, true)
+				mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE second ()")).WillReturnError(errors.New("syntax error"))
+				expectUnlock(mock)
+			},
+			expectedError: "error applying migration 2_second: syntax error",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database, mock, err := sqlxmock.Newx()
+			require.NoError(t, err)
+			defer database.Close()
+			tt.mockDatabase(mock)
+
+			migrator, err := db.NewMigrator(database, testMigrations)
+			require.NoError(t, err)
+
+			err = tt.migrate(context.Background(), migrator)
+			if tt.expectedError != "" {
+				assert.EqualError(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestMigratorStatus(t *testing.T) {
+	database, mock, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	defer database.Close()
+	expectLock(mock)
+	expectVersion(mock, 1, false)
+	expectUnlock(mock)
+
+	migrator, err := db.NewMigrator(database, testMigrations)
+	require.NoError(t, err)
+
+	status, err := migrator.Status(context.Background())
+	require.NoError(t, err)
+	assert.Equal(t, int64(1), status.Version)
+	assert.Equal(t, int64(2), status.Latest)
+	require.Len(t, status.Pending, 1)
+	assert.Equal(t, int64(2), status.Pending[0].Version)
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,138 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"math/rand/v2"
+	"time"
+)
+
+const (
+	SQLStateSerializationFailure = "40001"
+	SQLStateDeadlockDetected     = "40P01"
+)
+
+var (
+	transactionRetries = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "todoservice_db_transaction_retries_total",
+		Help: "Number of transactions re-run after a retryable failure, by SQLSTATE.",
+	}, []string{"sqlstate"})
+
+	transactionRetriesExhausted = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "todoservice_db_transaction_retries_exhausted_total",
+		Help: "Number of transactions that still failed after APP_DB_TX_MAX_ATTEMPTS attempts, by SQLSTATE.",
+	}, []string{"sqlstate"})
+)
+
+type attemptKey struct{}
+
+// attempt remembers a retryable failure seen by the driver while a unit of work runs,
+// because callers may swallow or replace the error before it reaches Transact.
+type attempt struct {
+	failure error
+	hooks   []func(committed bool)
+}
+
+// AfterTransaction registers fn to run once the transaction in ctx has ended and released its connection,
+// with committed reporting whether it was committed. Without a transaction started by Transact in ctx,
+// fn runs right away as if the transaction had committed.
+func AfterTransaction(ctx context.Context, fn func(committed bool)) {
+	a, ok := ctx.Value(attemptKey{}).(*attempt)
+	if !ok {
+		fn(true)
+		return
+	}
+	a.hooks = append(a.hooks, fn)
+}
+
+// recordFailure is called by the driver for every failed statement of a transaction started by Transact.
+func recordFailure(ctx context.Context, err error) {
+	if _, ok := RetryableSQLState(err); !ok {
+		return
+	}
+	if a, ok := ctx.Value(attemptKey{}).(*attempt); ok && a.failure == nil {
+		a.failure = err
+	}
+}
+
+// RetryableSQLState reports whether err is a postgres serialization failure or deadlock, which are safe to
+// retry because the database has already rolled the transaction back.
+func RetryableSQLState(err error) (string, bool) {
+	var pqErr *pq.Error
+	if !errors.As(err, &pqErr) {
+		return "", false
+	}
+	code := string(pqErr.Code)
+	return code, code == SQLStateSerializationFailure || code == SQLStateDeadlockDetected
+}
+
+// Transact runs fn in a transaction stored in its context and commits when fn succeeds. When the transaction
+// fails with a retryable SQLSTATE the whole unit of work is run again after a jittered backoff,
+// at most config.MaxAttempts times. fn must therefore not have side effects outside the transaction.
+func Transact(ctx context.Context, database *sqlx.DB, opts *sql.TxOptions, config TransactionConfig, fn func(ctx context.Context) error) error {
+	for n := 1; ; n++ {
+		err := transactOnce(ctx, database, opts, fn)
+		sqlState, retryable := RetryableSQLState(err)
+		if !retryable {
+			return err
+		}
+		if n >= config.MaxAttempts {
+			transactionRetriesExhausted.WithLabelValues(sqlState).Inc()
+			return err
+		}
+
+		delay := backoff(config, n-1)
+		log.C(ctx).Warnf("retrying transaction in %s after attempt %d failed with SQLSTATE %s", delay, n, sqlState)
+		transactionRetries.WithLabelValues(sqlState).Inc()
+		select {
+		case <-time.After(delay):
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+}
+
+func transactOnce(ctx context.Context, database *sqlx.DB, opts *sql.TxOptions, fn func(ctx context.Context) error) error {
+	a := &attempt{}
+	ctx = context.WithValue(ctx, attemptKey{}, a)
+	tx, err := database.BeginTxx(ctx, opts)
+	if err != nil {
+		return fmt.Errorf("error beginning transaction: %w", err)
+	}
+	defer tx.Rollback()
+
+	err = fn(SaveToContext(ctx, tx))
+	if a.failure != nil {
+		err = fmt.Errorf("transaction aborted: %w", a.failure)
+	}
+	if err == nil {
+		if err = tx.Commit(); err != nil {
+			err = fmt.Errorf("error committing transaction: %w", err)
+		}
+	} else {
+		_ = tx.Rollback()
+	}
+	for _, hook := range a.hooks {
+		hook(err == nil)
+	}
+	return err
+}
+
+// backoff returns a random delay up to RetryBaseDelay * 2^retry, capped at RetryMaxDelay.
+func backoff(config TransactionConfig, retry int) time.Duration {
+	ceiling := config.RetryBaseDelay << retry
+	if ceiling <= 0 || ceiling > config.RetryMaxDelay {
+		ceiling = config.RetryMaxDelay
+	}
+	if ceiling <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int64N(int64(ceiling))) + 1
+}
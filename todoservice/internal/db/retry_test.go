@@ -0,0 +1,243 @@
+package db_test
+
+import (
+	"context"
+	"database/sql/driver"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+const fakeDriverName = "fake-traced"
+
+// fakeDriver fails the first failures statements with failWith, every other statement succeeds.
+type fakeDriver struct {
+	failures  atomic.Int32
+	failWith  error
+	commits   atomic.Int32
+	rollbacks atomic.Int32
+}
+
+var fake = &fakeDriver{}
+
+func init() {
+	db.RegisterTracedDriver(fakeDriverName, fake)
+}
+
+func (d *fakeDriver) reset(failures int32, failWith error) {
+	d.failures.Store(failures)
+	d.failWith = failWith
+	d.commits.Store(0)
+	d.rollbacks.Store(0)
+}
+
+func (d *fakeDriver) Open(string) (driver.Conn, error) {
+	return &fakeConn{driver: d}, nil
+}
+
+type fakeConn struct {
+	driver *fakeDriver
+}
+
+func (c *fakeConn) Prepare(string) (driver.Stmt, error) {
+	return nil, errors.New("prepare is not supported")
+}
+
+func (c *fakeConn) Close() error {
+	return nil
+}
+
+func (c *fakeConn) Begin() (driver.Tx, error) {
+	return &fakeTx{driver: c.driver}, nil
+}
+
+func (c *fakeConn) BeginTx(context.Context, driver.TxOptions) (driver.Tx, error) {
+	return &fakeTx{driver: c.driver}, nil
+}
+
+func (c *fakeConn) ExecContext(context.Context, string, []driver.NamedValue) (driver.Result, error) {
+	if c.driver.failures.Add(-1) >= 0 {
+		return nil, c.driver.failWith
+	}
+	return driver.RowsAffected(1), nil
+}
+
+type fakeTx struct {
+	driver *fakeDriver
+}
+
+func (t *fakeTx) Commit() error {
+	t.driver.commits.Add(1)
+	return nil
+}
+
+func (t *fakeTx) Rollback() error {
+	t.driver.rollbacks.Add(1)
+	return nil
+}
+
+func update(ctx context.Context) error {
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "UPDATE todos SET title = $1 WHERE id = $2", "title", "1")
+	return err
+}
+
+func TestTransact(t *testing.T) {
+	config := db.TransactionConfig{MaxAttempts: 3, RetryBaseDelay: time.Millisecond, RetryMaxDelay: time.Millisecond}
+	serializationFailure := &pq.Error{Code: db.SQLStateSerializationFailure}
+
+	tests := []struct {
+		name              string
+		failures          int32
+		failWith          error
+		fn                func(ctx context.Context) error
+		expectedError     error
+		expectedAttempts  int32
+		expectedCommits   int32
+		expectedRollbacks int32
+	}{
+		{
+			name:             "Success",
+			fn:               update,
+			expectedAttempts: 1,
+			expectedCommits:  1,
+		},
+		{
+			name:              "Retry on serialization failure",
+			failures:          2,
+			failWith:          serializationFailure,
+			fn:                update,
+			expectedAttempts:  3,
+			expectedCommits:   1,
+			expectedRollbacks: 2,
+		},
+		{
+			name:     "Retry when the caller replaces the error",
+			failures: 1,
+			failWith: &pq.Error{Code: db.SQLStateDeadlockDetected},
+			fn: func(ctx context.Context) error {
+				if err := update(ctx); err != nil {
+					return errors.New("todo not found")
+				}
+				return nil
+			},
+			expectedAttempts:  2,
+			expectedCommits:   1,
+			expectedRollbacks: 1,
+		},
+		{
+			name:              "Error when retries are exhausted",
+			failures:          3,
+			failWith:          serializationFailure,
+			fn:                update,
+			expectedError:     serializationFailure,
+			expectedAttempts:  3,
+			expectedRollbacks: 3,
+		},
+		{
+			name:              "No retry on other errors",
+			failures:          1,
+			failWith:          &pq.Error{Code: "23505"},
+			fn:                update,
+			expectedError:     &pq.Error{Code: "23505"},
+			expectedAttempts:  1,
+			expectedRollbacks: 1,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fake.reset(tt.failures, tt.failWith)
+			database, err := sqlx.Open(fakeDriverName, "")
+			require.NoError(t, err)
+			defer database.Close()
+
+			var attempts int32
+			err = db.Transact(context.Background(), database, nil, config, func(ctx context.Context) error {
+				attempts++
+				return tt.fn(ctx)
+			})
+
+			if tt.expectedError != nil {
+				var pqErr *pq.Error
+				require.ErrorAs(t, err, &pqErr)
+				assert.Equal(t, tt.expectedError.(*pq.Error).Code, pqErr.Code)
+			} else {
+				require.NoError(t, err)
+			}
+			assert.Equal(t, tt.expectedAttempts, attempts)
+			assert.Equal(t, tt.expectedCommits, fake.commits.Load())
+			assert.Equal(t, tt.expectedRollbacks, fake.rollbacks.Load())
+		})
+	}
+
+	expected := `
+# HELP todoservice_db_transaction_retries_exhausted_total Number of transactions that still failed after APP_DB_TX_MAX_ATTEMPTS attempts, by SQLSTATE.
+# TYPE todoservice_db_transaction_retries_exhausted_total counter
+todoservice_db_transaction_retries_exhausted_total{sqlstate="40001"} 1
+`
+	assert.NoError(t, testutil.GatherAndCompare(prometheus.DefaultGatherer, strings.NewReader(expected), "todoservice_db_transaction_retries_exhausted_total"))
+}
+
+func TestAfterTransaction(t *testing.T) {
+	fake.reset(1, &pq.Error{Code: db.SQLStateSerializationFailure})
+	database, err := sqlx.Open(fakeDriverName, "")
+	require.NoError(t, err)
+	defer database.Close()
+
+	type outcome struct {
+		committed bool
+		commits   int32
+		rollbacks int32
+	}
+	var outcomes []outcome
+	config := db.TransactionConfig{MaxAttempts: 3, RetryBaseDelay: time.Millisecond, RetryMaxDelay: time.Millisecond}
+	err = db.Transact(context.Background(), database, nil, config, func(ctx context.Context) error {
+		db.AfterTransaction(ctx, func(committed bool) {
+			outcomes = append(outcomes, outcome{committed: committed, commits: fake.commits.Load(), rollbacks: fake.rollbacks.Load()})
+		})
+		return update(ctx)
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, []outcome{
+		{committed: false, commits: 0, rollbacks: 1},
+		{committed: true, commits: 1, rollbacks: 1},
+	}, outcomes)
+}
+
+func TestAfterTransaction_WithoutTransaction(t *testing.T) {
+	ran := false
+	db.AfterTransaction(context.Background(), func(committed bool) {
+		ran = committed
+	})
+	assert.True(t, ran)
+}
+
+func TestTransact_ContextCancelled(t *testing.T) {
+	fake.reset(1, &pq.Error{Code: db.SQLStateSerializationFailure})
+	database, err := sqlx.Open(fakeDriverName, "")
+	require.NoError(t, err)
+	defer database.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	config := db.TransactionConfig{MaxAttempts: 3, RetryBaseDelay: time.Hour, RetryMaxDelay: time.Hour}
+	err = db.Transact(ctx, database, nil, config, func(ctx context.Context) error {
+		cancel()
+		return update(ctx)
+	})
+
+	assert.ErrorIs(t, err, context.Canceled)
+}
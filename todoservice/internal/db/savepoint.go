@@ -0,0 +1,34 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+)
+
+// WithSavepoint runs fn inside the savepoint name of the transaction stored in ctx. When fn fails its changes
+// are rolled back to the savepoint, so the transaction stays usable for the statements that follow.
+// name is used as an SQL identifier and must not come from user input.
+func WithSavepoint(ctx context.Context, name string, fn func(ctx context.Context) error) error {
+	tx, err := FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+	if _, err = tx.ExecContext(ctx, "SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("failed to create savepoint %s: %w", name, err)
+	}
+
+	if err = fn(ctx); err != nil {
+		if _, rollbackErr := tx.ExecContext(ctx, "ROLLBACK TO SAVEPOINT "+name); rollbackErr != nil {
+			return errors.Join(err, fmt.Errorf("failed to roll back to savepoint %s: %w", name, rollbackErr))
+		}
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, "RELEASE SAVEPOINT "+name); err != nil {
+		return fmt.Errorf("failed to release savepoint %s: %w", name, err)
+	}
+	return nil
+}
@@ -0,0 +1,64 @@
+package db_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+)
+
+func TestWithSavepoint(t *testing.T) {
+	failure := errors.New("operation failed")
+
+	testCases := []struct {
+		name          string
+		fn            func(ctx context.Context) error
+		setupMocks    func(mockDB sqlxmock.Sqlmock)
+		expectedError error
+	}{
+		{
+			name: "Release the savepoint when fn succeeds",
+			fn:   func(ctx context.Context) error { return nil },
+			setupMocks: func(mockDB sqlxmock.Sqlmock) {
+				mockDB.ExpectExec(`^SAVEPOINT item$`).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectExec(`^RELEASE SAVEPOINT item$`).WillReturnResult(sqlxmock.NewResult(0, 0))
+			},
+		},
+		{
+			name: "Roll back to the savepoint when fn fails",
+			fn:   func(ctx context.Context) error { return failure },
+			setupMocks: func(mockDB sqlxmock.Sqlmock) {
+				mockDB.ExpectExec(`^SAVEPOINT item$`).WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectExec(`^ROLLBACK TO SAVEPOINT item$`).WillReturnResult(sqlxmock.NewResult(0, 0))
+			},
+			expectedError: failure,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			database, mockDB, err := sqlxmock.Newx()
+			require.NoError(t, err)
+			mockDB.ExpectBegin()
+			tc.setupMocks(mockDB)
+			mockDB.ExpectRollback()
+
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			err = db.WithSavepoint(db.SaveToContext(ctx, tx), "item", tc.fn)
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+			} else {
+				require.NoError(t, err)
+			}
+
+			require.NoError(t, tx.Rollback())
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,144 @@
+package db
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/tracing"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"go.opentelemetry.io/otel/attribute"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// TracedDriverName is the postgres driver that opens a span for every query and transaction.
+const TracedDriverName = "postgres-traced"
+
+func init() {
+	RegisterTracedDriver(TracedDriverName, &pq.Driver{})
+}
+
+// RegisterTracedDriver registers parent under name, wrapped so that queries and transactions are traced
+// and retryable failures are reported to Transact.
+func RegisterTracedDriver(name string, parent driver.Driver) {
+	sql.Register(name, &tracedDriver{parent: parent})
+	sqlx.BindDriver(name, sqlx.DOLLAR)
+}
+
+type tracedDriver struct {
+	parent driver.Driver
+}
+
+func (d *tracedDriver) Open(name string) (driver.Conn, error) {
+	conn, err := d.parent.Open(name)
+	if err != nil {
+		return nil, err
+	}
+	return &tracedConn{Conn: conn}, nil
+}
+
+type tracedConn struct {
+	driver.Conn
+}
+
+var (
+	_ driver.ConnBeginTx        = &tracedConn{}
+	_ driver.ConnPrepareContext = &tracedConn{}
+	_ driver.QueryerContext     = &tracedConn{}
+	_ driver.ExecerContext      = &tracedConn{}
+	_ driver.Pinger             = &tracedConn{}
+	_ driver.SessionResetter    = &tracedConn{}
+	_ driver.Validator          = &tracedConn{}
+)
+
+func (c *tracedConn) BeginTx(ctx context.Context, opts driver.TxOptions) (driver.Tx, error) {
+	ctx, span := tracing.Start(ctx, "db.transaction", semconv.DBSystemPostgreSQL)
+	beginner, ok := c.Conn.(driver.ConnBeginTx)
+	if !ok {
+		tracing.End(span, driver.ErrSkip)
+		return nil, driver.ErrSkip
+	}
+	tx, err := beginner.BeginTx(ctx, opts)
+	if err != nil {
+		tracing.End(span, err)
+		return nil, err
+	}
+	return &tracedTx{Tx: tx, span: span}, nil
+}
+
+func (c *tracedConn) PrepareContext(ctx context.Context, query string) (driver.Stmt, error) {
+	if preparer, ok := c.Conn.(driver.ConnPrepareContext); ok {
+		return preparer.PrepareContext(ctx, query)
+	}
+	return c.Conn.Prepare(query)
+}
+
+func (c *tracedConn) QueryContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Rows, error) {
+	queryer, ok := c.Conn.(driver.QueryerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	ctx, span := startQuery(ctx, query)
+	rows, err := queryer.QueryContext(ctx, query, args)
+	tracing.End(span, err)
+	recordFailure(ctx, err)
+	return rows, err
+}
+
+func (c *tracedConn) ExecContext(ctx context.Context, query string, args []driver.NamedValue) (driver.Result, error) {
+	execer, ok := c.Conn.(driver.ExecerContext)
+	if !ok {
+		return nil, driver.ErrSkip
+	}
+	ctx, span := startQuery(ctx, query)
+	result, err := execer.ExecContext(ctx, query, args)
+	tracing.End(span, err)
+	recordFailure(ctx, err)
+	return result, err
+}
+
+func (c *tracedConn) Ping(ctx context.Context) error {
+	if pinger, ok := c.Conn.(driver.Pinger); ok {
+		return pinger.Ping(ctx)
+	}
+	return nil
+}
+
+func (c *tracedConn) ResetSession(ctx context.Context) error {
+	if resetter, ok := c.Conn.(driver.SessionResetter); ok {
+		return resetter.ResetSession(ctx)
+	}
+	return nil
+}
+
+func (c *tracedConn) IsValid() bool {
+	if validator, ok := c.Conn.(driver.Validator); ok {
+		return validator.IsValid()
+	}
+	return true
+}
+
+type tracedTx struct {
+	driver.Tx
+	span trace.Span
+}
+
+func (t *tracedTx) Commit() error {
+	err := t.Tx.Commit()
+	t.span.SetAttributes(attribute.String("db.transaction.outcome", "commit"))
+	tracing.End(t.span, err)
+	return err
+}
+
+func (t *tracedTx) Rollback() error {
+	err := t.Tx.Rollback()
+	t.span.SetAttributes(attribute.String("db.transaction.outcome", "rollback"))
+	tracing.End(t.span, err)
+	return err
+}
+
+// startQuery names query spans after the SQL statement; argument values are never recorded.
+func startQuery(ctx context.Context, query string) (context.Context, trace.Span) {
+	return tracing.Start(ctx, "db.query", semconv.DBSystemPostgreSQL, semconv.DBQueryText(query))
+}
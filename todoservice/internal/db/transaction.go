@@ -0,0 +1,47 @@
+package db
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+var isolationLevels = map[string]sql.IsolationLevel{
+	"":                 sql.LevelDefault,
+	"default":          sql.LevelDefault,
+	"read uncommitted": sql.LevelReadUncommitted,
+	"read committed":   sql.LevelReadCommitted,
+	"repeatable read":  sql.LevelRepeatableRead,
+	"serializable":     sql.LevelSerializable,
+}
+
+// TransactionConfig controls the transactions opened by Transact.
+type TransactionConfig struct {
+	// IsolationLevel is the SQL name of the isolation level, see ParseIsolationLevel.
+	IsolationLevel string `envconfig:"APP_DB_ISOLATION_LEVEL" default:"read committed"`
+	// MaxAttempts caps how often a unit of work is run when it fails with a serialization failure or a deadlock.
+	MaxAttempts    int           `envconfig:"APP_DB_TX_MAX_ATTEMPTS" default:"3"`
+	RetryBaseDelay time.Duration `envconfig:"APP_DB_TX_RETRY_BASE_DELAY" default:"20ms"`
+	RetryMaxDelay  time.Duration `envconfig:"APP_DB_TX_RETRY_MAX_DELAY" default:"500ms"`
+}
+
+func (c TransactionConfig) Validate() error {
+	if _, err := ParseIsolationLevel(c.IsolationLevel); err != nil {
+		return err
+	}
+	if c.MaxAttempts < 1 {
+		return errors.New("APP_DB_TX_MAX_ATTEMPTS must be at least 1")
+	}
+	return nil
+}
+
+// ParseIsolationLevel maps the SQL name of an isolation level, e.g. "repeatable read", to sql.IsolationLevel.
+func ParseIsolationLevel(level string) (sql.IsolationLevel, error) {
+	isolation, ok := isolationLevels[strings.ToLower(strings.TrimSpace(level))]
+	if !ok {
+		return sql.LevelDefault, fmt.Errorf("unsupported isolation level %q", level)
+	}
+	return isolation, nil
+}
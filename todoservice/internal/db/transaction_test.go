@@ -0,0 +1,22 @@
+package db_test
+
+import (
+	"database/sql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestParseIsolationLevel(t *testing.T) {
+	isolation, err := db.ParseIsolationLevel("Repeatable Read")
+	require.NoError(t, err)
+	assert.Equal(t, sql.LevelRepeatableRead, isolation)
+
+	isolation, err = db.ParseIsolationLevel("")
+	require.NoError(t, err)
+	assert.Equal(t, sql.LevelDefault, isolation)
+
+	_, err = db.ParseIsolationLevel("snapshot")
+	assert.Error(t, err)
+}
@@ -0,0 +1,163 @@
+package attachment
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/attachments"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/problem"
+	"github.com/gorilla/mux"
+	"io"
+	"mime"
+	"net/http"
+	"strconv"
+)
+
+const (
+	// formField is the name of the multipart form field carrying the file.
+	formField = "file"
+	// multipartOverhead leaves room for the boundaries and headers around the file in the request body.
+	multipartOverhead = 1 << 20
+)
+
+type Handler struct {
+	service attachments.AttachmentService
+	maxSize int64
+}
+
+func NewHandler(service attachments.AttachmentService, maxSize int64) *Handler {
+	return &Handler{service: service, maxSize: maxSize}
+}
+
+// UploadAttachment streams the "file" part of a multipart/form-data request to the attachment service.
+// The content type declared by the client is ignored, the service detects it from the content.
+func (h *Handler) UploadAttachment(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("upload attachment handler")
+	claim, ok := jwt.ClaimsFromContext(r.Context())
+	if !ok {
+		log.C(r.Context()).Error("there is no user claim in the context")
+		problem.Write(w, r, http.StatusUnauthorized, "there is no user claim in the context")
+		return
+	}
+
+	r.Body = http.MaxBytesReader(w, r.Body, h.maxSize+multipartOverhead)
+	reader, err := r.MultipartReader()
+	if err != nil {
+		log.C(r.Context()).Errorf("error while uploading attachment handler: %v", err)
+		problem.Write(w, r, http.StatusUnsupportedMediaType, "expected a multipart/form-data request")
+		return
+	}
+	var part io.ReadCloser
+	var filename string
+	for {
+		p, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			log.C(r.Context()).Errorf("error while uploading attachment handler: %v", err)
+			problem.Write(w, r, statusForBodyError(err, http.StatusBadRequest), "Invalid request payload")
+			return
+		}
+		if p.FormName() == formField {
+			part, filename = p, p.FileName()
+			break
+		}
+	}
+	if part == nil {
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload", problem.FieldError{Field: formField, Message: "is required"})
+		return
+	}
+	defer part.Close()
+
+	attachment := models.Attachment{
+		TodoID:     mux.Vars(r)["id"],
+		UploaderID: claim.ID,
+		Filename:   filename,
+	}
+	createdAttachment, err := h.service.Upload(r.Context(), attachment, part)
+	log.C(r.Context()).Debugf("upload attachment handler with attachment: %v", createdAttachment)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while uploading attachment handler: %v", err)
+		problem.Write(w, r, statusForBodyError(err, problem.StatusFor(err, http.StatusInternalServerError)), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(createdAttachment); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+func (h *Handler) ListAttachments(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list attachments handler")
+	todoAttachments, err := h.service.ListAttachments(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing attachments handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(todoAttachments); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+// DownloadAttachment streams the content of an attachment, always as a download so that browsers do not render it.
+func (h *Handler) DownloadAttachment(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("download attachment handler")
+	vars := mux.Vars(r)
+	attachment, content, err := h.service.OpenAttachment(r.Context(), vars["id"], vars["attachment_id"])
+	if err != nil {
+		log.C(r.Context()).Errorf("error while downloading attachment handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", attachment.ContentType)
+	w.Header().Set("Content-Length", strconv.FormatInt(attachment.Size, 10))
+	w.Header().Set("Content-Disposition", mime.FormatMediaType("attachment", map[string]string{"filename": attachment.Filename}))
+	w.Header().Set("Cache-Control", "private")
+	w.WriteHeader(http.StatusOK)
+	// The content is only streamed once the request transaction has ended, so that slow clients
+	// do not hold a database connection for the whole download.
+	db.AfterTransaction(r.Context(), func(bool) {
+		defer content.Close()
+		if r.Method == http.MethodHead {
+			return
+		}
+		if _, err := io.Copy(w, content); err != nil {
+			log.C(r.Context()).Errorf("error while streaming attachment %s: %v", attachment.ID, err)
+		}
+	})
+}
+
+func (h *Handler) DeleteAttachment(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("delete attachment handler")
+	vars := mux.Vars(r)
+	if err := h.service.DeleteAttachment(r.Context(), vars["id"], vars["attachment_id"]); err != nil {
+		log.C(r.Context()).Errorf("error while deleting attachment handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// statusForBodyError reports requests cut off by http.MaxBytesReader as too large.
+func statusForBodyError(err error, fallback int) int {
+	var maxBytesErr *http.MaxBytesError
+	if errors.As(err, &maxBytesErr) {
+		return http.StatusRequestEntityTooLarge
+	}
+	return fallback
+}
@@ -0,0 +1,217 @@
+package attachment_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/attachments/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http/attachment"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt/jwttest"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+const (
+	todoID       = "todo1"
+	attachmentID = "attachment1"
+	uploaderID   = "user1"
+)
+
+func multipartBody(t *testing.T, field, filename, content string) (*bytes.Buffer, string) {
+	body := &bytes.Buffer{}
+	writer := multipart.NewWriter(body)
+	part, err := writer.CreateFormFile(field, filename)
+	require.NoError(t, err)
+	_, err = part.Write([]byte(content))
+	require.NoError(t, err)
+	require.NoError(t, writer.Close())
+	return body, writer.FormDataContentType()
+}
+
+func TestUploadAttachmentHandler(t *testing.T) {
+	input := models.Attachment{TodoID: todoID, UploaderID: uploaderID, Filename: "notes.txt"}
+	created := models.Attachment{ID: attachmentID, TodoID: todoID, UploaderID: uploaderID, Filename: "notes.txt", ContentType: "text/plain", Size: 5}
+
+	tests := []struct {
+		name               string
+		field              string
+		content            string
+		contentType        string
+		mockService        func() *automock.AttachmentService
+		expectedStatusCode int
+	}{
+		{
+			name:    "Upload attachment",
+			field:   "file",
+			content: "hello",
+			mockService: func() *automock.AttachmentService {
+				mockService := &automock.AttachmentService{}
+				mockService.EXPECT().Upload(mock.Anything, input, mock.Anything).
+					RunAndReturn(func(_ context.Context, _ models.Attachment, content io.Reader) (models.Attachment, error) {
+						body, err := io.ReadAll(content)
+						assert.NoError(t, err)
+						assert.Equal(t, "hello", string(body))
+						return created, nil
+					}).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name:    "Error when the content type is not allowed",
+			field:   "file",
+			content: "<html></html>",
+			mockService: func() *automock.AttachmentService {
+				mockService := &automock.AttachmentService{}
+				mockService.EXPECT().Upload(mock.Anything, input, mock.Anything).
+					Return(models.Attachment{}, fmt.Errorf("attachments of type text/html are not allowed: %w", pkg.ErrUnsupported)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusUnsupportedMediaType,
+		},
+		{
+			name:    "Error when the request body is too large",
+			field:   "file",
+			content: strings.Repeat("a", 2<<20),
+			mockService: func() *automock.AttachmentService {
+				mockService := &automock.AttachmentService{}
+				mockService.EXPECT().Upload(mock.Anything, input, mock.Anything).
+					RunAndReturn(func(_ context.Context, _ models.Attachment, content io.Reader) (models.Attachment, error) {
+						_, err := io.ReadAll(content)
+						return models.Attachment{}, fmt.Errorf("error writing blob: %w", err)
+					}).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusRequestEntityTooLarge,
+		},
+		{
+			name:    "Error when the file field is missing",
+			field:   "document",
+			content: "hello",
+			mockService: func() *automock.AttachmentService {
+				return &automock.AttachmentService{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:        "Error when the request is not multipart",
+			contentType: constants.ContentTypeJSON,
+			mockService: func() *automock.AttachmentService {
+				return &automock.AttachmentService{}
+			},
+			expectedStatusCode: http.StatusUnsupportedMediaType,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := attachment.NewHandler(mockService, 16)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			body, contentType := multipartBody(t, tt.field, "notes.txt", tt.content)
+			if tt.contentType != "" {
+				contentType = tt.contentType
+			}
+			req, _ := http.NewRequest(http.MethodPost, "/todos/todo1/attachments", body)
+			req.Header.Set("Content-Type", contentType)
+			req = mux.SetURLVars(jwttest.WithUser(req, uploaderID, constants.Writer), map[string]string{"id": todoID})
+			w := httptest.NewRecorder()
+
+			handler.UploadAttachment(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			if tt.expectedStatusCode == http.StatusCreated {
+				var got models.Attachment
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, created, got)
+			}
+		})
+	}
+}
+
+func TestDownloadAttachmentHandler(t *testing.T) {
+	stored := models.Attachment{ID: attachmentID, TodoID: todoID, Filename: "résumé.pdf", ContentType: "application/pdf", Size: 5}
+
+	tests := []struct {
+		name               string
+		mockService        func() *automock.AttachmentService
+		expectedStatusCode int
+		expectedHeaders    map[string]string
+		expectedBody       string
+	}{
+		{
+			name: "Download attachment",
+			mockService: func() *automock.AttachmentService {
+				mockService := &automock.AttachmentService{}
+				mockService.EXPECT().OpenAttachment(mock.Anything, todoID, attachmentID).
+					Return(stored, io.NopCloser(strings.NewReader("%PDF-")), nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedHeaders: map[string]string{
+				"Content-Type":        "application/pdf",
+				"Content-Length":      "5",
+				"Content-Disposition": "attachment; filename*=utf-8''r%C3%A9sum%C3%A9.pdf",
+			},
+			expectedBody: "%PDF-",
+		},
+		{
+			name: "Error when the attachment does not exist",
+			mockService: func() *automock.AttachmentService {
+				mockService := &automock.AttachmentService{}
+				mockService.EXPECT().OpenAttachment(mock.Anything, todoID, attachmentID).
+					Return(models.Attachment{}, nil, fmt.Errorf("attachment %s not found: %w", attachmentID, pkg.ErrNotFound)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := attachment.NewHandler(mockService, 16)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodGet, "/todos/todo1/attachments/attachment1", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": todoID, "attachment_id": attachmentID})
+			w := httptest.NewRecorder()
+
+			handler.DownloadAttachment(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			for key, value := range tt.expectedHeaders {
+				assert.Equal(t, value, w.Header().Get(key))
+			}
+			if tt.expectedBody != "" {
+				assert.Equal(t, tt.expectedBody, w.Body.String())
+			}
+		})
+	}
+}
+
+func TestDeleteAttachmentHandler(t *testing.T) {
+	mockService := &automock.AttachmentService{}
+	mockService.EXPECT().DeleteAttachment(mock.Anything, todoID, attachmentID).Return(nil).Once()
+	defer mock.AssertExpectationsForObjects(t, mockService)
+	handler := attachment.NewHandler(mockService, 16)
+
+	req, _ := http.NewRequest(http.MethodDelete, "/todos/todo1/attachments/attachment1", nil)
+	req = mux.SetURLVars(req, map[string]string{"id": todoID, "attachment_id": attachmentID})
+	w := httptest.NewRecorder()
+
+	handler.DeleteAttachment(w, req)
+
+	assert.Equal(t, http.StatusNoContent, w.Code)
+}
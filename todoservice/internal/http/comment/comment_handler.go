@@ -0,0 +1,165 @@
+package comment
+
+import (
+	"encoding/json"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/comments"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/problem"
+	"github.com/gorilla/mux"
+	"net/http"
+	"strconv"
+)
+
+type Handler struct {
+	service comments.CommentService
+}
+
+func NewHandler(service comments.CommentService) *Handler {
+	return &Handler{service: service}
+}
+
+type commentBody struct {
+	Body string `json:"body"`
+}
+
+func (h *Handler) CreateComment(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("create comment handler")
+	actor, ok := actorFromRequest(r)
+	if !ok {
+		problem.Write(w, r, http.StatusUnauthorized, "there is no user claim in the context")
+		return
+	}
+	var input commentBody
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		log.C(r.Context()).Errorf("error while creating comment handler: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	comment := models.Comment{
+		TodoID:   mux.Vars(r)["id"],
+		AuthorID: actor.UserID,
+		Body:     input.Body,
+	}
+	createdComment, err := h.service.AddComment(r.Context(), comment)
+	log.C(r.Context()).Debugf("create comment handler with comment: %v", createdComment)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating comment handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(createdComment); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+// ListComments returns a page of the comments of the todo selected with the limit and offset query parameters.
+func (h *Handler) ListComments(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list comments handler")
+	todoID := mux.Vars(r)["id"]
+
+	limit, ok := intQuery(w, r, "limit")
+	if !ok {
+		return
+	}
+	offset, ok := intQuery(w, r, "offset")
+	if !ok {
+		return
+	}
+
+	page, err := h.service.ListComments(r.Context(), todoID, limit, offset)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing comments handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(page); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+// UpdateComment replaces the body of a comment. Only its author or an admin may edit it.
+func (h *Handler) UpdateComment(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("update comment handler")
+	actor, ok := actorFromRequest(r)
+	if !ok {
+		problem.Write(w, r, http.StatusUnauthorized, "there is no user claim in the context")
+		return
+	}
+	var input commentBody
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		log.C(r.Context()).Errorf("error while updating comment handler: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	vars := mux.Vars(r)
+
+	updatedComment, err := h.service.UpdateComment(r.Context(), actor, vars["id"], vars["comment_id"], input.Body)
+	log.C(r.Context()).Debugf("update comment handler with comment: %v", updatedComment)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while updating comment handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(updatedComment); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+// DeleteComment deletes a comment. Only its author or an admin may delete it.
+func (h *Handler) DeleteComment(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("delete comment handler")
+	actor, ok := actorFromRequest(r)
+	if !ok {
+		problem.Write(w, r, http.StatusUnauthorized, "there is no user claim in the context")
+		return
+	}
+	vars := mux.Vars(r)
+
+	if err := h.service.DeleteComment(r.Context(), actor, vars["id"], vars["comment_id"]); err != nil {
+		log.C(r.Context()).Errorf("error while deleting comment handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func actorFromRequest(r *http.Request) (comments.Actor, bool) {
+	claim, ok := jwt.ClaimsFromContext(r.Context())
+	if !ok {
+		log.C(r.Context()).Error("there is no user claim in the context")
+		return comments.Actor{}, false
+	}
+	return comments.Actor{UserID: claim.ID, Admin: claim.Role == string(constants.Admin)}, true
+}
+
+// intQuery reads an optional non-negative integer query parameter, missing parameters are zero.
+func intQuery(w http.ResponseWriter, r *http.Request, name string) (int, bool) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return 0, true
+	}
+	result, err := strconv.Atoi(value)
+	if err != nil || result < 0 {
+		log.C(r.Context()).Errorf("invalid %s query parameter: %q", name, value)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid query parameter", problem.FieldError{Field: name, Message: "must be a non-negative integer"})
+		return 0, false
+	}
+	return result, true
+}
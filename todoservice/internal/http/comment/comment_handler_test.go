@@ -0,0 +1,262 @@
+package comment_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/comments"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/comments/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http/comment"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt/jwttest"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const (
+	todoID    = "todo1"
+	commentID = "comment1"
+	authorID  = "user1"
+)
+
+func TestCreateCommentHandler(t *testing.T) {
+	input := models.Comment{TodoID: todoID, AuthorID: authorID, Body: "ping @bob@example.com"}
+	created := models.Comment{ID: commentID, TodoID: todoID, AuthorID: authorID, Body: "ping @bob@example.com",
+		Mentions: []models.Mention{{UserID: "user2", Email: "bob@example.com"}}}
+
+	tests := []struct {
+		name               string
+		body               string
+		mockService        func() *automock.CommentService
+		expectedStatusCode int
+	}{
+		{
+			name: "Create comment",
+			body: `{"body":"ping @bob@example.com"}`,
+			mockService: func() *automock.CommentService {
+				mockService := &automock.CommentService{}
+				mockService.EXPECT().AddComment(mock.Anything, input).Return(created, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name: "Error when the comment is invalid",
+			body: `{"body":""}`,
+			mockService: func() *automock.CommentService {
+				mockService := &automock.CommentService{}
+				mockService.EXPECT().AddComment(mock.Anything, models.Comment{TodoID: todoID, AuthorID: authorID}).
+					Return(models.Comment{}, fmt.Errorf("comment body cannot be empty: %w", pkg.ErrBadRequest)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error when the body is invalid",
+			body: `{`,
+			mockService: func() *automock.CommentService {
+				return &automock.CommentService{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := comment.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodPost, "/todos/todo1/comments", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", constants.ContentTypeJSON)
+			req = mux.SetURLVars(jwttest.WithUser(req, authorID, constants.Writer), map[string]string{"id": todoID})
+			w := httptest.NewRecorder()
+
+			handler.CreateComment(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			if tt.expectedStatusCode == http.StatusCreated {
+				var got models.Comment
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, created, got)
+			}
+		})
+	}
+}
+
+func TestListCommentsHandler(t *testing.T) {
+	page := models.CommentPage{
+		Comments: []models.Comment{{ID: commentID, TodoID: todoID, AuthorID: authorID, Body: "first", Mentions: []models.Mention{}}},
+		Total:    3,
+		Limit:    1,
+		Offset:   2,
+	}
+
+	tests := []struct {
+		name               string
+		query              string
+		mockService        func() *automock.CommentService
+		expectedStatusCode int
+	}{
+		{
+			name:  "List a page of comments",
+			query: "?limit=1&offset=2",
+			mockService: func() *automock.CommentService {
+				mockService := &automock.CommentService{}
+				mockService.EXPECT().ListComments(mock.Anything, todoID, 1, 2).Return(page, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:  "Error when the limit is not a number",
+			query: "?limit=all",
+			mockService: func() *automock.CommentService {
+				return &automock.CommentService{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name:  "Error when the limit is too big",
+			query: "?limit=1000",
+			mockService: func() *automock.CommentService {
+				mockService := &automock.CommentService{}
+				mockService.EXPECT().ListComments(mock.Anything, todoID, 1000, 0).
+					Return(models.CommentPage{}, fmt.Errorf("limit must be between 1 and %d: %w", comments.MaxPageSize, pkg.ErrBadRequest)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := comment.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodGet, "/todos/todo1/comments"+tt.query, nil)
+			req = mux.SetURLVars(req, map[string]string{"id": todoID})
+			w := httptest.NewRecorder()
+
+			handler.ListComments(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			if tt.expectedStatusCode == http.StatusOK {
+				var got models.CommentPage
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, page, got)
+			}
+		})
+	}
+}
+
+func TestUpdateCommentHandler(t *testing.T) {
+	updated := models.Comment{ID: commentID, TodoID: todoID, AuthorID: authorID, Body: "edited", Mentions: []models.Mention{}}
+
+	tests := []struct {
+		name               string
+		userID             string
+		role               constants.Role
+		mockService        func() *automock.CommentService
+		expectedStatusCode int
+	}{
+		{
+			name:   "Author edits the comment",
+			userID: authorID,
+			role:   constants.Writer,
+			mockService: func() *automock.CommentService {
+				mockService := &automock.CommentService{}
+				mockService.EXPECT().UpdateComment(mock.Anything, comments.Actor{UserID: authorID}, todoID, commentID, "edited").Return(updated, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:   "Admin edits the comment",
+			userID: "admin1",
+			role:   constants.Admin,
+			mockService: func() *automock.CommentService {
+				mockService := &automock.CommentService{}
+				mockService.EXPECT().UpdateComment(mock.Anything, comments.Actor{UserID: "admin1", Admin: true}, todoID, commentID, "edited").Return(updated, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name:   "Error when the user is not the author",
+			userID: "user2",
+			role:   constants.Writer,
+			mockService: func() *automock.CommentService {
+				mockService := &automock.CommentService{}
+				mockService.EXPECT().UpdateComment(mock.Anything, comments.Actor{UserID: "user2"}, todoID, commentID, "edited").
+					Return(models.Comment{}, fmt.Errorf("only the author can change comment %s: %w", commentID, pkg.ErrForbidden)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := comment.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodPatch, "/todos/todo1/comments/comment1", bytes.NewBufferString(`{"body":"edited"}`))
+			req.Header.Set("Content-Type", constants.ContentTypeJSON)
+			req = mux.SetURLVars(jwttest.WithUser(req, tt.userID, tt.role), map[string]string{"id": todoID, "comment_id": commentID})
+			w := httptest.NewRecorder()
+
+			handler.UpdateComment(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestDeleteCommentHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		mockService        func() *automock.CommentService
+		expectedStatusCode int
+	}{
+		{
+			name: "Delete comment",
+			mockService: func() *automock.CommentService {
+				mockService := &automock.CommentService{}
+				mockService.EXPECT().DeleteComment(mock.Anything, comments.Actor{UserID: authorID}, todoID, commentID).Return(nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "Error when the comment does not exist",
+			mockService: func() *automock.CommentService {
+				mockService := &automock.CommentService{}
+				mockService.EXPECT().DeleteComment(mock.Anything, comments.Actor{UserID: authorID}, todoID, commentID).
+					Return(fmt.Errorf("comment %s not found: %w", commentID, pkg.ErrNotFound)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := comment.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodDelete, "/todos/todo1/comments/comment1", nil)
+			req = mux.SetURLVars(jwttest.WithUser(req, authorID, constants.Writer), map[string]string{"id": todoID, "comment_id": commentID})
+			w := httptest.NewRecorder()
+
+			handler.DeleteComment(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
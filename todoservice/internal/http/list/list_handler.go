@@ -3,23 +3,23 @@ package list
 import (
 	"encoding/json"
 	"fmt"
-	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/lists"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/problem"
 	"github.com/gorilla/mux"
-	"github.com/jmoiron/sqlx"
 	"net/http"
 )
 
 type Handler struct {
-	service  lists.ListService
-	database *sqlx.DB
+	service lists.ListService
 }
 
-func NewHandler(service lists.ListService, database *sqlx.DB) *Handler {
-	return &Handler{service: service, database: database}
+func NewHandler(service lists.ListService) *Handler {
+	return &Handler{service: service}
 }
 
 func (h *Handler) CreateList(w http.ResponseWriter, r *http.Request) {
@@ -27,37 +27,22 @@ func (h *Handler) CreateList(w http.ResponseWriter, r *http.Request) {
 	var list models.List
 	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
 		log.C(r.Context()).Errorf("error while creating list handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while creating list handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
 	createdList, err := h.service.CreateList(ctx, list)
 	log.C(r.Context()).Debugf("create list handler with id: %v", createdList)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusBadRequest), err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while committing transaction in create list handler: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusCreated)
 	if err := json.NewEncoder(w).Encode(createdList); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
 		return
 	}
 }
@@ -69,34 +54,43 @@ func (h *Handler) GetList(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
+	list, err := h.service.GetList(ctx, id)
+	log.C(r.Context()).Debugf("get list handler with id: %v", list.ID)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while getting list handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
-	defer tx.Rollback()
 
-	ctx = db.SaveToContext(ctx, tx)
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(list); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
 
-	list, err := h.service.GetList(ctx, id)
-	log.C(r.Context()).Debugf("get list handler with id: %v", list.ID)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while getting list handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+func (h *Handler) GetListsByIDs(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("get lists by ids handler called")
+	ctx := r.Context()
+	ids := pkg.SplitIDs(r.URL.Query().Get("ids"))
+	if err := pkg.ValidateBatchIDs(ids); err != nil {
+		log.C(r.Context()).Errorf("error while getting lists by ids invalid ids: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("erorr while get list handler transaction is committing: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+	result, err := h.service.GetListsByIDs(ctx, ids)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while getting lists by ids failed: %v", err)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(list); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 }
@@ -109,7 +103,7 @@ func (h *Handler) UpdateList(w http.ResponseWriter, r *http.Request) {
 	var list models.List
 	if err := json.NewDecoder(r.Body).Decode(&list); err != nil {
 		log.C(r.Context()).Errorf("error while updating list handler: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 	list.ID = id
@@ -117,21 +111,11 @@ func (h *Handler) UpdateList(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while updating list handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
-	_, err = h.service.GetList(ctx, list.ID)
+	_, err := h.service.GetList(ctx, list.ID)
 	log.C(r.Context()).Debugf("update list handler with id: %v", list.ID)
 	if err != nil {
 		log.C(r.Context()).Errorf("update list handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
@@ -139,15 +123,10 @@ func (h *Handler) UpdateList(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Debugf("update list handler with id: %v", list.ID)
 	if err != nil {
 		log.C(r.Context()).Errorf("update list handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while committing transaction in update list handler: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 }
@@ -159,35 +138,20 @@ func (h *Handler) DeleteList(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while deleting list handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
-	_, err = h.service.GetList(ctx, id)
+	_, err := h.service.GetList(ctx, id)
 	log.C(r.Context()).Debugf("delete list handler with id: %v", id)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while deleting list handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
 	if err := h.service.DeleteList(ctx, id); err != nil {
 		log.C(r.Context()).Errorf("error while deleting list handle: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while committing transaction in delete list handler: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.WriteHeader(http.StatusNoContent)
 }
 
@@ -198,34 +162,18 @@ func (h *Handler) ListAllByUser(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while listing all list by user id handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
 	listsByUserID, err := h.service.ListAllByUserID(ctx, id)
 	log.C(r.Context()).Debugf("list all by user id handler: %v", listsByUserID)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while list all by user id handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
-		return
-	}
-
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while committing transaction in list all by user id: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(listsByUserID); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
 	}
 }
 
@@ -233,33 +181,17 @@ func (h *Handler) GetAllLists(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Info("get all lists")
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while getting all lists handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
 	result, err := h.service.GetAllLists(ctx)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while getting all lists handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
-		return
-	}
-
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while committing transaction in get all lists: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(result); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
 		return
 	}
 }
@@ -270,34 +202,18 @@ func (h *Handler) GetUsersByListID(w http.ResponseWriter, r *http.Request) {
 	id := vars["id"]
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while getting users by list id handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
 	result, err := h.service.GetUsersByListID(ctx, id)
 	log.C(r.Context()).Debugf("get users by list id handler: %v", result)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while getting users by list id handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
-		return
-	}
-
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while getting users by list id transaction does not commit: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(result); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
 		return
 	}
 }
@@ -308,34 +224,18 @@ func (h *Handler) GetListOwnerID(w http.ResponseWriter, r *http.Request) {
 	id := vars["id"]
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while getting list owner id handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
 	result, err := h.service.GetListOwnerID(ctx, id)
 	log.C(r.Context()).Debugf("get list owner id handler: %v", result)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while getting list owner id handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
-		return
-	}
-
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while getting list owner id transaction does not commit: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(result); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
 		return
 	}
 }
@@ -345,38 +245,23 @@ func (h *Handler) CreateAccess(w http.ResponseWriter, r *http.Request) {
 	var access models.Access
 	if err := json.NewDecoder(r.Body).Decode(&access); err != nil {
 		log.C(r.Context()).Errorf("error while creating access handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while creating access handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
 	createdAccess, err := h.service.CreateAccess(ctx, access)
 	log.C(r.Context()).Debugf("create access handler: %v", createdAccess)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while creating access handler: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusBadRequest), err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while creating access transaction does not commit: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusCreated)
 	if err := json.NewEncoder(w).Encode(createdAccess); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
 		return
 	}
 }
@@ -389,27 +274,12 @@ func (h *Handler) DeleteAccess(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while deleting access handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
 	if err := h.service.DeleteAccess(ctx, listID, userID); err != nil {
 		log.C(r.Context()).Errorf("error while deleting access handler: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while deleting access transaction does not commit: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
 	w.WriteHeader(http.StatusNoContent)
 }
 
@@ -421,30 +291,14 @@ func (h *Handler) AcceptList(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while getting access handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
-	err = h.service.AcceptList(ctx, listID, userID)
+	err := h.service.AcceptList(ctx, listID, userID)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while getting accepting list handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 	log.C(r.Context()).Debugf("get access handler - userID: %s, listID: %s", userID, listID)
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while getting access transaction does not commit: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
-
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 }
@@ -457,34 +311,18 @@ func (h *Handler) GetAccess(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while getting access handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
 	access, err := h.service.GetAccess(ctx, listID, userID)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while getting access handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 	log.C(r.Context()).Debugf("get access handler - userID: %s, listID: %s", access.UserID, access.ListID)
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while getting access transaction does not commit: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
-
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(access); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
 		return
 	}
 }
@@ -498,32 +336,22 @@ func (h *Handler) UpdateListDescription(w http.ResponseWriter, r *http.Request)
 	}
 	if err := json.NewDecoder(r.Body).Decode(&updateData); err != nil {
 		log.C(r.Context()).Errorf("error while updating list description handler: %v", err)
-		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
 		return
 	}
 
 	if updateData.Description == "" {
 		log.C(r.Context()).Error("error while updating list description handler: empty description")
-		http.Error(w, "Description cannot be empty", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Description cannot be empty", problem.FieldError{Field: "description", Message: "cannot be empty"})
 		return
 	}
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
+	_, err := h.service.GetList(ctx, listID)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while updating list description handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
-	_, err = h.service.GetList(ctx, listID)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while updating list description handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
@@ -531,19 +359,14 @@ func (h *Handler) UpdateListDescription(w http.ResponseWriter, r *http.Request)
 	log.C(r.Context()).Debugf("update list description handler with list: %v", updatedList)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while updating list description handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while updating list description handler transaction does not commit: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(updatedList); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
 		return
 	}
 }
@@ -557,32 +380,22 @@ func (h *Handler) UpdateListName(w http.ResponseWriter, r *http.Request) {
 	}
 	if err := json.NewDecoder(r.Body).Decode(&updateData); err != nil {
 		log.C(r.Context()).Errorf("error while updating list name handler: %v", err)
-		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
 		return
 	}
 
 	if updateData.Name == "" {
 		log.C(r.Context()).Error("error while updating list name handler: empty name")
-		http.Error(w, "Description cannot be empty", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Name cannot be empty", problem.FieldError{Field: "name", Message: "cannot be empty"})
 		return
 	}
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while updating list name handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
-	_, err = h.service.GetList(ctx, listID)
+	_, err := h.service.GetList(ctx, listID)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while updating list name handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
@@ -590,199 +403,148 @@ func (h *Handler) UpdateListName(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Debugf("update list name handler with list: %v", updatedList)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while updating list name handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while updating list name handler transaction does not commit: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(updatedList); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
 		return
 	}
 }
 
 func (h *Handler) GetListsByUser(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Info("get lists by user handler")
-	userID, ok := r.Context().Value("user_id").(string)
+	userID, ok := jwt.UserIDFromContext(r.Context())
 	if !ok {
 		log.C(r.Context()).Errorf("error while getting lists by user handler missing user id in the context")
-		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError))
 		return
 	}
+	withArchived := includeArchived(r)
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while getting lists by user id handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
 	access, err := h.service.ListAllByUserID(ctx, userID)
 	var result []models.List
 	for _, el := range access {
 		l, err := h.service.GetList(ctx, el.ListID)
 		if err != nil {
 			log.C(r.Context()).Errorf("error while getting the list with id: %s; %v", el.ListID, err)
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			problem.Write(w, r, http.StatusInternalServerError, err.Error())
 			return
 		}
+		if l.ArchivedAt != nil && !withArchived {
+			continue
+		}
 		result = append(result, l)
 	}
 	log.C(r.Context()).Debugf("get lists by user id handler: %v", result)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while getting list by user id handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
-		return
-	}
-
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while getting list by user id transaction does not commit: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(result); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
 		return
 	}
 }
 
 func (h *Handler) GetAcceptedLists(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Info("get accepted lists by user handler")
-	userID, ok := r.Context().Value("user_id").(string)
+	userID, ok := jwt.UserIDFromContext(r.Context())
 	if !ok {
 		log.C(r.Context()).Errorf("error while getting lists by user handler missing user id in the context")
-		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError))
 		return
 	}
+	withArchived := includeArchived(r)
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while getting lists by user id handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
 	access, err := h.service.GetAcceptedLists(ctx, userID)
 	var result []models.List
 	for _, el := range access {
 		l, err := h.service.GetList(ctx, el.ListID)
 		if err != nil {
 			log.C(r.Context()).Errorf("error while getting the list with id: %s; %v", el.ListID, err)
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			problem.Write(w, r, http.StatusInternalServerError, err.Error())
 			return
 		}
+		if l.ArchivedAt != nil && !withArchived {
+			continue
+		}
 		result = append(result, l)
 	}
 	log.C(r.Context()).Debugf("get lists by user id handler: %v", result)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while getting list by user id handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
-		return
-	}
-
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while getting list by user id transaction does not commit: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(result); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
 		return
 	}
 }
 
 func (h *Handler) GetPendingLists(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Info("get pending lists by user handler")
-	userID, ok := r.Context().Value("user_id").(string)
+	userID, ok := jwt.UserIDFromContext(r.Context())
 	if !ok {
 		log.C(r.Context()).Errorf("error while getting pending lists by user handler missing user id in the context")
-		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError))
 		return
 	}
+	withArchived := includeArchived(r)
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while getting pending lists by user id handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
 	access, err := h.service.GetPendingLists(ctx, userID)
 	var result []models.List
 	for _, el := range access {
 		l, err := h.service.GetList(ctx, el.ListID)
 		if err != nil {
 			log.C(r.Context()).Errorf("error while getting the pending list with id: %s; %v", el.ListID, err)
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			problem.Write(w, r, http.StatusInternalServerError, err.Error())
 			return
 		}
+		if l.ArchivedAt != nil && !withArchived {
+			continue
+		}
 		result = append(result, l)
 	}
 	log.C(r.Context()).Debugf("get pending lists by user id handler: %v", result)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while getting pending list by user id handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
-		return
-	}
-
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while getting pending list by user id transaction does not commit: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(result); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
 		return
 	}
 }
 
 func (h *Handler) GetAllUserTodos(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Info("get all todos by user handler")
-	userID, ok := r.Context().Value("user_id").(string)
+	userID, ok := jwt.UserIDFromContext(r.Context())
 	if !ok {
 		log.C(r.Context()).Errorf("error while getting all todos by user handler missing user id in the context")
-		http.Error(w, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError))
 		return
 	}
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while getting all todos by user id handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
 	access, err := h.service.ListAllByUserID(ctx, userID)
 	accepted, err := h.service.GetAcceptedLists(ctx, userID)
 	combined := append(access, accepted...)
@@ -791,7 +553,7 @@ func (h *Handler) GetAllUserTodos(w http.ResponseWriter, r *http.Request) {
 		todosForList, err := h.service.GetAllTodosForList(ctx, el.ListID)
 		if err != nil {
 			log.C(r.Context()).Errorf("error while getting the list with id: %s; %v", el.ListID, err)
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			problem.Write(w, r, http.StatusInternalServerError, err.Error())
 			return
 		}
 		result = append(result, todosForList...)
@@ -799,20 +561,14 @@ func (h *Handler) GetAllUserTodos(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Debugf("get lists by user id handler: %v", result)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while getting list by user id handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
-		return
-	}
-
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while getting list by user id transaction does not commit: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(result); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
 		return
 	}
 }
@@ -824,34 +580,106 @@ func (h *Handler) GetAccessesByListID(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
+	accesses, err := h.service.GetAccessesByListID(ctx, listID)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while getting access handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
-	defer tx.Rollback()
+	log.C(r.Context()).Debugf("get accesses handler %v", accesses)
 
-	ctx = db.SaveToContext(ctx, tx)
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(accesses); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
 
-	accesses, err := h.service.GetAccessesByListID(ctx, listID)
+// MoveList puts a list right before the list before_id and right after the list after_id among the lists of
+// the caller. Every user orders their lists independently.
+func (h *Handler) MoveList(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("move list handler")
+	userID, ok := jwt.UserIDFromContext(r.Context())
+	if !ok {
+		log.C(r.Context()).Errorf("error while moving list handler missing user id in the context")
+		problem.Write(w, r, http.StatusInternalServerError, "there is no user id in the context:"+http.StatusText(http.StatusInternalServerError))
+		return
+	}
+	listID := mux.Vars(r)["id"]
+
+	var moveData struct {
+		BeforeID string `json:"before_id"`
+		AfterID  string `json:"after_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&moveData); err != nil {
+		log.C(r.Context()).Errorf("error while moving list handler: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	if moveData.BeforeID == "" && moveData.AfterID == "" {
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload",
+			problem.FieldError{Field: "before_id", Message: "before_id or after_id is required"},
+			problem.FieldError{Field: "after_id", Message: "before_id or after_id is required"})
+		return
+	}
+
+	access, err := h.service.MoveList(r.Context(), listID, userID, moveData.BeforeID, moveData.AfterID)
 	if err != nil {
-		log.C(r.Context()).Errorf("error while getting access handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		log.C(r.Context()).Errorf("error while moving list handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
 		return
 	}
-	log.C(r.Context()).Debugf("get accesses handler %v", accesses)
+	log.C(r.Context()).Debugf("move list handler - userID: %s, listID: %s, position: %s", userID, listID, access.Position)
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(access); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while getting access transaction does not commit: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+// ArchiveList makes the list read-only. Archived lists are left out of the lists of the user unless
+// include_archived=true is passed.
+func (h *Handler) ArchiveList(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("archive list handler")
+	listID := mux.Vars(r)["id"]
+
+	list, err := h.service.ArchiveList(r.Context(), listID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while archiving list handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
 		return
 	}
 
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(accesses); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+	if err := json.NewEncoder(w).Encode(list); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+func (h *Handler) UnarchiveList(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("unarchive list handler")
+	listID := mux.Vars(r)["id"]
+
+	list, err := h.service.UnarchiveList(r.Context(), listID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while unarchiving list handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
 		return
 	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(list); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+func includeArchived(r *http.Request) bool {
+	return r.URL.Query().Get("include_archived") == "true"
 }
@@ -4,37 +4,36 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http/list"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/lists/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt/jwttest"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
-	sqlxmock "github.com/zhashkevych/go-sqlxmock"
 	"net/http"
 	"net/http/httptest"
 	"testing"
+	"time"
 )
 
 func TestCreateListHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	err = errors.New("error")
+	err := errors.New("error")
 	id := "1"
 	modelInput := models.List{
 		ID:          id,
 		Name:        "Test List",
 		Description: "Test List",
 		OwnerID:     "user1",
-		Tags:        json.RawMessage{0x6e, 0x75, 0x6c, 0x6c},
 	}
 
 	tests := []struct {
 		name               string
 		mockService        func() *automock.ListService
-		mockDatabase       func()
 		expectedStatusCode int
 		expectedError      error
 	}{
@@ -45,10 +44,6 @@ func TestCreateListHandler(t *testing.T) {
 				mockService.EXPECT().CreateList(mock.Anything, modelInput).Return(id, nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusCreated,
 			expectedError:      nil,
 		},
@@ -59,10 +54,6 @@ func TestCreateListHandler(t *testing.T) {
 				mockService.EXPECT().CreateList(mock.Anything, modelInput).Return("", err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectRollback()
-			},
 			expectedStatusCode: http.StatusBadRequest,
 			expectedError:      err,
 		},
@@ -70,7 +61,7 @@ func TestCreateListHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := list.NewHandler(mockService, db)
+			handler := list.NewHandler(mockService)
 
 			body, _ := json.Marshal(modelInput)
 			req, _ := http.NewRequest(http.MethodPost, "/lists/create", bytes.NewBuffer(body))
@@ -78,8 +69,6 @@ func TestCreateListHandler(t *testing.T) {
 			w := httptest.NewRecorder()
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.CreateList(w, req)
 			resp := w.Result()
 			defer func() {
@@ -99,17 +88,12 @@ func TestCreateListHandler(t *testing.T) {
 				_ = json.NewDecoder(resp.Body).Decode(&respID)
 				assert.Equal(t, "1", respID)
 			}
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
-			}
 		})
 	}
 }
 
 func TestGetListHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	err = errors.New("error")
+	err := errors.New("error")
 	id := "1"
 	modelInput := models.List{
 		ID:          id,
@@ -126,7 +110,6 @@ func TestGetListHandler(t *testing.T) {
 	tests := []struct {
 		name               string
 		mockService        func() *automock.ListService
-		mockDatabase       func()
 		expectedStatusCode int
 		urlVars            map[string]string
 		expectedError      error
@@ -138,10 +121,6 @@ func TestGetListHandler(t *testing.T) {
 				mockService.EXPECT().GetList(mock.Anything, id).Return(model, nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusOK,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      nil,
@@ -153,10 +132,6 @@ func TestGetListHandler(t *testing.T) {
 				mockService.EXPECT().GetList(mock.Anything, id).Return(models.List{}, err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectRollback()
-			},
 			expectedStatusCode: http.StatusNotFound,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      err,
@@ -165,7 +140,7 @@ func TestGetListHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := list.NewHandler(mockService, db)
+			handler := list.NewHandler(mockService)
 
 			body, _ := json.Marshal(modelInput)
 			req, _ := http.NewRequest(http.MethodGet, "/lists/1", bytes.NewBuffer(body))
@@ -174,8 +149,6 @@ func TestGetListHandler(t *testing.T) {
 			req = mux.SetURLVars(req, tt.urlVars)
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.GetList(w, req)
 			resp := w.Result()
 			defer func() {
@@ -198,17 +171,12 @@ func TestGetListHandler(t *testing.T) {
 				}
 				assert.JSONEq(t, string(expectedResponse), actualResponse.String())
 			}
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
-			}
 		})
 	}
 }
 
 func TestGetAllListsHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	err = errors.New("error")
+	err := errors.New("error")
 
 	model := []models.List{
 		{
@@ -221,7 +189,6 @@ func TestGetAllListsHandler(t *testing.T) {
 	tests := []struct {
 		name               string
 		mockService        func() *automock.ListService
-		mockDatabase       func()
 		expectedStatusCode int
 		expectedError      error
 	}{
@@ -232,10 +199,6 @@ func TestGetAllListsHandler(t *testing.T) {
 				mockService.EXPECT().GetAllLists(mock.Anything).Return(model, nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusOK,
 			expectedError:      nil,
 		},
@@ -246,10 +209,6 @@ func TestGetAllListsHandler(t *testing.T) {
 				mockService.EXPECT().GetAllLists(mock.Anything).Return([]models.List{}, err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectRollback()
-			},
 			expectedStatusCode: http.StatusNotFound,
 			expectedError:      err,
 		},
@@ -257,15 +216,13 @@ func TestGetAllListsHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := list.NewHandler(mockService, db)
+			handler := list.NewHandler(mockService)
 
 			req, _ := http.NewRequest(http.MethodGet, "/lists/all", nil)
 			req.Header.Set("Content-Type", constants.ContentTypeJSON)
 			w := httptest.NewRecorder()
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.GetAllLists(w, req)
 			resp := w.Result()
 			defer func() {
@@ -288,17 +245,12 @@ func TestGetAllListsHandler(t *testing.T) {
 				}
 				assert.JSONEq(t, string(expectedResponse), actualResponse.String())
 			}
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
-			}
 		})
 	}
 }
 
 func TestGetListOwnerIDHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	err = errors.New("error")
+	err := errors.New("error")
 	id := "1"
 	modelInput := models.List{
 		ID:          id,
@@ -310,7 +262,6 @@ func TestGetListOwnerIDHandler(t *testing.T) {
 	tests := []struct {
 		name               string
 		mockService        func() *automock.ListService
-		mockDatabase       func()
 		expectedStatusCode int
 		urlVars            map[string]string
 		expectedError      error
@@ -322,10 +273,6 @@ func TestGetListOwnerIDHandler(t *testing.T) {
 				mockService.EXPECT().GetListOwnerID(mock.Anything, id).Return(ownerID, nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusOK,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      nil,
@@ -337,10 +284,6 @@ func TestGetListOwnerIDHandler(t *testing.T) {
 				mockService.EXPECT().GetListOwnerID(mock.Anything, id).Return("", err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectRollback()
-			},
 			expectedStatusCode: http.StatusNotFound,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      err,
@@ -349,7 +292,7 @@ func TestGetListOwnerIDHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := list.NewHandler(mockService, db)
+			handler := list.NewHandler(mockService)
 
 			body, _ := json.Marshal(modelInput)
 			req, _ := http.NewRequest(http.MethodGet, "/lists/1/owner", bytes.NewBuffer(body))
@@ -358,8 +301,6 @@ func TestGetListOwnerIDHandler(t *testing.T) {
 			req = mux.SetURLVars(req, tt.urlVars)
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.GetListOwnerID(w, req)
 			resp := w.Result()
 			defer func() {
@@ -382,17 +323,12 @@ func TestGetListOwnerIDHandler(t *testing.T) {
 				}
 				assert.JSONEq(t, string(expectedResponse), actualResponse.String())
 			}
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
-			}
 		})
 	}
 }
 
 func TestGetUsersByListIDHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	err = errors.New("error")
+	err := errors.New("error")
 	id := "1"
 	modelInput := models.List{
 		ID:          id,
@@ -416,7 +352,6 @@ func TestGetUsersByListIDHandler(t *testing.T) {
 	tests := []struct {
 		name               string
 		mockService        func() *automock.ListService
-		mockDatabase       func()
 		expectedStatusCode int
 		urlVars            map[string]string
 		expectedError      error
@@ -428,10 +363,6 @@ func TestGetUsersByListIDHandler(t *testing.T) {
 				mockService.EXPECT().GetUsersByListID(mock.Anything, id).Return(model, nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusOK,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      nil,
@@ -443,10 +374,6 @@ func TestGetUsersByListIDHandler(t *testing.T) {
 				mockService.EXPECT().GetUsersByListID(mock.Anything, id).Return([]models.Access{}, err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectRollback()
-			},
 			expectedStatusCode: http.StatusNotFound,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      err,
@@ -455,7 +382,7 @@ func TestGetUsersByListIDHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := list.NewHandler(mockService, db)
+			handler := list.NewHandler(mockService)
 
 			body, _ := json.Marshal(modelInput)
 			req, _ := http.NewRequest(http.MethodGet, "/lists/1", bytes.NewBuffer(body))
@@ -464,8 +391,6 @@ func TestGetUsersByListIDHandler(t *testing.T) {
 			req = mux.SetURLVars(req, tt.urlVars)
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.GetUsersByListID(w, req)
 			resp := w.Result()
 			defer func() {
@@ -488,29 +413,22 @@ func TestGetUsersByListIDHandler(t *testing.T) {
 				}
 				assert.JSONEq(t, string(expectedResponse), actualResponse.String())
 			}
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
-			}
 		})
 	}
 }
 
 func TestUpdateListHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	err = errors.New("error")
+	err := errors.New("error")
 	id := ""
 	modelInput := models.List{
 		ID:          id,
 		Name:        "Test List",
 		Description: "Test List",
 		OwnerID:     "user1",
-		Tags:        json.RawMessage(`"null"`),
 	}
 	tests := []struct {
 		name               string
 		mockService        func() *automock.ListService
-		mockDatabase       func()
 		expectedStatusCode int
 		urlVars            map[string]string
 		expectedError      error
@@ -526,10 +444,6 @@ func TestUpdateListHandler(t *testing.T) {
 				})).Return(nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusOK,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      nil,
@@ -541,10 +455,6 @@ func TestUpdateListHandler(t *testing.T) {
 				mockService.EXPECT().GetList(mock.Anything, id).Return(models.List{}, err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectRollback()
-			},
 			expectedStatusCode: http.StatusNotFound,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      err,
@@ -553,7 +463,7 @@ func TestUpdateListHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := list.NewHandler(mockService, db)
+			handler := list.NewHandler(mockService)
 
 			body, _ := json.Marshal(modelInput)
 			req, _ := http.NewRequest(http.MethodPut, "/lists/update/1", bytes.NewBuffer(body))
@@ -561,8 +471,6 @@ func TestUpdateListHandler(t *testing.T) {
 			w := httptest.NewRecorder()
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.UpdateList(w, req)
 			resp := w.Result()
 			defer func() {
@@ -582,23 +490,17 @@ func TestUpdateListHandler(t *testing.T) {
 				_ = json.NewDecoder(resp.Body).Decode(&response)
 				assert.Equal(t, "", response)
 			}
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
-			}
 		})
 	}
 }
 
 func TestDeleteListHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	err = errors.New("error")
+	err := errors.New("error")
 	id := "1"
 
 	tests := []struct {
 		name               string
 		mockService        func() *automock.ListService
-		mockDatabase       func()
 		expectedStatusCode int
 		urlVars            map[string]string
 		expectedError      error
@@ -611,10 +513,6 @@ func TestDeleteListHandler(t *testing.T) {
 				mockService.EXPECT().DeleteList(mock.Anything, id).Return(nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusNoContent,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      nil,
@@ -626,10 +524,6 @@ func TestDeleteListHandler(t *testing.T) {
 				mockService.EXPECT().GetList(mock.Anything, id).Return(models.List{}, err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectRollback()
-			},
 			expectedStatusCode: http.StatusNotFound,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      err,
@@ -638,7 +532,7 @@ func TestDeleteListHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := list.NewHandler(mockService, db)
+			handler := list.NewHandler(mockService)
 
 			req, _ := http.NewRequest(http.MethodDelete, "/lists/delete/1", nil)
 			req.Header.Set("Content-Type", constants.ContentTypeJSON)
@@ -646,8 +540,6 @@ func TestDeleteListHandler(t *testing.T) {
 			w := httptest.NewRecorder()
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.DeleteList(w, req)
 			resp := w.Result()
 			defer func() {
@@ -667,17 +559,12 @@ func TestDeleteListHandler(t *testing.T) {
 				_ = json.NewDecoder(resp.Body).Decode(&respID)
 				assert.Equal(t, "", respID)
 			}
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
-			}
 		})
 	}
 }
 
 func TestListAllByUserIDHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	err = errors.New("error")
+	err := errors.New("error")
 	id := "1"
 	accessModel := models.Access{
 		ListID: id,
@@ -686,7 +573,6 @@ func TestListAllByUserIDHandler(t *testing.T) {
 	tests := []struct {
 		name               string
 		mockService        func() *automock.ListService
-		mockDatabase       func()
 		expectedStatusCode int
 		urlVars            map[string]string
 		expectedError      error
@@ -698,10 +584,6 @@ func TestListAllByUserIDHandler(t *testing.T) {
 				mockService.EXPECT().ListAllByUserID(mock.Anything, id).Return([]models.Access{accessModel}, nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusOK,
 			urlVars:            map[string]string{"user_id": id},
 			expectedError:      nil,
@@ -713,10 +595,6 @@ func TestListAllByUserIDHandler(t *testing.T) {
 				mockService.EXPECT().ListAllByUserID(mock.Anything, id).Return([]models.Access{}, err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectRollback()
-			},
 			expectedStatusCode: http.StatusNotFound,
 			urlVars:            map[string]string{"user_id": id},
 			expectedError:      err,
@@ -725,7 +603,7 @@ func TestListAllByUserIDHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := list.NewHandler(mockService, db)
+			handler := list.NewHandler(mockService)
 
 			req, _ := http.NewRequest(http.MethodGet, "/users/1/lists", nil)
 			req.Header.Set("Content-Type", constants.ContentTypeJSON)
@@ -734,8 +612,6 @@ func TestListAllByUserIDHandler(t *testing.T) {
 			req = mux.SetURLVars(req, tt.urlVars)
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.ListAllByUser(w, req)
 			resp := w.Result()
 			defer func() {
@@ -758,17 +634,12 @@ func TestListAllByUserIDHandler(t *testing.T) {
 				}
 				assert.JSONEq(t, string(expectedResponse), actualResponse.String())
 			}
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
-			}
 		})
 	}
 }
 
 func TestCreateAccessHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	err = errors.New("error")
+	err := errors.New("error")
 	modelInput := models.Access{
 		ListID: "1",
 		UserID: "user1",
@@ -784,7 +655,6 @@ func TestCreateAccessHandler(t *testing.T) {
 	tests := []struct {
 		name               string
 		mockService        func() *automock.ListService
-		mockDatabase       func()
 		expectedStatusCode int
 		expectedError      error
 	}{
@@ -795,10 +665,6 @@ func TestCreateAccessHandler(t *testing.T) {
 				mockService.EXPECT().CreateAccess(mock.Anything, modelInput).Return(model, nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusCreated,
 			expectedError:      nil,
 		},
@@ -809,10 +675,6 @@ func TestCreateAccessHandler(t *testing.T) {
 				mockService.EXPECT().CreateAccess(mock.Anything, modelInput).Return(models.Access{}, err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectRollback()
-			},
 			expectedStatusCode: http.StatusBadRequest,
 			expectedError:      err,
 		},
@@ -820,7 +682,7 @@ func TestCreateAccessHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := list.NewHandler(mockService, db)
+			handler := list.NewHandler(mockService)
 
 			body, _ := json.Marshal(modelInput)
 			req, _ := http.NewRequest(http.MethodPost, "/lists_access/create", bytes.NewBuffer(body))
@@ -828,8 +690,6 @@ func TestCreateAccessHandler(t *testing.T) {
 			w := httptest.NewRecorder()
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.CreateAccess(w, req)
 			resp := w.Result()
 			defer func() {
@@ -849,17 +709,12 @@ func TestCreateAccessHandler(t *testing.T) {
 				_ = json.NewDecoder(resp.Body).Decode(&respID)
 				assert.Equal(t, model, respID)
 			}
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
-			}
 		})
 	}
 }
 
 func TestGetAccessHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	err = errors.New("error")
+	err := errors.New("error")
 	listID := "listID"
 	userID := "userID"
 	model := models.Access{
@@ -870,7 +725,6 @@ func TestGetAccessHandler(t *testing.T) {
 	tests := []struct {
 		name               string
 		mockService        func() *automock.ListService
-		mockDatabase       func()
 		expectedStatusCode int
 		urlVars            map[string]string
 		expectedError      error
@@ -882,10 +736,6 @@ func TestGetAccessHandler(t *testing.T) {
 				mockService.EXPECT().GetAccess(mock.Anything, listID, userID).Return(model, nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusOK,
 			urlVars:            map[string]string{"list_id": listID, "user_id": userID},
 			expectedError:      nil,
@@ -897,10 +747,6 @@ func TestGetAccessHandler(t *testing.T) {
 				mockService.EXPECT().GetAccess(mock.Anything, listID, userID).Return(models.Access{}, err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectRollback()
-			},
 			expectedStatusCode: http.StatusNotFound,
 			urlVars:            map[string]string{"list_id": listID, "user_id": userID},
 			expectedError:      err,
@@ -909,7 +755,7 @@ func TestGetAccessHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := list.NewHandler(mockService, db)
+			handler := list.NewHandler(mockService)
 
 			req, _ := http.NewRequest(http.MethodGet, "/lists/listID/userID", nil)
 			req.Header.Set("Content-Type", constants.ContentTypeJSON)
@@ -917,8 +763,6 @@ func TestGetAccessHandler(t *testing.T) {
 			req = mux.SetURLVars(req, tt.urlVars)
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.GetAccess(w, req)
 			resp := w.Result()
 			defer func() {
@@ -941,24 +785,18 @@ func TestGetAccessHandler(t *testing.T) {
 				}
 				assert.JSONEq(t, string(expectedResponse), actualResponse.String())
 			}
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
-			}
 		})
 	}
 }
 
 func TestDeleteAccessHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	err = errors.New("error")
+	err := errors.New("error")
 	listID := "listID"
 	userID := "userID"
 
 	tests := []struct {
 		name               string
 		mockService        func() *automock.ListService
-		mockDatabase       func()
 		expectedStatusCode int
 		urlVars            map[string]string
 		expectedError      error
@@ -970,10 +808,6 @@ func TestDeleteAccessHandler(t *testing.T) {
 				mockService.EXPECT().DeleteAccess(mock.Anything, listID, userID).Return(nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusNoContent,
 			urlVars:            map[string]string{"list_id": listID, "user_id": userID},
 			expectedError:      nil,
@@ -985,10 +819,6 @@ func TestDeleteAccessHandler(t *testing.T) {
 				mockService.EXPECT().DeleteAccess(mock.Anything, listID, userID).Return(err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectRollback()
-			},
 			expectedStatusCode: http.StatusBadRequest,
 			urlVars:            map[string]string{"list_id": listID, "user_id": userID},
 			expectedError:      err,
@@ -997,7 +827,7 @@ func TestDeleteAccessHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := list.NewHandler(mockService, db)
+			handler := list.NewHandler(mockService)
 
 			req, _ := http.NewRequest(http.MethodDelete, "/lists_access/listID/userID", nil)
 			req.Header.Set("Content-Type", constants.ContentTypeJSON)
@@ -1005,8 +835,6 @@ func TestDeleteAccessHandler(t *testing.T) {
 			w := httptest.NewRecorder()
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.DeleteAccess(w, req)
 			resp := w.Result()
 			defer func() {
@@ -1026,9 +854,170 @@ func TestDeleteAccessHandler(t *testing.T) {
 				_ = json.NewDecoder(resp.Body).Decode(&respID)
 				assert.Equal(t, "", respID)
 			}
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
+		})
+	}
+}
+
+func TestMoveListHandler(t *testing.T) {
+	moved := models.Access{ListID: "1", UserID: "user1", Status: "owner", Position: "V"}
+
+	tests := []struct {
+		name               string
+		body               string
+		mockService        func() *automock.ListService
+		expectedStatusCode int
+	}{
+		{
+			name: "Move list after another list",
+			body: `{"after_id":"2"}`,
+			mockService: func() *automock.ListService {
+				mockService := &automock.ListService{}
+				mockService.EXPECT().MoveList(mock.Anything, "1", "user1", "", "2").Return(moved, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Error when the user has no access to the list",
+			body: `{"before_id":"2"}`,
+			mockService: func() *automock.ListService {
+				mockService := &automock.ListService{}
+				mockService.EXPECT().MoveList(mock.Anything, "1", "user1", "2", "").
+					Return(models.Access{}, fmt.Errorf("list 1 of user user1 not found: %w", pkg.ErrNotFound)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Error when no neighbour is given",
+			body: `{}`,
+			mockService: func() *automock.ListService {
+				return &automock.ListService{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := list.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodPost, "/lists/1/move", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", constants.ContentTypeJSON)
+			req = mux.SetURLVars(req, map[string]string{"id": "1"})
+			req = jwttest.WithUser(req, "user1", constants.Writer)
+			w := httptest.NewRecorder()
+
+			handler.MoveList(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			if tt.expectedStatusCode == http.StatusOK {
+				var got models.Access
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, moved, got)
+			}
+		})
+	}
+}
+
+func TestArchiveListHandler(t *testing.T) {
+	archivedAt := time.Date(2026, 10, 19, 16, 0, 0, 0, time.UTC)
+	archived := models.List{ID: "1", Name: "list", OwnerID: "user1", ArchivedAt: &archivedAt}
+
+	tests := []struct {
+		name               string
+		mockService        func() *automock.ListService
+		expectedStatusCode int
+	}{
+		{
+			name: "Archive list",
+			mockService: func() *automock.ListService {
+				mockService := &automock.ListService{}
+				mockService.EXPECT().ArchiveList(mock.Anything, "1").Return(archived, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Error when the list does not exist",
+			mockService: func() *automock.ListService {
+				mockService := &automock.ListService{}
+				mockService.EXPECT().ArchiveList(mock.Anything, "1").
+					Return(models.List{}, fmt.Errorf("list 1 not found: %w", pkg.ErrNotFound)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := list.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodPost, "/lists/1/archive", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": "1"})
+			w := httptest.NewRecorder()
+
+			handler.ArchiveList(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			if tt.expectedStatusCode == http.StatusOK {
+				var got models.List
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, archived.ID, got.ID)
+				require.NotNil(t, got.ArchivedAt)
+				assert.True(t, archivedAt.Equal(*got.ArchivedAt))
+			}
+		})
+	}
+}
+
+func TestGetListsByUserHandlerArchived(t *testing.T) {
+	archivedAt := time.Date(2026, 10, 19, 16, 0, 0, 0, time.UTC)
+	active := models.List{ID: "1", Name: "active", OwnerID: "user1"}
+	archived := models.List{ID: "2", Name: "archived", OwnerID: "user1", ArchivedAt: &archivedAt}
+
+	tests := []struct {
+		name        string
+		query       string
+		expectedIDs []string
+	}{
+		{
+			name:        "Archived lists are left out by default",
+			expectedIDs: []string{"1"},
+		},
+		{
+			name:        "Archived lists are returned with include_archived",
+			query:       "?include_archived=true",
+			expectedIDs: []string{"1", "2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := &automock.ListService{}
+			mockService.EXPECT().ListAllByUserID(mock.Anything, "user1").
+				Return([]models.Access{{ListID: "1", UserID: "user1"}, {ListID: "2", UserID: "user1"}}, nil).Once()
+			mockService.EXPECT().GetList(mock.Anything, "1").Return(active, nil).Once()
+			mockService.EXPECT().GetList(mock.Anything, "2").Return(archived, nil).Once()
+			handler := list.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodGet, "/lists/user"+tt.query, nil)
+			req = jwttest.WithUser(req, "user1", constants.Writer)
+			w := httptest.NewRecorder()
+
+			handler.GetListsByUser(w, req)
+
+			assert.Equal(t, http.StatusOK, w.Code)
+			var got []models.List
+			require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+			ids := make([]string, 0, len(got))
+			for _, l := range got {
+				ids = append(ids, l.ID)
 			}
+			assert.Equal(t, tt.expectedIDs, ids)
 		})
 	}
 }
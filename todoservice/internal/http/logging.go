@@ -0,0 +1,71 @@
+package http
+
+import (
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/problem"
+	"github.com/google/uuid"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/otel/trace"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+)
+
+var requestIDPattern = regexp.MustCompile(`^[a-zA-Z0-9._-]{1,128}$`)
+
+// HandleRequestLogging assigns every request an X-Request-ID, keeping the one sent by the caller when it is well formed,
+// adds request scoped fields to the context logger and writes one access log line per request.
+func HandleRequestLogging(nextHandler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+
+		requestID := r.Header.Get(problem.RequestIDHeader)
+		if !requestIDPattern.MatchString(requestID) {
+			requestID = uuid.New().String()
+			r.Header.Set(problem.RequestIDHeader, requestID)
+		}
+		w.Header().Set(problem.RequestIDHeader, requestID)
+
+		route := routeTemplate(r)
+		fields := resourceFields(route, mux.Vars(r))
+		fields["request_id"] = requestID
+		fields["method"] = r.Method
+		fields["route"] = route
+		if spanContext := trace.SpanContextFromContext(r.Context()); spanContext.IsValid() {
+			fields["trace_id"] = spanContext.TraceID().String()
+		}
+		ctx := log.WithFields(r.Context(), fields)
+
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		nextHandler.ServeHTTP(recorder, r.WithContext(ctx))
+
+		log.C(ctx).WithFields(logrus.Fields{
+			"status":      recorder.status,
+			"duration_ms": time.Since(start).Milliseconds(),
+		}).Info("request completed")
+	})
+}
+
+// resourceFields names the ids found in the route after the resource they belong to.
+func resourceFields(route string, vars map[string]string) logrus.Fields {
+	fields := logrus.Fields{}
+	if listID, ok := vars["list_id"]; ok {
+		fields["list_id"] = listID
+	}
+	if userID, ok := vars["user_id"]; ok {
+		fields["subject_user_id"] = userID
+	}
+	if id, ok := vars["id"]; ok {
+		switch {
+		case strings.HasPrefix(route, "/lists"):
+			fields["list_id"] = id
+		case strings.HasPrefix(route, "/todos"):
+			fields["todo_id"] = id
+		case strings.HasPrefix(route, "/users"):
+			fields["subject_user_id"] = id
+		}
+	}
+	return fields
+}
@@ -0,0 +1,80 @@
+package http_test
+
+import (
+	http2 "github.com/Victor-Uzunov/devops-project/todoservice/internal/http"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/problem"
+	"github.com/gorilla/mux"
+	"github.com/sirupsen/logrus/hooks/test"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestHandleRequestLogging(t *testing.T) {
+	tests := []struct {
+		name              string
+		requestID         string
+		expectGenerated   bool
+		expectedRequestID string
+	}{
+		{
+			name:              "Propagates request id",
+			requestID:         "req-123",
+			expectedRequestID: "req-123",
+		},
+		{
+			name:            "Generates missing request id",
+			expectGenerated: true,
+		},
+		{
+			name:            "Replaces malformed request id",
+			requestID:       "bad id\nwith newline",
+			expectGenerated: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			hook := test.NewGlobal()
+
+			var handlerRequestID string
+			router := mux.NewRouter()
+			router.Use(http2.HandleRequestLogging)
+			router.HandleFunc("/todos/{id:[a-zA-Z0-9-]+}", func(w http.ResponseWriter, r *http.Request) {
+				handlerRequestID = r.Header.Get(problem.RequestIDHeader)
+				log.C(r.Context()).Info("handler called")
+				w.WriteHeader(http.StatusAccepted)
+			}).Methods(http.MethodGet)
+
+			req := httptest.NewRequest(http.MethodGet, "/todos/42", nil)
+			if tt.requestID != "" {
+				req.Header.Set(problem.RequestIDHeader, tt.requestID)
+			}
+			rr := httptest.NewRecorder()
+			router.ServeHTTP(rr, req)
+
+			requestID := rr.Header().Get(problem.RequestIDHeader)
+			if tt.expectGenerated {
+				assert.Len(t, requestID, 36)
+			} else {
+				assert.Equal(t, tt.expectedRequestID, requestID)
+			}
+			assert.Equal(t, requestID, handlerRequestID)
+
+			entries := hook.AllEntries()
+			require.Len(t, entries, 2)
+			for _, entry := range entries {
+				assert.Equal(t, requestID, entry.Data["request_id"])
+				assert.Equal(t, "/todos/{id:[a-zA-Z0-9-]+}", entry.Data["route"])
+				assert.Equal(t, "42", entry.Data["todo_id"])
+			}
+			accessLog := entries[1]
+			assert.Equal(t, "request completed", accessLog.Message)
+			assert.Equal(t, http.StatusAccepted, accessLog.Data["status"])
+			assert.Contains(t, accessLog.Data, "duration_ms")
+		})
+	}
+}
@@ -0,0 +1,50 @@
+package http
+
+import (
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+var (
+	requestsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "todoservice_http_requests_total",
+		Help: "Number of HTTP requests by route template, method and status code.",
+	}, []string{"route", "method", "status"})
+
+	requestDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "todoservice_http_request_duration_seconds",
+		Help:    "Latency of HTTP requests by route template, method and status code.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"route", "method", "status"})
+)
+
+// HandleMetrics records the request count and latency of every matched route.
+func HandleMetrics(nextHandler http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
+		recorder := &statusRecorder{ResponseWriter: w, status: http.StatusOK}
+		nextHandler.ServeHTTP(recorder, r)
+
+		labels := prometheus.Labels{
+			"route":  routeTemplate(r),
+			"method": r.Method,
+			"status": strconv.Itoa(recorder.status),
+		}
+		requestsTotal.With(labels).Inc()
+		requestDuration.With(labels).Observe(time.Since(start).Seconds())
+	})
+}
+
+// routeTemplate returns the template of the matched route, falling back to the raw path.
+func routeTemplate(r *http.Request) string {
+	if current := mux.CurrentRoute(r); current != nil {
+		if template, err := current.GetPathTemplate(); err == nil {
+			return template
+		}
+	}
+	return r.URL.Path
+}
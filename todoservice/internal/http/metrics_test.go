@@ -0,0 +1,32 @@
+package http_test
+
+import (
+	http2 "github.com/Victor-Uzunov/devops-project/todoservice/internal/http"
+	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestHandleMetrics(t *testing.T) {
+	router := mux.NewRouter()
+	router.Use(http2.HandleMetrics)
+	router.HandleFunc("/todos/{id:[a-zA-Z0-9-]+}", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusNotFound)
+	}).Methods(http.MethodGet)
+
+	for _, id := range []string{"1", "2"} {
+		router.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodGet, "/todos/"+id, nil))
+	}
+
+	expected := `
+# HELP todoservice_http_requests_total Number of HTTP requests by route template, method and status code.
+# TYPE todoservice_http_requests_total counter
+todoservice_http_requests_total{method="GET",route="/todos/{id:[a-zA-Z0-9-]+}",status="404"} 2
+`
+	assert.NoError(t, testutil.GatherAndCompare(prometheus.DefaultGatherer, strings.NewReader(expected), "todoservice_http_requests_total"))
+}
@@ -4,7 +4,7 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
-	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"fmt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/lists"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/todos"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/users"
@@ -13,8 +13,8 @@ import (
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/problem"
 	"github.com/gorilla/mux"
-	"github.com/jmoiron/sqlx"
 	"github.com/sirupsen/logrus"
 	"io"
 	"net/http"
@@ -32,52 +32,29 @@ type Middleware struct {
 	listService lists.ListService
 	todoService todos.TodoService
 	tokenParser *jwt.TokenParser
-	database    *sqlx.DB
 }
 
-func NewMiddleware(userService users.UserService, listService lists.ListService, todoService todos.TodoService, tokenParser *jwt.TokenParser, database *sqlx.DB) Middlewares {
+func NewMiddleware(userService users.UserService, listService lists.ListService, todoService todos.TodoService, tokenParser *jwt.TokenParser) Middlewares {
 	return &Middleware{
 		userService: userService,
 		listService: listService,
 		todoService: todoService,
 		tokenParser: tokenParser,
-		database:    database,
 	}
 }
 
-func setCORSHeaders(w http.ResponseWriter) {
-	w.Header().Set("Access-Control-Allow-Origin", "http://localhost:4000")
-	w.Header().Set("Access-Control-Allow-Methods", "GET, POST, PUT, DELETE, OPTIONS")
-	w.Header().Set("Access-Control-Allow-Headers", "Content-Type, Authorization")
-	w.Header().Set("Access-Control-Allow-Credentials", "true")
-}
-
-func HandlePreflight(nextHandler http.Handler) http.Handler {
-	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		setCORSHeaders(w)
-		log.C(r.Context()).Debugf("setCORSHeaders successfully")
-		if r.Method == http.MethodOptions {
-			logrus.Debug("Setting CORS Headers")
-			w.WriteHeader(http.StatusOK)
-			return
-		}
-
-		nextHandler.ServeHTTP(w, r)
-	})
-}
-
 func (m *Middleware) Protected(next http.Handler, neededRole constants.Role, accessibility constants.Accessibility) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		log.C(r.Context()).Info("Protected middleware")
-		claim, ok := r.Context().Value("user").(*jwt.Claims)
+		claim, ok := jwt.ClaimsFromContext(r.Context())
 		if !ok {
-			http.Error(w, "there is no user claim in the context", http.StatusUnauthorized)
+			problem.Write(w, r, http.StatusUnauthorized, "there is no user claim in the context")
 			return
 		}
 		log.C(r.Context()).Debugf("the email and the role of the user are: %s, %s", claim.Email, claim.Role)
 		if constants.RolePower(neededRole) > constants.RolePower(pkg.StringToRole(claim.Role)) {
 			log.C(r.Context()).Errorf("user`s role is %v, but the needed role is %v", claim.Role, neededRole)
-			http.Error(w, http.StatusText(http.StatusForbidden)+"you do not have the needed role for this action", http.StatusForbidden)
+			problem.Write(w, r, http.StatusForbidden, http.StatusText(http.StatusForbidden)+"you do not have the needed role for this action")
 			return
 		}
 
@@ -85,14 +62,18 @@ func (m *Middleware) Protected(next http.Handler, neededRole constants.Role, acc
 		case constants.IsOwner:
 			m.isOwner(w, r, next)
 		case constants.HasAccessTodo:
-			m.hasTodoAccess(w, r, next)
+			m.hasTodoAccess(w, r, next, neededRole)
 		case constants.HasAccessList:
-			m.hasListAccess(w, r, next)
+			m.hasListAccess(w, r, next, false)
+		case constants.HasAccessWritableList:
+			m.hasListAccess(w, r, next, true)
+		case constants.HasAccessLists:
+			m.hasListsAccess(w, r, next)
 		case constants.NoRestriction:
 			next.ServeHTTP(w, r)
 		default:
 			log.C(r.Context()).Errorf("accessibility `%s` is not valid one", accessibility)
-			http.Error(w, http.StatusText(http.StatusInternalServerError)+"not a valid accessibility type", http.StatusInternalServerError)
+			problem.Write(w, r, http.StatusInternalServerError, http.StatusText(http.StatusInternalServerError)+"not a valid accessibility type")
 			return
 		}
 	})
@@ -112,7 +93,7 @@ func (m *Middleware) isOwner(w http.ResponseWriter, r *http.Request, next http.H
 	bodyBytes, err := io.ReadAll(r.Body)
 	if err != nil {
 		log.C(ctx).Errorf("cannot read request body: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 	r.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
@@ -121,7 +102,7 @@ func (m *Middleware) isOwner(w http.ResponseWriter, r *http.Request, next http.H
 		var list models.List
 		if err := json.Unmarshal(bodyBytes, &list); err != nil {
 			log.C(ctx).Errorf("cannot get list from the body in hasAccess middleware: %v", err)
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			problem.Write(w, r, http.StatusInternalServerError, err.Error())
 			return
 		}
 		id = list.ID
@@ -136,26 +117,16 @@ func (m *Middleware) isOwner(w http.ResponseWriter, r *http.Request, next http.H
 		return
 	} else if user == nil {
 		log.C(ctx).Error("error: there is no user in the context")
-		http.Error(w, "there is no user in the context", http.StatusUnauthorized)
+		problem.Write(w, r, http.StatusUnauthorized, "there is no user in the context")
 		return
 	} else {
 		claim = user
 	}
 	log.C(ctx).Debugf("the email and role are: %s, %s", claim.Email, claim.Role)
 
-	tx, err := m.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(ctx).Errorf("isOwner middleware transaction failed: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
 	user, err := m.userService.GetUserByEmail(ctx, claim.Email)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		problem.Write(w, r, http.StatusUnauthorized, err.Error())
 		return
 	}
 
@@ -163,7 +134,7 @@ func (m *Middleware) isOwner(w http.ResponseWriter, r *http.Request, next http.H
 		list, err := m.listService.GetList(ctx, id)
 		if err != nil {
 			log.C(r.Context()).Errorf("middleware cannot get list: %v", err)
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			problem.Write(w, r, http.StatusBadRequest, err.Error())
 			return
 		}
 		ownerID = list.OwnerID
@@ -171,19 +142,15 @@ func (m *Middleware) isOwner(w http.ResponseWriter, r *http.Request, next http.H
 
 	if user.ID != ownerID {
 		log.C(ctx).Errorf("userID: %s is not owner of the listID: %s, ownnerID is: %s", user.ID, id, ownerID)
-		http.Error(w, http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
-		return
-	}
-	err = tx.Commit()
-	if err != nil {
-		log.C(ctx).Errorf("JWTMiddleware transaction failed to commit: %v", err)
-		http.Error(w, http.StatusText(http.StatusInternalServerError)+" error committing the transaction", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusUnauthorized, http.StatusText(http.StatusUnauthorized))
 		return
 	}
 	next.ServeHTTP(w, r)
 }
 
-func (m *Middleware) hasListAccess(w http.ResponseWriter, r *http.Request, next http.Handler) {
+// hasListAccess lets the user through when the list is one of their lists. When writable is set, changes to
+// the list are rejected for everyone, admins included, while it is archived.
+func (m *Middleware) hasListAccess(w http.ResponseWriter, r *http.Request, next http.Handler, writable bool) {
 	ctx := r.Context()
 	log.C(ctx).Info("has access in list middleware")
 	vars := mux.Vars(r)
@@ -195,87 +162,112 @@ func (m *Middleware) hasListAccess(w http.ResponseWriter, r *http.Request, next
 	var claim *jwt.Claims
 	if user, isAdmin := authorizeAdmin(r); isAdmin {
 		log.C(ctx).Debugf("user is admin: %v", claim)
+		if writable && !m.isWritable(w, r, id) {
+			return
+		}
 		next.ServeHTTP(w, r)
 		return
 	} else if user == nil {
 		log.C(ctx).Debug("there is no user in the context")
-		http.Error(w, "there is no user in the context", http.StatusUnauthorized)
+		problem.Write(w, r, http.StatusUnauthorized, "there is no user in the context")
 		return
 	} else {
 		claim = user
 	}
 	log.C(ctx).Debugf("the email and role are: %s, %s", claim.Email, claim.Role)
 
-	tx, err := m.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(ctx).Errorf("isOwner middleware transaction failed: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
 	user, err := m.userService.GetUserByEmail(ctx, claim.Email)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		problem.Write(w, r, http.StatusUnauthorized, err.Error())
 		return
 	}
-	listsAccess, err := m.listService.ListAllByUserID(ctx, user.ID)
+	accessible, err := m.accessibleListIDs(ctx, user.ID)
 	if err != nil {
-		log.C(r.Context()).Errorf("middleware cannot get all lists for a user: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		log.C(r.Context()).Errorf("middleware cannot get lists for a user: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
-	listsPending, err := m.listService.GetPendingLists(ctx, user.ID)
-	if err != nil {
-		log.C(r.Context()).Errorf("middleware cannot get pending lists for a user: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+	if _, ok := accessible[id]; !ok {
+		log.C(ctx).Errorf("user do not have access for list with ID: %s", id)
+		problem.Write(w, r, http.StatusForbidden, http.StatusText(http.StatusForbidden))
 		return
 	}
-	listsAccepted, err := m.listService.GetAcceptedLists(ctx, user.ID)
-	if err != nil {
-		log.C(r.Context()).Errorf("middleware cannot get accepted lists for a user: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+	if writable && !m.isWritable(w, r, id) {
 		return
 	}
+	next.ServeHTTP(w, r)
 
-	hasAccess := false
-	for _, access := range listsAccess {
-		if access.ListID == id {
-			hasAccess = true
-			break
-		}
-	}
-	for _, access := range listsPending {
-		if access.ListID == id {
-			hasAccess = true
-			break
-		}
+}
+
+func (m *Middleware) hasListsAccess(w http.ResponseWriter, r *http.Request, next http.Handler) {
+	ctx := r.Context()
+	log.C(ctx).Info("has access in lists middleware")
+	ids := pkg.SplitIDs(r.URL.Query().Get("ids"))
+	if len(ids) == 0 {
+		ids = pkg.SplitIDs(r.URL.Query().Get("list_ids"))
 	}
-	for _, access := range listsAccepted {
-		if access.ListID == id {
-			hasAccess = true
-			break
-		}
+
+	var claim *jwt.Claims
+	if user, isAdmin := authorizeAdmin(r); isAdmin {
+		log.C(ctx).Debugf("user is admin: %v", claim)
+		next.ServeHTTP(w, r)
+		return
+	} else if user == nil {
+		log.C(ctx).Debug("there is no user in the context")
+		problem.Write(w, r, http.StatusUnauthorized, "there is no user in the context")
+		return
+	} else {
+		claim = user
 	}
 
-	if !hasAccess {
-		log.C(ctx).Errorf("user do not have access for list with ID: %s", id)
-		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+	user, err := m.userService.GetUserByEmail(ctx, claim.Email)
+	if err != nil {
+		problem.Write(w, r, http.StatusUnauthorized, err.Error())
 		return
 	}
-	err = tx.Commit()
+	accessible, err := m.accessibleListIDs(ctx, user.ID)
 	if err != nil {
-		log.C(ctx).Errorf("JWTMiddleware transaction failed to commit: %v", err)
-		http.Error(w, http.StatusText(http.StatusInternalServerError)+" error committing the transaction", http.StatusInternalServerError)
+		log.C(r.Context()).Errorf("middleware cannot get lists for a user: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
+
+	for _, id := range ids {
+		if _, ok := accessible[id]; !ok {
+			log.C(ctx).Errorf("user do not have access for list with ID: %s", id)
+			problem.Write(w, r, http.StatusForbidden, http.StatusText(http.StatusForbidden))
+			return
+		}
+	}
 	next.ServeHTTP(w, r)
+}
 
+func (m *Middleware) accessibleListIDs(ctx context.Context, userID string) (map[string]struct{}, error) {
+	listsAccess, err := m.listService.ListAllByUserID(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get all lists for a user: %w", err)
+	}
+	listsPending, err := m.listService.GetPendingLists(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get pending lists for a user: %w", err)
+	}
+	listsAccepted, err := m.listService.GetAcceptedLists(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get accepted lists for a user: %w", err)
+	}
+
+	accessible := make(map[string]struct{})
+	for _, accesses := range [][]models.Access{listsAccess, listsPending, listsAccepted} {
+		for _, access := range accesses {
+			accessible[access.ListID] = struct{}{}
+		}
+	}
+	return accessible, nil
 }
 
-func (m *Middleware) hasTodoAccess(w http.ResponseWriter, r *http.Request, next http.Handler) {
+// hasTodoAccess lets the user through when the todo belongs to one of their lists. Once the user is known to
+// have access, writes are rejected for everyone, admins included, while the list of the todo is archived.
+func (m *Middleware) hasTodoAccess(w http.ResponseWriter, r *http.Request, next http.Handler, neededRole constants.Role) {
 	ctx := r.Context()
 	log.C(ctx).Info("has access in todo middleware")
 	vars := mux.Vars(r)
@@ -287,7 +279,7 @@ func (m *Middleware) hasTodoAccess(w http.ResponseWriter, r *http.Request, next
 	bodyBytes, err := io.ReadAll(r.Body)
 	if err != nil {
 		log.C(ctx).Errorf("cannot read request body: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 	r.Body = io.NopCloser(bytes.NewBuffer(bodyBytes))
@@ -295,52 +287,58 @@ func (m *Middleware) hasTodoAccess(w http.ResponseWriter, r *http.Request, next
 	if id == "" {
 		if err := json.Unmarshal(bodyBytes, &todo); err != nil {
 			log.C(ctx).Errorf("cannot get todo from the body in hasAccess middleware: %v", err)
-			http.Error(w, err.Error(), http.StatusInternalServerError)
+			problem.Write(w, r, http.StatusInternalServerError, err.Error())
 			return
 		}
 		id = todo.ID
 		listID = todo.ListID
 		getTodo = false
 	}
+	writes := constants.RolePower(neededRole) >= constants.RolePower(constants.Writer)
 	var claim *jwt.Claims
 	if user, isAdmin := authorizeAdmin(r); isAdmin {
 		log.C(ctx).Debugf("user is admin: %v", claim)
+		if writes {
+			if getTodo {
+				todo, err = m.todoService.GetTodo(ctx, id)
+				if err != nil {
+					log.C(ctx).Errorf("middleware cannot get todo for a user: %v", err)
+					problem.Write(w, r, http.StatusBadRequest, err.Error())
+					return
+				}
+				listID = todo.ListID
+			}
+			if !m.isWritable(w, r, listID) {
+				return
+			}
+		}
 		next.ServeHTTP(w, r)
 		return
 	} else if user == nil {
 		log.C(ctx).Debug("there is no user in the context")
-		http.Error(w, "there is no user in the context", http.StatusUnauthorized)
+		problem.Write(w, r, http.StatusUnauthorized, "there is no user in the context")
 		return
 	} else {
 		claim = user
 	}
 	log.C(ctx).Debugf("the email and role are: %s, %s", claim.Email, claim.Role)
 
-	tx, err := m.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(ctx).Errorf("isOwner middleware transaction failed: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
 	log.C(ctx).Debugf("the email and role are: %s", claim.Email)
 	user, err := m.userService.GetUserByEmail(ctx, claim.Email)
 	if err != nil {
-		http.Error(w, err.Error(), http.StatusUnauthorized)
+		problem.Write(w, r, http.StatusUnauthorized, err.Error())
 		return
 	}
 	listsAccess, err := m.listService.ListAllByUserID(ctx, user.ID)
 	if err != nil {
 		log.C(ctx).Errorf("middleware cannot get all lists for a user: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 	listsAccepted, err := m.listService.GetAcceptedLists(ctx, user.ID)
 	if err != nil {
 		log.C(r.Context()).Errorf("middleware cannot get accepted lists for a user: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 
@@ -348,7 +346,7 @@ func (m *Middleware) hasTodoAccess(w http.ResponseWriter, r *http.Request, next
 		todo, err = m.todoService.GetTodo(ctx, id)
 		if err != nil {
 			log.C(ctx).Errorf("middleware cannot get todo for a user: %v", err)
-			http.Error(w, err.Error(), http.StatusBadRequest)
+			problem.Write(w, r, http.StatusBadRequest, err.Error())
 			return
 		}
 		listID = todo.ListID
@@ -368,13 +366,10 @@ func (m *Middleware) hasTodoAccess(w http.ResponseWriter, r *http.Request, next
 		}
 	}
 	if !hasAccess {
-		http.Error(w, http.StatusText(http.StatusForbidden), http.StatusForbidden)
+		problem.Write(w, r, http.StatusForbidden, http.StatusText(http.StatusForbidden))
 		return
 	}
-	err = tx.Commit()
-	if err != nil {
-		log.C(ctx).Errorf("JWTMiddleware transaction failed to commit: %v", err)
-		http.Error(w, http.StatusText(http.StatusInternalServerError)+" error committing the transaction", http.StatusInternalServerError)
+	if writes && !m.isWritable(w, r, listID) {
 		return
 	}
 	next.ServeHTTP(w, r.WithContext(ctx))
@@ -397,18 +392,18 @@ func (m *Middleware) JWTMiddleware(next http.Handler) http.Handler {
 		claim, err := m.tokenParser.ParseJWT(token)
 		if err != nil {
 			log.C(ctx).Errorf("error parsing token: %v", err)
-			http.Error(w, "error while parsing the token: "+http.StatusText(http.StatusUnauthorized), http.StatusUnauthorized)
+			problem.Write(w, r, http.StatusUnauthorized, "error while parsing the token: "+http.StatusText(http.StatusUnauthorized))
 			return
 		}
 
-		ctx = context.WithValue(ctx, "user", claim)
-		ctx = context.WithValue(ctx, "user_id", claim.ID)
+		ctx = jwt.WithClaims(ctx, claim)
+		ctx = log.WithFields(ctx, logrus.Fields{"user_id": claim.ID})
 		next.ServeHTTP(w, r.WithContext(ctx))
 	})
 }
 
 func authorizeAdmin(r *http.Request) (*jwt.Claims, bool) {
-	claim, ok := r.Context().Value("user").(*jwt.Claims)
+	claim, ok := jwt.ClaimsFromContext(r.Context())
 	if !ok {
 		return nil, false
 	}
@@ -419,3 +414,19 @@ func authorizeAdmin(r *http.Request) (*jwt.Claims, bool) {
 
 	return claim, false
 }
+
+// isWritable writes a conflict and reports false when the list is archived.
+func (m *Middleware) isWritable(w http.ResponseWriter, r *http.Request, listID string) bool {
+	list, err := m.listService.GetList(r.Context(), listID)
+	if err != nil {
+		log.C(r.Context()).Errorf("middleware cannot get list %s: %v", listID, err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusBadRequest), err.Error())
+		return false
+	}
+	if list.ArchivedAt != nil {
+		log.C(r.Context()).Errorf("list %s is archived", listID)
+		problem.Write(w, r, http.StatusConflict, "list is archived and read-only")
+		return false
+	}
+	return true
+}
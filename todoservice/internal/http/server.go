@@ -1,71 +1,131 @@
 package http
 
 import (
+	"context"
+	"fmt"
+	attachmentdomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/attachments"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/blob"
+	commentdomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/comments"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http/attachment"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http/comment"
 	httplist "github.com/Victor-Uzunov/devops-project/todoservice/internal/http/list"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http/status"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http/tag"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http/template"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http/todo"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http/user"
 	listsdomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/lists"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/oauth2"
+	statusdomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses"
+	tagdomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/tags"
+	templatedomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/templates"
 	tododomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/todos"
 	userdomain "github.com/Victor-Uzunov/devops-project/todoservice/internal/users"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/health"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/httpserver"
 	token "github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt"
-	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/time"
+	timeutil "github.com/Victor-Uzunov/devops-project/todoservice/pkg/time"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/uid"
 	"github.com/gorilla/mux"
 	"github.com/jmoiron/sqlx"
-	"github.com/rs/cors"
-	"log"
+	"net"
 	"net/http"
 )
 
+// defaultAddress is used when APP_SERVER_ADDRESS is not set.
+const defaultAddress = ":5000"
+
 type Server struct {
-	ListHandler   *httplist.Handler
-	TodoHandler   *todo.Handler
-	UserHandler   *user.Handler
-	Oauth2Handler *oauth2.Handler
-	Middleware    Middlewares
+	ListHandler       *httplist.Handler
+	TodoHandler       *todo.Handler
+	TagHandler        *tag.Handler
+	StatusHandler     *status.Handler
+	CommentHandler    *comment.Handler
+	AttachmentHandler *attachment.Handler
+	TemplateHandler   *template.Handler
+	UserHandler       *user.Handler
+	Oauth2Handler     *oauth2.Handler
+	Middleware        Middlewares
+	UnitOfWork        *UnitOfWork
+	Health            *health.Health
+	BlobSweeper       *attachmentdomain.BlobSweeper
 }
 
-func NewServer(db *sqlx.DB, config token.ConfigOAuth2) *Server {
+func NewServer(database *sqlx.DB, config token.ConfigOAuth2, healthChecks *health.Health, txConfig db.TransactionConfig, maxBodyBytes int64, store blob.BlobStore, attachmentConfig attachmentdomain.Config, todoConfig tododomain.Config) *Server {
 	listRepo := listsdomain.NewSQLXListRepository()
 	todoRepo := tododomain.NewSQLXTodoRepository()
 	userRepo := userdomain.NewSQLXUserRepository()
+	tagRepo := tagdomain.NewSQLXTagRepository()
+	statusRepo := statusdomain.NewSQLXStatusRepository()
+	commentRepo := commentdomain.NewSQLXCommentRepository()
+	attachmentRepo := attachmentdomain.NewSQLXAttachmentRepository()
+	templateRepo := templatedomain.NewSQLXTemplateRepository()
 
 	uuidServer := uid.NewService()
-	timeServer := time.Time{}
+	timeServer := timeutil.Time{}
 
 	listService := listsdomain.NewService(listRepo, uuidServer, timeServer)
-	todoService := tododomain.NewService(todoRepo, uuidServer, timeServer)
+	todoService := tododomain.NewService(todoRepo, statusRepo, listRepo, uuidServer, timeServer, todoConfig)
 	userService := userdomain.NewService(userRepo, uuidServer, timeServer)
-
-	listHandler := httplist.NewHandler(listService, db)
-	todoHandler := todo.NewHandler(todoService, db)
-	userHandler := user.NewHandler(userService, db)
-
-	oauth2Handler := oauth2.NewOAuth2(config, userService, db)
+	tagService := tagdomain.NewService(tagRepo, uuidServer)
+	statusService := statusdomain.NewService(statusRepo, uuidServer)
+	commentService := commentdomain.NewService(commentRepo, uuidServer)
+	attachmentService := attachmentdomain.NewService(attachmentRepo, store, uuidServer, attachmentConfig)
+	templateService := templatedomain.NewService(templateRepo, listRepo, statusRepo, tagRepo, todoRepo, uuidServer, timeServer)
+
+	listHandler := httplist.NewHandler(listService)
+	todoHandler := todo.NewHandler(todoService)
+	userHandler := user.NewHandler(userService)
+	tagHandler := tag.NewHandler(tagService)
+	statusHandler := status.NewHandler(statusService)
+	commentHandler := comment.NewHandler(commentService)
+	attachmentHandler := attachment.NewHandler(attachmentService, attachmentConfig.MaxSize)
+	templateHandler := template.NewHandler(templateService)
+
+	oauth2Handler := oauth2.NewOAuth2(config, userService, database)
 	tokenParser := token.NewTokenParser(config)
-	middleware := NewMiddleware(userService, listService, todoService, tokenParser, db)
+	middleware := NewMiddleware(userService, listService, todoService, tokenParser)
 
 	return &Server{
-		ListHandler:   listHandler,
-		TodoHandler:   todoHandler,
-		UserHandler:   userHandler,
-		Oauth2Handler: oauth2Handler,
-		Middleware:    middleware,
+		ListHandler:       listHandler,
+		TodoHandler:       todoHandler,
+		TagHandler:        tagHandler,
+		StatusHandler:     statusHandler,
+		CommentHandler:    commentHandler,
+		AttachmentHandler: attachmentHandler,
+		TemplateHandler:   templateHandler,
+		UserHandler:       userHandler,
+		Oauth2Handler:     oauth2Handler,
+		Middleware:        middleware,
+		UnitOfWork:        NewUnitOfWork(database, txConfig, maxBodyBytes),
+		Health:            healthChecks,
+		BlobSweeper:       attachmentdomain.NewBlobSweeper(database, attachmentService, txConfig, attachmentConfig),
 	}
 }
 
-func NewServerWithServices(db *sqlx.DB, listService listsdomain.ListService, todoService tododomain.TodoService, userService userdomain.UserService, middleware Middlewares) *Server {
-	listHandler := httplist.NewHandler(listService, db)
-	todoHandler := todo.NewHandler(todoService, db)
-	userHandler := user.NewHandler(userService, db)
+func NewServerWithServices(database *sqlx.DB, listService listsdomain.ListService, todoService tododomain.TodoService, userService userdomain.UserService, tagService tagdomain.TagService, statusService statusdomain.StatusService, commentService commentdomain.CommentService, attachmentService attachmentdomain.AttachmentService, templateService templatedomain.TemplateService, middleware Middlewares) *Server {
+	listHandler := httplist.NewHandler(listService)
+	todoHandler := todo.NewHandler(todoService)
+	userHandler := user.NewHandler(userService)
+	tagHandler := tag.NewHandler(tagService)
+	statusHandler := status.NewHandler(statusService)
+	commentHandler := comment.NewHandler(commentService)
+	attachmentHandler := attachment.NewHandler(attachmentService, attachmentdomain.DefaultMaxSize)
+	templateHandler := template.NewHandler(templateService)
 
 	return &Server{
-		ListHandler: listHandler,
-		TodoHandler: todoHandler,
-		UserHandler: userHandler,
-		Middleware:  middleware,
+		ListHandler:       listHandler,
+		TodoHandler:       todoHandler,
+		TagHandler:        tagHandler,
+		StatusHandler:     statusHandler,
+		CommentHandler:    commentHandler,
+		AttachmentHandler: attachmentHandler,
+		TemplateHandler:   templateHandler,
+		UserHandler:       userHandler,
+		Middleware:        middleware,
+		UnitOfWork:        NewUnitOfWork(database, db.TransactionConfig{MaxAttempts: 1}, httpserver.DefaultMaxBodyBytes),
 	}
 }
 
@@ -75,42 +135,86 @@ func (s *Server) RegisterRoutes(router *mux.Router) {
 	loginRouter.HandleFunc("/github", s.Oauth2Handler.GithubLoginHandler).Methods(http.MethodGet)
 	loginRouter.HandleFunc("/github/callback", s.Oauth2Handler.GithubCallbackHandler).Methods(http.MethodGet)
 	loginRouter.HandleFunc("/refresh-token", s.Oauth2Handler.RefreshTokenHandler).Methods(http.MethodGet)
-	loginRouter.HandleFunc("/logout", s.UserHandler.Logout).Methods(http.MethodPost)
+	loginRouter.Handle("/logout", s.UnitOfWork.Handle(http.HandlerFunc(s.UserHandler.Logout))).Methods(http.MethodPost)
+
+	// Attachments are streamed, so they run in a unit of work that neither buffers the request body nor retries.
+	streamingRouter := router.PathPrefix("").Subrouter()
+	streamingRouter.Use(s.Middleware.JWTMiddleware, s.UnitOfWork.HandleStreaming)
+	streamingRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/attachments", s.Middleware.Protected(http.HandlerFunc(s.AttachmentHandler.ListAttachments), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodGet)
+	streamingRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/attachments", s.Middleware.Protected(http.HandlerFunc(s.AttachmentHandler.UploadAttachment), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPost)
+	streamingRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/attachments/{attachment_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.AttachmentHandler.DownloadAttachment), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodGet, http.MethodHead)
+	streamingRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/attachments/{attachment_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.AttachmentHandler.DeleteAttachment), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodDelete)
 
 	protectedRouter := router.PathPrefix("").Subrouter()
-	protectedRouter.Use(s.Middleware.JWTMiddleware)
+	protectedRouter.Use(s.Middleware.JWTMiddleware, s.UnitOfWork.Handle)
 	protectedRouter.Handle("/lists/create", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.CreateList), constants.Writer, constants.NoRestriction)).Methods(http.MethodPost)
 	protectedRouter.Handle("/lists_access/create/{list_id:[a-zA-Z0-9-]+}/{user_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.CreateAccess), constants.Reader, constants.HasAccessList)).Methods(http.MethodPost)
 	protectedRouter.Handle("/lists_access/list/{list_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.GetAccessesByListID), constants.Reader, constants.HasAccessList)).Methods(http.MethodGet)
 	protectedRouter.Handle("/lists_access/{list_id:[a-zA-Z0-9-]+}/{user_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.AcceptList), constants.Reader, constants.NoRestriction)).Methods(http.MethodPost)
 	protectedRouter.Handle("/lists_access/{list_id:[a-zA-Z0-9-]+}/{user_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.GetAccess), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
 	protectedRouter.Handle("/lists_access/{list_id:[a-zA-Z0-9-]+}/{user_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.DeleteAccess), constants.Reader, constants.NoRestriction)).Methods(http.MethodDelete)
+	protectedRouter.Handle("/lists", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.GetListsByIDs), constants.Reader, constants.HasAccessLists)).Queries("ids", "{ids}").Methods(http.MethodGet)
 	protectedRouter.Handle("/lists/all", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.GetAllLists), constants.Admin, constants.NoRestriction)).Methods(http.MethodGet)
 	protectedRouter.Handle("/lists/user/all", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.GetListsByUser), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
 	protectedRouter.Handle("/lists/user/accepted", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.GetAcceptedLists), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
 	protectedRouter.Handle("/lists/pending/all", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.GetPendingLists), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
 	protectedRouter.Handle("/lists/{list_id:[a-zA-Z0-9-]+}/todos", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.ListTodosByListID), constants.Reader, constants.HasAccessList)).Methods(http.MethodGet)
+	protectedRouter.Handle("/lists/{list_id:[a-zA-Z0-9-]+}/tags", s.Middleware.Protected(http.HandlerFunc(s.TagHandler.ListTags), constants.Reader, constants.HasAccessList)).Methods(http.MethodGet)
+	protectedRouter.Handle("/lists/{list_id:[a-zA-Z0-9-]+}/tags", s.Middleware.Protected(http.HandlerFunc(s.TagHandler.CreateTag), constants.Writer, constants.HasAccessWritableList)).Methods(http.MethodPost)
+	protectedRouter.Handle("/lists/{list_id:[a-zA-Z0-9-]+}/tags/{tag_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TagHandler.UpdateTag), constants.Writer, constants.HasAccessWritableList)).Methods(http.MethodPatch)
+	protectedRouter.Handle("/lists/{list_id:[a-zA-Z0-9-]+}/tags/{tag_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TagHandler.DeleteTag), constants.Writer, constants.HasAccessWritableList)).Methods(http.MethodDelete)
+	protectedRouter.Handle("/lists/{list_id:[a-zA-Z0-9-]+}/statuses", s.Middleware.Protected(http.HandlerFunc(s.StatusHandler.ListStatuses), constants.Reader, constants.HasAccessList)).Methods(http.MethodGet)
+	protectedRouter.Handle("/lists/{list_id:[a-zA-Z0-9-]+}/statuses", s.Middleware.Protected(http.HandlerFunc(s.StatusHandler.CreateStatus), constants.Writer, constants.HasAccessWritableList)).Methods(http.MethodPost)
+	protectedRouter.Handle("/lists/{list_id:[a-zA-Z0-9-]+}/statuses/{status_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.StatusHandler.UpdateStatus), constants.Writer, constants.HasAccessWritableList)).Methods(http.MethodPatch)
+	protectedRouter.Handle("/lists/{list_id:[a-zA-Z0-9-]+}/statuses/{status_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.StatusHandler.DeleteStatus), constants.Writer, constants.HasAccessWritableList)).Methods(http.MethodDelete)
 	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/owner", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.GetListOwnerID), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
 	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/users", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.GetUsersByListID), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
-	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/description", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.UpdateListDescription), constants.Writer, constants.HasAccessList)).Methods(http.MethodPatch)
-	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/name", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.UpdateListName), constants.Writer, constants.HasAccessList)).Methods(http.MethodPatch)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/description", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.UpdateListDescription), constants.Writer, constants.HasAccessWritableList)).Methods(http.MethodPatch)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/name", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.UpdateListName), constants.Writer, constants.HasAccessWritableList)).Methods(http.MethodPatch)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/clone", s.Middleware.Protected(http.HandlerFunc(s.TemplateHandler.CloneList), constants.Writer, constants.HasAccessList)).Methods(http.MethodPost)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/template", s.Middleware.Protected(http.HandlerFunc(s.TemplateHandler.SaveTemplate), constants.Writer, constants.HasAccessList)).Methods(http.MethodPost)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/archive", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.ArchiveList), constants.Reader, constants.IsOwner)).Methods(http.MethodPost)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/unarchive", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.UnarchiveList), constants.Reader, constants.IsOwner)).Methods(http.MethodPost)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}/move", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.MoveList), constants.Reader, constants.HasAccessList)).Methods(http.MethodPost)
 	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.GetList), constants.Reader, constants.HasAccessList)).Methods(http.MethodGet)
-	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.UpdateList), constants.Writer, constants.HasAccessList)).Methods(http.MethodPut)
+	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.UpdateList), constants.Writer, constants.HasAccessWritableList)).Methods(http.MethodPut)
 	protectedRouter.Handle("/lists/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.DeleteList), constants.Writer, constants.HasAccessList)).Methods(http.MethodDelete)
 
 	protectedRouter.Handle("/todos/create", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.CreateTodo), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPost)
+	protectedRouter.Handle("/todos", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.ListTodosByListIDs), constants.Reader, constants.HasAccessLists)).Queries("list_ids", "{list_ids}").Methods(http.MethodGet)
+	protectedRouter.Handle("/todos/move", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.MoveTodos), constants.Writer, constants.NoRestriction)).Methods(http.MethodPost)
+	protectedRouter.Handle("/todos/bulk", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.BulkUpdateTodos), constants.Writer, constants.NoRestriction)).Methods(http.MethodPost)
 	protectedRouter.Handle("/todos/all", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.GetAllTodos), constants.Admin, constants.NoRestriction)).Methods(http.MethodGet)
 	protectedRouter.Handle("/todos/user/all", s.Middleware.Protected(http.HandlerFunc(s.ListHandler.GetAllUserTodos), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
 	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/complete", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.CompleteTodo), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPatch)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/status", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.UpdateTodoStatus), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPatch)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/copy", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.CopyTodo), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPost)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/move", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.MoveTodo), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPost)
 	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/title", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.UpdateTodoTitle), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPatch)
 	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/assign_to", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.UpdateAssignedTo), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPatch)
 	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/priority", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.UpdateTodoPriority), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPatch)
 	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/description", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.UpdateTodoDescription), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPatch)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/tags/{tag_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.AddTag), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPut)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/tags/{tag_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.RemoveTag), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodDelete)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/blocked_by", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.ListBlockers), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodGet)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/blocks", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.ListBlocked), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodGet)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/blocked_by/{blocker_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.AddDependency), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPut)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/blocked_by/{blocker_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.RemoveDependency), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodDelete)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/comments", s.Middleware.Protected(http.HandlerFunc(s.CommentHandler.ListComments), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodGet)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/comments", s.Middleware.Protected(http.HandlerFunc(s.CommentHandler.CreateComment), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPost)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/comments/{comment_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.CommentHandler.UpdateComment), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPatch)
+	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}/comments/{comment_id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.CommentHandler.DeleteComment), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodDelete)
 	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.GetTodo), constants.Reader, constants.HasAccessTodo)).Methods(http.MethodGet)
 	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.UpdateTodo), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodPut)
 	protectedRouter.Handle("/todos/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TodoHandler.DeleteTodo), constants.Writer, constants.HasAccessTodo)).Methods(http.MethodDelete)
 
+	protectedRouter.Handle("/templates", s.Middleware.Protected(http.HandlerFunc(s.TemplateHandler.ListTemplates), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
+	protectedRouter.Handle("/templates/{id:[a-zA-Z0-9-]+}/lists", s.Middleware.Protected(http.HandlerFunc(s.TemplateHandler.CreateListFromTemplate), constants.Writer, constants.NoRestriction)).Methods(http.MethodPost)
+	protectedRouter.Handle("/templates/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TemplateHandler.GetTemplate), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
+	protectedRouter.Handle("/templates/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.TemplateHandler.DeleteTemplate), constants.Reader, constants.NoRestriction)).Methods(http.MethodDelete)
+
 	protectedRouter.Handle("/users/create", s.Middleware.Protected(http.HandlerFunc(s.UserHandler.CreateUser), constants.Admin, constants.NoRestriction)).Methods(http.MethodPost)
+	protectedRouter.Handle("/users", s.Middleware.Protected(http.HandlerFunc(s.UserHandler.GetUsersByIDs), constants.Reader, constants.NoRestriction)).Queries("ids", "{ids}").Methods(http.MethodGet)
 	protectedRouter.Handle("/users/all", s.Middleware.Protected(http.HandlerFunc(s.UserHandler.GetAllUsers), constants.Reader, constants.NoRestriction)).Methods(http.MethodGet)
 	protectedRouter.Handle("/users/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.UserHandler.UpdateUser), constants.Admin, constants.NoRestriction)).Methods(http.MethodPut)
 	protectedRouter.Handle("/users/{id:[a-zA-Z0-9-]+}", s.Middleware.Protected(http.HandlerFunc(s.UserHandler.DeleteUser), constants.Admin, constants.NoRestriction)).Methods(http.MethodDelete)
@@ -119,26 +223,30 @@ func (s *Server) RegisterRoutes(router *mux.Router) {
 
 }
 
-func (s *Server) Start() {
+// Handler returns the router of the REST API wrapped with CORS handling and security headers.
+func (s *Server) Handler(config httpserver.Config) http.Handler {
 	router := mux.NewRouter()
-	router.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("OK"))
-	})
-
-	router.HandleFunc("/readyz", func(w http.ResponseWriter, r *http.Request) {
-		w.WriteHeader(http.StatusOK)
-		w.Write([]byte("Ready"))
-
-	})
-	c := cors.New(cors.Options{
-		AllowedOrigins:   []string{"http://localhost:8000"},
-		AllowedMethods:   []string{"GET", "POST", "PUT", "DELETE", "OPTIONS"},
-		AllowedHeaders:   []string{"Authorization", "Content-Type"},
-		AllowCredentials: true,
-	})
-	router.Use(HandlePreflight)
-	handler := c.Handler(router)
+	router.HandleFunc("/healthz", s.Health.LivenessHandler).Methods(http.MethodGet)
+	router.HandleFunc("/readyz", s.Health.ReadinessHandler).Methods(http.MethodGet)
+	router.Use(HandleTracing, HandleRequestLogging, HandleMetrics)
 	s.RegisterRoutes(router)
-	log.Fatal(http.ListenAndServe(":5000", handler))
+	cors := httpserver.NewCORS(config.CORSConfig, []string{http.MethodGet, http.MethodPost, http.MethodPut, http.MethodPatch, http.MethodDelete})
+	return httpserver.SecurityHeaders(config.SecurityHeadersConfig, nil, cors.Handler(router))
+}
+
+// Run serves the REST API on the configured address, :5000 by default, until ctx is cancelled,
+// see httpserver.Serve.
+func (s *Server) Run(ctx context.Context, config httpserver.Config) error {
+	if err := config.Validate(); err != nil {
+		return err
+	}
+	address := config.Address
+	if address == "" {
+		address = defaultAddress
+	}
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return fmt.Errorf("error listening on %s: %w", address, err)
+	}
+	return httpserver.Serve(ctx, "rest server", listener, s.Handler(config), config, s.Health)
 }
@@ -4,12 +4,18 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	automock6 "github.com/Victor-Uzunov/devops-project/todoservice/internal/attachments/automock"
+	automock5 "github.com/Victor-Uzunov/devops-project/todoservice/internal/comments/automock"
 	http2 "github.com/Victor-Uzunov/devops-project/todoservice/internal/http"
 	middle "github.com/Victor-Uzunov/devops-project/todoservice/internal/http/automock"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/lists/automock"
+	automock7 "github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses/automock"
+	automock4 "github.com/Victor-Uzunov/devops-project/todoservice/internal/tags/automock"
+	automock8 "github.com/Victor-Uzunov/devops-project/todoservice/internal/templates/automock"
 	automock2 "github.com/Victor-Uzunov/devops-project/todoservice/internal/todos/automock"
 	automock3 "github.com/Victor-Uzunov/devops-project/todoservice/internal/users/automock"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt/jwttest"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
@@ -21,6 +27,20 @@ import (
 	"testing"
 )
 
+// passThroughMiddleware authenticates every request as the user with ID 1 and skips authorization.
+func passThroughMiddleware() *middle.Middlewares {
+	middleware := new(middle.Middlewares)
+	middleware.EXPECT().JWTMiddleware(mock.Anything).RunAndReturn(func(next http.Handler) http.Handler {
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			next.ServeHTTP(w, jwttest.WithUser(r, "1", constants.Writer))
+		})
+	})
+	middleware.EXPECT().Protected(mock.Anything, mock.Anything, mock.Anything).RunAndReturn(func(next http.Handler, _ constants.Role, _ constants.Accessibility) http.Handler {
+		return next
+	})
+	return middleware
+}
+
 func TestCreateRoutes(t *testing.T) {
 	db, mockDatabase, err := sqlxmock.Newx()
 	require.NoError(t, err)
@@ -38,25 +58,11 @@ func TestCreateRoutes(t *testing.T) {
 			method: http.MethodPost,
 			url:    "/lists/create",
 			input: &models.List{
-				ID:   "1",
-				Tags: json.RawMessage{0x6e, 0x75, 0x6c, 0x6c},
+				ID: "1",
 			},
 			expectedStatus: http.StatusCreated,
 			mockServiceFunction: func(listService *automock.ListService, todoService *automock2.TodoService, middleware *middle.Middlewares) {
-				listService.EXPECT().CreateList(mock.Anything, models.List{ID: "1", Tags: json.RawMessage{0x6e, 0x75, 0x6c, 0x6c}}).Return("TestID", nil).Once()
-				middleware.EXPECT().
-					JWTMiddleware(mock.AnythingOfType("http.HandlerFunc")).
-					Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-						next := r.Context().Value("nextHandler").(http.HandlerFunc)
-						next.ServeHTTP(w, r)
-					}))
-
-				middleware.EXPECT().
-					Protected(mock.Anything, mock.Anything, mock.Anything).
-					Return(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-						next := r.Context().Value("nextHandler").(http.HandlerFunc)
-						next.ServeHTTP(w, r)
-					}))
+				listService.EXPECT().CreateList(mock.Anything, models.List{ID: "1"}).Return("TestID", nil).Once()
 			},
 			mockDatabase: func() {
 				mockDatabase.ExpectBegin()
@@ -68,12 +74,11 @@ func TestCreateRoutes(t *testing.T) {
 			method: http.MethodPost,
 			url:    "/lists/create",
 			input: &models.List{
-				ID:   "1",
-				Tags: json.RawMessage{0x6e, 0x75, 0x6c, 0x6c},
+				ID: "1",
 			},
 			expectedStatus: http.StatusBadRequest,
 			mockServiceFunction: func(listService *automock.ListService, todoService *automock2.TodoService, middleware *middle.Middlewares) {
-				listService.EXPECT().CreateList(mock.Anything, models.List{ID: "1", Tags: json.RawMessage{0x6e, 0x75, 0x6c, 0x6c}}).Return("", errors.New("error")).Once()
+				listService.EXPECT().CreateList(mock.Anything, models.List{ID: "1"}).Return("", errors.New("error")).Once()
 			},
 			mockDatabase: func() {
 				mockDatabase.ExpectBegin()
@@ -85,12 +90,11 @@ func TestCreateRoutes(t *testing.T) {
 			method: http.MethodPost,
 			url:    "/todos/create",
 			input: &models.List{
-				ID:   "1",
-				Tags: json.RawMessage{0x6e, 0x75, 0x6c, 0x6c},
+				ID: "1",
 			},
 			expectedStatus: http.StatusCreated,
 			mockServiceFunction: func(listService *automock.ListService, todoService *automock2.TodoService, middleware *middle.Middlewares) {
-				todoService.EXPECT().CreateTodo(mock.Anything, models.Todo{ID: "1", Tags: json.RawMessage{0x6e, 0x75, 0x6c, 0x6c}}).Return("TestID", nil).Once()
+				todoService.EXPECT().CreateTodo(mock.Anything, models.Todo{ID: "1"}).Return("TestID", nil).Once()
 			},
 			mockDatabase: func() {
 				mockDatabase.ExpectBegin()
@@ -102,12 +106,11 @@ func TestCreateRoutes(t *testing.T) {
 			method: http.MethodPost,
 			url:    "/todos/create",
 			input: &models.List{
-				ID:   "1",
-				Tags: json.RawMessage{0x6e, 0x75, 0x6c, 0x6c},
+				ID: "1",
 			},
 			expectedStatus: http.StatusBadRequest,
 			mockServiceFunction: func(listService *automock.ListService, todoService *automock2.TodoService, middleware *middle.Middlewares) {
-				todoService.EXPECT().CreateTodo(mock.Anything, models.Todo{ID: "1", Tags: json.RawMessage{0x6e, 0x75, 0x6c, 0x6c}}).Return("", errors.New("error")).Once()
+				todoService.EXPECT().CreateTodo(mock.Anything, models.Todo{ID: "1"}).Return("", errors.New("error")).Once()
 			},
 			mockDatabase: func() {
 				mockDatabase.ExpectBegin()
@@ -121,7 +124,7 @@ func TestCreateRoutes(t *testing.T) {
 			listService := new(automock.ListService)
 			todoService := new(automock2.TodoService)
 			userService := new(automock3.UserService)
-			middleware := new(middle.Middlewares)
+			middleware := passThroughMiddleware()
 			test.mockServiceFunction(listService, todoService, middleware)
 
 			test.mockDatabase()
@@ -139,7 +142,7 @@ func TestCreateRoutes(t *testing.T) {
 
 			w := httptest.NewRecorder()
 
-			server := http2.NewServerWithServices(db, listService, todoService, userService, middleware)
+			server := http2.NewServerWithServices(db, listService, todoService, userService, new(automock4.TagService), new(automock7.StatusService), new(automock5.CommentService), new(automock6.AttachmentService), new(automock8.TemplateService), middleware)
 			router := mux.NewRouter()
 			server.RegisterRoutes(router)
 			router.ServeHTTP(w, req)
@@ -225,7 +228,7 @@ func TestGetRoutes(t *testing.T) {
 			listService := new(automock.ListService)
 			todoService := new(automock2.TodoService)
 			userService := new(automock3.UserService)
-			middleware := new(middle.Middlewares)
+			middleware := passThroughMiddleware()
 			test.mockServiceFunction(listService, todoService)
 
 			test.mockDatabase()
@@ -235,7 +238,7 @@ func TestGetRoutes(t *testing.T) {
 
 			w := httptest.NewRecorder()
 
-			server := http2.NewServerWithServices(db, listService, todoService, userService, middleware)
+			server := http2.NewServerWithServices(db, listService, todoService, userService, new(automock4.TagService), new(automock7.StatusService), new(automock5.CommentService), new(automock6.AttachmentService), new(automock8.TemplateService), middleware)
 			router := mux.NewRouter()
 			server.RegisterRoutes(router)
 			router.ServeHTTP(w, req)
@@ -259,7 +262,6 @@ func TestUpdateRoutes(t *testing.T) {
 		Title:       "Test",
 		Description: "Test",
 		ListID:      "user1",
-		Tags:        json.RawMessage{0x6e, 0x75, 0x6c, 0x6c},
 	}
 	tests := []struct {
 		name                string
@@ -279,12 +281,16 @@ func TestUpdateRoutes(t *testing.T) {
 				Name:        "Test",
 				Description: "Test",
 				OwnerID:     "user1",
-				Tags:        json.RawMessage{0x6e, 0x75, 0x6c, 0x6c},
 			},
 			expectedStatus: http.StatusOK,
 			mockServiceFunction: func(listService *automock.ListService, todoService *automock2.TodoService) {
 				listService.EXPECT().GetList(mock.Anything, "1").Return(models.List{}, nil).Once()
-				listService.EXPECT().UpdateList(mock.Anything, models.List{}).Return(nil).Once()
+				listService.EXPECT().UpdateList(mock.Anything, models.List{
+					ID:          "1",
+					Name:        "Test",
+					Description: "Test",
+					OwnerID:     "user1",
+				}).Return(nil).Once()
 			},
 			mockDatabase: func() {
 				mockDatabase.ExpectBegin()
@@ -300,7 +306,6 @@ func TestUpdateRoutes(t *testing.T) {
 				Name:        "Test",
 				Description: "Test",
 				OwnerID:     "user1",
-				Tags:        json.RawMessage{0x6e, 0x75, 0x6c, 0x6c},
 			},
 			expectedStatus: http.StatusNotFound,
 			mockServiceFunction: func(listService *automock.ListService, todoService *automock2.TodoService) {
@@ -347,7 +352,7 @@ func TestUpdateRoutes(t *testing.T) {
 			listService := new(automock.ListService)
 			todoService := new(automock2.TodoService)
 			userService := new(automock3.UserService)
-			middleware := new(middle.Middlewares)
+			middleware := passThroughMiddleware()
 			test.mockServiceFunction(listService, todoService)
 
 			test.mockDatabase()
@@ -363,7 +368,7 @@ func TestUpdateRoutes(t *testing.T) {
 
 			w := httptest.NewRecorder()
 
-			server := http2.NewServerWithServices(db, listService, todoService, userService, middleware)
+			server := http2.NewServerWithServices(db, listService, todoService, userService, new(automock4.TagService), new(automock7.StatusService), new(automock5.CommentService), new(automock6.AttachmentService), new(automock8.TemplateService), middleware)
 			router := mux.NewRouter()
 			server.RegisterRoutes(router)
 			router.ServeHTTP(w, req)
@@ -451,7 +456,7 @@ func TestDeleteRoutes(t *testing.T) {
 			listService := new(automock.ListService)
 			todoService := new(automock2.TodoService)
 			userService := new(automock3.UserService)
-			middleware := new(middle.Middlewares)
+			middleware := passThroughMiddleware()
 			test.mockServiceFunction(listService, todoService)
 
 			test.mockDatabase()
@@ -461,7 +466,7 @@ func TestDeleteRoutes(t *testing.T) {
 
 			w := httptest.NewRecorder()
 
-			server := http2.NewServerWithServices(db, listService, todoService, userService, middleware)
+			server := http2.NewServerWithServices(db, listService, todoService, userService, new(automock4.TagService), new(automock7.StatusService), new(automock5.CommentService), new(automock6.AttachmentService), new(automock8.TemplateService), middleware)
 			router := mux.NewRouter()
 			server.RegisterRoutes(router)
 			router.ServeHTTP(w, req)
@@ -491,7 +496,7 @@ func TestListAllRoutes(t *testing.T) {
 		{
 			name:           "List all lists by userID",
 			method:         http.MethodGet,
-			url:            "/users/1/lists",
+			url:            "/lists/user/all",
 			expectedStatus: http.StatusOK,
 			mockServiceFunction: func(listService *automock.ListService, todoService *automock2.TodoService) {
 				listService.EXPECT().ListAllByUserID(mock.Anything, "1").Return([]models.Access{}, nil).Once()
@@ -504,7 +509,7 @@ func TestListAllRoutes(t *testing.T) {
 		{
 			name:           "Error when list all lists by user fails",
 			method:         http.MethodGet,
-			url:            "/users/1/lists",
+			url:            "/lists/user/all",
 			expectedStatus: http.StatusNotFound,
 			mockServiceFunction: func(listService *automock.ListService, todoService *automock2.TodoService) {
 				listService.EXPECT().ListAllByUserID(mock.Anything, "1").Return(nil, errors.New("error")).Once()
@@ -547,7 +552,7 @@ func TestListAllRoutes(t *testing.T) {
 			listService := new(automock.ListService)
 			todoService := new(automock2.TodoService)
 			userService := new(automock3.UserService)
-			middleware := new(middle.Middlewares)
+			middleware := passThroughMiddleware()
 			test.mockServiceFunction(listService, todoService)
 
 			test.mockDatabase()
@@ -557,7 +562,7 @@ func TestListAllRoutes(t *testing.T) {
 
 			w := httptest.NewRecorder()
 
-			server := http2.NewServerWithServices(db, listService, todoService, userService, middleware)
+			server := http2.NewServerWithServices(db, listService, todoService, userService, new(automock4.TagService), new(automock7.StatusService), new(automock5.CommentService), new(automock6.AttachmentService), new(automock8.TemplateService), middleware)
 			router := mux.NewRouter()
 			server.RegisterRoutes(router)
 			router.ServeHTTP(w, req)
@@ -0,0 +1,134 @@
+package status
+
+import (
+	"encoding/json"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/problem"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type Handler struct {
+	service statuses.StatusService
+}
+
+func NewHandler(service statuses.StatusService) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) CreateStatus(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("create status handler")
+	var status models.Status
+	if err := json.NewDecoder(r.Body).Decode(&status); err != nil {
+		log.C(r.Context()).Errorf("error while creating status handler: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	status.ListID = mux.Vars(r)["list_id"]
+
+	createdStatus, err := h.service.CreateStatus(r.Context(), status)
+	log.C(r.Context()).Debugf("create status handler with status: %v", createdStatus)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating status handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(createdStatus); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+func (h *Handler) ListStatuses(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list statuses handler")
+	listID := mux.Vars(r)["list_id"]
+
+	result, err := h.service.ListStatusesByListID(r.Context(), listID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing statuses handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+// UpdateStatus renames, reorders or reconfigures a status; fields missing from the body keep their current value.
+func (h *Handler) UpdateStatus(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("update status handler")
+	vars := mux.Vars(r)
+	listID := vars["list_id"]
+	statusID := vars["status_id"]
+
+	var updateData struct {
+		Name        *string   `json:"name"`
+		Done        *bool     `json:"done"`
+		Position    *int      `json:"position"`
+		AllowedNext *[]string `json:"allowed_next"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&updateData); err != nil {
+		log.C(r.Context()).Errorf("error while updating status handler: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	ctx := r.Context()
+
+	status, err := h.service.GetStatus(ctx, listID, statusID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while updating status handler, there is no such status: %v", err)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
+		return
+	}
+	if updateData.Name != nil {
+		status.Name = *updateData.Name
+	}
+	if updateData.Done != nil {
+		status.Done = *updateData.Done
+	}
+	if updateData.Position != nil {
+		status.Position = *updateData.Position
+	}
+	if updateData.AllowedNext != nil {
+		status.AllowedNext = *updateData.AllowedNext
+	}
+
+	updatedStatus, err := h.service.UpdateStatus(ctx, status)
+	log.C(r.Context()).Debugf("update status handler with status: %v", updatedStatus)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while updating status handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(updatedStatus); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+func (h *Handler) DeleteStatus(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("delete status handler")
+	vars := mux.Vars(r)
+
+	if err := h.service.DeleteStatus(r.Context(), vars["list_id"], vars["status_id"]); err != nil {
+		log.C(r.Context()).Errorf("error while deleting status handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,224 @@
+package status_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http/status"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const (
+	listID   = "list1"
+	statusID = "status1"
+)
+
+func TestCreateStatusHandler(t *testing.T) {
+	input := models.Status{ListID: listID, Name: "Blocked"}
+	created := models.Status{ID: statusID, ListID: listID, Name: "Blocked", Position: 4, AllowedNext: []string{}}
+
+	tests := []struct {
+		name               string
+		mockService        func() *automock.StatusService
+		expectedStatusCode int
+	}{
+		{
+			name: "Create status",
+			mockService: func() *automock.StatusService {
+				mockService := &automock.StatusService{}
+				mockService.EXPECT().CreateStatus(mock.Anything, input).Return(created, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name: "Error when the status is invalid",
+			mockService: func() *automock.StatusService {
+				mockService := &automock.StatusService{}
+				mockService.EXPECT().CreateStatus(mock.Anything, input).Return(models.Status{}, fmt.Errorf("invalid status: %w", pkg.ErrBadRequest)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := status.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodPost, "/lists/list1/statuses", bytes.NewBufferString(`{"name":"Blocked"}`))
+			req.Header.Set("Content-Type", constants.ContentTypeJSON)
+			req = mux.SetURLVars(req, map[string]string{"list_id": listID})
+			w := httptest.NewRecorder()
+
+			handler.CreateStatus(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			if tt.expectedStatusCode == http.StatusCreated {
+				var got models.Status
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, created, got)
+			}
+		})
+	}
+}
+
+func TestListStatusesHandler(t *testing.T) {
+	statuses := []models.Status{{ID: statusID, ListID: listID, Name: "To Do", AllowedNext: []string{}}}
+
+	tests := []struct {
+		name               string
+		mockService        func() *automock.StatusService
+		expectedStatusCode int
+	}{
+		{
+			name: "List statuses",
+			mockService: func() *automock.StatusService {
+				mockService := &automock.StatusService{}
+				mockService.EXPECT().ListStatusesByListID(mock.Anything, listID).Return(statuses, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Error when list statuses fails",
+			mockService: func() *automock.StatusService {
+				mockService := &automock.StatusService{}
+				mockService.EXPECT().ListStatusesByListID(mock.Anything, listID).Return(nil, errors.New("error")).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := status.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodGet, "/lists/list1/statuses", nil)
+			req = mux.SetURLVars(req, map[string]string{"list_id": listID})
+			w := httptest.NewRecorder()
+
+			handler.ListStatuses(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			if tt.expectedStatusCode == http.StatusOK {
+				var got []models.Status
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, statuses, got)
+			}
+		})
+	}
+}
+
+func TestUpdateStatusHandler(t *testing.T) {
+	existing := models.Status{ID: statusID, ListID: listID, Name: "Review", Position: 2, AllowedNext: []string{"done"}}
+	moved := models.Status{ID: statusID, ListID: listID, Name: "Review", Position: 1, AllowedNext: []string{}}
+
+	tests := []struct {
+		name               string
+		body               string
+		mockService        func() *automock.StatusService
+		expectedStatusCode int
+	}{
+		{
+			name: "Move status and clear its transitions",
+			body: `{"position":1,"allowed_next":[]}`,
+			mockService: func() *automock.StatusService {
+				mockService := &automock.StatusService{}
+				mockService.EXPECT().GetStatus(mock.Anything, listID, statusID).Return(existing, nil).Once()
+				mockService.EXPECT().UpdateStatus(mock.Anything, moved).Return(moved, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Error when the status does not exist",
+			body: `{"name":"QA"}`,
+			mockService: func() *automock.StatusService {
+				mockService := &automock.StatusService{}
+				mockService.EXPECT().GetStatus(mock.Anything, listID, statusID).Return(models.Status{}, errors.New("status not found")).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Error when the body is invalid",
+			body: `{`,
+			mockService: func() *automock.StatusService {
+				return &automock.StatusService{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := status.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodPatch, "/lists/list1/statuses/status1", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", constants.ContentTypeJSON)
+			req = mux.SetURLVars(req, map[string]string{"list_id": listID, "status_id": statusID})
+			w := httptest.NewRecorder()
+
+			handler.UpdateStatus(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestDeleteStatusHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		mockService        func() *automock.StatusService
+		expectedStatusCode int
+	}{
+		{
+			name: "Delete status",
+			mockService: func() *automock.StatusService {
+				mockService := &automock.StatusService{}
+				mockService.EXPECT().DeleteStatus(mock.Anything, listID, statusID).Return(nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "Error when the status still has todos",
+			mockService: func() *automock.StatusService {
+				mockService := &automock.StatusService{}
+				mockService.EXPECT().DeleteStatus(mock.Anything, listID, statusID).Return(fmt.Errorf("status %s still has todos: %w", statusID, pkg.ErrConflict)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := status.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodDelete, "/lists/list1/statuses/status1", nil)
+			req = mux.SetURLVars(req, map[string]string{"list_id": listID, "status_id": statusID})
+			w := httptest.NewRecorder()
+
+			handler.DeleteStatus(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
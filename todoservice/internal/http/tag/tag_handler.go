@@ -0,0 +1,126 @@
+package tag
+
+import (
+	"encoding/json"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/tags"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/problem"
+	"github.com/gorilla/mux"
+	"net/http"
+)
+
+type Handler struct {
+	service tags.TagService
+}
+
+func NewHandler(service tags.TagService) *Handler {
+	return &Handler{service: service}
+}
+
+func (h *Handler) CreateTag(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("create tag handler")
+	var tag models.Tag
+	if err := json.NewDecoder(r.Body).Decode(&tag); err != nil {
+		log.C(r.Context()).Errorf("error while creating tag handler: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	tag.ListID = mux.Vars(r)["list_id"]
+
+	createdTag, err := h.service.CreateTag(r.Context(), tag)
+	log.C(r.Context()).Debugf("create tag handler with tag: %v", createdTag)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating tag handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(createdTag); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+func (h *Handler) ListTags(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list tags handler")
+	listID := mux.Vars(r)["list_id"]
+
+	result, err := h.service.ListTagsByListID(r.Context(), listID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing tags handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+// UpdateTag renames or recolors a tag; fields missing from the body keep their current value.
+func (h *Handler) UpdateTag(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("update tag handler")
+	vars := mux.Vars(r)
+	listID := vars["list_id"]
+	tagID := vars["tag_id"]
+
+	var updateData struct {
+		Name  *string `json:"name"`
+		Color *string `json:"color"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&updateData); err != nil {
+		log.C(r.Context()).Errorf("error while updating tag handler: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	ctx := r.Context()
+
+	tag, err := h.service.GetTag(ctx, listID, tagID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while updating tag handler, there is no such tag: %v", err)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
+		return
+	}
+	if updateData.Name != nil {
+		tag.Name = *updateData.Name
+	}
+	if updateData.Color != nil {
+		tag.Color = *updateData.Color
+	}
+
+	updatedTag, err := h.service.UpdateTag(ctx, tag)
+	log.C(r.Context()).Debugf("update tag handler with tag: %v", updatedTag)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while updating tag handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(updatedTag); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+func (h *Handler) DeleteTag(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("delete tag handler")
+	vars := mux.Vars(r)
+
+	if err := h.service.DeleteTag(r.Context(), vars["list_id"], vars["tag_id"]); err != nil {
+		log.C(r.Context()).Errorf("error while deleting tag handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -0,0 +1,224 @@
+package tag_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http/tag"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/tags/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+const (
+	listID = "list1"
+	tagID  = "tag1"
+)
+
+func TestCreateTagHandler(t *testing.T) {
+	input := models.Tag{ListID: listID, Name: "work"}
+	created := models.Tag{ID: tagID, ListID: listID, Name: "work", Color: "#9e9e9e"}
+
+	tests := []struct {
+		name               string
+		mockService        func() *automock.TagService
+		expectedStatusCode int
+	}{
+		{
+			name: "Create tag",
+			mockService: func() *automock.TagService {
+				mockService := &automock.TagService{}
+				mockService.EXPECT().CreateTag(mock.Anything, input).Return(created, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name: "Error when the tag is invalid",
+			mockService: func() *automock.TagService {
+				mockService := &automock.TagService{}
+				mockService.EXPECT().CreateTag(mock.Anything, input).Return(models.Tag{}, fmt.Errorf("invalid tag: %w", pkg.ErrBadRequest)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := tag.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodPost, "/lists/list1/tags", bytes.NewBufferString(`{"name":"work"}`))
+			req.Header.Set("Content-Type", constants.ContentTypeJSON)
+			req = mux.SetURLVars(req, map[string]string{"list_id": listID})
+			w := httptest.NewRecorder()
+
+			handler.CreateTag(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			if tt.expectedStatusCode == http.StatusCreated {
+				var got models.Tag
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, created, got)
+			}
+		})
+	}
+}
+
+func TestListTagsHandler(t *testing.T) {
+	tags := []models.Tag{{ID: tagID, ListID: listID, Name: "work", Color: "#9e9e9e"}}
+
+	tests := []struct {
+		name               string
+		mockService        func() *automock.TagService
+		expectedStatusCode int
+	}{
+		{
+			name: "List tags",
+			mockService: func() *automock.TagService {
+				mockService := &automock.TagService{}
+				mockService.EXPECT().ListTagsByListID(mock.Anything, listID).Return(tags, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Error when list tags fails",
+			mockService: func() *automock.TagService {
+				mockService := &automock.TagService{}
+				mockService.EXPECT().ListTagsByListID(mock.Anything, listID).Return(nil, errors.New("error")).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := tag.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodGet, "/lists/list1/tags", nil)
+			req = mux.SetURLVars(req, map[string]string{"list_id": listID})
+			w := httptest.NewRecorder()
+
+			handler.ListTags(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			if tt.expectedStatusCode == http.StatusOK {
+				var got []models.Tag
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, tags, got)
+			}
+		})
+	}
+}
+
+func TestUpdateTagHandler(t *testing.T) {
+	existing := models.Tag{ID: tagID, ListID: listID, Name: "work", Color: "#9e9e9e"}
+	renamed := models.Tag{ID: tagID, ListID: listID, Name: "office", Color: "#9e9e9e"}
+
+	tests := []struct {
+		name               string
+		body               string
+		mockService        func() *automock.TagService
+		expectedStatusCode int
+	}{
+		{
+			name: "Rename tag and keep its color",
+			body: `{"name":"office"}`,
+			mockService: func() *automock.TagService {
+				mockService := &automock.TagService{}
+				mockService.EXPECT().GetTag(mock.Anything, listID, tagID).Return(existing, nil).Once()
+				mockService.EXPECT().UpdateTag(mock.Anything, renamed).Return(renamed, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Error when the tag does not exist",
+			body: `{"name":"office"}`,
+			mockService: func() *automock.TagService {
+				mockService := &automock.TagService{}
+				mockService.EXPECT().GetTag(mock.Anything, listID, tagID).Return(models.Tag{}, errors.New("tag not found")).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Error when the body is invalid",
+			body: `{`,
+			mockService: func() *automock.TagService {
+				return &automock.TagService{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := tag.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodPatch, "/lists/list1/tags/tag1", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", constants.ContentTypeJSON)
+			req = mux.SetURLVars(req, map[string]string{"list_id": listID, "tag_id": tagID})
+			w := httptest.NewRecorder()
+
+			handler.UpdateTag(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestDeleteTagHandler(t *testing.T) {
+	tests := []struct {
+		name               string
+		mockService        func() *automock.TagService
+		expectedStatusCode int
+	}{
+		{
+			name: "Delete tag",
+			mockService: func() *automock.TagService {
+				mockService := &automock.TagService{}
+				mockService.EXPECT().DeleteTag(mock.Anything, listID, tagID).Return(nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusNoContent,
+		},
+		{
+			name: "Error when the tag does not exist",
+			mockService: func() *automock.TagService {
+				mockService := &automock.TagService{}
+				mockService.EXPECT().DeleteTag(mock.Anything, listID, tagID).Return(fmt.Errorf("tag %s not found: %w", tagID, pkg.ErrNotFound)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := tag.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodDelete, "/lists/list1/tags/tag1", nil)
+			req = mux.SetURLVars(req, map[string]string{"list_id": listID, "tag_id": tagID})
+			w := httptest.NewRecorder()
+
+			handler.DeleteTag(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
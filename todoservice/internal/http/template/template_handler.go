@@ -0,0 +1,173 @@
+package template
+
+import (
+	"encoding/json"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/templates"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/problem"
+	"github.com/gorilla/mux"
+	"io"
+	"net/http"
+	"time"
+)
+
+type Handler struct {
+	service templates.TemplateService
+}
+
+func NewHandler(service templates.TemplateService) *Handler {
+	return &Handler{service: service}
+}
+
+type saveTemplateBody struct {
+	Name        string `json:"name"`
+	Description string `json:"description"`
+}
+
+type instantiateBody struct {
+	Name       string     `json:"name"`
+	AnchorDate *time.Time `json:"anchor_date"`
+}
+
+// CloneList copies the list into a new list of the user. The body with the clone options may be omitted.
+func (h *Handler) CloneList(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("clone list handler")
+	userID, ok := userIDFromRequest(r)
+	if !ok {
+		problem.Write(w, r, http.StatusUnauthorized, "there is no user claim in the context")
+		return
+	}
+	var options models.CloneOptions
+	if err := json.NewDecoder(r.Body).Decode(&options); err != nil && !errors.Is(err, io.EOF) {
+		log.C(r.Context()).Errorf("error while cloning list handler: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	list, err := h.service.CloneList(r.Context(), userID, mux.Vars(r)["id"], options)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while cloning list handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+	writeJSON(w, r, http.StatusCreated, list)
+}
+
+func (h *Handler) SaveTemplate(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("save template handler")
+	userID, ok := userIDFromRequest(r)
+	if !ok {
+		problem.Write(w, r, http.StatusUnauthorized, "there is no user claim in the context")
+		return
+	}
+	var input saveTemplateBody
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil {
+		log.C(r.Context()).Errorf("error while saving template handler: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	template, err := h.service.SaveTemplate(r.Context(), userID, mux.Vars(r)["id"], input.Name, input.Description)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while saving template handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+	writeJSON(w, r, http.StatusCreated, template)
+}
+
+// ListTemplates returns the templates of the user, newest first.
+func (h *Handler) ListTemplates(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list templates handler")
+	userID, ok := userIDFromRequest(r)
+	if !ok {
+		problem.Write(w, r, http.StatusUnauthorized, "there is no user claim in the context")
+		return
+	}
+
+	result, err := h.service.ListTemplates(r.Context(), userID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing templates handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+	writeJSON(w, r, http.StatusOK, result)
+}
+
+func (h *Handler) GetTemplate(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("get template handler")
+	userID, ok := userIDFromRequest(r)
+	if !ok {
+		problem.Write(w, r, http.StatusUnauthorized, "there is no user claim in the context")
+		return
+	}
+
+	template, err := h.service.GetTemplate(r.Context(), userID, mux.Vars(r)["id"])
+	if err != nil {
+		log.C(r.Context()).Errorf("error while getting template handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+	writeJSON(w, r, http.StatusOK, template)
+}
+
+func (h *Handler) DeleteTemplate(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("delete template handler")
+	userID, ok := userIDFromRequest(r)
+	if !ok {
+		problem.Write(w, r, http.StatusUnauthorized, "there is no user claim in the context")
+		return
+	}
+
+	if err := h.service.DeleteTemplate(r.Context(), userID, mux.Vars(r)["id"]); err != nil {
+		log.C(r.Context()).Errorf("error while deleting template handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// CreateListFromTemplate creates a list from the template. Without anchor_date the dates start today.
+func (h *Handler) CreateListFromTemplate(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("create list from template handler")
+	userID, ok := userIDFromRequest(r)
+	if !ok {
+		problem.Write(w, r, http.StatusUnauthorized, "there is no user claim in the context")
+		return
+	}
+	var input instantiateBody
+	if err := json.NewDecoder(r.Body).Decode(&input); err != nil && !errors.Is(err, io.EOF) {
+		log.C(r.Context()).Errorf("error while creating list from template handler: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+
+	list, err := h.service.CreateListFromTemplate(r.Context(), userID, mux.Vars(r)["id"], input.Name, input.AnchorDate)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while creating list from template handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+	writeJSON(w, r, http.StatusCreated, list)
+}
+
+func userIDFromRequest(r *http.Request) (string, bool) {
+	claim, ok := jwt.ClaimsFromContext(r.Context())
+	if !ok {
+		log.C(r.Context()).Error("there is no user claim in the context")
+		return "", false
+	}
+	return claim.ID, true
+}
+
+func writeJSON(w http.ResponseWriter, r *http.Request, status int, value interface{}) {
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(value); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+	}
+}
@@ -0,0 +1,202 @@
+package template_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http/template"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/templates/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt/jwttest"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+const (
+	listID     = "list1"
+	templateID = "tpl1"
+	userID     = "user1"
+)
+
+func TestCloneListHandler(t *testing.T) {
+	anchor := time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)
+	cloned := models.List{ID: "list2", Name: "Trip (copy)", OwnerID: userID, Tags: []models.Tag{}}
+
+	tests := []struct {
+		name               string
+		body               string
+		mockService        func() *automock.TemplateService
+		expectedStatusCode int
+	}{
+		{
+			name: "Clone with options",
+			body: `{"include_collaborators":true,"reset_completion":true,"anchor_date":"2026-02-01T00:00:00Z"}`,
+			mockService: func() *automock.TemplateService {
+				mockService := &automock.TemplateService{}
+				mockService.EXPECT().CloneList(mock.Anything, userID, listID, models.CloneOptions{IncludeCollaborators: true, ResetCompletion: true, AnchorDate: &anchor}).
+					Return(cloned, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name: "Clone without a body",
+			mockService: func() *automock.TemplateService {
+				mockService := &automock.TemplateService{}
+				mockService.EXPECT().CloneList(mock.Anything, userID, listID, models.CloneOptions{}).Return(cloned, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name: "Error when the list does not exist",
+			body: `{}`,
+			mockService: func() *automock.TemplateService {
+				mockService := &automock.TemplateService{}
+				mockService.EXPECT().CloneList(mock.Anything, userID, listID, models.CloneOptions{}).
+					Return(models.List{}, fmt.Errorf("list not found: %w", pkg.ErrNotFound)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+		{
+			name: "Error when the body is invalid",
+			body: `{"anchor_date":"tomorrow"}`,
+			mockService: func() *automock.TemplateService {
+				return &automock.TemplateService{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := template.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodPost, "/lists/list1/clone", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", constants.ContentTypeJSON)
+			req = mux.SetURLVars(jwttest.WithUser(req, userID, constants.Writer), map[string]string{"id": listID})
+			w := httptest.NewRecorder()
+
+			handler.CloneList(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			if tt.expectedStatusCode == http.StatusCreated {
+				var got models.List
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, cloned, got)
+			}
+		})
+	}
+}
+
+func TestSaveTemplateHandler(t *testing.T) {
+	saved := models.Template{ID: templateID, OwnerID: userID, Name: "Sprint", Blueprint: models.Blueprint{}}
+
+	tests := []struct {
+		name               string
+		body               string
+		mockService        func() *automock.TemplateService
+		expectedStatusCode int
+	}{
+		{
+			name: "Save template",
+			body: `{"name":"Sprint","description":"two weeks"}`,
+			mockService: func() *automock.TemplateService {
+				mockService := &automock.TemplateService{}
+				mockService.EXPECT().SaveTemplate(mock.Anything, userID, listID, "Sprint", "two weeks").Return(saved, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name: "Error when the name is empty",
+			body: `{"name":""}`,
+			mockService: func() *automock.TemplateService {
+				mockService := &automock.TemplateService{}
+				mockService.EXPECT().SaveTemplate(mock.Anything, userID, listID, "", "").
+					Return(models.Template{}, fmt.Errorf("template name is required: %w", pkg.ErrBadRequest)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := template.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodPost, "/lists/list1/template", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", constants.ContentTypeJSON)
+			req = mux.SetURLVars(jwttest.WithUser(req, userID, constants.Writer), map[string]string{"id": listID})
+			w := httptest.NewRecorder()
+
+			handler.SaveTemplate(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestCreateListFromTemplateHandler(t *testing.T) {
+	created := models.List{ID: "list2", Name: "Sprint 12", OwnerID: userID, Tags: []models.Tag{}}
+
+	tests := []struct {
+		name               string
+		body               string
+		mockService        func() *automock.TemplateService
+		expectedStatusCode int
+	}{
+		{
+			name: "Create list anchored today",
+			body: `{"name":"Sprint 12"}`,
+			mockService: func() *automock.TemplateService {
+				mockService := &automock.TemplateService{}
+				mockService.EXPECT().CreateListFromTemplate(mock.Anything, userID, templateID, "Sprint 12", (*time.Time)(nil)).Return(created, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name: "Error when the template belongs to another user",
+			body: `{"name":"Sprint 12"}`,
+			mockService: func() *automock.TemplateService {
+				mockService := &automock.TemplateService{}
+				mockService.EXPECT().CreateListFromTemplate(mock.Anything, userID, templateID, "Sprint 12", (*time.Time)(nil)).
+					Return(models.List{}, fmt.Errorf("template tpl1 not found: %w", pkg.ErrNotFound)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := template.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodPost, "/templates/tpl1/lists", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", constants.ContentTypeJSON)
+			req = mux.SetURLVars(jwttest.WithUser(req, userID, constants.Writer), map[string]string{"id": templateID})
+			w := httptest.NewRecorder()
+
+			handler.CreateListFromTemplate(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			if tt.expectedStatusCode == http.StatusCreated {
+				var got models.List
+				assert.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, created, got)
+			}
+		})
+	}
+}
@@ -3,26 +3,25 @@ package todo
 import (
 	"bytes"
 	"encoding/json"
-	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/todos"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/converters"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/problem"
 	"github.com/gorilla/mux"
-	"github.com/jmoiron/sqlx"
 	"io"
 	"net/http"
 )
 
 type Handler struct {
-	service  todos.TodoService
-	database *sqlx.DB
+	service todos.TodoService
 }
 
-func NewHandler(service todos.TodoService, database *sqlx.DB) *Handler {
-	return &Handler{service: service, database: database}
+func NewHandler(service todos.TodoService) *Handler {
+	return &Handler{service: service}
 }
 
 func (h *Handler) CreateTodo(w http.ResponseWriter, r *http.Request) {
@@ -30,13 +29,13 @@ func (h *Handler) CreateTodo(w http.ResponseWriter, r *http.Request) {
 	bodyBytes, err := io.ReadAll(r.Body)
 	if err != nil {
 		log.C(r.Context()).Errorf("error reading request body: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 
 	if len(bodyBytes) == 0 {
 		log.C(r.Context()).Error("request body is empty")
-		http.Error(w, "Request body cannot be empty", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Request body cannot be empty")
 		return
 	}
 
@@ -47,37 +46,23 @@ func (h *Handler) CreateTodo(w http.ResponseWriter, r *http.Request) {
 	var todo models.Todo
 	if err := json.NewDecoder(r.Body).Decode(&todo); err != nil {
 		log.C(r.Context()).Errorf("error while todo handler create request body err: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while todo handler create tx err: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
 	createdTodo, err := h.service.CreateTodo(ctx, todo)
 	log.C(r.Context()).Debugf("todo handler create success, createdTodo: %v", createdTodo)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while todo handler create err: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusBadRequest), err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("erorr while todo handler create tx err: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusCreated)
 	if err := json.NewEncoder(w).Encode(createdTodo); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 }
@@ -89,33 +74,18 @@ func (h *Handler) GetTodo(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while todo handler get tx err: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
 	todo, err := h.service.GetTodo(ctx, id)
 	log.C(r.Context()).Debugf("todo handler get success, todo: %v", todo)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while todo handler get err: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while todo handler get tx err: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(todo); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 	}
 }
 
@@ -127,26 +97,16 @@ func (h *Handler) UpdateTodo(w http.ResponseWriter, r *http.Request) {
 
 	if err := json.NewDecoder(r.Body).Decode(&todo); err != nil {
 		log.C(r.Context()).Errorf("error while todo handler update req body err: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 	todo.ID = todoID
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
+	_, err := h.service.GetTodo(ctx, todoID)
 	if err != nil {
-		log.C(r.Context()).Errorf("error while todo handler update tx err: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
-	_, err = h.service.GetTodo(ctx, todoID)
-	if err != nil {
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
@@ -154,14 +114,10 @@ func (h *Handler) UpdateTodo(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Debugf("todo handler update success, todo: %v", todo)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while todo handler update err: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-	}
-
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while todo handler update tx err: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
 		return
 	}
+
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 }
@@ -173,35 +129,20 @@ func (h *Handler) DeleteTodo(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("erorr while todo handler delete tx err: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
-	_, err = h.service.GetTodo(ctx, id)
+	_, err := h.service.GetTodo(ctx, id)
 	log.C(r.Context()).Debugf("todo handler delete success, todo: %v", id)
 	if err != nil {
 		log.C(r.Context()).Errorf("erorr while todo handler delete err: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
 	if err := h.service.DeleteTodo(ctx, id); err != nil {
 		log.C(r.Context()).Errorf("erorr while todo handler delete err: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("erorr while todo handler delete tx err: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.WriteHeader(http.StatusNoContent)
 }
 
@@ -212,67 +153,73 @@ func (h *Handler) ListTodosByListID(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("todo handler list tx err: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
+	var todosByUser []models.Todo
+	var err error
+	if tagID := r.URL.Query().Get("tag_id"); tagID != "" {
+		if err = pkg.ValidateUUID(tagID); err != nil {
+			log.C(r.Context()).Errorf("invalid tag id: %v", err)
+			problem.Write(w, r, http.StatusBadRequest, "Invalid uuid for the tag", problem.FieldError{Field: "tag_id", Message: err.Error()})
+			return
+		}
+		todosByUser, err = h.service.ListTodosByTagID(ctx, listID, tagID)
+	} else {
+		todosByUser, err = h.service.ListTodosByListID(ctx, listID)
 	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
-	todosByUser, err := h.service.ListTodosByListID(ctx, listID)
 	if err != nil {
 		log.C(r.Context()).Errorf("erorr while todo handler list tx err: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("erorr while todo handler list tx err: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(todosByUser); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 }
 
-func (h *Handler) GetAllTodos(w http.ResponseWriter, r *http.Request) {
-	log.C(r.Context()).Info("todo handler get all request")
+func (h *Handler) ListTodosByListIDs(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("todo list by list ids handler called")
 	ctx := r.Context()
+	ids := pkg.SplitIDs(r.URL.Query().Get("list_ids"))
+	if err := pkg.ValidateBatchIDs(ids); err != nil {
+		log.C(r.Context()).Errorf("error while listing todos by list ids invalid ids: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, err.Error())
+		return
+	}
 
-	tx, err := h.database.BeginTxx(ctx, nil)
+	result, err := h.service.ListTodosByListIDs(ctx, ids)
 	if err != nil {
-		log.C(r.Context()).Errorf("erorr while todo handler get tx err: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		log.C(r.Context()).Errorf("error while listing todos by list ids failed: %v", err)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
-	defer tx.Rollback()
+}
 
-	ctx = db.SaveToContext(ctx, tx)
+func (h *Handler) GetAllTodos(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("todo handler get all request")
+	ctx := r.Context()
 
 	todosByUser, err := h.service.GetAllTodos(ctx)
 	log.C(r.Context()).Debugf("todo handler get all success, todos: %v", todosByUser)
 	if err != nil {
 		log.C(r.Context()).Errorf("erorr while todo handler get all err: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("erorr while todo handler get all tx err: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(todosByUser); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 }
@@ -283,20 +230,10 @@ func (h *Handler) CompleteTodo(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("erorr while completing todo handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
-	_, err = h.service.GetTodo(ctx, todoID)
+	_, err := h.service.GetTodo(ctx, todoID)
 	if err != nil {
 		log.C(r.Context()).Errorf("erorr while completing todo handler, there is no such todo: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
@@ -304,19 +241,14 @@ func (h *Handler) CompleteTodo(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Debugf("complete todo handler for todo: %v", updatedTodo)
 	if err != nil {
 		log.C(r.Context()).Errorf("erorr while completing todo handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("erorr while completing todo handler transaction does not commit: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(updatedTodo); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
 		return
 	}
 }
@@ -330,32 +262,22 @@ func (h *Handler) UpdateTodoDescription(w http.ResponseWriter, r *http.Request)
 	}
 	if err := json.NewDecoder(r.Body).Decode(&updateData); err != nil {
 		log.C(r.Context()).Errorf("erorr while updating todo description handler: %v", err)
-		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
 		return
 	}
 
 	if updateData.Description == "" {
 		log.C(r.Context()).Error("error while updating todo description handler, no description provided")
-		http.Error(w, "Description cannot be empty", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Description cannot be empty", problem.FieldError{Field: "description", Message: "cannot be empty"})
 		return
 	}
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
+	_, err := h.service.GetTodo(ctx, todoID)
 	if err != nil {
 		log.C(r.Context()).Errorf("erorr while updating todo description handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
-	_, err = h.service.GetTodo(ctx, todoID)
-	if err != nil {
-		log.C(r.Context()).Errorf("erorr while updating todo description handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
@@ -363,19 +285,14 @@ func (h *Handler) UpdateTodoDescription(w http.ResponseWriter, r *http.Request)
 	log.C(r.Context()).Debugf("update todo description handler with list: %v", updatedTodo)
 	if err != nil {
 		log.C(r.Context()).Errorf("erorr while updating toso description handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("erorr while updating todo description handler transaction does not commit: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(updatedTodo); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
 		return
 	}
 }
@@ -389,32 +306,22 @@ func (h *Handler) UpdateTodoTitle(w http.ResponseWriter, r *http.Request) {
 	}
 	if err := json.NewDecoder(r.Body).Decode(&updateData); err != nil {
 		log.C(r.Context()).Errorf("erorr while updating todo title handler: %v", err)
-		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
 		return
 	}
 
 	if updateData.Title == "" {
 		log.C(r.Context()).Error("error while updating todo title handler, no title provided")
-		http.Error(w, "Title cannot be empty", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Title cannot be empty", problem.FieldError{Field: "title", Message: "cannot be empty"})
 		return
 	}
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("erorr while updating todo title handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
-	_, err = h.service.GetTodo(ctx, todoID)
+	_, err := h.service.GetTodo(ctx, todoID)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while updating todo title handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
@@ -422,19 +329,14 @@ func (h *Handler) UpdateTodoTitle(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Debugf("update todo title handler with list: %v", updatedTodo)
 	if err != nil {
 		log.C(r.Context()).Errorf("erorr while updating todo title handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("erorr while updating todo title handler transaction does not commit: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(updatedTodo); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
 		return
 	}
 }
@@ -448,34 +350,24 @@ func (h *Handler) UpdateTodoPriority(w http.ResponseWriter, r *http.Request) {
 	}
 	if err := json.NewDecoder(r.Body).Decode(&updateData); err != nil {
 		log.C(r.Context()).Errorf("erorr while updating todo priority handler: %v", err)
-		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
 		return
 	}
 
 	priority, err := converters.ToPriorityLevel(updateData.Priority)
 	if err != nil {
 		log.C(r.Context()).Errorf("invalid priority level: %v", err)
-		http.Error(w, "Invalid priority level", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid priority level", problem.FieldError{Field: "priority", Message: err.Error()})
 		return
 	}
 	log.C(r.Context()).Debugf("update todo priority handler with priority: %v", priority)
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("erorr while updating todo priority handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
 	_, err = h.service.GetTodo(ctx, todoID)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while updating todo priority handler: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
@@ -483,19 +375,14 @@ func (h *Handler) UpdateTodoPriority(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Debugf("update todo priority handler with list: %v", updatedTodo)
 	if err != nil {
 		log.C(r.Context()).Errorf("error updating todo priority handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while updating todo priority handler transaction does not commit: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(updatedTodo); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
 		return
 	}
 }
@@ -509,12 +396,12 @@ func (h *Handler) UpdateAssignedTo(w http.ResponseWriter, r *http.Request) {
 	}
 	if err := json.NewDecoder(r.Body).Decode(&updateData); err != nil {
 		log.C(r.Context()).Errorf("erorr while updating todo assigned_to handler: %v", err)
-		http.Error(w, "Invalid request payload", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
 		return
 	}
 	if err := pkg.ValidateUUID(updateData.UserID); err != nil {
 		log.C(r.Context()).Errorf("invalid user id: %v", err)
-		http.Error(w, "Invalid uuid for the user", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid uuid for the user", problem.FieldError{Field: "user_id", Message: err.Error()})
 		return
 	}
 
@@ -522,40 +409,399 @@ func (h *Handler) UpdateAssignedTo(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
+	_, err := h.service.GetTodo(ctx, todoID)
 	if err != nil {
-		log.C(r.Context()).Errorf("erorr while updating todo assigned_to handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		log.C(r.Context()).Errorf("error while updating todo priority handler, there is no such todo: %v", err)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
-	defer tx.Rollback()
 
-	ctx = db.SaveToContext(ctx, tx)
+	updatedTodo, err := h.service.UpdateAssignedTo(ctx, todoID, updateData.UserID)
+	log.C(r.Context()).Debugf("update todo assigned_to handler with list: %v", updatedTodo)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while updating todo assigned_to handler: %v", err)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
 
-	_, err = h.service.GetTodo(ctx, todoID)
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(updatedTodo); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+func (h *Handler) AddTag(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("add todo tag handler")
+	vars := mux.Vars(r)
+	todoID := vars["id"]
+	tagID := vars["tag_id"]
+
+	updatedTodo, err := h.service.AddTag(r.Context(), todoID, tagID)
+	log.C(r.Context()).Debugf("add todo tag handler for todo: %v", updatedTodo)
 	if err != nil {
-		log.C(r.Context()).Errorf("error while updating todo priority handler, there is no such todo: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		log.C(r.Context()).Errorf("error while adding todo tag handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
 		return
 	}
 
-	updatedTodo, err := h.service.UpdateAssignedTo(ctx, todoID, updateData.UserID)
-	log.C(r.Context()).Debugf("update todo assigned_to handler with list: %v", updatedTodo)
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(updatedTodo); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+func (h *Handler) RemoveTag(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("remove todo tag handler")
+	vars := mux.Vars(r)
+	todoID := vars["id"]
+	tagID := vars["tag_id"]
+
+	updatedTodo, err := h.service.RemoveTag(r.Context(), todoID, tagID)
+	log.C(r.Context()).Debugf("remove todo tag handler for todo: %v", updatedTodo)
 	if err != nil {
-		log.C(r.Context()).Errorf("error while updating todo assigned_to handler: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		log.C(r.Context()).Errorf("error while removing todo tag handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(updatedTodo); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
 		return
 	}
+}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("erorr while updating todo assigned_to handler transaction does not commit: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+// UpdateTodoStatus moves a todo to another status column, or reorders it within its column.
+// The todo is appended to the column when the body has no position.
+func (h *Handler) UpdateTodoStatus(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("update todo status handler")
+	todoID := mux.Vars(r)["id"]
+
+	var updateData struct {
+		StatusID string `json:"status_id"`
+		Position *int   `json:"position"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&updateData); err != nil {
+		log.C(r.Context()).Errorf("error while updating todo status handler: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
 		return
 	}
+	if updateData.StatusID == "" {
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload", problem.FieldError{Field: "status_id", Message: "status_id is required"})
+		return
+	}
+
+	updatedTodo, err := h.service.UpdateTodoStatus(r.Context(), todoID, updateData.StatusID, updateData.Position)
+	log.C(r.Context()).Debugf("update todo status handler with todo: %v", updatedTodo)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while updating todo status handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(updatedTodo); err != nil {
-		http.Error(w, "failed to encode response", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
 		return
 	}
 }
+
+// MoveTodo appends a todo to the list list_id and/or puts it right before the todo before_id and right after
+// the todo after_id of its list. Any of them may be omitted, but not all of them.
+func (h *Handler) MoveTodo(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("move todo handler")
+	todoID := mux.Vars(r)["id"]
+
+	var moveData struct {
+		ListID   string `json:"list_id"`
+		BeforeID string `json:"before_id"`
+		AfterID  string `json:"after_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&moveData); err != nil {
+		log.C(r.Context()).Errorf("error while moving todo handler: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	reorder := moveData.BeforeID != "" || moveData.AfterID != ""
+	if moveData.ListID == "" && !reorder {
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload",
+			problem.FieldError{Field: "list_id", Message: "list_id, before_id or after_id is required"},
+			problem.FieldError{Field: "before_id", Message: "list_id, before_id or after_id is required"},
+			problem.FieldError{Field: "after_id", Message: "list_id, before_id or after_id is required"})
+		return
+	}
+
+	var movedTodo models.Todo
+	var err error
+	if moveData.ListID != "" {
+		actor, ok := actorFromRequest(r)
+		if !ok {
+			problem.Write(w, r, http.StatusUnauthorized, "there is no user claim in the context")
+			return
+		}
+		movedTodo, err = h.service.MoveTodoToList(r.Context(), actor, todoID, moveData.ListID)
+	}
+	if err == nil && reorder {
+		movedTodo, err = h.service.MoveTodo(r.Context(), todoID, moveData.BeforeID, moveData.AfterID)
+	}
+	log.C(r.Context()).Debugf("move todo handler with todo: %v", movedTodo)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while moving todo handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(movedTodo); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+// MoveTodos appends the todos ids to the list list_id in the given order. Nothing is moved when any of
+// them cannot be moved.
+func (h *Handler) MoveTodos(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("move todos handler")
+	actor, ok := actorFromRequest(r)
+	if !ok {
+		problem.Write(w, r, http.StatusUnauthorized, "there is no user claim in the context")
+		return
+	}
+
+	var moveData struct {
+		IDs    []string `json:"ids"`
+		ListID string   `json:"list_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&moveData); err != nil {
+		log.C(r.Context()).Errorf("error while moving todos handler: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	var fieldErrors []problem.FieldError
+	if len(moveData.IDs) == 0 {
+		fieldErrors = append(fieldErrors, problem.FieldError{Field: "ids", Message: "ids is required"})
+	}
+	if moveData.ListID == "" {
+		fieldErrors = append(fieldErrors, problem.FieldError{Field: "list_id", Message: "list_id is required"})
+	}
+	if len(fieldErrors) > 0 {
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload", fieldErrors...)
+		return
+	}
+
+	movedTodos, err := h.service.MoveTodosToList(r.Context(), actor, moveData.IDs, moveData.ListID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while moving todos handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(movedTodos); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+// bulkResult is the outcome of one bulk operation. Status is the HTTP status the operation would have got
+// from its own endpoint.
+type bulkResult struct {
+	Action models.BulkAction `json:"action"`
+	TodoID string            `json:"todo_id"`
+	Status int               `json:"status"`
+	Error  string            `json:"error,omitempty"`
+	Todo   *models.Todo      `json:"todo,omitempty"`
+}
+
+// BulkUpdateTodos applies a batch of operations in one transaction and answers with a result for every
+// operation in the order of the request. A failed operation is undone without affecting the others.
+func (h *Handler) BulkUpdateTodos(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("bulk update todos handler")
+	actor, ok := actorFromRequest(r)
+	if !ok {
+		problem.Write(w, r, http.StatusUnauthorized, "there is no user claim in the context")
+		return
+	}
+
+	var bulkData struct {
+		Operations []models.BulkOperation `json:"operations"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&bulkData); err != nil {
+		log.C(r.Context()).Errorf("error while bulk updating todos handler: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	if len(bulkData.Operations) == 0 {
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload",
+			problem.FieldError{Field: "operations", Message: "operations is required"})
+		return
+	}
+
+	results, err := h.service.BulkUpdateTodos(r.Context(), actor, bulkData.Operations)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while bulk updating todos handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	response := struct {
+		Results []bulkResult `json:"results"`
+	}{Results: make([]bulkResult, 0, len(results))}
+	for _, result := range results {
+		item := bulkResult{Action: result.Operation.Action, TodoID: result.Operation.TodoID, Status: http.StatusOK, Todo: result.Todo}
+		switch {
+		case result.Err != nil:
+			item.Status = problem.StatusFor(result.Err, http.StatusInternalServerError)
+			item.Error = result.Err.Error()
+		case result.Operation.Action == models.BulkDelete:
+			item.Status = http.StatusNoContent
+		}
+		response.Results = append(response.Results, item)
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+// CopyTodo appends a copy of a todo to the list list_id, which may be the list of the todo.
+func (h *Handler) CopyTodo(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("copy todo handler")
+	actor, ok := actorFromRequest(r)
+	if !ok {
+		problem.Write(w, r, http.StatusUnauthorized, "there is no user claim in the context")
+		return
+	}
+	todoID := mux.Vars(r)["id"]
+
+	var copyData struct {
+		ListID string `json:"list_id"`
+	}
+	if err := json.NewDecoder(r.Body).Decode(&copyData); err != nil {
+		log.C(r.Context()).Errorf("error while copying todo handler: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload")
+		return
+	}
+	if copyData.ListID == "" {
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request payload",
+			problem.FieldError{Field: "list_id", Message: "list_id is required"})
+		return
+	}
+
+	copiedTodo, err := h.service.CopyTodo(r.Context(), actor, todoID, copyData.ListID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while copying todo handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusCreated)
+	if err := json.NewEncoder(w).Encode(copiedTodo); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+// AddDependency makes the todo wait for the todo blocker_id of the same list.
+func (h *Handler) AddDependency(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("add todo dependency handler")
+	vars := mux.Vars(r)
+	todoID := vars["id"]
+	blockerID := vars["blocker_id"]
+
+	updatedTodo, err := h.service.AddDependency(r.Context(), todoID, blockerID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while adding todo dependency handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(updatedTodo); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+func (h *Handler) RemoveDependency(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("remove todo dependency handler")
+	vars := mux.Vars(r)
+	todoID := vars["id"]
+	blockerID := vars["blocker_id"]
+
+	updatedTodo, err := h.service.RemoveDependency(r.Context(), todoID, blockerID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while removing todo dependency handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(updatedTodo); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+// ListBlockers returns the todos that the todo waits for.
+func (h *Handler) ListBlockers(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list todo blockers handler")
+	todoID := mux.Vars(r)["id"]
+
+	blockers, err := h.service.ListBlockers(r.Context(), todoID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing todo blockers handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(blockers); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+// ListBlocked returns the todos that wait for the todo.
+func (h *Handler) ListBlocked(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("list todos blocked by todo handler")
+	todoID := mux.Vars(r)["id"]
+
+	blocked, err := h.service.ListBlocked(r.Context(), todoID)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while listing todos blocked by todo handler: %v", err)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusInternalServerError), err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(blocked); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, "failed to encode response")
+		return
+	}
+}
+
+func actorFromRequest(r *http.Request) (todos.Actor, bool) {
+	claim, ok := jwt.ClaimsFromContext(r.Context())
+	if !ok {
+		log.C(r.Context()).Error("there is no user claim in the context")
+		return todos.Actor{}, false
+	}
+	return todos.Actor{UserID: claim.ID, Admin: claim.Role == string(constants.Admin)}, true
+}
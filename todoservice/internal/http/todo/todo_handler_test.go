@@ -4,37 +4,37 @@ import (
 	"bytes"
 	"encoding/json"
 	"errors"
+	"fmt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/http/todo"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/todos"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/todos/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt/jwttest"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/problem"
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
-	sqlxmock "github.com/zhashkevych/go-sqlxmock"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 )
 
 func TestCreateTodoHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	err = errors.New("error")
+	err := errors.New("error")
 	id := "1"
 	modelInput := models.Todo{
 		ID:          id,
 		Title:       "Test Todo",
 		Description: "Test Todo",
 		ListID:      "list1",
-		Tags:        json.RawMessage{0x6e, 0x75, 0x6c, 0x6c},
 	}
 
 	tests := []struct {
 		name               string
 		mockService        func() *automock.TodoService
-		mockDatabase       func()
 		expectedStatusCode int
 		expectedError      error
 	}{
@@ -45,10 +45,6 @@ func TestCreateTodoHandler(t *testing.T) {
 				mockService.EXPECT().CreateTodo(mock.Anything, modelInput).Return(id, nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusCreated,
 			expectedError:      nil,
 		},
@@ -59,10 +55,6 @@ func TestCreateTodoHandler(t *testing.T) {
 				mockService.EXPECT().CreateTodo(mock.Anything, modelInput).Return("", err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectRollback()
-			},
 			expectedStatusCode: http.StatusBadRequest,
 			expectedError:      err,
 		},
@@ -70,7 +62,7 @@ func TestCreateTodoHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := todo.NewHandler(mockService, db)
+			handler := todo.NewHandler(mockService)
 
 			body, _ := json.Marshal(modelInput)
 			req, _ := http.NewRequest(http.MethodPost, "/todos/create", bytes.NewBuffer(body))
@@ -78,8 +70,6 @@ func TestCreateTodoHandler(t *testing.T) {
 			w := httptest.NewRecorder()
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.CreateTodo(w, req)
 			resp := w.Result()
 			defer func() {
@@ -100,17 +90,12 @@ func TestCreateTodoHandler(t *testing.T) {
 				assert.Equal(t, "1", respID)
 			}
 
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
-			}
 		})
 	}
 }
 
 func TestGetTodoHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	err = errors.New("error")
+	err := errors.New("error")
 	id := "1"
 	modelInput := models.Todo{
 		ID:          id,
@@ -127,7 +112,6 @@ func TestGetTodoHandler(t *testing.T) {
 	tests := []struct {
 		name               string
 		mockService        func() *automock.TodoService
-		mockDatabase       func()
 		expectedStatusCode int
 		urlVars            map[string]string
 		expectedError      error
@@ -139,10 +123,6 @@ func TestGetTodoHandler(t *testing.T) {
 				mockService.EXPECT().GetTodo(mock.Anything, id).Return(model, nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusOK,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      nil,
@@ -154,10 +134,6 @@ func TestGetTodoHandler(t *testing.T) {
 				mockService.EXPECT().GetTodo(mock.Anything, id).Return(models.Todo{}, err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectRollback()
-			},
 			expectedStatusCode: http.StatusNotFound,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      err,
@@ -166,7 +142,7 @@ func TestGetTodoHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := todo.NewHandler(mockService, db)
+			handler := todo.NewHandler(mockService)
 
 			body, _ := json.Marshal(modelInput)
 			req, _ := http.NewRequest(http.MethodGet, "/todos/1", bytes.NewBuffer(body))
@@ -175,8 +151,6 @@ func TestGetTodoHandler(t *testing.T) {
 			req = mux.SetURLVars(req, tt.urlVars)
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.GetTodo(w, req)
 			resp := w.Result()
 			defer func() {
@@ -200,29 +174,22 @@ func TestGetTodoHandler(t *testing.T) {
 				assert.JSONEq(t, string(expectedResponse), actualResponse.String())
 			}
 
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
-			}
 		})
 	}
 }
 
 func TestUpdateTodoHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	err = errors.New("error")
+	err := errors.New("error")
 	id := ""
 	modelInput := models.Todo{
 		ID:          id,
 		Title:       "Test Todo",
 		Description: "Test Todo",
 		ListID:      "list1",
-		Tags:        json.RawMessage{0x6e, 0x75, 0x6c, 0x6c},
 	}
 	tests := []struct {
 		name               string
 		mockService        func() *automock.TodoService
-		mockDatabase       func()
 		expectedStatusCode int
 		urlVars            map[string]string
 		expectedError      error
@@ -235,10 +202,6 @@ func TestUpdateTodoHandler(t *testing.T) {
 				mockService.EXPECT().UpdateTodo(mock.Anything, modelInput).Return(nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusOK,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      nil,
@@ -250,10 +213,6 @@ func TestUpdateTodoHandler(t *testing.T) {
 				mockService.EXPECT().GetTodo(mock.Anything, id).Return(models.Todo{}, err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectRollback()
-			},
 			expectedStatusCode: http.StatusNotFound,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      err,
@@ -262,7 +221,7 @@ func TestUpdateTodoHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := todo.NewHandler(mockService, db)
+			handler := todo.NewHandler(mockService)
 
 			body, _ := json.Marshal(modelInput)
 			req, _ := http.NewRequest(http.MethodPut, "/todos/update/1", bytes.NewBuffer(body))
@@ -270,8 +229,6 @@ func TestUpdateTodoHandler(t *testing.T) {
 			w := httptest.NewRecorder()
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.UpdateTodo(w, req)
 			resp := w.Result()
 			defer func() {
@@ -292,23 +249,17 @@ func TestUpdateTodoHandler(t *testing.T) {
 				assert.Equal(t, "", response)
 			}
 
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
-			}
 		})
 	}
 }
 
 func TestDeleteTodoHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	err = errors.New("error")
+	err := errors.New("error")
 	id := "1"
 
 	tests := []struct {
 		name               string
 		mockService        func() *automock.TodoService
-		mockDatabase       func()
 		expectedStatusCode int
 		urlVars            map[string]string
 		expectedError      error
@@ -321,10 +272,6 @@ func TestDeleteTodoHandler(t *testing.T) {
 				mockService.EXPECT().DeleteTodo(mock.Anything, id).Return(nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusNoContent,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      nil,
@@ -336,10 +283,6 @@ func TestDeleteTodoHandler(t *testing.T) {
 				mockService.EXPECT().GetTodo(mock.Anything, id).Return(models.Todo{}, err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectRollback()
-			},
 			expectedStatusCode: http.StatusNotFound,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      err,
@@ -348,7 +291,7 @@ func TestDeleteTodoHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := todo.NewHandler(mockService, db)
+			handler := todo.NewHandler(mockService)
 
 			req, _ := http.NewRequest(http.MethodDelete, "/todos/delete/1", nil)
 			req.Header.Set("Content-Type", constants.ContentTypeJSON)
@@ -356,8 +299,6 @@ func TestDeleteTodoHandler(t *testing.T) {
 			w := httptest.NewRecorder()
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.DeleteTodo(w, req)
 			resp := w.Result()
 			defer func() {
@@ -378,17 +319,12 @@ func TestDeleteTodoHandler(t *testing.T) {
 				assert.Equal(t, "", respID)
 			}
 
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
-			}
 		})
 	}
 }
 
 func TestListTodosByListIDHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	err = errors.New("error")
+	err := errors.New("error")
 	id := "1"
 	model := models.Todo{
 		ID:          id,
@@ -399,7 +335,6 @@ func TestListTodosByListIDHandler(t *testing.T) {
 	tests := []struct {
 		name               string
 		mockService        func() *automock.TodoService
-		mockDatabase       func()
 		expectedStatusCode int
 		urlVars            map[string]string
 		expectedError      error
@@ -411,10 +346,6 @@ func TestListTodosByListIDHandler(t *testing.T) {
 				mockService.EXPECT().ListTodosByListID(mock.Anything, id).Return([]models.Todo{model}, nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusOK,
 			urlVars:            map[string]string{"list_id": id},
 			expectedError:      nil,
@@ -426,10 +357,6 @@ func TestListTodosByListIDHandler(t *testing.T) {
 				mockService.EXPECT().ListTodosByListID(mock.Anything, id).Return([]models.Todo{}, err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectRollback()
-			},
 			expectedStatusCode: http.StatusNotFound,
 			urlVars:            map[string]string{"list_id": id},
 			expectedError:      err,
@@ -438,7 +365,7 @@ func TestListTodosByListIDHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := todo.NewHandler(mockService, db)
+			handler := todo.NewHandler(mockService)
 
 			req, _ := http.NewRequest(http.MethodGet, "/lists/1/todos", nil)
 			req.Header.Set("Content-Type", constants.ContentTypeJSON)
@@ -447,8 +374,6 @@ func TestListTodosByListIDHandler(t *testing.T) {
 			req = mux.SetURLVars(req, tt.urlVars)
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.ListTodosByListID(w, req)
 			resp := w.Result()
 			defer func() {
@@ -472,8 +397,534 @@ func TestListTodosByListIDHandler(t *testing.T) {
 				assert.JSONEq(t, string(expectedResponse), actualResponse.String())
 			}
 
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
+		})
+	}
+}
+
+func TestListTodosByListIDsHandler(t *testing.T) {
+	err := errors.New("error")
+	models1 := []models.Todo{
+		{ID: "1", Title: "First", ListID: "list1"},
+		{ID: "2", Title: "Second", ListID: "list2"},
+	}
+	tests := []struct {
+		name               string
+		url                string
+		mockService        func() *automock.TodoService
+		expectedStatusCode int
+		expectedResponse   []models.Todo
+	}{
+		{
+			name: "List all by multiple list ids",
+			url:  "/todos?list_ids=list1,list2",
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().ListTodosByListIDs(mock.Anything, []string{"list1", "list2"}).Return(models1, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedResponse:   models1,
+		},
+		{
+			name: "Bad request when no list ids are given",
+			url:  "/todos?list_ids=,",
+			mockService: func() *automock.TodoService {
+				return &automock.TodoService{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error when service fails",
+			url:  "/todos?list_ids=list1",
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().ListTodosByListIDs(mock.Anything, []string{"list1"}).Return(nil, err).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusInternalServerError,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := todo.NewHandler(mockService)
+
+			req, _ := http.NewRequest(http.MethodGet, tt.url, nil)
+			w := httptest.NewRecorder()
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			handler.ListTodosByListIDs(w, req)
+			resp := w.Result()
+
+			assert.Equal(t, tt.expectedStatusCode, resp.StatusCode)
+			if tt.expectedResponse != nil {
+				expectedResponse, _ := json.Marshal(tt.expectedResponse)
+				assert.JSONEq(t, string(expectedResponse), w.Body.String())
+			}
+
+		})
+	}
+}
+
+func TestUpdateTodoTitleHandler_ProblemDetails(t *testing.T) {
+	err := errors.New("todo not found")
+	tests := []struct {
+		name            string
+		body            string
+		mockService     func() *automock.TodoService
+		expectedProblem problem.Details
+	}{
+		{
+			name: "Validation error lists the offending field",
+			body: `{"title": ""}`,
+			mockService: func() *automock.TodoService {
+				return &automock.TodoService{}
+			},
+			expectedProblem: problem.Details{
+				Status:    http.StatusBadRequest,
+				Code:      problem.CodeValidationFailed,
+				Message:   "Title cannot be empty",
+				Fields:    []problem.FieldError{{Field: "title", Message: "cannot be empty"}},
+				RequestID: "req-1",
+			},
+		},
+		{
+			name: "Missing todo is reported as not found",
+			body: `{"title": "New title"}`,
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().GetTodo(mock.Anything, "1").Return(models.Todo{}, err).Once()
+				return mockService
+			},
+			expectedProblem: problem.Details{
+				Status:    http.StatusNotFound,
+				Code:      problem.CodeNotFound,
+				Message:   err.Error(),
+				RequestID: "req-1",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := todo.NewHandler(mockService)
+
+			req, _ := http.NewRequest(http.MethodPatch, "/todos/1/title", bytes.NewBufferString(tt.body))
+			req.Header.Set(problem.RequestIDHeader, "req-1")
+			req = mux.SetURLVars(req, map[string]string{"id": "1"})
+			w := httptest.NewRecorder()
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			handler.UpdateTodoTitle(w, req)
+			resp := w.Result()
+
+			assert.Equal(t, tt.expectedProblem.Status, resp.StatusCode)
+			assert.Equal(t, problem.ContentType, resp.Header.Get("Content-Type"))
+			var actual problem.Details
+			require.NoError(t, json.NewDecoder(resp.Body).Decode(&actual))
+			assert.Equal(t, tt.expectedProblem, actual)
+
+		})
+	}
+}
+
+func TestUpdateTodoStatusHandler(t *testing.T) {
+	position := 0
+	moved := models.Todo{ID: "1", ListID: "list1", StatusID: "status2", Position: "V"}
+
+	tests := []struct {
+		name               string
+		body               string
+		mockService        func() *automock.TodoService
+		expectedStatusCode int
+	}{
+		{
+			name: "Move todo to the top of another status",
+			body: `{"status_id":"status2","position":0}`,
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().UpdateTodoStatus(mock.Anything, "1", "status2", &position).Return(moved, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Error when the transition is not allowed",
+			body: `{"status_id":"status2"}`,
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().UpdateTodoStatus(mock.Anything, "1", "status2", (*int)(nil)).
+					Return(models.Todo{}, fmt.Errorf("todos in status %q cannot move to status %q: %w", "To Do", "Done", pkg.ErrConflict)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name: "Error when the status is missing",
+			body: `{"position":0}`,
+			mockService: func() *automock.TodoService {
+				return &automock.TodoService{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := todo.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodPatch, "/todos/1/status", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", constants.ContentTypeJSON)
+			req = mux.SetURLVars(req, map[string]string{"id": "1"})
+			w := httptest.NewRecorder()
+
+			handler.UpdateTodoStatus(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			if tt.expectedStatusCode == http.StatusOK {
+				var got models.Todo
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, moved.StatusID, got.StatusID)
+			}
+		})
+	}
+}
+
+func TestMoveTodoHandler(t *testing.T) {
+	moved := models.Todo{ID: "1", ListID: "list1", StatusID: "status1", Position: "AV"}
+
+	tests := []struct {
+		name               string
+		body               string
+		mockService        func() *automock.TodoService
+		expectedStatusCode int
+	}{
+		{
+			name: "Move todo between two neighbours",
+			body: `{"before_id":"3","after_id":"2"}`,
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().MoveTodo(mock.Anything, "1", "3", "2").Return(moved, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Error when the neighbour is in another list",
+			body: `{"after_id":"2"}`,
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().MoveTodo(mock.Anything, "1", "", "2").
+					Return(models.Todo{}, fmt.Errorf("todo 2 is not in list list1: %w", pkg.ErrBadRequest)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Move todo to another list and reorder it",
+			body: `{"list_id":"list2","after_id":"2"}`,
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().MoveTodoToList(mock.Anything, todos.Actor{UserID: "user1"}, "1", "list2").Return(models.Todo{ID: "1", ListID: "list2"}, nil).Once()
+				mockService.EXPECT().MoveTodo(mock.Anything, "1", "", "2").Return(moved, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Error when the user cannot write to the target list",
+			body: `{"list_id":"list2"}`,
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().MoveTodoToList(mock.Anything, todos.Actor{UserID: "user1"}, "1", "list2").
+					Return(models.Todo{}, fmt.Errorf("user user1 cannot write to list list2: %w", pkg.ErrForbidden)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusForbidden,
+		},
+		{
+			name: "Error when the target list is archived",
+			body: `{"list_id":"list2"}`,
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().MoveTodoToList(mock.Anything, todos.Actor{UserID: "user1"}, "1", "list2").
+					Return(models.Todo{}, fmt.Errorf("list list2 is archived and read-only: %w", pkg.ErrConflict)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name: "Error when no neighbour is given",
+			body: `{}`,
+			mockService: func() *automock.TodoService {
+				return &automock.TodoService{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := todo.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodPost, "/todos/1/move", bytes.NewBufferString(tt.body))
+			req.Header.Set("Content-Type", constants.ContentTypeJSON)
+			req = mux.SetURLVars(jwttest.WithUser(req, "user1", constants.Writer), map[string]string{"id": "1"})
+			w := httptest.NewRecorder()
+
+			handler.MoveTodo(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			if tt.expectedStatusCode == http.StatusOK {
+				var got models.Todo
+				require.NoError(t, json.NewDecoder(w.Body).Decode(&got))
+				assert.Equal(t, moved.Position, got.Position)
+			}
+		})
+	}
+}
+
+func TestAddDependencyHandler(t *testing.T) {
+	blocked := models.Todo{ID: "1", ListID: "list1"}
+
+	tests := []struct {
+		name               string
+		mockService        func() *automock.TodoService
+		expectedStatusCode int
+	}{
+		{
+			name: "Add dependency",
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().AddDependency(mock.Anything, "1", "2").Return(blocked, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Error when the dependency closes a cycle",
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().AddDependency(mock.Anything, "1", "2").
+					Return(models.Todo{}, fmt.Errorf("todo 2 already waits for todo 1: %w", pkg.ErrConflict)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := todo.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodPut, "/todos/1/blocked_by/2", nil)
+			req = mux.SetURLVars(req, map[string]string{"id": "1", "blocker_id": "2"})
+			w := httptest.NewRecorder()
+
+			handler.AddDependency(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestCopyTodoHandler(t *testing.T) {
+	copied := models.Todo{ID: "2", ListID: "list2", Title: "Todo"}
+
+	tests := []struct {
+		name               string
+		body               string
+		mockService        func() *automock.TodoService
+		expectedStatusCode int
+	}{
+		{
+			name: "Copy todo to another list",
+			body: `{"list_id":"list2"}`,
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().CopyTodo(mock.Anything, todos.Actor{UserID: "admin1", Admin: true}, "1", "list2").Return(copied, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusCreated,
+		},
+		{
+			name: "Error when the list is archived",
+			body: `{"list_id":"list2"}`,
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().CopyTodo(mock.Anything, todos.Actor{UserID: "admin1", Admin: true}, "1", "list2").
+					Return(models.Todo{}, fmt.Errorf("list list2 is archived and read-only: %w", pkg.ErrConflict)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name: "Error when the list is missing",
+			body: `{}`,
+			mockService: func() *automock.TodoService {
+				return &automock.TodoService{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := todo.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodPost, "/todos/1/copy", bytes.NewBufferString(tt.body))
+			req = mux.SetURLVars(jwttest.WithUser(req, "admin1", constants.Admin), map[string]string{"id": "1"})
+			w := httptest.NewRecorder()
+
+			handler.CopyTodo(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestMoveTodosHandler(t *testing.T) {
+	actor := todos.Actor{UserID: "user1"}
+
+	tests := []struct {
+		name               string
+		body               string
+		mockService        func() *automock.TodoService
+		expectedStatusCode int
+	}{
+		{
+			name: "Move todos to another list",
+			body: `{"ids":["1","2"],"list_id":"list2"}`,
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().MoveTodosToList(mock.Anything, actor, []string{"1", "2"}, "list2").
+					Return([]models.Todo{{ID: "1", ListID: "list2"}, {ID: "2", ListID: "list2"}}, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusOK,
+		},
+		{
+			name: "Error when a todo is in an archived list",
+			body: `{"ids":["1","2"],"list_id":"list2"}`,
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().MoveTodosToList(mock.Anything, actor, []string{"1", "2"}, "list2").
+					Return(nil, fmt.Errorf("failed to move todo 2: list list1 is archived and read-only: %w", pkg.ErrConflict)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusConflict,
+		},
+		{
+			name: "Error when the list is missing",
+			body: `{"ids":["1"]}`,
+			mockService: func() *automock.TodoService {
+				return &automock.TodoService{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := todo.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodPost, "/todos/move", bytes.NewBufferString(tt.body))
+			req = jwttest.WithUser(req, "user1", constants.Writer)
+			w := httptest.NewRecorder()
+
+			handler.MoveTodos(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+		})
+	}
+}
+
+func TestBulkUpdateTodosHandler(t *testing.T) {
+	completed := models.Todo{ID: "1", ListID: "list1", Completed: true}
+	complete := models.BulkOperation{Action: models.BulkComplete, TodoID: "1"}
+	deleteTodo := models.BulkOperation{Action: models.BulkDelete, TodoID: "2"}
+	reassign := models.BulkOperation{Action: models.BulkReassign, TodoID: "3", AssignedTo: "user2"}
+	move := models.BulkOperation{Action: models.BulkMove, TodoID: "1", ListID: "list2"}
+	actor := todos.Actor{UserID: "user1"}
+
+	tests := []struct {
+		name               string
+		body               string
+		mockService        func() *automock.TodoService
+		expectedStatusCode int
+		expectedBody       string
+	}{
+		{
+			name: "Report the result of every operation",
+			body: `{"operations":[{"action":"complete","todo_id":"1"},{"action":"delete","todo_id":"2"},{"action":"reassign","todo_id":"3","assigned_to":"user2"}]}`,
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().BulkUpdateTodos(mock.Anything, actor, []models.BulkOperation{complete, deleteTodo, reassign}).Return([]models.BulkResult{
+					{Operation: complete, Todo: &completed},
+					{Operation: deleteTodo},
+					{Operation: reassign, Err: fmt.Errorf("user user2 cannot write to list list1: %w", pkg.ErrForbidden)},
+				}, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody: `{"results":[` +
+				`{"action":"complete","todo_id":"1","status":200,"todo":{"id":"1","list_id":"list1","title":"","description":"","tags":null,"completed":true,"status_id":"","position":"","due_date":null,"start_date":null,"priority":"","creation_date":"0001-01-01T00:00:00Z","last_update_date":"0001-01-01T00:00:00Z","assigned_to":null}},` +
+				`{"action":"delete","todo_id":"2","status":204},` +
+				`{"action":"reassign","todo_id":"3","status":403,"error":"user user2 cannot write to list list1: forbidden"}]}`,
+		},
+		{
+			name: "Report a move into an archived list as a conflict",
+			body: `{"operations":[{"action":"move","todo_id":"1","list_id":"list2"}]}`,
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().BulkUpdateTodos(mock.Anything, actor, []models.BulkOperation{move}).Return([]models.BulkResult{
+					{Operation: move, Err: fmt.Errorf("list list2 is archived and read-only: %w", pkg.ErrConflict)},
+				}, nil).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusOK,
+			expectedBody:       `{"results":[{"action":"move","todo_id":"1","status":409,"error":"list list2 is archived and read-only: conflict"}]}`,
+		},
+		{
+			name: "Error when there are too many operations",
+			body: `{"operations":[{"action":"complete","todo_id":"1"}]}`,
+			mockService: func() *automock.TodoService {
+				mockService := &automock.TodoService{}
+				mockService.EXPECT().BulkUpdateTodos(mock.Anything, actor, []models.BulkOperation{complete}).
+					Return(nil, fmt.Errorf("at most 100 operations are allowed: %w", pkg.ErrBadRequest)).Once()
+				return mockService
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+		{
+			name: "Error without operations",
+			body: `{"operations":[]}`,
+			mockService: func() *automock.TodoService {
+				return &automock.TodoService{}
+			},
+			expectedStatusCode: http.StatusBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			mockService := tt.mockService()
+			handler := todo.NewHandler(mockService)
+			defer mock.AssertExpectationsForObjects(t, mockService)
+
+			req, _ := http.NewRequest(http.MethodPost, "/todos/bulk", bytes.NewBufferString(tt.body))
+			req = jwttest.WithUser(req, "user1", constants.Writer)
+			w := httptest.NewRecorder()
+
+			handler.BulkUpdateTodos(w, req)
+
+			assert.Equal(t, tt.expectedStatusCode, w.Code)
+			if tt.expectedBody != "" {
+				assert.JSONEq(t, tt.expectedBody, w.Body.String())
 			}
 		})
 	}
@@ -0,0 +1,198 @@
+package http
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/httpserver"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/problem"
+	"github.com/jmoiron/sqlx"
+	"io"
+	"net/http"
+)
+
+// errUnsuccessfulResponse rolls the request transaction back without being reported as a failure.
+var errUnsuccessfulResponse = errors.New("handler answered with a non 2xx status")
+
+// UnitOfWork runs every request in a single transaction shared by the authorization middlewares,
+// the handler and the services, so that access checks and writes are atomic.
+type UnitOfWork struct {
+	database     *sqlx.DB
+	config       db.TransactionConfig
+	isolation    sql.IsolationLevel
+	maxBodyBytes int64
+}
+
+// NewUnitOfWork expects config to be validated, an unknown isolation level falls back to the database default.
+// maxBodyBytes caps the request bodies buffered by Handle, a non-positive value falls back to httpserver.DefaultMaxBodyBytes.
+func NewUnitOfWork(database *sqlx.DB, config db.TransactionConfig, maxBodyBytes int64) *UnitOfWork {
+	isolation, _ := db.ParseIsolationLevel(config.IsolationLevel)
+	if maxBodyBytes <= 0 {
+		maxBodyBytes = httpserver.DefaultMaxBodyBytes
+	}
+	return &UnitOfWork{database: database, config: config, isolation: isolation, maxBodyBytes: maxBodyBytes}
+}
+
+// Handle opens the transaction, read-only for safe methods, and stores it in the request context.
+// The response is buffered so that it is only sent once the outcome of the transaction is known:
+// it is committed when the handler answers with 2xx and rolled back otherwise.
+// The request body is read up front so that it can be replayed, bodies above the limit are rejected with 413.
+// Serialization failures and deadlocks re-run the whole request, see db.Transact.
+func (u *UnitOfWork) Handle(next http.Handler) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		var body []byte
+		if r.Body != nil {
+			var err error
+			if body, err = io.ReadAll(http.MaxBytesReader(w, r.Body, u.maxBodyBytes)); err != nil {
+				var maxBytesErr *http.MaxBytesError
+				if errors.As(err, &maxBytesErr) {
+					problem.Write(w, r, http.StatusRequestEntityTooLarge, fmt.Sprintf("the request body exceeds the limit of %d bytes", maxBytesErr.Limit))
+					return
+				}
+				log.C(ctx).Errorf("error reading request body: %v", err)
+				problem.Write(w, r, http.StatusBadRequest, "error reading the request body")
+				return
+			}
+		}
+
+		readOnly := r.Method == http.MethodGet || r.Method == http.MethodHead
+		opts := &sql.TxOptions{Isolation: u.isolation, ReadOnly: readOnly}
+		var buffered *bufferedResponseWriter
+		err := db.Transact(ctx, u.database, opts, u.config, func(ctx context.Context) error {
+			buffered = newBufferedResponseWriter()
+			attempt := r.WithContext(ctx)
+			attempt.Body = io.NopCloser(bytes.NewReader(body))
+			next.ServeHTTP(buffered, attempt)
+			if buffered.status < http.StatusOK || buffered.status >= http.StatusMultipleChoices {
+				return errUnsuccessfulResponse
+			}
+			return nil
+		})
+
+		u.respond(w, r, buffered, err)
+	})
+}
+
+// HandleStreaming is Handle for requests whose body or response is too large to be held in memory,
+// e.g. attachment uploads and downloads. The request body cannot be replayed, so it runs exactly once.
+// Responses to safe methods are streamed as they are written; the others are buffered like in Handle,
+// so that a failed commit is never reported as a success. Handlers streaming a large response body should
+// write it with db.AfterTransaction, so that the connection is not held while the client reads it.
+func (u *UnitOfWork) HandleStreaming(next http.Handler) http.Handler {
+	config := u.config
+	config.MaxAttempts = 1
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := r.Context()
+		readOnly := r.Method == http.MethodGet || r.Method == http.MethodHead
+		opts := &sql.TxOptions{Isolation: u.isolation, ReadOnly: readOnly}
+		if !readOnly {
+			buffered := newBufferedResponseWriter()
+			err := db.Transact(ctx, u.database, opts, config, func(ctx context.Context) error {
+				next.ServeHTTP(buffered, r.WithContext(ctx))
+				if buffered.status < http.StatusOK || buffered.status >= http.StatusMultipleChoices {
+					return errUnsuccessfulResponse
+				}
+				return nil
+			})
+			u.respond(w, r, buffered, err)
+			return
+		}
+
+		streamed := &streamingResponseWriter{ResponseWriter: w, status: http.StatusOK}
+		err := db.Transact(ctx, u.database, opts, config, func(ctx context.Context) error {
+			next.ServeHTTP(streamed, r.WithContext(ctx))
+			if streamed.status < http.StatusOK || streamed.status >= http.StatusMultipleChoices {
+				return errUnsuccessfulResponse
+			}
+			return nil
+		})
+		if err == nil || errors.Is(err, errUnsuccessfulResponse) {
+			return
+		}
+		log.C(ctx).Errorf("request transaction failed: %v", err)
+		if !streamed.wroteHeader {
+			problem.Write(w, r, http.StatusInternalServerError, "error completing the transaction")
+		}
+	})
+}
+
+func (u *UnitOfWork) respond(w http.ResponseWriter, r *http.Request, buffered *bufferedResponseWriter, err error) {
+	ctx := r.Context()
+	switch {
+	case err == nil:
+		buffered.flush(w, r)
+	case errors.Is(err, errUnsuccessfulResponse):
+		log.C(ctx).Debugf("rolled back request transaction, status is %d", buffered.status)
+		buffered.flush(w, r)
+	default:
+		log.C(ctx).Errorf("request transaction failed: %v", err)
+		if _, retryable := db.RetryableSQLState(err); retryable {
+			problem.Write(w, r, http.StatusConflict, "the resource was modified concurrently, please retry")
+			return
+		}
+		problem.Write(w, r, http.StatusInternalServerError, "error completing the transaction")
+	}
+}
+
+type streamingResponseWriter struct {
+	http.ResponseWriter
+	status      int
+	wroteHeader bool
+}
+
+func (s *streamingResponseWriter) WriteHeader(status int) {
+	if s.wroteHeader {
+		return
+	}
+	s.status = status
+	s.wroteHeader = true
+	s.ResponseWriter.WriteHeader(status)
+}
+
+func (s *streamingResponseWriter) Write(p []byte) (int, error) {
+	s.wroteHeader = true
+	return s.ResponseWriter.Write(p)
+}
+
+type bufferedResponseWriter struct {
+	header      http.Header
+	status      int
+	wroteHeader bool
+	body        bytes.Buffer
+}
+
+func newBufferedResponseWriter() *bufferedResponseWriter {
+	return &bufferedResponseWriter{header: http.Header{}, status: http.StatusOK}
+}
+
+func (b *bufferedResponseWriter) Header() http.Header {
+	return b.header
+}
+
+func (b *bufferedResponseWriter) WriteHeader(status int) {
+	if b.wroteHeader {
+		return
+	}
+	b.status = status
+	b.wroteHeader = true
+}
+
+func (b *bufferedResponseWriter) Write(p []byte) (int, error) {
+	b.wroteHeader = true
+	return b.body.Write(p)
+}
+
+func (b *bufferedResponseWriter) flush(w http.ResponseWriter, r *http.Request) {
+	for key, values := range b.header {
+		w.Header()[key] = values
+	}
+	w.WriteHeader(b.status)
+	if _, err := w.Write(b.body.Bytes()); err != nil {
+		log.C(r.Context()).Errorf("error writing response: %v", err)
+	}
+}
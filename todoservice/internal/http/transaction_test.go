@@ -0,0 +1,222 @@
+package http_test
+
+import (
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	http2 "github.com/Victor-Uzunov/devops-project/todoservice/internal/http"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/httpserver"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestUnitOfWork_Handle(t *testing.T) {
+	tests := []struct {
+		name           string
+		status         int
+		mockDatabase   func(mockDatabase sqlxmock.Sqlmock)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:   "Commit on success",
+			status: http.StatusCreated,
+			mockDatabase: func(mockDatabase sqlxmock.Sqlmock) {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectCommit()
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   "written",
+		},
+		{
+			name:   "Rollback on client error",
+			status: http.StatusForbidden,
+			mockDatabase: func(mockDatabase sqlxmock.Sqlmock) {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectRollback()
+			},
+			expectedStatus: http.StatusForbidden,
+			expectedBody:   "written",
+		},
+		{
+			name:   "Retry on serialization failure",
+			status: http.StatusCreated,
+			mockDatabase: func(mockDatabase sqlxmock.Sqlmock) {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectCommit().WillReturnError(&pq.Error{Code: db.SQLStateSerializationFailure})
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectCommit()
+			},
+			expectedStatus: http.StatusCreated,
+			expectedBody:   "written",
+		},
+		{
+			name:   "Conflict when retries are exhausted",
+			status: http.StatusOK,
+			mockDatabase: func(mockDatabase sqlxmock.Sqlmock) {
+				for i := 0; i < 3; i++ {
+					mockDatabase.ExpectBegin()
+					mockDatabase.ExpectCommit().WillReturnError(&pq.Error{Code: db.SQLStateDeadlockDetected})
+				}
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "modified concurrently",
+		},
+		{
+			name:   "Error when commit fails",
+			status: http.StatusOK,
+			mockDatabase: func(mockDatabase sqlxmock.Sqlmock) {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectCommit().WillReturnError(errors.New("serialization failure"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "error completing the transaction",
+		},
+		{
+			name:   "Error when begin fails",
+			status: http.StatusOK,
+			mockDatabase: func(mockDatabase sqlxmock.Sqlmock) {
+				mockDatabase.ExpectBegin().WillReturnError(errors.New("connection refused"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "error completing the transaction",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database, mockDatabase, err := sqlxmock.Newx()
+			require.NoError(t, err)
+			tt.mockDatabase(mockDatabase)
+
+			handler := http2.NewUnitOfWork(database, db.TransactionConfig{
+				IsolationLevel: "read committed",
+				MaxAttempts:    3,
+				RetryBaseDelay: time.Millisecond,
+				RetryMaxDelay:  time.Millisecond,
+			}, httpserver.DefaultMaxBodyBytes).Handle(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, err := db.FromContext(r.Context())
+				assert.NoError(t, err)
+				w.WriteHeader(tt.status)
+				_, _ = w.Write([]byte("written"))
+			}))
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/lists/create", nil))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.expectedBody)
+			if tt.expectedStatus == http.StatusInternalServerError || tt.expectedStatus == http.StatusConflict {
+				assert.NotContains(t, rr.Body.String(), "written")
+			}
+			assert.NoError(t, mockDatabase.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUnitOfWork_Handle_BodyTooLarge(t *testing.T) {
+	database, mockDatabase, err := sqlxmock.Newx()
+	require.NoError(t, err)
+
+	handler := http2.NewUnitOfWork(database, db.TransactionConfig{MaxAttempts: 1}, 4).Handle(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		t.Error("handler must not run for a body above the limit")
+	}))
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodPost, "/lists/create", strings.NewReader("too large")))
+
+	assert.Equal(t, http.StatusRequestEntityTooLarge, rr.Code)
+	assert.Contains(t, rr.Body.String(), "the request body exceeds the limit of 4 bytes")
+	assert.NoError(t, mockDatabase.ExpectationsWereMet())
+}
+
+func TestUnitOfWork_HandleStreaming(t *testing.T) {
+	tests := []struct {
+		name           string
+		method         string
+		mockDatabase   func(mockDatabase sqlxmock.Sqlmock)
+		expectedStatus int
+		expectedBody   string
+	}{
+		{
+			name:   "Stream downloads in a read-only transaction",
+			method: http.MethodGet,
+			mockDatabase: func(mockDatabase sqlxmock.Sqlmock) {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectCommit()
+			},
+			expectedStatus: http.StatusOK,
+			expectedBody:   "written",
+		},
+		{
+			name:   "Uploads are not retried on serialization failure",
+			method: http.MethodPost,
+			mockDatabase: func(mockDatabase sqlxmock.Sqlmock) {
+				mockDatabase.ExpectBegin()
+				mockDatabase.ExpectCommit().WillReturnError(&pq.Error{Code: db.SQLStateSerializationFailure})
+			},
+			expectedStatus: http.StatusConflict,
+			expectedBody:   "modified concurrently",
+		},
+		{
+			name:   "Error when begin fails",
+			method: http.MethodGet,
+			mockDatabase: func(mockDatabase sqlxmock.Sqlmock) {
+				mockDatabase.ExpectBegin().WillReturnError(errors.New("connection refused"))
+			},
+			expectedStatus: http.StatusInternalServerError,
+			expectedBody:   "error completing the transaction",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			database, mockDatabase, err := sqlxmock.Newx()
+			require.NoError(t, err)
+			tt.mockDatabase(mockDatabase)
+
+			handler := http2.NewUnitOfWork(database, db.TransactionConfig{
+				IsolationLevel: "read committed",
+				MaxAttempts:    3,
+				RetryBaseDelay: time.Millisecond,
+				RetryMaxDelay:  time.Millisecond,
+			}, httpserver.DefaultMaxBodyBytes).HandleStreaming(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				_, err := db.FromContext(r.Context())
+				assert.NoError(t, err)
+				_, _ = w.Write([]byte("written"))
+			}))
+			rr := httptest.NewRecorder()
+			handler.ServeHTTP(rr, httptest.NewRequest(tt.method, "/todos/1/attachments", nil))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			assert.Contains(t, rr.Body.String(), tt.expectedBody)
+			if tt.expectedStatus != http.StatusOK {
+				assert.NotContains(t, rr.Body.String(), "written")
+			}
+			assert.NoError(t, mockDatabase.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestUnitOfWork_HandleStreaming_AfterTransaction(t *testing.T) {
+	database, mockDatabase, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	mockDatabase.ExpectBegin()
+	mockDatabase.ExpectCommit()
+
+	handler := http2.NewUnitOfWork(database, db.TransactionConfig{MaxAttempts: 1}, httpserver.DefaultMaxBodyBytes).HandleStreaming(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+		db.AfterTransaction(r.Context(), func(committed bool) {
+			assert.True(t, committed)
+			assert.NoError(t, mockDatabase.ExpectationsWereMet(), "the transaction must end before the body is streamed")
+			_, _ = w.Write([]byte("streamed"))
+		})
+	}))
+	rr := httptest.NewRecorder()
+	handler.ServeHTTP(rr, httptest.NewRequest(http.MethodGet, "/todos/1/attachments/1", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.Equal(t, "streamed", rr.Body.String())
+}
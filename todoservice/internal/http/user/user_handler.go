@@ -3,24 +3,23 @@ package user
 import (
 	"context"
 	"encoding/json"
-	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/users"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/problem"
 	"github.com/gorilla/mux"
-	"github.com/jmoiron/sqlx"
 	"net/http"
 	"time"
 )
 
 type Handler struct {
-	service  users.UserService
-	database *sqlx.DB
+	service users.UserService
 }
 
-func NewHandler(service users.UserService, database *sqlx.DB) *Handler {
-	return &Handler{service: service, database: database}
+func NewHandler(service users.UserService) *Handler {
+	return &Handler{service: service}
 }
 
 func (h *Handler) CreateUser(w http.ResponseWriter, r *http.Request) {
@@ -28,38 +27,23 @@ func (h *Handler) CreateUser(w http.ResponseWriter, r *http.Request) {
 	var user models.User
 	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
 		log.C(r.Context()).Errorf("error while creating user json decoding failed: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while creating user transaction failed: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
 	createdUser, err := h.service.CreateUser(ctx, user)
 	log.C(r.Context()).Debugf("create user success: %v", createdUser)
 	if err != nil {
 		log.C(r.Context()).Errorf("error while creating user failed: %v", err)
-		http.Error(w, "Failed to create user. Please ensure all required fields are correctly filled and there is no such a user already. Error details: "+err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, problem.StatusFor(err, http.StatusBadRequest), "Failed to create user. Please ensure all required fields are correctly filled and there is no such a user already. Error details: "+err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while creating user transaction failed: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusCreated)
 	if err := json.NewEncoder(w).Encode(createdUser); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 }
@@ -69,32 +53,42 @@ func (h *Handler) GetUser(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	id := mux.Vars(r)["id"]
 
-	tx, err := h.database.BeginTxx(ctx, nil)
+	user, err := h.service.GetUser(ctx, id)
 	if err != nil {
-		log.C(r.Context()).Errorf("error while getting user transaction failed: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		log.C(r.Context()).Errorf("erorr while getting user failed: %v", err)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
-	defer tx.Rollback()
 
-	ctx = db.SaveToContext(ctx, tx)
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.WriteHeader(http.StatusOK)
+	if err := json.NewEncoder(w).Encode(user); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
+		return
+	}
+}
 
-	user, err := h.service.GetUser(ctx, id)
-	if err != nil {
-		log.C(r.Context()).Errorf("erorr while getting user failed: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+func (h *Handler) GetUsersByIDs(w http.ResponseWriter, r *http.Request) {
+	log.C(r.Context()).Info("get users by ids handler called")
+	ctx := r.Context()
+	ids := pkg.SplitIDs(r.URL.Query().Get("ids"))
+	if err := pkg.ValidateBatchIDs(ids); err != nil {
+		log.C(r.Context()).Errorf("error while getting users by ids invalid ids: %v", err)
+		problem.Write(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while getting user transaction failed: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+	result, err := h.service.GetUsersByIDs(ctx, ids)
+	if err != nil {
+		log.C(r.Context()).Errorf("error while getting users by ids failed: %v", err)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
+
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
-	if err := json.NewEncoder(w).Encode(user); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+	if err := json.NewEncoder(w).Encode(result); err != nil {
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 }
@@ -104,27 +98,17 @@ func (h *Handler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 	var user models.User
 	if err := json.NewDecoder(r.Body).Decode(&user); err != nil {
 		log.C(r.Context()).Errorf("error while updating user json decoding failed: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, err.Error())
 		return
 	}
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("erorr while updating user transaction failed: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
-	_, err = h.service.GetUser(ctx, user.ID)
+	_, err := h.service.GetUser(ctx, user.ID)
 	log.C(r.Context()).Debugf("update user success: %v", user)
 	if err != nil {
 		log.C(r.Context()).Errorf("erorr while updating user failed: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
@@ -132,15 +116,10 @@ func (h *Handler) UpdateUser(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Debugf("update user success: %v", user)
 	if err != nil {
 		log.C(r.Context()).Errorf("erorr while updating user failed: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("erorr while updating user transaction failed: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 }
@@ -152,36 +131,21 @@ func (h *Handler) DeleteUser(w http.ResponseWriter, r *http.Request) {
 
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("delete user transaction failed: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
-	_, err = h.service.GetUser(ctx, id)
+	_, err := h.service.GetUser(ctx, id)
 	log.C(r.Context()).Debugf("get user success: %v", id)
 	if err != nil {
 		log.C(r.Context()).Errorf("erorr while deleting user failed: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
 	if err := h.service.DeleteUser(ctx, id); err != nil {
 		log.C(r.Context()).Errorf("erorr while deleting user failed: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 	log.C(r.Context()).Debugf("delete user success: %v", id)
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("erorr while deleting user transaction failed: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.WriteHeader(http.StatusNoContent)
 }
 
@@ -189,33 +153,18 @@ func (h *Handler) GetAllUsers(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Info("get all users handler called")
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("erorr while getting all users transaction failed: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
 	todosByUser, err := h.service.GetAllUsers(ctx)
 	log.C(r.Context()).Debugf("get all users success: %v", todosByUser)
 	if err != nil {
 		log.C(r.Context()).Errorf("erorr while getting all users failed: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("erorr while getting all users transaction failed: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(todosByUser); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 }
@@ -226,33 +175,18 @@ func (h *Handler) GetUserByEmail(w http.ResponseWriter, r *http.Request) {
 	email := vars["email"]
 	ctx := r.Context()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("erorr while getting all users transaction failed: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
 	user, err := h.service.GetUserByEmail(ctx, email)
 	log.C(r.Context()).Debugf("get user`s email success: %v", user)
 	if err != nil {
 		log.C(r.Context()).Errorf("erorr while getting user`s email failed: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("erorr while getting user`s email transaction failed: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 	if err := json.NewEncoder(w).Encode(user); err != nil {
-		http.Error(w, err.Error(), http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, err.Error())
 		return
 	}
 }
@@ -268,25 +202,15 @@ func (h *Handler) Logout(w http.ResponseWriter, r *http.Request) {
 	var logoutRequest LogoutRequest
 	if err := json.NewDecoder(r.Body).Decode(&logoutRequest); err != nil {
 		log.C(ctx).Errorf("error unmarshalling request body: %v", err)
-		http.Error(w, "Invalid request body", http.StatusBadRequest)
+		problem.Write(w, r, http.StatusBadRequest, "Invalid request body")
 		return
 	}
 	defer r.Body.Close()
 
-	tx, err := h.database.BeginTxx(ctx, nil)
-	if err != nil {
-		log.C(r.Context()).Errorf("error while getting user transaction failed: %v", err)
-		http.Error(w, err.Error(), http.StatusInternalServerError)
-		return
-	}
-	defer tx.Rollback()
-
-	ctx = db.SaveToContext(ctx, tx)
-
-	err = h.service.Logout(ctx, logoutRequest.Email)
+	err := h.service.Logout(ctx, logoutRequest.Email)
 	if err != nil {
 		log.C(r.Context()).Errorf("erorr while logging out user failed: %v", err)
-		http.Error(w, err.Error(), http.StatusNotFound)
+		problem.Write(w, r, http.StatusNotFound, err.Error())
 		return
 	}
 
@@ -318,11 +242,6 @@ func (h *Handler) Logout(w http.ResponseWriter, r *http.Request) {
 
 	//h.deleteUserCookies(ctx, &w)
 
-	if err = tx.Commit(); err != nil {
-		log.C(r.Context()).Errorf("error while getting user transaction failed: %v", err)
-		http.Error(w, err.Error(), http.StatusBadRequest)
-		return
-	}
 	w.Header().Set("Content-Type", constants.ContentTypeJSON)
 	w.WriteHeader(http.StatusOK)
 }
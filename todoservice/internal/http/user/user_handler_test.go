@@ -12,19 +12,13 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
-	sqlxmock "github.com/zhashkevych/go-sqlxmock"
 	"net/http"
 	"net/http/httptest"
 	"testing"
 )
 
 func TestCreateUserHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	if err != nil {
-		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
-	}
-	err = errors.New("error")
+	err := errors.New("error")
 	id := "1"
 	modelInput := models.User{
 		ID:       id,
@@ -36,7 +30,6 @@ func TestCreateUserHandler(t *testing.T) {
 	tests := []struct {
 		name               string
 		mockService        func() *automock.UserService
-		mockDatabase       func()
 		expectedStatusCode int
 		expectedError      error
 	}{
@@ -47,10 +40,6 @@ func TestCreateUserHandler(t *testing.T) {
 				mockService.EXPECT().CreateUser(mock.Anything, modelInput).Return(id, nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusCreated,
 			expectedError:      nil,
 		},
@@ -61,10 +50,6 @@ func TestCreateUserHandler(t *testing.T) {
 				mockService.EXPECT().CreateUser(mock.Anything, modelInput).Return("", err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectRollback()
-			},
 			expectedStatusCode: http.StatusBadRequest,
 			expectedError:      err,
 		},
@@ -72,7 +57,7 @@ func TestCreateUserHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := user.NewHandler(mockService, db)
+			handler := user.NewHandler(mockService)
 
 			body, _ := json.Marshal(modelInput)
 			req, _ := http.NewRequest(http.MethodPost, "/users/create", bytes.NewBuffer(body))
@@ -80,8 +65,6 @@ func TestCreateUserHandler(t *testing.T) {
 			w := httptest.NewRecorder()
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.CreateUser(w, req)
 			resp := w.Result()
 			defer func() {
@@ -101,20 +84,12 @@ func TestCreateUserHandler(t *testing.T) {
 				_ = json.NewDecoder(resp.Body).Decode(&respID)
 				assert.Equal(t, id, respID)
 			}
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
-			}
 		})
 	}
 }
 
 func TestGetUserHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	if err != nil {
-		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
-	}
-	err = errors.New("error")
+	err := errors.New("error")
 	id := "1"
 	model := models.User{
 		ID:       id,
@@ -126,7 +101,6 @@ func TestGetUserHandler(t *testing.T) {
 	tests := []struct {
 		name               string
 		mockService        func() *automock.UserService
-		mockDatabase       func()
 		expectedStatusCode int
 		urlVars            map[string]string
 		expectedError      error
@@ -138,10 +112,6 @@ func TestGetUserHandler(t *testing.T) {
 				mockService.EXPECT().GetUser(mock.Anything, id).Return(model, nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusOK,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      nil,
@@ -153,10 +123,6 @@ func TestGetUserHandler(t *testing.T) {
 				mockService.EXPECT().GetUser(mock.Anything, id).Return(models.User{}, err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectRollback()
-			},
 			expectedStatusCode: http.StatusNotFound,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      err,
@@ -165,7 +131,7 @@ func TestGetUserHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := user.NewHandler(mockService, db)
+			handler := user.NewHandler(mockService)
 
 			req, _ := http.NewRequest(http.MethodGet, "/users/"+id, nil)
 			req.Header.Set("Content-Type", constants2.ContentTypeJSON)
@@ -173,8 +139,6 @@ func TestGetUserHandler(t *testing.T) {
 			req = mux.SetURLVars(req, tt.urlVars)
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.GetUser(w, req)
 			resp := w.Result()
 			defer func() {
@@ -198,20 +162,12 @@ func TestGetUserHandler(t *testing.T) {
 				assert.JSONEq(t, string(expectedResponse), actualResponse.String())
 			}
 
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
-			}
 		})
 	}
 }
 
 func TestUpdateUserHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	if err != nil {
-		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
-	}
-	err = errors.New("error")
+	err := errors.New("error")
 	id := "1"
 	modelInput := models.User{
 		ID:       id,
@@ -223,7 +179,6 @@ func TestUpdateUserHandler(t *testing.T) {
 	tests := []struct {
 		name               string
 		mockService        func() *automock.UserService
-		mockDatabase       func()
 		expectedStatusCode int
 		urlVars            map[string]string
 		expectedError      error
@@ -236,10 +191,6 @@ func TestUpdateUserHandler(t *testing.T) {
 				mockService.EXPECT().UpdateUser(mock.Anything, modelInput).Return(nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusOK,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      nil,
@@ -251,9 +202,6 @@ func TestUpdateUserHandler(t *testing.T) {
 				mockService.EXPECT().GetUser(mock.Anything, id).Return(models.User{}, err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-			},
 			expectedStatusCode: http.StatusNotFound,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      err,
@@ -262,7 +210,7 @@ func TestUpdateUserHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := user.NewHandler(mockService, db)
+			handler := user.NewHandler(mockService)
 
 			body, _ := json.Marshal(modelInput)
 			req, _ := http.NewRequest(http.MethodPut, "/users/update/"+id, bytes.NewBuffer(body))
@@ -270,8 +218,6 @@ func TestUpdateUserHandler(t *testing.T) {
 			w := httptest.NewRecorder()
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.UpdateUser(w, req)
 			resp := w.Result()
 			defer func() {
@@ -292,26 +238,17 @@ func TestUpdateUserHandler(t *testing.T) {
 				assert.Equal(t, "", response)
 			}
 
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
-			}
 		})
 	}
 }
 
 func TestDeleteUserHandler(t *testing.T) {
-	db, mockDatabase, err := sqlxmock.Newx()
-	require.NoError(t, err)
-	if err != nil {
-		t.Fatalf("an error '%s' was not expected when opening a stub database connection", err)
-	}
-	err = errors.New("error")
+	err := errors.New("error")
 	id := "1"
 
 	tests := []struct {
 		name               string
 		mockService        func() *automock.UserService
-		mockDatabase       func()
 		expectedStatusCode int
 		urlVars            map[string]string
 		expectedError      error
@@ -324,10 +261,6 @@ func TestDeleteUserHandler(t *testing.T) {
 				mockService.EXPECT().DeleteUser(mock.Anything, id).Return(nil).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectCommit()
-			},
 			expectedStatusCode: http.StatusNoContent,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      nil,
@@ -339,10 +272,6 @@ func TestDeleteUserHandler(t *testing.T) {
 				mockService.EXPECT().GetUser(mock.Anything, id).Return(models.User{}, err).Once()
 				return mockService
 			},
-			mockDatabase: func() {
-				mockDatabase.ExpectBegin()
-				mockDatabase.ExpectRollback()
-			},
 			expectedStatusCode: http.StatusNotFound,
 			urlVars:            map[string]string{"id": id},
 			expectedError:      err,
@@ -351,7 +280,7 @@ func TestDeleteUserHandler(t *testing.T) {
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			mockService := tt.mockService()
-			handler := user.NewHandler(mockService, db)
+			handler := user.NewHandler(mockService)
 
 			req, _ := http.NewRequest(http.MethodDelete, "/users/delete/"+id, nil)
 			req.Header.Set("Content-Type", constants2.ContentTypeJSON)
@@ -359,8 +288,6 @@ func TestDeleteUserHandler(t *testing.T) {
 			w := httptest.NewRecorder()
 			defer mock.AssertExpectationsForObjects(t, mockService)
 
-			tt.mockDatabase()
-
 			handler.DeleteUser(w, req)
 			resp := w.Result()
 			defer func() {
@@ -381,9 +308,6 @@ func TestDeleteUserHandler(t *testing.T) {
 				assert.Equal(t, "", respID)
 			}
 
-			if err := mockDatabase.ExpectationsWereMet(); err != nil {
-				t.Errorf("there were unfulfilled expectations: %s", err)
-			}
 		})
 	}
 }
@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+
+	time "time"
 )
 
 // ListRepository is an autogenerated mock type for the ListRepository type
@@ -513,6 +515,65 @@ func (_c *ListRepository_GetAccessesByListID_Call) RunAndReturn(run func(context
 	return _c
 }
 
+// GetAccessesByUserID provides a mock function with given fields: ctx, userID
+func (_m *ListRepository) GetAccessesByUserID(ctx context.Context, userID string) ([]models.Access, error) {
+	ret := _m.Called(ctx, userID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAccessesByUserID")
+	}
+
+	var r0 []models.Access
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Access, error)); ok {
+		return rf(ctx, userID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Access); ok {
+		r0 = rf(ctx, userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Access)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRepository_GetAccessesByUserID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAccessesByUserID'
+type ListRepository_GetAccessesByUserID_Call struct {
+	*mock.Call
+}
+
+// GetAccessesByUserID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - userID string
+func (_e *ListRepository_Expecter) GetAccessesByUserID(ctx interface{}, userID interface{}) *ListRepository_GetAccessesByUserID_Call {
+	return &ListRepository_GetAccessesByUserID_Call{Call: _e.mock.On("GetAccessesByUserID", ctx, userID)}
+}
+
+func (_c *ListRepository_GetAccessesByUserID_Call) Run(run func(ctx context.Context, userID string)) *ListRepository_GetAccessesByUserID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ListRepository_GetAccessesByUserID_Call) Return(_a0 []models.Access, _a1 error) *ListRepository_GetAccessesByUserID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListRepository_GetAccessesByUserID_Call) RunAndReturn(run func(context.Context, string) ([]models.Access, error)) *ListRepository_GetAccessesByUserID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetAll provides a mock function with given fields: ctx
 func (_m *ListRepository) GetAll(ctx context.Context) ([]models.List, error) {
 	ret := _m.Called(ctx)
@@ -630,6 +691,65 @@ func (_c *ListRepository_GetAllTodosForList_Call) RunAndReturn(run func(context.
 	return _c
 }
 
+// GetByIDs provides a mock function with given fields: ctx, ids
+func (_m *ListRepository) GetByIDs(ctx context.Context, ids []string) ([]models.List, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 []models.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]models.List, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []models.List); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListRepository_GetByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDs'
+type ListRepository_GetByIDs_Call struct {
+	*mock.Call
+}
+
+// GetByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []string
+func (_e *ListRepository_Expecter) GetByIDs(ctx interface{}, ids interface{}) *ListRepository_GetByIDs_Call {
+	return &ListRepository_GetByIDs_Call{Call: _e.mock.On("GetByIDs", ctx, ids)}
+}
+
+func (_c *ListRepository_GetByIDs_Call) Run(run func(ctx context.Context, ids []string)) *ListRepository_GetByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *ListRepository_GetByIDs_Call) Return(_a0 []models.List, _a1 error) *ListRepository_GetByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListRepository_GetByIDs_Call) RunAndReturn(run func(context.Context, []string) ([]models.List, error)) *ListRepository_GetByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetListOwnerID provides a mock function with given fields: ctx, listID
 func (_m *ListRepository) GetListOwnerID(ctx context.Context, listID string) (string, error) {
 	ret := _m.Called(ctx, listID)
@@ -864,6 +984,54 @@ func (_c *ListRepository_ListAllByUserID_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// SetArchivedAt provides a mock function with given fields: ctx, id, archivedAt
+func (_m *ListRepository) SetArchivedAt(ctx context.Context, id string, archivedAt *time.Time) error {
+	ret := _m.Called(ctx, id, archivedAt)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetArchivedAt")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, *time.Time) error); ok {
+		r0 = rf(ctx, id, archivedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListRepository_SetArchivedAt_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetArchivedAt'
+type ListRepository_SetArchivedAt_Call struct {
+	*mock.Call
+}
+
+// SetArchivedAt is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - archivedAt *time.Time
+func (_e *ListRepository_Expecter) SetArchivedAt(ctx interface{}, id interface{}, archivedAt interface{}) *ListRepository_SetArchivedAt_Call {
+	return &ListRepository_SetArchivedAt_Call{Call: _e.mock.On("SetArchivedAt", ctx, id, archivedAt)}
+}
+
+func (_c *ListRepository_SetArchivedAt_Call) Run(run func(ctx context.Context, id string, archivedAt *time.Time)) *ListRepository_SetArchivedAt_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(*time.Time))
+	})
+	return _c
+}
+
+func (_c *ListRepository_SetArchivedAt_Call) Return(_a0 error) *ListRepository_SetArchivedAt_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ListRepository_SetArchivedAt_Call) RunAndReturn(run func(context.Context, string, *time.Time) error) *ListRepository_SetArchivedAt_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, list
 func (_m *ListRepository) Update(ctx context.Context, list models.List) error {
 	ret := _m.Called(ctx, list)
@@ -911,6 +1079,55 @@ func (_c *ListRepository_Update_Call) RunAndReturn(run func(context.Context, mod
 	return _c
 }
 
+// UpdateAccessPosition provides a mock function with given fields: ctx, listID, userID, position
+func (_m *ListRepository) UpdateAccessPosition(ctx context.Context, listID string, userID string, position string) error {
+	ret := _m.Called(ctx, listID, userID, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateAccessPosition")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, listID, userID, position)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ListRepository_UpdateAccessPosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateAccessPosition'
+type ListRepository_UpdateAccessPosition_Call struct {
+	*mock.Call
+}
+
+// UpdateAccessPosition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - userID string
+//   - position string
+func (_e *ListRepository_Expecter) UpdateAccessPosition(ctx interface{}, listID interface{}, userID interface{}, position interface{}) *ListRepository_UpdateAccessPosition_Call {
+	return &ListRepository_UpdateAccessPosition_Call{Call: _e.mock.On("UpdateAccessPosition", ctx, listID, userID, position)}
+}
+
+func (_c *ListRepository_UpdateAccessPosition_Call) Run(run func(ctx context.Context, listID string, userID string, position string)) *ListRepository_UpdateAccessPosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *ListRepository_UpdateAccessPosition_Call) Return(_a0 error) *ListRepository_UpdateAccessPosition_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *ListRepository_UpdateAccessPosition_Call) RunAndReturn(run func(context.Context, string, string, string) error) *ListRepository_UpdateAccessPosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateListDescription provides a mock function with given fields: ctx, listID, description
 func (_m *ListRepository) UpdateListDescription(ctx context.Context, listID string, description string) (models.List, error) {
 	ret := _m.Called(ctx, listID, description)
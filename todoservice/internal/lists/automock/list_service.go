@@ -71,6 +71,63 @@ func (_c *ListService_AcceptList_Call) RunAndReturn(run func(context.Context, st
 	return _c
 }
 
+// ArchiveList provides a mock function with given fields: ctx, id
+func (_m *ListService) ArchiveList(ctx context.Context, id string) (models.List, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ArchiveList")
+	}
+
+	var r0 models.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.List, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.List); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.List)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListService_ArchiveList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ArchiveList'
+type ListService_ArchiveList_Call struct {
+	*mock.Call
+}
+
+// ArchiveList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ListService_Expecter) ArchiveList(ctx interface{}, id interface{}) *ListService_ArchiveList_Call {
+	return &ListService_ArchiveList_Call{Call: _e.mock.On("ArchiveList", ctx, id)}
+}
+
+func (_c *ListService_ArchiveList_Call) Run(run func(ctx context.Context, id string)) *ListService_ArchiveList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ListService_ArchiveList_Call) Return(_a0 models.List, _a1 error) *ListService_ArchiveList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListService_ArchiveList_Call) RunAndReturn(run func(context.Context, string) (models.List, error)) *ListService_ArchiveList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // CreateAccess provides a mock function with given fields: ctx, list
 func (_m *ListService) CreateAccess(ctx context.Context, list models.Access) (models.Access, error) {
 	ret := _m.Called(ctx, list)
@@ -687,6 +744,65 @@ func (_c *ListService_GetListOwnerID_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetListsByIDs provides a mock function with given fields: ctx, ids
+func (_m *ListService) GetListsByIDs(ctx context.Context, ids []string) ([]models.List, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetListsByIDs")
+	}
+
+	var r0 []models.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]models.List, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []models.List); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.List)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListService_GetListsByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetListsByIDs'
+type ListService_GetListsByIDs_Call struct {
+	*mock.Call
+}
+
+// GetListsByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []string
+func (_e *ListService_Expecter) GetListsByIDs(ctx interface{}, ids interface{}) *ListService_GetListsByIDs_Call {
+	return &ListService_GetListsByIDs_Call{Call: _e.mock.On("GetListsByIDs", ctx, ids)}
+}
+
+func (_c *ListService_GetListsByIDs_Call) Run(run func(ctx context.Context, ids []string)) *ListService_GetListsByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *ListService_GetListsByIDs_Call) Return(_a0 []models.List, _a1 error) *ListService_GetListsByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListService_GetListsByIDs_Call) RunAndReturn(run func(context.Context, []string) ([]models.List, error)) *ListService_GetListsByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // GetPendingLists provides a mock function with given fields: ctx, userID
 func (_m *ListService) GetPendingLists(ctx context.Context, userID string) ([]models.Access, error) {
 	ret := _m.Called(ctx, userID)
@@ -864,6 +980,123 @@ func (_c *ListService_ListAllByUserID_Call) RunAndReturn(run func(context.Contex
 	return _c
 }
 
+// MoveList provides a mock function with given fields: ctx, listID, userID, beforeID, afterID
+func (_m *ListService) MoveList(ctx context.Context, listID string, userID string, beforeID string, afterID string) (models.Access, error) {
+	ret := _m.Called(ctx, listID, userID, beforeID, afterID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveList")
+	}
+
+	var r0 models.Access
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) (models.Access, error)); ok {
+		return rf(ctx, listID, userID, beforeID, afterID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string, string) models.Access); ok {
+		r0 = rf(ctx, listID, userID, beforeID, afterID)
+	} else {
+		r0 = ret.Get(0).(models.Access)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string, string) error); ok {
+		r1 = rf(ctx, listID, userID, beforeID, afterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListService_MoveList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveList'
+type ListService_MoveList_Call struct {
+	*mock.Call
+}
+
+// MoveList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - userID string
+//   - beforeID string
+//   - afterID string
+func (_e *ListService_Expecter) MoveList(ctx interface{}, listID interface{}, userID interface{}, beforeID interface{}, afterID interface{}) *ListService_MoveList_Call {
+	return &ListService_MoveList_Call{Call: _e.mock.On("MoveList", ctx, listID, userID, beforeID, afterID)}
+}
+
+func (_c *ListService_MoveList_Call) Run(run func(ctx context.Context, listID string, userID string, beforeID string, afterID string)) *ListService_MoveList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string), args[4].(string))
+	})
+	return _c
+}
+
+func (_c *ListService_MoveList_Call) Return(_a0 models.Access, _a1 error) *ListService_MoveList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListService_MoveList_Call) RunAndReturn(run func(context.Context, string, string, string, string) (models.Access, error)) *ListService_MoveList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// UnarchiveList provides a mock function with given fields: ctx, id
+func (_m *ListService) UnarchiveList(ctx context.Context, id string) (models.List, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UnarchiveList")
+	}
+
+	var r0 models.List
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.List, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.List); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.List)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListService_UnarchiveList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UnarchiveList'
+type ListService_UnarchiveList_Call struct {
+	*mock.Call
+}
+
+// UnarchiveList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *ListService_Expecter) UnarchiveList(ctx interface{}, id interface{}) *ListService_UnarchiveList_Call {
+	return &ListService_UnarchiveList_Call{Call: _e.mock.On("UnarchiveList", ctx, id)}
+}
+
+func (_c *ListService_UnarchiveList_Call) Run(run func(ctx context.Context, id string)) *ListService_UnarchiveList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *ListService_UnarchiveList_Call) Return(_a0 models.List, _a1 error) *ListService_UnarchiveList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *ListService_UnarchiveList_Call) RunAndReturn(run func(context.Context, string) (models.List, error)) *ListService_UnarchiveList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateList provides a mock function with given fields: ctx, list
 func (_m *ListService) UpdateList(ctx context.Context, list models.List) error {
 	ret := _m.Called(ctx, list)
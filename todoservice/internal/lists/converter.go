@@ -1,8 +1,10 @@
 package lists
 
 import (
-	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"database/sql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/tags"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"time"
 )
 
 type Converter struct{}
@@ -18,10 +20,11 @@ func (c *Converter) ConvertListToModel(entity Entity) models.List {
 		Description: entity.Description,
 		OwnerID:     entity.OwnerID,
 		SharedWith:  entity.SharedWith,
-		Tags:        pkg.JSONRawMessageFromNullableString(entity.Tags),
+		Tags:        tags.FromJSON(entity.Tags),
 		CreatedAt:   entity.CreatedAt,
 		UpdatedAt:   entity.UpdatedAt,
 		Visibility:  entity.Visibility,
+		ArchivedAt:  archivedAt(entity.ArchivedAt),
 	}
 }
 
@@ -32,27 +35,43 @@ func (c *Converter) ConvertListToEntity(list models.List) Entity {
 		Description: list.Description,
 		OwnerID:     list.OwnerID,
 		SharedWith:  list.SharedWith,
-		Tags:        pkg.NewNullableStringFromJSONRawMessage(list.Tags),
 		CreatedAt:   list.CreatedAt,
 		UpdatedAt:   list.UpdatedAt,
 		Visibility:  list.Visibility,
+		ArchivedAt:  nullTime(list.ArchivedAt),
 	}
 }
 
 func (c *Converter) ConvertAccessToModel(entity AccessEntity) models.Access {
 	return models.Access{
-		ListID: entity.ListID,
-		UserID: entity.UserID,
-		Role:   entity.Role,
-		Status: entity.Status,
+		ListID:   entity.ListID,
+		UserID:   entity.UserID,
+		Role:     entity.Role,
+		Status:   entity.Status,
+		Position: entity.Position,
 	}
 }
 
 func (c *Converter) ConvertAccessToEntity(access models.Access) AccessEntity {
 	return AccessEntity{
-		ListID: access.ListID,
-		UserID: access.UserID,
-		Role:   access.Role,
-		Status: access.Status,
+		ListID:   access.ListID,
+		UserID:   access.UserID,
+		Role:     access.Role,
+		Status:   access.Status,
+		Position: access.Position,
 	}
 }
+
+func archivedAt(column sql.NullTime) *time.Time {
+	if !column.Valid {
+		return nil
+	}
+	return &column.Time
+}
+
+func nullTime(t *time.Time) sql.NullTime {
+	if t == nil {
+		return sql.NullTime{}
+	}
+	return sql.NullTime{Time: *t, Valid: true}
+}
@@ -17,7 +17,8 @@ func TestConvertListToModel(t *testing.T) {
 	creationTime := time.Now()
 	updateTime := time.Now()
 
-	tags, err := json.Marshal([]constants2.TagType{constants2.TagWork, constants2.TagFinance})
+	modelTags := []models.Tag{{ID: "tagID", ListID: "listID", Name: "work", Color: "#9e9e9e"}}
+	tags, err := json.Marshal(modelTags)
 	require.NoError(t, err)
 
 	entity := lists.Entity{
@@ -38,7 +39,7 @@ func TestConvertListToModel(t *testing.T) {
 		Description: "This is a test list",
 		OwnerID:     "owner1",
 		SharedWith:  []string{"user1", "user2"},
-		Tags:        json.RawMessage(string(tags)),
+		Tags:        modelTags,
 		CreatedAt:   creationTime,
 		UpdatedAt:   updateTime,
 		Visibility:  constants2.VisibilityPrivate,
@@ -56,8 +57,7 @@ func TestConvertListToEntity(t *testing.T) {
 	creationTime := time.Now()
 	updateTime := time.Now()
 
-	tags, err := json.Marshal([]constants2.TagType{constants2.TagWork, constants2.TagFinance})
-	require.NoError(t, err)
+	modelTags := []models.Tag{{ID: "tagID", ListID: "listID", Name: "work", Color: "#9e9e9e"}}
 
 	model := models.List{
 		ID:          "123",
@@ -65,7 +65,7 @@ func TestConvertListToEntity(t *testing.T) {
 		Description: "This is a test list",
 		OwnerID:     "owner123",
 		SharedWith:  []string{"user1", "user2"},
-		Tags:        json.RawMessage(string(tags)),
+		Tags:        modelTags,
 		CreatedAt:   creationTime,
 		UpdatedAt:   updateTime,
 		Visibility:  constants2.VisibilityPrivate,
@@ -77,7 +77,6 @@ func TestConvertListToEntity(t *testing.T) {
 		Description: "This is a test list",
 		OwnerID:     "owner123",
 		SharedWith:  []string{"user1", "user2"},
-		Tags:        pkg.NewValidNullableString(string(tags)),
 		CreatedAt:   creationTime,
 		UpdatedAt:   updateTime,
 		Visibility:  constants2.VisibilityPrivate,
@@ -16,11 +16,13 @@ type Entity struct {
 	CreatedAt   time.Time            `db:"created_at"`
 	UpdatedAt   time.Time            `db:"updated_at"`
 	Visibility  constants.Visibility `db:"visibility"`
+	ArchivedAt  sql.NullTime         `db:"archived_at"`
 }
 
 type AccessEntity struct {
-	ListID string         `db:"list_id"`
-	UserID string         `db:"user_id"`
-	Role   constants.Role `db:"access_level"`
-	Status string         `db:"status"`
+	ListID   string         `db:"list_id"`
+	UserID   string         `db:"user_id"`
+	Role     constants.Role `db:"access_level"`
+	Status   string         `db:"status"`
+	Position string         `db:"position"`
 }
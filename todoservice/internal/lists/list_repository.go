@@ -6,17 +6,23 @@ import (
 	"errors"
 	"fmt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/tags"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/todos"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/rank"
 	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
 )
 
 //go:generate mockery --name=ListRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
 type ListRepository interface {
 	Update(ctx context.Context, list models.List) error
 	Get(ctx context.Context, id string) (models.List, error)
+	GetByIDs(ctx context.Context, ids []string) ([]models.List, error)
 	GetAll(ctx context.Context) ([]models.List, error)
 	GetAccess(ctx context.Context, listID string, userID string) (models.Access, error)
 	GetUsersByListID(ctx context.Context, listID string) ([]models.Access, error)
@@ -33,6 +39,9 @@ type ListRepository interface {
 	AcceptList(ctx context.Context, listID string, userID string) error
 	GetAccessesByListID(ctx context.Context, listID string) ([]models.Access, error)
 	GetAcceptedLists(ctx context.Context, listID string) ([]models.Access, error)
+	GetAccessesByUserID(ctx context.Context, userID string) ([]models.Access, error)
+	UpdateAccessPosition(ctx context.Context, listID string, userID string, position string) error
+	SetArchivedAt(ctx context.Context, id string, archivedAt *time.Time) error
 }
 
 type SQLXListRepository struct {
@@ -57,8 +66,8 @@ func (r *SQLXListRepository) Create(ctx context.Context, list models.List) (stri
 	log.C(ctx).Debugf("successfull converted list: %v", entity)
 
 	insertListQuery := `
-		INSERT INTO lists (id, name, description, owner_id, visibility, tags, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8)
+		INSERT INTO lists (id, name, description, owner_id, visibility, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7)
 		RETURNING id
 	`
 
@@ -69,7 +78,6 @@ func (r *SQLXListRepository) Create(ctx context.Context, list models.List) (stri
 		entity.Description,
 		entity.OwnerID,
 		entity.Visibility,
-		entity.Tags,
 		entity.CreatedAt,
 		entity.UpdatedAt,
 	).Scan(&id)
@@ -83,10 +91,14 @@ func (r *SQLXListRepository) Create(ctx context.Context, list models.List) (stri
 	status := constants.StatusOwner
 
 	insertAccessQuery := `
-			INSERT INTO list_access (user_id, list_id, access_level, status)
-			VALUES ($1, $2, $3, $4)
+			INSERT INTO list_access (user_id, list_id, access_level, status, position)
+			VALUES ($1, $2, $3, $4, $5)
 		`
-	if _, err = tx.ExecContext(ctx, insertAccessQuery, entity.OwnerID, entity.ID, "admin", status); err != nil {
+	position, err := r.nextAccessPosition(ctx, tx, entity.OwnerID)
+	if err != nil {
+		return "", err
+	}
+	if _, err = tx.ExecContext(ctx, insertAccessQuery, entity.OwnerID, entity.ID, "admin", status, position); err != nil {
 		log.C(ctx).Errorf("failed to create list_access: %v", err)
 		return "", fmt.Errorf("failed to create list_access: %w", err)
 	}
@@ -103,7 +115,10 @@ func (r *SQLXListRepository) Create(ctx context.Context, list models.List) (stri
 			log.C(ctx).Errorf("failed to create list_access: %v", err)
 			return "", fmt.Errorf("failed to get user's role: %w", err)
 		}
-		if _, err = tx.ExecContext(ctx, insertAccessQuery, userID, entity.ID, role, status); err != nil {
+		if position, err = r.nextAccessPosition(ctx, tx, userID); err != nil {
+			return "", err
+		}
+		if _, err = tx.ExecContext(ctx, insertAccessQuery, userID, entity.ID, role, status, position); err != nil {
 			log.C(ctx).Errorf("failed to create list_access: %v", err)
 			return "", fmt.Errorf("failed to insert access: %w", err)
 		}
@@ -121,7 +136,7 @@ func (r *SQLXListRepository) Get(ctx context.Context, id string) (models.List, e
 	}
 
 	query := `
-		SELECT id, name, description, owner_id, visibility, tags, created_at, updated_at
+		SELECT id, name, description, owner_id, visibility, ` + tags.ListTagsColumn + `, created_at, updated_at, archived_at
 		FROM lists
 		WHERE id = $1
 `
@@ -153,6 +168,52 @@ func (r *SQLXListRepository) Get(ctx context.Context, id string) (models.List, e
 	return r.converter.ConvertListToModel(entity), nil
 }
 
+func (r *SQLXListRepository) GetByIDs(ctx context.Context, ids []string) ([]models.List, error) {
+	log.C(ctx).Info("getting lists by ids repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	query := `
+		SELECT id, name, description, owner_id, visibility, ` + tags.ListTagsColumn + `, created_at, updated_at, archived_at
+		FROM lists
+		WHERE id = ANY($1)
+`
+	var entities []Entity
+	err = tx.SelectContext(ctx, &entities, query, pq.Array(ids))
+	if err != nil {
+		log.C(ctx).Errorf("failed to fetch lists: %v", err)
+		return nil, fmt.Errorf("failed to get lists: %w", err)
+	}
+
+	sharedWithQuery := `
+		SELECT list_id, user_id
+		FROM list_access
+		WHERE list_id = ANY($1)
+	`
+	var accesses []AccessEntity
+	err = tx.SelectContext(ctx, &accesses, sharedWithQuery, pq.Array(ids))
+	if err != nil {
+		log.C(ctx).Errorf("failed to fetch list_access: %v", err)
+		return nil, fmt.Errorf("failed to fetch list_access: %w", err)
+	}
+
+	sharedWith := make(map[string][]string)
+	for _, access := range accesses {
+		sharedWith[access.ListID] = append(sharedWith[access.ListID], access.UserID)
+	}
+
+	result := make([]models.List, 0, len(entities))
+	for _, entity := range entities {
+		entity.SharedWith = sharedWith[entity.ID]
+		result = append(result, r.converter.ConvertListToModel(entity))
+	}
+	log.C(ctx).Debugf("got %d lists by ids", len(result))
+	return result, nil
+}
+
 func (r *SQLXListRepository) Update(ctx context.Context, list models.List) error {
 	log.C(ctx).Info("updating list repository")
 	tx, err := db.FromContext(ctx)
@@ -164,12 +225,12 @@ func (r *SQLXListRepository) Update(ctx context.Context, list models.List) error
 
 	updateListQuery := `
 		UPDATE lists
-		SET name = $1, description = $2, visibility = $3, tags = $4
-		WHERE id = $5
+		SET name = $1, description = $2, visibility = $3
+		WHERE id = $4
 	`
 
 	_, err = tx.ExecContext(ctx, updateListQuery, entity.Name, entity.Description,
-		entity.Visibility, entity.Tags, entity.ID)
+		entity.Visibility, entity.ID)
 	if err != nil {
 		log.C(ctx).Errorf("failed to update list: %v", err)
 		return fmt.Errorf("failed to update list: %w", err)
@@ -224,9 +285,10 @@ func (r *SQLXListRepository) ListAllByUserID(ctx context.Context, userID string)
 		return []models.Access{}, err
 	}
 	query := `
-		SELECT list_id, user_id, access_level, status
+		SELECT list_id, user_id, access_level, status, position
 		FROM list_access
 		WHERE user_id = $1 AND status = 'owner'
+		ORDER BY position, list_id
 	`
 
 	var lists []AccessEntity
@@ -256,9 +318,10 @@ func (r *SQLXListRepository) GetAcceptedLists(ctx context.Context, userID string
 		return []models.Access{}, err
 	}
 	query := `
-		SELECT list_id, user_id, access_level, status
+		SELECT list_id, user_id, access_level, status, position
 		FROM list_access
 		WHERE user_id = $1 AND status = 'accepted'
+		ORDER BY position, list_id
 	`
 
 	var lists []AccessEntity
@@ -288,9 +351,10 @@ func (r *SQLXListRepository) GetPendingLists(ctx context.Context, userID string)
 		return []models.Access{}, err
 	}
 	query := `
-		SELECT list_id, user_id, access_level, status
+		SELECT list_id, user_id, access_level, status, position
 		FROM list_access
 		WHERE user_id = $1 AND status = 'pending'
+		ORDER BY position, list_id
 	`
 
 	var lists []AccessEntity
@@ -320,7 +384,7 @@ func (r *SQLXListRepository) GetAll(ctx context.Context) ([]models.List, error)
 		return []models.List{}, err
 	}
 	query := `
-		SELECT id, name, description, owner_id, visibility, tags, created_at, updated_at
+		SELECT id, name, description, owner_id, visibility, ` + tags.ListTagsColumn + `, created_at, updated_at, archived_at
 		FROM lists
 	`
 
@@ -435,21 +499,27 @@ func (r *SQLXListRepository) CreateAccess(ctx context.Context, access models.Acc
 	status := constants.StatusPending
 
 	insertListQuery := `
-		INSERT INTO list_access (list_id, user_id, access_level, status)
-		VALUES ($1, $2, $3, $4)
+		INSERT INTO list_access (list_id, user_id, access_level, status, position)
+		VALUES ($1, $2, $3, $4, $5)
 	`
 
+	position, err := r.nextAccessPosition(ctx, tx, entity.UserID)
+	if err != nil {
+		return models.Access{}, err
+	}
 	_, err = tx.ExecContext(ctx, insertListQuery,
 		entity.ListID,
 		entity.UserID,
 		entity.Role,
 		status,
+		position,
 	)
 	if err != nil {
 		log.C(ctx).Errorf("failed to create list_access: %v", err)
 		return models.Access{}, fmt.Errorf("failed to create list access: %w", err)
 	}
 
+	access.Position = position
 	return access, nil
 }
 
@@ -594,9 +664,10 @@ func (r *SQLXListRepository) GetAllTodosForList(ctx context.Context, listID stri
 		return []models.Todo{}, err
 	}
 	query := `
-		SELECT id, title, description, list_id, priority, start_date, due_date, completed, tags, created_at, updated_at
+		SELECT id, title, description, list_id, priority, start_date, due_date, completed, ` + tags.TodoTagsColumn + `, created_at, updated_at
 		FROM todos
 		WHERE list_id = $1
+		ORDER BY position, id
 	`
 
 	var allTodos []todos.Entity
@@ -652,3 +723,100 @@ func (r *SQLXListRepository) GetAccessesByListID(ctx context.Context, listID str
 
 	return accesses, nil
 }
+
+// GetAccessesByUserID returns the owned and accepted lists of the user in the order the user arranged them.
+func (r *SQLXListRepository) GetAccessesByUserID(ctx context.Context, userID string) ([]models.Access, error) {
+	log.C(ctx).Info("getting list accesses by user id repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	query := `
+		SELECT list_id, user_id, access_level, status, position
+		FROM list_access
+		WHERE user_id = $1 AND status IN ($2, $3)
+		ORDER BY position, list_id
+	`
+
+	var accesses []AccessEntity
+	err = tx.SelectContext(ctx, &accesses, query, userID, constants.StatusOwner, constants.StatusAccepted)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get list accesses: %v", err)
+		return nil, fmt.Errorf("failed to get list accesses: %w", err)
+	}
+
+	result := make([]models.Access, 0, len(accesses))
+	for _, entity := range accesses {
+		result = append(result, r.converter.ConvertAccessToModel(entity))
+	}
+	return result, nil
+}
+
+func (r *SQLXListRepository) UpdateAccessPosition(ctx context.Context, listID string, userID string, position string) error {
+	log.C(ctx).Info("updating list access position repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `UPDATE list_access SET position = $1 WHERE list_id = $2 AND user_id = $3`, position, listID, userID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update list access position: %v", err)
+		return fmt.Errorf("failed to update list access position: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("list %s of user %s not found: %w", listID, userID, pkg.ErrNotFound)
+	}
+	return nil
+}
+
+// SetArchivedAt archives the list at archivedAt, a nil archivedAt restores it.
+func (r *SQLXListRepository) SetArchivedAt(ctx context.Context, id string, archivedAt *time.Time) error {
+	log.C(ctx).Info("setting list archived at repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `UPDATE lists SET archived_at = $1 WHERE id = $2`, nullTime(archivedAt), id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to set list archived at: %v", err)
+		return fmt.Errorf("failed to set list archived at: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("list %s not found: %w", id, pkg.ErrNotFound)
+	}
+	return nil
+}
+
+// nextAccessPosition returns the position that puts a new list at the end of the lists of the user. The user
+// is locked until the end of the transaction, so concurrent new lists of the user get distinct positions.
+func (r *SQLXListRepository) nextAccessPosition(ctx context.Context, tx *sqlx.Tx, userID string) (string, error) {
+	if _, err := tx.ExecContext(ctx, `SELECT id FROM users WHERE id = $1 FOR UPDATE`, userID); err != nil {
+		log.C(ctx).Errorf("failed to lock user: %v", err)
+		return "", fmt.Errorf("failed to lock user: %w", err)
+	}
+	var last string
+	err := tx.GetContext(ctx, &last, `SELECT COALESCE(MAX(position), '') FROM list_access WHERE user_id = $1`, userID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get last list position: %v", err)
+		return "", fmt.Errorf("failed to get last list position: %w", err)
+	}
+	position, err := rank.Between(last, "")
+	if err != nil {
+		return "", fmt.Errorf("failed to rank list: %w", err)
+	}
+	return position, nil
+}
@@ -7,9 +7,9 @@ import (
 	"fmt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/lists"
-	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	sqlxmock "github.com/zhashkevych/go-sqlxmock"
@@ -17,6 +17,11 @@ import (
 	"time"
 )
 
+var (
+	testTags     = []models.Tag{{ID: "tagID", ListID: "listID", Name: "work", Color: "#9e9e9e"}}
+	testTagsJSON = `[{"id":"tagID","list_id":"listID","name":"work","color":"#9e9e9e"}]`
+)
+
 func TestSQLXListRepositoryCreate(t *testing.T) {
 	database, mockDB, err := sqlxmock.Newx()
 	require.NoError(t, err)
@@ -37,20 +42,24 @@ func TestSQLXListRepositoryCreate(t *testing.T) {
 				Description: "Test Description",
 				OwnerID:     "owner-id",
 				Visibility:  constants.VisibilityShared,
-				Tags:        pkg.JSONRawMessageFromNullableString(pkg.NewValidNullableString("tag1, tag2")),
+				Tags:        testTags,
 				SharedWith:  []string{"user1", "user2"},
 			},
 			setupMocks: func() {
 				mockDB.ExpectBegin()
 				mockDB.ExpectQuery(`^INSERT INTO lists`).WithArgs(
-					"1", "Test List", "Test Description", "owner-id", constants.VisibilityShared, "tag1, tag2", sqlxmock.AnyArg(), sqlxmock.AnyArg(),
+					"1", "Test List", "Test Description", "owner-id", constants.VisibilityShared, sqlxmock.AnyArg(), sqlxmock.AnyArg(),
 				).WillReturnRows(sqlxmock.NewRows([]string{"id"}).AddRow("1"))
 
-				mockDB.ExpectExec(`^INSERT INTO list_access`).WithArgs("owner-id", "1", "admin", "owner").WillReturnResult(sqlxmock.NewResult(1, 1))
+				mockDB.ExpectExec(`^SELECT id FROM users WHERE id = \$1 FOR UPDATE`).WithArgs("owner-id").WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectQuery(`^SELECT COALESCE\(MAX\(position\), ''\) FROM list_access`).WithArgs("owner-id").WillReturnRows(sqlxmock.NewRows([]string{"position"}).AddRow("V"))
+				mockDB.ExpectExec(`^INSERT INTO list_access`).WithArgs("owner-id", "1", "admin", "owner", "l").WillReturnResult(sqlxmock.NewResult(1, 1))
 
 				for _, userID := range []string{"user1", "user2"} {
 					mockDB.ExpectQuery(`^SELECT role FROM users`).WithArgs(userID).WillReturnRows(sqlxmock.NewRows([]string{"role"}).AddRow("role"))
-					mockDB.ExpectExec(`^INSERT INTO list_access`).WithArgs(userID, "1", "role", "pending").WillReturnResult(sqlxmock.NewResult(1, 1))
+					mockDB.ExpectExec(`^SELECT id FROM users WHERE id = \$1 FOR UPDATE`).WithArgs(userID).WillReturnResult(sqlxmock.NewResult(0, 1))
+					mockDB.ExpectQuery(`^SELECT COALESCE\(MAX\(position\), ''\) FROM list_access`).WithArgs(userID).WillReturnRows(sqlxmock.NewRows([]string{"position"}).AddRow(""))
+					mockDB.ExpectExec(`^INSERT INTO list_access`).WithArgs(userID, "1", "role", "pending", "V").WillReturnResult(sqlxmock.NewResult(1, 1))
 				}
 				mockDB.ExpectCommit()
 			},
@@ -65,7 +74,7 @@ func TestSQLXListRepositoryCreate(t *testing.T) {
 				Description: "Test Description",
 				OwnerID:     "owner-id",
 				Visibility:  constants.VisibilityShared,
-				Tags:        pkg.JSONRawMessageFromNullableString(pkg.NewValidNullableString("tag1, tag2")),
+				Tags:        testTags,
 				SharedWith:  []string{"user1", "user2"},
 			},
 			setupMocks: func() {
@@ -125,9 +134,9 @@ func TestSQLXListRepositoryGet(t *testing.T) {
 			id:   "1",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectQuery("SELECT id, name, description, owner_id, visibility, tags, created_at, updated_at FROM lists").WithArgs(
+				mockDB.ExpectQuery("SELECT id, name, description, owner_id, visibility, .* AS tags, created_at, updated_at, archived_at FROM lists").WithArgs(
 					"1").WillReturnRows(sqlxmock.NewRows([]string{"id", "name", "description", "owner_id", "visibility", "tags", "created_at", "updated_at"}).
-					AddRow("1", "Test List", "Test Description", "owner-id", constants.VisibilityShared, "tag1, tag2", time.Time{}, time.Time{}))
+					AddRow("1", "Test List", "Test Description", "owner-id", constants.VisibilityShared, testTagsJSON, time.Time{}, time.Time{}))
 
 				mockDB.ExpectQuery(`^SELECT user_id FROM list_access`).
 					WithArgs("1").
@@ -140,7 +149,7 @@ func TestSQLXListRepositoryGet(t *testing.T) {
 				Description: "Test Description",
 				OwnerID:     "owner-id",
 				Visibility:  constants.VisibilityShared,
-				Tags:        pkg.JSONRawMessageFromNullableString(pkg.NewValidNullableString("tag1, tag2")),
+				Tags:        testTags,
 				SharedWith:  []string{"user1", "user2"},
 			},
 			expectedError: nil,
@@ -150,7 +159,7 @@ func TestSQLXListRepositoryGet(t *testing.T) {
 			id:   "1",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectQuery("SELECT id, name, description, owner_id, visibility, tags, created_at, updated_at FROM lists").WithArgs("1").WillReturnError(errors.New("db error"))
+				mockDB.ExpectQuery("SELECT id, name, description, owner_id, visibility, .* AS tags, created_at, updated_at, archived_at FROM lists").WithArgs("1").WillReturnError(errors.New("db error"))
 				mockDB.ExpectRollback()
 			},
 			expectedList:  models.List{},
@@ -188,6 +197,93 @@ func TestSQLXListRepositoryGet(t *testing.T) {
 	}
 }
 
+func TestSQLXListRepositoryGetByIDs(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := lists.NewSQLXListRepository()
+	ids := []string{"1", "2"}
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedLists []models.List
+		expectedError error
+	}{
+		{
+			name: "Successful get of lists by ids",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT id, name, description, owner_id, visibility, .* AS tags, created_at, updated_at, archived_at FROM lists").WithArgs(
+					pq.Array(ids)).WillReturnRows(sqlxmock.NewRows([]string{"id", "name", "description", "owner_id", "visibility", "tags", "created_at", "updated_at"}).
+					AddRow("1", "First", "First Description", "owner-id", constants.VisibilityShared, nil, time.Time{}, time.Time{}).
+					AddRow("2", "Second", "Second Description", "owner-id", constants.VisibilityPrivate, nil, time.Time{}, time.Time{}))
+				mockDB.ExpectQuery(`^SELECT list_id, user_id FROM list_access`).
+					WithArgs(pq.Array(ids)).
+					WillReturnRows(sqlxmock.NewRows([]string{"list_id", "user_id"}).AddRow("1", "owner-id").AddRow("1", "user1").AddRow("2", "owner-id"))
+				mockDB.ExpectCommit()
+			},
+			expectedLists: []models.List{
+				{
+					ID:          "1",
+					Name:        "First",
+					Description: "First Description",
+					OwnerID:     "owner-id",
+					Visibility:  constants.VisibilityShared,
+					SharedWith:  []string{"owner-id", "user1"},
+					Tags:        []models.Tag{},
+				},
+				{
+					ID:          "2",
+					Name:        "Second",
+					Description: "Second Description",
+					OwnerID:     "owner-id",
+					Visibility:  constants.VisibilityPrivate,
+					SharedWith:  []string{"owner-id"},
+					Tags:        []models.Tag{},
+				},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed get lists due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT id, name, description, owner_id, visibility, .* AS tags, created_at, updated_at, archived_at FROM lists").WithArgs(pq.Array(ids)).WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to get lists: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			result, err := repo.GetByIDs(ctx, ids)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedLists, result)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestSQLXListRepositoryUpdate(t *testing.T) {
 	database, mockDB, err := sqlxmock.Newx()
 	require.NoError(t, err)
@@ -206,7 +302,7 @@ func TestSQLXListRepositoryUpdate(t *testing.T) {
 			setupMocks: func() {
 				mockDB.ExpectBegin()
 				mockDB.ExpectExec("UPDATE lists").
-					WithArgs("Test List", "Test Description", constants.VisibilityShared, "tag1, tag2", "1").
+					WithArgs("Test List", "Test Description", constants.VisibilityShared, "1").
 					WillReturnResult(sqlxmock.NewResult(1, 1))
 				mockDB.ExpectCommit()
 			},
@@ -216,7 +312,7 @@ func TestSQLXListRepositoryUpdate(t *testing.T) {
 				Description: "Test Description",
 				OwnerID:     "owner-id",
 				Visibility:  constants.VisibilityShared,
-				Tags:        pkg.JSONRawMessageFromNullableString(pkg.NewValidNullableString("tag1, tag2")),
+				Tags:        testTags,
 				SharedWith:  []string{"user1", "user2"},
 			},
 			expectedError: nil,
@@ -235,7 +331,7 @@ func TestSQLXListRepositoryUpdate(t *testing.T) {
 				Description: "Test Description",
 				OwnerID:     "owner-id",
 				Visibility:  constants.VisibilityShared,
-				Tags:        pkg.JSONRawMessageFromNullableString(pkg.NewValidNullableString("tag1, tag2")),
+				Tags:        testTags,
 				SharedWith:  []string{"user1", "user2"},
 			},
 			expectedError: fmt.Errorf("failed to update list: %w", errors.New("db error")),
@@ -353,25 +449,27 @@ func TestSQLXListRepositoryListAllByUserID(t *testing.T) {
 			userID: "user_id",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectQuery("SELECT list_id, user_id, access_level, status FROM list_access").
+				mockDB.ExpectQuery("SELECT list_id, user_id, access_level, status, position FROM list_access").
 					WithArgs("user_id").
-					WillReturnRows(sqlxmock.NewRows([]string{"list_id", "user_id", "access_level", "status"}).
-						AddRow("1", "user_id", constants.Reader, "owner").
-						AddRow("2", "user_id", constants.Reader, "owner"))
+					WillReturnRows(sqlxmock.NewRows([]string{"list_id", "user_id", "access_level", "status", "position"}).
+						AddRow("1", "user_id", constants.Reader, "owner", "G").
+						AddRow("2", "user_id", constants.Reader, "owner", "V"))
 				mockDB.ExpectCommit()
 			},
 			expectedLists: []models.Access{
 				{
-					ListID: "1",
-					UserID: "user_id",
-					Role:   constants.Reader,
-					Status: "owner",
+					ListID:   "1",
+					UserID:   "user_id",
+					Role:     constants.Reader,
+					Status:   "owner",
+					Position: "G",
 				},
 				{
-					ListID: "2",
-					UserID: "user_id",
-					Role:   constants.Reader,
-					Status: "owner",
+					ListID:   "2",
+					UserID:   "user_id",
+					Role:     constants.Reader,
+					Status:   "owner",
+					Position: "V",
 				},
 			},
 			expectedError: nil,
@@ -381,7 +479,7 @@ func TestSQLXListRepositoryListAllByUserID(t *testing.T) {
 			userID: "owner-id",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectQuery("SELECT list_id, user_id, access_level, status FROM list_access").
+				mockDB.ExpectQuery("SELECT list_id, user_id, access_level, status, position FROM list_access").
 					WithArgs("owner-id").
 					WillReturnError(sql.ErrNoRows)
 				mockDB.ExpectRollback()
@@ -436,10 +534,10 @@ func TestSQLXListRepositoryListAll(t *testing.T) {
 			name: "Successful get of all lists",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectQuery("SELECT id, name, description, owner_id, visibility, tags, created_at, updated_at FROM lists").
+				mockDB.ExpectQuery("SELECT id, name, description, owner_id, visibility, .* AS tags, created_at, updated_at, archived_at FROM lists").
 					WillReturnRows(sqlxmock.NewRows([]string{"id", "name", "description", "owner_id", "visibility", "tags", "created_at", "updated_at"}).
-						AddRow("1", "Test List", "Test Description", "owner-id", constants.VisibilityShared, "tag1, tag2", time.Time{}, time.Time{}).
-						AddRow("1", "Test List", "Test Description", "owner-id", constants.VisibilityShared, "tag1, tag2", time.Time{}, time.Time{}))
+						AddRow("1", "Test List", "Test Description", "owner-id", constants.VisibilityShared, testTagsJSON, time.Time{}, time.Time{}).
+						AddRow("1", "Test List", "Test Description", "owner-id", constants.VisibilityShared, testTagsJSON, time.Time{}, time.Time{}))
 
 				mockDB.ExpectQuery(`^SELECT user_id FROM list_access`).
 					WithArgs("1").
@@ -456,7 +554,7 @@ func TestSQLXListRepositoryListAll(t *testing.T) {
 					Description: "Test Description",
 					OwnerID:     "owner-id",
 					Visibility:  constants.VisibilityShared,
-					Tags:        pkg.JSONRawMessageFromNullableString(pkg.NewValidNullableString("tag1, tag2")),
+					Tags:        testTags,
 					SharedWith:  []string{"user1", "user2"},
 				},
 				{
@@ -465,7 +563,7 @@ func TestSQLXListRepositoryListAll(t *testing.T) {
 					Description: "Test Description",
 					OwnerID:     "owner-id",
 					Visibility:  constants.VisibilityShared,
-					Tags:        pkg.JSONRawMessageFromNullableString(pkg.NewValidNullableString("tag1, tag2")),
+					Tags:        testTags,
 					SharedWith:  []string{"user1", "user2"},
 				},
 			},
@@ -475,7 +573,7 @@ func TestSQLXListRepositoryListAll(t *testing.T) {
 			name: "Failed get all lists due to database error",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectQuery("SELECT id, name, description, owner_id, visibility, tags, created_at, updated_at FROM lists").WillReturnError(errors.New("db error"))
+				mockDB.ExpectQuery("SELECT id, name, description, owner_id, visibility, .* AS tags, created_at, updated_at, archived_at FROM lists").WillReturnError(errors.New("db error"))
 				mockDB.ExpectRollback()
 			},
 			expectedList:  []models.List{},
@@ -678,16 +776,19 @@ func TestSQLXListRepositoryCreateAccess(t *testing.T) {
 			},
 			setupMocks: func() {
 				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^SELECT id FROM users WHERE id = \$1 FOR UPDATE`).WithArgs("user_id").WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectQuery(`^SELECT COALESCE\(MAX\(position\), ''\) FROM list_access`).WithArgs("user_id").WillReturnRows(sqlxmock.NewRows([]string{"position"}).AddRow("V"))
 				mockDB.ExpectExec(`^INSERT INTO list_access`).WithArgs(
-					"list_id", "user_id", constants.Reader, "pending",
+					"list_id", "user_id", constants.Reader, "pending", "l",
 				).WillReturnResult(sqlxmock.NewResult(1, 1))
 
 				mockDB.ExpectCommit()
 			},
 			expectedAccess: models.Access{
-				ListID: "list_id",
-				UserID: "user_id",
-				Role:   constants.Reader,
+				ListID:   "list_id",
+				UserID:   "user_id",
+				Role:     constants.Reader,
+				Position: "l",
 			},
 			expectedError: nil,
 		},
@@ -700,6 +801,8 @@ func TestSQLXListRepositoryCreateAccess(t *testing.T) {
 			},
 			setupMocks: func() {
 				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^SELECT id FROM users WHERE id = \$1 FOR UPDATE`).WithArgs("user_id").WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectQuery(`^SELECT COALESCE\(MAX\(position\), ''\) FROM list_access`).WithArgs("user_id").WillReturnRows(sqlxmock.NewRows([]string{"position"}).AddRow(""))
 				mockDB.ExpectExec(`^INSERT INTO list_access`).WillReturnError(errors.New("db error"))
 				mockDB.ExpectRollback()
 			},
@@ -874,3 +977,139 @@ func TestSQLXListRepositoryDeleteAccess(t *testing.T) {
 		})
 	}
 }
+
+func TestSQLXListRepositoryUpdateAccessPosition(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := lists.NewSQLXListRepository()
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedError error
+	}{
+		{
+			name: "Successful update of a list position",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^UPDATE list_access SET position`).
+					WithArgs("V", "list1", "user1").
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "Failed update because the user has no access to the list",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^UPDATE list_access SET position`).
+					WithArgs("V", "list1", "user1").
+					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectRollback()
+			},
+			expectedError: errors.New("list list1 of user user1 not found: not found"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			err = repo.UpdateAccessPosition(ctx, "list1", "user1", "V")
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestSQLXListRepositorySetArchivedAt(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := lists.NewSQLXListRepository()
+	archivedAt := time.Date(2026, 10, 19, 16, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name          string
+		archivedAt    *time.Time
+		setupMocks    func()
+		expectedError error
+	}{
+		{
+			name:       "Successful archive of a list",
+			archivedAt: &archivedAt,
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^UPDATE lists SET archived_at`).
+					WithArgs(archivedAt, "list1").
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "Successful unarchive of a list",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^UPDATE lists SET archived_at`).
+					WithArgs(nil, "list1").
+					WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name:       "Failed archive because the list does not exist",
+			archivedAt: &archivedAt,
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^UPDATE lists SET archived_at`).
+					WithArgs(archivedAt, "list1").
+					WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectRollback()
+			},
+			expectedError: errors.New("list list1 not found: not found"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			err = repo.SetArchivedAt(ctx, "list1", tc.archivedAt)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
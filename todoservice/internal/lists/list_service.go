@@ -3,9 +3,13 @@ package lists
 import (
 	"context"
 	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/rank"
+	"slices"
 	"time"
 )
 
@@ -14,6 +18,7 @@ type ListService interface {
 	CreateList(ctx context.Context, list models.List) (string, error)
 	CreateAccess(ctx context.Context, list models.Access) (models.Access, error)
 	GetList(ctx context.Context, id string) (models.List, error)
+	GetListsByIDs(ctx context.Context, ids []string) ([]models.List, error)
 	GetAccess(ctx context.Context, listID string, userID string) (models.Access, error)
 	GetAllLists(ctx context.Context) ([]models.List, error)
 	GetUsersByListID(ctx context.Context, listID string) ([]models.Access, error)
@@ -29,6 +34,9 @@ type ListService interface {
 	AcceptList(ctx context.Context, listID string, userID string) error
 	GetAccessesByListID(ctx context.Context, listID string) ([]models.Access, error)
 	GetAcceptedLists(ctx context.Context, userID string) ([]models.Access, error)
+	MoveList(ctx context.Context, listID, userID, beforeID, afterID string) (models.Access, error)
+	ArchiveList(ctx context.Context, id string) (models.List, error)
+	UnarchiveList(ctx context.Context, id string) (models.List, error)
 }
 
 //go:generate mockery --name=UUIDService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
@@ -71,6 +79,11 @@ func (s *service) GetList(ctx context.Context, id string) (models.List, error) {
 	return s.repo.Get(ctx, id)
 }
 
+func (s *service) GetListsByIDs(ctx context.Context, ids []string) ([]models.List, error) {
+	log.C(ctx).Info("getting lists by ids service")
+	return s.repo.GetByIDs(ctx, ids)
+}
+
 func (s *service) DeleteList(ctx context.Context, id string) error {
 	log.C(ctx).Info("deleting list service")
 	return s.repo.Delete(ctx, id)
@@ -168,3 +181,87 @@ func (s *service) GetAccessesByListID(ctx context.Context, listID string) ([]mod
 	log.C(ctx).Infof("getting accesses by list id: %s", listID)
 	return s.repo.GetAccessesByListID(ctx, listID)
 }
+
+// MoveList puts the list right before the list beforeID and right after the list afterID among the owned and
+// accepted lists of the user, either of which may be empty. The order is kept separately for every user.
+func (s *service) MoveList(ctx context.Context, listID, userID, beforeID, afterID string) (models.Access, error) {
+	log.C(ctx).Info("moving list service")
+	if beforeID == "" && afterID == "" {
+		return models.Access{}, fmt.Errorf("a list to move before or after is required: %w", pkg.ErrBadRequest)
+	}
+	if beforeID == listID || afterID == listID {
+		return models.Access{}, fmt.Errorf("list %s cannot be moved next to itself: %w", listID, pkg.ErrBadRequest)
+	}
+	accesses, err := s.repo.GetAccessesByUserID(ctx, userID)
+	if err != nil {
+		return models.Access{}, err
+	}
+	current := slices.IndexFunc(accesses, func(access models.Access) bool { return access.ListID == listID })
+	if current < 0 {
+		return models.Access{}, fmt.Errorf("list %s of user %s not found: %w", listID, userID, pkg.ErrNotFound)
+	}
+	access := accesses[current]
+	others := slices.Delete(accesses, current, current+1)
+
+	after := slices.IndexFunc(others, func(other models.Access) bool { return other.ListID == afterID })
+	if afterID != "" && after < 0 {
+		return models.Access{}, fmt.Errorf("list %s is not in the lists of user %s: %w", afterID, userID, pkg.ErrBadRequest)
+	}
+	before := slices.IndexFunc(others, func(other models.Access) bool { return other.ListID == beforeID })
+	if beforeID != "" && before < 0 {
+		return models.Access{}, fmt.Errorf("list %s is not in the lists of user %s: %w", beforeID, userID, pkg.ErrBadRequest)
+	}
+	if after >= 0 && before >= 0 && after >= before {
+		return models.Access{}, fmt.Errorf("list %s does not come before list %s: %w", afterID, beforeID, pkg.ErrBadRequest)
+	}
+
+	keys := make([]string, 0, len(others))
+	for _, other := range others {
+		keys = append(keys, other.Position)
+	}
+	if access.Position, err = rank.Insert(keys, after, before); err != nil {
+		if errors.Is(err, rank.ErrOutOfOrder) {
+			return models.Access{}, fmt.Errorf("failed to rank list: %w: %w", err, pkg.ErrConflict)
+		}
+		return models.Access{}, fmt.Errorf("failed to rank list: %w", err)
+	}
+	if err = s.repo.UpdateAccessPosition(ctx, listID, userID, access.Position); err != nil {
+		return models.Access{}, err
+	}
+	return access, nil
+}
+
+// ArchiveList makes the list read-only and hides it from the default listings. Archiving an archived list keeps
+// its original archive time.
+func (s *service) ArchiveList(ctx context.Context, id string) (models.List, error) {
+	log.C(ctx).Info("archiving list service")
+	list, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return models.List{}, err
+	}
+	if list.ArchivedAt != nil {
+		return list, nil
+	}
+	now := s.timeService.Now()
+	if err = s.repo.SetArchivedAt(ctx, id, &now); err != nil {
+		return models.List{}, err
+	}
+	list.ArchivedAt = &now
+	return list, nil
+}
+
+func (s *service) UnarchiveList(ctx context.Context, id string) (models.List, error) {
+	log.C(ctx).Info("unarchiving list service")
+	list, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return models.List{}, err
+	}
+	if list.ArchivedAt == nil {
+		return list, nil
+	}
+	if err = s.repo.SetArchivedAt(ctx, id, nil); err != nil {
+		return models.List{}, err
+	}
+	list.ArchivedAt = nil
+	return list, nil
+}
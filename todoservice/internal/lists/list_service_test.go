@@ -5,6 +5,7 @@ import (
 	"errors"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/lists"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/lists/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -855,3 +856,198 @@ func TestServiceDeleteAccess(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceMoveList(t *testing.T) {
+	ctx := context.Background()
+	userID := "user1"
+	accesses := func() []models.Access {
+		return []models.Access{
+			{ListID: "a", UserID: userID, Position: "1V"},
+			{ListID: "b", UserID: userID, Position: "3V"},
+			{ListID: "c", UserID: userID, Position: "5V"},
+		}
+	}
+
+	tests := []struct {
+		name             string
+		listID           string
+		beforeID         string
+		afterID          string
+		repo             func() *automock.ListRepository
+		expectedPosition string
+		expectedError    error
+	}{
+		{
+			name:    "Move list to the end",
+			listID:  "a",
+			afterID: "c",
+			repo: func() *automock.ListRepository {
+				repo := &automock.ListRepository{}
+				repo.EXPECT().GetAccessesByUserID(ctx, userID).Return(accesses(), nil).Once()
+				repo.EXPECT().UpdateAccessPosition(ctx, "a", userID, "Y").Return(nil).Once()
+				return repo
+			},
+			expectedPosition: "Y",
+		},
+		{
+			name:     "Move list between two lists",
+			listID:   "c",
+			beforeID: "b",
+			afterID:  "a",
+			repo: func() *automock.ListRepository {
+				repo := &automock.ListRepository{}
+				repo.EXPECT().GetAccessesByUserID(ctx, userID).Return(accesses(), nil).Once()
+				repo.EXPECT().UpdateAccessPosition(ctx, "c", userID, "2").Return(nil).Once()
+				return repo
+			},
+			expectedPosition: "2",
+		},
+		{
+			name:     "Error when the neighbours share a position",
+			listID:   "a",
+			beforeID: "c",
+			afterID:  "b",
+			repo: func() *automock.ListRepository {
+				repo := &automock.ListRepository{}
+				repo.EXPECT().GetAccessesByUserID(ctx, userID).Return([]models.Access{
+					{ListID: "a", UserID: userID, Position: "1V"},
+					{ListID: "b", UserID: userID, Position: "3V"},
+					{ListID: "c", UserID: userID, Position: "3V"},
+				}, nil).Once()
+				return repo
+			},
+			expectedError: pkg.ErrConflict,
+		},
+		{
+			name:     "Error when the user has no access to the list",
+			listID:   "d",
+			beforeID: "a",
+			repo: func() *automock.ListRepository {
+				repo := &automock.ListRepository{}
+				repo.EXPECT().GetAccessesByUserID(ctx, userID).Return(accesses(), nil).Once()
+				return repo
+			},
+			expectedError: pkg.ErrNotFound,
+		},
+		{
+			name:     "Error when the neighbour is not a list of the user",
+			listID:   "a",
+			beforeID: "d",
+			repo: func() *automock.ListRepository {
+				repo := &automock.ListRepository{}
+				repo.EXPECT().GetAccessesByUserID(ctx, userID).Return(accesses(), nil).Once()
+				return repo
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+		{
+			name:   "Error without neighbours",
+			listID: "a",
+			repo: func() *automock.ListRepository {
+				return &automock.ListRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := lists.NewService(repo, &automock.UUIDService{}, &automock.TimeService{})
+			access, err := svc.MoveList(ctx, tt.listID, userID, tt.beforeID, tt.afterID)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expectedPosition, access.Position)
+			}
+		})
+	}
+}
+
+func TestServiceArchiveList(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, 10, 19, 16, 0, 0, 0, time.UTC)
+	earlier := now.Add(-time.Hour)
+
+	tests := []struct {
+		name          string
+		archive       bool
+		repo          func() *automock.ListRepository
+		expected      *time.Time
+		expectedError error
+	}{
+		{
+			name:    "Archive a list",
+			archive: true,
+			repo: func() *automock.ListRepository {
+				repo := &automock.ListRepository{}
+				repo.EXPECT().Get(ctx, "list1").Return(models.List{ID: "list1"}, nil).Once()
+				repo.EXPECT().SetArchivedAt(ctx, "list1", &now).Return(nil).Once()
+				return repo
+			},
+			expected: &now,
+		},
+		{
+			name:    "Archiving an archived list keeps the archive time",
+			archive: true,
+			repo: func() *automock.ListRepository {
+				repo := &automock.ListRepository{}
+				repo.EXPECT().Get(ctx, "list1").Return(models.List{ID: "list1", ArchivedAt: &earlier}, nil).Once()
+				return repo
+			},
+			expected: &earlier,
+		},
+		{
+			name: "Unarchive a list",
+			repo: func() *automock.ListRepository {
+				repo := &automock.ListRepository{}
+				repo.EXPECT().Get(ctx, "list1").Return(models.List{ID: "list1", ArchivedAt: &earlier}, nil).Once()
+				repo.EXPECT().SetArchivedAt(ctx, "list1", (*time.Time)(nil)).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			name: "Unarchiving a list that is not archived does nothing",
+			repo: func() *automock.ListRepository {
+				repo := &automock.ListRepository{}
+				repo.EXPECT().Get(ctx, "list1").Return(models.List{ID: "list1"}, nil).Once()
+				return repo
+			},
+		},
+		{
+			name:    "Error when the list does not exist",
+			archive: true,
+			repo: func() *automock.ListRepository {
+				repo := &automock.ListRepository{}
+				repo.EXPECT().Get(ctx, "list1").Return(models.List{}, pkg.ErrNotFound).Once()
+				return repo
+			},
+			expectedError: pkg.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			timeService := &automock.TimeService{}
+			timeService.EXPECT().Now().Return(now).Maybe()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := lists.NewService(repo, &automock.UUIDService{}, timeService)
+			var list models.List
+			var err error
+			if tt.archive {
+				list, err = svc.ArchiveList(ctx, "list1")
+			} else {
+				list, err = svc.UnarchiveList(ctx, "list1")
+			}
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, list.ArchivedAt)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+package metrics
+
+import (
+	"context"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/jmoiron/sqlx"
+	"github.com/prometheus/client_golang/prometheus"
+	"time"
+)
+
+const businessQueryTimeout = 5 * time.Second
+
+type businessCounts struct {
+	OpenTodos          float64 `db:"open_todos"`
+	OverdueTodos       float64 `db:"overdue_todos"`
+	PendingInvitations float64 `db:"pending_invitations"`
+}
+
+// BusinessCollector reads the business gauges from the database on every scrape.
+type BusinessCollector struct {
+	database           *sqlx.DB
+	openTodos          *prometheus.Desc
+	overdueTodos       *prometheus.Desc
+	pendingInvitations *prometheus.Desc
+}
+
+var _ prometheus.Collector = &BusinessCollector{}
+
+func NewBusinessCollector(database *sqlx.DB) *BusinessCollector {
+	return &BusinessCollector{
+		database:           database,
+		openTodos:          prometheus.NewDesc(namespace+"_open_todos", "Number of todos that are not completed.", nil, nil),
+		overdueTodos:       prometheus.NewDesc(namespace+"_overdue_todos", "Number of todos that are not completed and past their due date.", nil, nil),
+		pendingInvitations: prometheus.NewDesc(namespace+"_pending_invitations", "Number of list invitations that are not accepted yet.", nil, nil),
+	}
+}
+
+func (c *BusinessCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- c.openTodos
+	ch <- c.overdueTodos
+	ch <- c.pendingInvitations
+}
+
+func (c *BusinessCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), businessQueryTimeout)
+	defer cancel()
+
+	query := `
+		SELECT
+			(SELECT COUNT(*) FROM todos WHERE completed = FALSE) AS open_todos,
+			(SELECT COUNT(*) FROM todos WHERE completed = FALSE AND due_date < NOW()) AS overdue_todos,
+			(SELECT COUNT(*) FROM list_access WHERE status = 'pending') AS pending_invitations
+	`
+	var counts businessCounts
+	if err := c.database.GetContext(ctx, &counts, query); err != nil {
+		log.C(ctx).Errorf("failed to collect business metrics: %v", err)
+		ch <- prometheus.NewInvalidMetric(c.openTodos, err)
+		return
+	}
+
+	ch <- prometheus.MustNewConstMetric(c.openTodos, prometheus.GaugeValue, counts.OpenTodos)
+	ch <- prometheus.MustNewConstMetric(c.overdueTodos, prometheus.GaugeValue, counts.OverdueTodos)
+	ch <- prometheus.MustNewConstMetric(c.pendingInvitations, prometheus.GaugeValue, counts.PendingInvitations)
+}
@@ -0,0 +1,64 @@
+package metrics_test
+
+import (
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/metrics"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"strings"
+	"testing"
+)
+
+func TestBusinessCollector(t *testing.T) {
+	tests := []struct {
+		name          string
+		mockDatabase  func(mock sqlxmock.Sqlmock)
+		expected      string
+		expectedError bool
+	}{
+		{
+			name: "Success",
+			mockDatabase: func(mock sqlxmock.Sqlmock) {
+				rows := sqlxmock.NewRows([]string{"open_todos", "overdue_todos", "pending_invitations"}).AddRow(7, 2, 3)
+				mock.ExpectQuery("SELECT").WillReturnRows(rows)
+			},
+			expected: `
+# HELP todoservice_open_todos Number of todos that are not completed.
+# TYPE todoservice_open_todos gauge
+todoservice_open_todos 7
+# HELP todoservice_overdue_todos Number of todos that are not completed and past their due date.
+# TYPE todoservice_overdue_todos gauge
+todoservice_overdue_todos 2
+# HELP todoservice_pending_invitations Number of list invitations that are not accepted yet.
+# TYPE todoservice_pending_invitations gauge
+todoservice_pending_invitations 3
+`,
+		},
+		{
+			name: "Query error",
+			mockDatabase: func(mock sqlxmock.Sqlmock) {
+				mock.ExpectQuery("SELECT").WillReturnError(errors.New("connection refused"))
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			db, mock, err := sqlxmock.Newx()
+			require.NoError(t, err)
+			defer db.Close()
+			tt.mockDatabase(mock)
+
+			err = testutil.CollectAndCompare(metrics.NewBusinessCollector(db), strings.NewReader(tt.expected))
+			if tt.expectedError {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+			assert.NoError(t, mock.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,51 @@
+// Package metrics exposes the Prometheus registry of todoservice on a separate admin port.
+package metrics
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/jmoiron/sqlx"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"net/http"
+	"time"
+)
+
+const (
+	namespace              = "todoservice"
+	adminReadHeaderTimeout = 5 * time.Second
+)
+
+type Config struct {
+	AdminPort string `envconfig:"APP_ADMIN_PORT" default:"9090"`
+}
+
+// RegisterDB registers the connection pool statistics and the business gauges computed from database.
+func RegisterDB(database *sqlx.DB) error {
+	if err := prometheus.Register(collectors.NewDBStatsCollector(database.DB, namespace)); err != nil {
+		return err
+	}
+	return prometheus.Register(NewBusinessCollector(database))
+}
+
+// StartAdminServer serves /metrics on the admin port so that it is never exposed next to the public API.
+// The returned server is meant to be shut down together with the REST server.
+func StartAdminServer(ctx context.Context, config Config) *http.Server {
+	router := http.NewServeMux()
+	router.Handle("/metrics", promhttp.Handler())
+	server := &http.Server{
+		Addr:              ":" + config.AdminPort,
+		Handler:           router,
+		ReadHeaderTimeout: adminReadHeaderTimeout,
+	}
+
+	go func() {
+		log.C(ctx).Infof("serving metrics on admin port %s", config.AdminPort)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.C(ctx).Errorf("admin server stopped: %v", err)
+		}
+	}()
+	return server
+}
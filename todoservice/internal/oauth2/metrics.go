@@ -0,0 +1,43 @@
+package oauth2
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"net/http"
+)
+
+const (
+	loginFlowGithub  = "github"
+	loginFlowRefresh = "refresh"
+
+	loginSuccess = "success"
+	loginFailure = "failure"
+)
+
+var loginsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "todoservice_logins_total",
+	Help: "Number of login attempts by flow and outcome.",
+}, []string{"flow", "outcome"})
+
+// loginRecorder counts a login attempt as failed when the handler answers with an error status.
+type loginRecorder struct {
+	http.ResponseWriter
+	status int
+}
+
+func newLoginRecorder(w http.ResponseWriter) *loginRecorder {
+	return &loginRecorder{ResponseWriter: w, status: http.StatusOK}
+}
+
+func (l *loginRecorder) WriteHeader(status int) {
+	l.status = status
+	l.ResponseWriter.WriteHeader(status)
+}
+
+func (l *loginRecorder) record(flow string) {
+	outcome := loginSuccess
+	if l.status >= http.StatusBadRequest {
+		outcome = loginFailure
+	}
+	loginsTotal.WithLabelValues(flow, outcome).Inc()
+}
@@ -12,6 +12,7 @@ import (
 	token "github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/problem"
 	"github.com/golang-jwt/jwt/v4"
 	"github.com/jmoiron/sqlx"
 	"golang.org/x/oauth2"
@@ -76,23 +77,27 @@ func (h *Handler) GithubLoginHandler(w http.ResponseWriter, r *http.Request) {
 
 func (h *Handler) GithubCallbackHandler(w http.ResponseWriter, r *http.Request) {
 	log.C(r.Context()).Info("github callback received")
+	recorder := newLoginRecorder(w)
+	defer recorder.record(loginFlowGithub)
+	w = recorder
+
 	code := r.URL.Query().Get("code")
 	tokenJWT, err := h.oauth2Config.Exchange(oauth2.NoContext, code)
 	if err != nil {
 		log.C(r.Context()).Errorf("failed to exchange code for token: %v", err)
-		http.Error(w, "token exchange failed", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "token exchange failed")
 		return
 	}
-	log.C(r.Context()).Debugf("exchanged token:  %s", tokenJWT.AccessToken)
+	log.C(r.Context()).Debug("exchanged github code for an access token")
 
 	accessToken := tokenJWT.AccessToken
 	githubData, err := h.getGithubData(r.Context(), accessToken)
 	if err != nil {
 		log.C(r.Context()).Debugf("failed to fetch github data: %v", err)
-		http.Error(w, "Failed to get github data", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "Failed to get github data")
 		return
 	}
-	log.C(r.Context()).Debugf("successfully parsed github data: %v", githubData)
+	log.C(r.Context()).Debug("successfully parsed github data")
 
 	h.loggedInHandler(w, r, githubData)
 }
@@ -100,17 +105,20 @@ func (h *Handler) GithubCallbackHandler(w http.ResponseWriter, r *http.Request)
 func (h *Handler) RefreshTokenHandler(w http.ResponseWriter, r *http.Request) {
 	ctx := r.Context()
 	log.C(ctx).Info("refresh token received handler")
+	recorder := newLoginRecorder(w)
+	defer recorder.record(loginFlowRefresh)
+	w = recorder
 
 	cookie, err := r.Cookie("refresh_token")
 	if err != nil || cookie.Value == "" {
-		http.Error(w, "Refresh token not found", http.StatusUnauthorized)
+		problem.Write(w, r, http.StatusUnauthorized, "Refresh token not found")
 		return
 	}
 
 	tx, err := h.database.BeginTxx(ctx, nil)
 	if err != nil {
 		log.C(ctx).Errorf("GenerateJWT refresh token transaction failed: %v", err)
-		http.Error(w, "failed to start transaction", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to start transaction")
 		return
 	}
 	defer tx.Rollback()
@@ -120,7 +128,7 @@ func (h *Handler) RefreshTokenHandler(w http.ResponseWriter, r *http.Request) {
 	user, err := h.userService.FindByRefreshToken(ctx, cookie.Value)
 	if err != nil {
 		log.C(ctx).Errorf("invalid refresh token: %v", err)
-		http.Error(w, "invalid refresh token", http.StatusUnauthorized)
+		problem.Write(w, r, http.StatusUnauthorized, "invalid refresh token")
 		return
 	}
 	log.C(ctx).Debugf("found user: %v", user)
@@ -128,27 +136,27 @@ func (h *Handler) RefreshTokenHandler(w http.ResponseWriter, r *http.Request) {
 	newAccessToken, err := h.GenerateJWT(ctx, h.jwtExpirationTime, user.Email, string(user.Role))
 	if err != nil {
 		log.C(ctx).Errorf("failed to generate new access token: %v", err)
-		http.Error(w, "failed to generate new access token", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to generate new access token")
 		return
 	}
 
 	newRefreshToken, err := h.generateRefreshToken(ctx, user.Email, string(user.Role))
 	if err != nil {
 		log.C(r.Context()).Errorf("failed to generate new refresh token: %v", err)
-		http.Error(w, "failed to generate new refresh token", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to generate new refresh token")
 		return
 	}
 
 	if err := h.userService.SaveRefreshToken(ctx, user.Email, newRefreshToken, time.Now().Add(h.refreshExpirationTime)); err != nil {
 		log.C(r.Context()).Errorf("failed to save new refresh token: %v", err)
-		http.Error(w, "failed to save new refresh token", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to save new refresh token")
 		return
 	}
 
 	err = tx.Commit()
 	if err != nil {
 		log.C(ctx).Errorf("JWTMiddleware transaction failed to commit: %v", err)
-		http.Error(w, "failed to commit transaction", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to commit transaction")
 		return
 	}
 
@@ -161,7 +169,7 @@ func (h *Handler) RefreshTokenHandler(w http.ResponseWriter, r *http.Request) {
 
 	if err := json.NewEncoder(w).Encode(tokens); err != nil {
 		log.C(r.Context()).Errorf("failed to write response: %v", err)
-		http.Error(w, "failed to write response", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to write response")
 	}
 }
 
@@ -171,7 +179,7 @@ func (h *Handler) getGithubData(context context.Context, accessToken string) (st
 	if err != nil {
 		return "", err
 	}
-	log.C(context).Debugf("successfully fetched github data: %v", userData)
+	log.C(context).Debug("successfully fetched github data")
 	userOrg, err := h.getGithubUserOrg(context, accessToken)
 	if err != nil {
 		return "", err
@@ -203,7 +211,7 @@ func (h *Handler) getGithubUserData(context context.Context, accessToken string)
 	}
 
 	respBody, _ := ioutil.ReadAll(resp.Body)
-	log.C(context).Debugf("got github user data: %s", string(respBody))
+	log.C(context).Debug("got github user data")
 	return string(respBody), nil
 }
 
@@ -217,7 +225,7 @@ func (h *Handler) getGithubUserOrg(context context.Context, accessToken string)
 	}
 
 	respBody, _ := ioutil.ReadAll(resp.Body)
-	log.C(context).Debugf("got github user organizations: %s", string(respBody))
+	log.C(context).Debug("got github user organizations")
 	return string(respBody), nil
 }
 
@@ -226,33 +234,33 @@ func (h *Handler) loggedInHandler(w http.ResponseWriter, r *http.Request, github
 
 	if githubData == "" {
 		log.C(r.Context()).Error("unauthorized access")
-		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		problem.Write(w, r, http.StatusUnauthorized, "unauthorized")
 		return
 	}
 
 	var prettyJSON bytes.Buffer
 	if err := json.Indent(&prettyJSON, []byte(githubData), "", "\t"); err != nil {
 		log.C(r.Context()).Errorf("JSON parse error: %v", err)
-		http.Error(w, "failed to parse JSON", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to parse JSON")
 		return
 	}
 
 	var data GitHubData
 	if err := json.Unmarshal(prettyJSON.Bytes(), &data); err != nil {
 		log.C(r.Context()).Errorf("JSON parse error: %v", err)
-		http.Error(w, "failed to parse JSON", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to parse JSON")
 		return
 	}
 	tokenJWT, err := h.GenerateJWT(r.Context(), h.jwtExpirationTime, data.User.Email, data.Role)
 	if err != nil {
 		log.C(r.Context()).Errorf("JWT generation error: %v", err)
-		http.Error(w, "failed to generate JWT", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to generate JWT")
 		return
 	}
 	refreshToken, err := h.generateRefreshToken(r.Context(), data.User.Email, data.Role)
 	if err != nil {
 		log.C(r.Context()).Errorf("JWT refresh generation error: %v", err)
-		http.Error(w, "failed to generate refresh token", http.StatusInternalServerError)
+		problem.Write(w, r, http.StatusInternalServerError, "failed to generate refresh token")
 		return
 	}
 
@@ -352,7 +360,7 @@ func (h *Handler) GenerateJWT(ctx context.Context, expTime time.Duration, email,
 		log.C(ctx).Errorf("failed to sign token: %v", err)
 		return "", err
 	}
-	log.C(ctx).Debugf("generated JWT token: %s", tokenString)
+	log.C(ctx).Debug("generated JWT token")
 
 	return tokenString, nil
 }
@@ -399,7 +407,7 @@ func (h *Handler) generateRefreshToken(ctx context.Context, email string, role s
 		return "", err
 	}
 
-	log.C(ctx).Debugf("generated refresh token: %s", tokenString)
+	log.C(ctx).Debug("generated refresh token")
 
 	return tokenString, nil
 }
@@ -0,0 +1,45 @@
+package statuses
+
+import (
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+// allowedNextColumn selects the targets of the transitions of the current row of list_statuses, ordered like the columns.
+const allowedNextColumn = `ARRAY(
+			SELECT tr.to_status_id::text
+			FROM list_status_transitions tr JOIN list_statuses target ON target.id = tr.to_status_id
+			WHERE tr.from_status_id = list_statuses.id
+			ORDER BY target.position
+		) AS allowed_next`
+
+type Converter struct{}
+
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+// ConvertStatusToModel never leaves AllowedNext nil so that it is encoded as an empty array.
+func (c *Converter) ConvertStatusToModel(entity Entity) models.Status {
+	allowedNext := make([]string, 0, len(entity.AllowedNext))
+	allowedNext = append(allowedNext, entity.AllowedNext...)
+	return models.Status{
+		ID:          entity.ID,
+		ListID:      entity.ListID,
+		Name:        entity.Name,
+		Position:    entity.Position,
+		Done:        entity.Done,
+		AllowedNext: allowedNext,
+		CreatedAt:   entity.CreatedAt,
+		UpdatedAt:   entity.UpdatedAt,
+	}
+}
+
+func (c *Converter) ConvertStatusToEntity(status models.Status) Entity {
+	return Entity{
+		ID:       status.ID,
+		ListID:   status.ListID,
+		Name:     status.Name,
+		Position: status.Position,
+		Done:     status.Done,
+	}
+}
@@ -0,0 +1,17 @@
+package statuses
+
+import (
+	"github.com/lib/pq"
+	"time"
+)
+
+type Entity struct {
+	ID          string         `db:"id"`
+	ListID      string         `db:"list_id"`
+	Name        string         `db:"name"`
+	Position    int            `db:"position"`
+	Done        bool           `db:"done"`
+	AllowedNext pq.StringArray `db:"allowed_next"`
+	CreatedAt   time.Time      `db:"created_at"`
+	UpdatedAt   time.Time      `db:"updated_at"`
+}
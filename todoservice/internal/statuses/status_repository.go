@@ -0,0 +1,280 @@
+package statuses
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
+)
+
+//go:generate mockery --name=StatusRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type StatusRepository interface {
+	Create(ctx context.Context, status models.Status) (string, error)
+	Get(ctx context.Context, listID string, id string) (models.Status, error)
+	GetAllByListID(ctx context.Context, listID string) ([]models.Status, error)
+	GetFirst(ctx context.Context, listID string, done bool) (models.Status, error)
+	Update(ctx context.Context, status models.Status) error
+	Move(ctx context.Context, listID string, id string, from int, to int) error
+	SetTransitions(ctx context.Context, listID string, id string, toIDs []string) error
+	Delete(ctx context.Context, listID string, id string) error
+}
+
+type SQLXStatusRepository struct {
+	converter *Converter
+}
+
+var _ StatusRepository = &SQLXStatusRepository{}
+
+func NewSQLXStatusRepository() StatusRepository {
+	return &SQLXStatusRepository{converter: NewConverter()}
+}
+
+// Create appends the status after the last status of its list.
+func (r *SQLXStatusRepository) Create(ctx context.Context, status models.Status) (string, error) {
+	log.C(ctx).Info("creating status repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return "", err
+	}
+
+	entity := r.converter.ConvertStatusToEntity(status)
+	query := `
+		INSERT INTO list_statuses (id, list_id, name, position, done)
+		VALUES ($1, $2, $3, (SELECT COALESCE(MAX(position) + 1, 0) FROM list_statuses WHERE list_id = $2), $4)
+		RETURNING id
+	`
+
+	var id string
+	err = tx.QueryRowContext(ctx, query, entity.ID, entity.ListID, entity.Name, entity.Done).Scan(&id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create status: %v", err)
+		return "", fmt.Errorf("failed to create status: %w", err)
+	}
+	log.C(ctx).Debugf("created status with ID: %v", id)
+	return id, nil
+}
+
+func (r *SQLXStatusRepository) Get(ctx context.Context, listID string, id string) (models.Status, error) {
+	log.C(ctx).Info("getting status repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return models.Status{}, err
+	}
+
+	query := `
+		SELECT id, list_id, name, position, done, ` + allowedNextColumn + `, created_at, updated_at
+		FROM list_statuses
+		WHERE list_id = $1 AND id = $2
+	`
+	var entity Entity
+	err = tx.GetContext(ctx, &entity, query, listID, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get status: %v", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Status{}, fmt.Errorf("status not found: %w", err)
+		}
+		return models.Status{}, fmt.Errorf("failed to get status: %w", err)
+	}
+	return r.converter.ConvertStatusToModel(entity), nil
+}
+
+func (r *SQLXStatusRepository) GetAllByListID(ctx context.Context, listID string) ([]models.Status, error) {
+	log.C(ctx).Info("getting all statuses for a list repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	query := `
+		SELECT id, list_id, name, position, done, ` + allowedNextColumn + `, created_at, updated_at
+		FROM list_statuses
+		WHERE list_id = $1
+		ORDER BY position
+	`
+	var entities []Entity
+	err = tx.SelectContext(ctx, &entities, query, listID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get statuses: %v", err)
+		return nil, fmt.Errorf("failed to get statuses: %w", err)
+	}
+
+	result := make([]models.Status, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, r.converter.ConvertStatusToModel(entity))
+	}
+	return result, nil
+}
+
+// GetFirst returns the leftmost status of the list whose done flag equals done.
+func (r *SQLXStatusRepository) GetFirst(ctx context.Context, listID string, done bool) (models.Status, error) {
+	log.C(ctx).Info("getting first status repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return models.Status{}, err
+	}
+
+	query := `
+		SELECT id, list_id, name, position, done, ` + allowedNextColumn + `, created_at, updated_at
+		FROM list_statuses
+		WHERE list_id = $1 AND done = $2
+		ORDER BY position
+		LIMIT 1
+	`
+	var entity Entity
+	err = tx.GetContext(ctx, &entity, query, listID, done)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get first status: %v", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Status{}, fmt.Errorf("list %s has no status with done %t: %w", listID, done, pkg.ErrNotFound)
+		}
+		return models.Status{}, fmt.Errorf("failed to get first status: %w", err)
+	}
+	return r.converter.ConvertStatusToModel(entity), nil
+}
+
+// Update renames the status and sets its done flag. The completed flag of its todos follows through a trigger.
+func (r *SQLXStatusRepository) Update(ctx context.Context, status models.Status) error {
+	log.C(ctx).Info("updating status repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	entity := r.converter.ConvertStatusToEntity(status)
+	query := `
+		UPDATE list_statuses
+		SET name = $1, done = $2
+		WHERE list_id = $3 AND id = $4
+	`
+	result, err := tx.ExecContext(ctx, query, entity.Name, entity.Done, entity.ListID, entity.ID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update status: %v", err)
+		return fmt.Errorf("failed to update status: %w", err)
+	}
+	return requireAffected(result, entity.ID)
+}
+
+// Move places the status at position to and shifts the statuses between from and to by one.
+func (r *SQLXStatusRepository) Move(ctx context.Context, listID string, id string, from int, to int) error {
+	log.C(ctx).Info("moving status repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	query := `
+		UPDATE list_statuses
+		SET position = CASE
+			WHEN id = $2 THEN $4
+			WHEN $3 < $4 THEN position - 1
+			ELSE position + 1
+		END
+		WHERE list_id = $1 AND position BETWEEN LEAST($3::int, $4::int) AND GREATEST($3::int, $4::int)
+	`
+	result, err := tx.ExecContext(ctx, query, listID, id, from, to)
+	if err != nil {
+		log.C(ctx).Errorf("failed to move status: %v", err)
+		return fmt.Errorf("failed to move status: %w", err)
+	}
+	return requireAffected(result, id)
+}
+
+// SetTransitions replaces the statuses the todos of the status may move to with toIDs,
+// which must all be statuses of the same list.
+func (r *SQLXStatusRepository) SetTransitions(ctx context.Context, listID string, id string, toIDs []string) error {
+	log.C(ctx).Info("setting status transitions repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM list_status_transitions WHERE from_status_id = $1`, id); err != nil {
+		log.C(ctx).Errorf("failed to clear status transitions: %v", err)
+		return fmt.Errorf("failed to clear status transitions: %w", err)
+	}
+	if len(toIDs) == 0 {
+		return nil
+	}
+
+	insertQuery := `
+		INSERT INTO list_status_transitions (from_status_id, to_status_id)
+		SELECT s.id, target.id
+		FROM list_statuses s
+		JOIN list_statuses target ON target.list_id = s.list_id
+		WHERE s.list_id = $1 AND s.id = $2 AND target.id = ANY($3)
+	`
+	result, err := tx.ExecContext(ctx, insertQuery, listID, id, pq.Array(toIDs))
+	if err != nil {
+		log.C(ctx).Errorf("failed to set status transitions: %v", err)
+		return fmt.Errorf("failed to set status transitions: %w", err)
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	if inserted != int64(len(toIDs)) {
+		log.C(ctx).Errorf("only %d of %d statuses belong to list %s", inserted, len(toIDs), listID)
+		return fmt.Errorf("allowed statuses must belong to the list of the status: %w", pkg.ErrBadRequest)
+	}
+	return nil
+}
+
+// Delete removes a status without todos and closes the gap it leaves in the positions of the list.
+func (r *SQLXStatusRepository) Delete(ctx context.Context, listID string, id string) error {
+	log.C(ctx).Info("deleting status repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	var inUse bool
+	err = tx.QueryRowContext(ctx, `SELECT EXISTS (SELECT 1 FROM todos WHERE status_id = $1)`, id).Scan(&inUse)
+	if err != nil {
+		log.C(ctx).Errorf("failed to check status todos: %v", err)
+		return fmt.Errorf("failed to check status todos: %w", err)
+	}
+	if inUse {
+		return fmt.Errorf("status %s still has todos: %w", id, pkg.ErrConflict)
+	}
+
+	var position int
+	err = tx.QueryRowContext(ctx, `DELETE FROM list_statuses WHERE list_id = $1 AND id = $2 RETURNING position`, listID, id).Scan(&position)
+	if err != nil {
+		log.C(ctx).Errorf("failed to delete status: %v", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return fmt.Errorf("status %s not found: %w", id, pkg.ErrNotFound)
+		}
+		return fmt.Errorf("failed to delete status: %w", err)
+	}
+
+	_, err = tx.ExecContext(ctx, `UPDATE list_statuses SET position = position - 1 WHERE list_id = $1 AND position > $2`, listID, position)
+	if err != nil {
+		log.C(ctx).Errorf("failed to close status positions: %v", err)
+		return fmt.Errorf("failed to close status positions: %w", err)
+	}
+	return nil
+}
+
+func requireAffected(result sql.Result, id string) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("status %s not found: %w", id, pkg.ErrNotFound)
+	}
+	return nil
+}
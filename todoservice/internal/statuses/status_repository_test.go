@@ -0,0 +1,248 @@
+package statuses_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+	"time"
+)
+
+func TestSQLXStatusRepositoryCreate(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := statuses.NewSQLXStatusRepository()
+	status := models.Status{ID: "1", ListID: "list1", Name: "Blocked"}
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedID    string
+		expectedError string
+	}{
+		{
+			name: "Successful creation",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery(`^INSERT INTO list_statuses`).WithArgs("1", "list1", "Blocked", false).
+					WillReturnRows(sqlxmock.NewRows([]string{"id"}).AddRow("1"))
+				mockDB.ExpectCommit()
+			},
+			expectedID: "1",
+		},
+		{
+			name: "Failed creation due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery(`^INSERT INTO list_statuses`).WithArgs("1", "list1", "Blocked", false).WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: "failed to create status: db error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			id, err := repo.Create(ctx, status)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				require.NoError(t, tx.Rollback())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedID, id)
+				require.NoError(t, tx.Commit())
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSQLXStatusRepositoryGetAllByListID(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := statuses.NewSQLXStatusRepository()
+
+	columns := []string{"id", "list_id", "name", "position", "done", "allowed_next", "created_at", "updated_at"}
+	mockDB.ExpectBegin()
+	mockDB.ExpectQuery("SELECT id, list_id, name, position, done, ARRAY").WithArgs("list1").
+		WillReturnRows(sqlxmock.NewRows(columns).
+			AddRow("1", "list1", "To Do", 0, false, pq.StringArray{"2"}, time.Time{}, time.Time{}).
+			AddRow("2", "list1", "Done", 1, true, pq.StringArray{}, time.Time{}, time.Time{}))
+	mockDB.ExpectCommit()
+
+	ctx := context.Background()
+	tx, err := database.BeginTxx(ctx, nil)
+	require.NoError(t, err)
+
+	result, err := repo.GetAllByListID(db.SaveToContext(ctx, tx), "list1")
+	require.NoError(t, err)
+	assert.Equal(t, []models.Status{
+		{ID: "1", ListID: "list1", Name: "To Do", Position: 0, AllowedNext: []string{"2"}},
+		{ID: "2", ListID: "list1", Name: "Done", Position: 1, Done: true, AllowedNext: []string{}},
+	}, result)
+	require.NoError(t, tx.Commit())
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestSQLXStatusRepositoryMove(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := statuses.NewSQLXStatusRepository()
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectExec(`^UPDATE list_statuses SET position = CASE`).WithArgs("list1", "1", 0, 2).WillReturnResult(sqlxmock.NewResult(0, 3))
+	mockDB.ExpectCommit()
+
+	ctx := context.Background()
+	tx, err := database.BeginTxx(ctx, nil)
+	require.NoError(t, err)
+
+	require.NoError(t, repo.Move(db.SaveToContext(ctx, tx), "list1", "1", 0, 2))
+	require.NoError(t, tx.Commit())
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestSQLXStatusRepositorySetTransitions(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := statuses.NewSQLXStatusRepository()
+
+	testCases := []struct {
+		name          string
+		toIDs         []string
+		setupMocks    func()
+		expectedError error
+	}{
+		{
+			name:  "Replace transitions",
+			toIDs: []string{"2", "3"},
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^DELETE FROM list_status_transitions`).WithArgs("1").WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectExec(`^INSERT INTO list_status_transitions`).WillReturnResult(sqlxmock.NewResult(0, 2))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name:  "Clear transitions",
+			toIDs: nil,
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^DELETE FROM list_status_transitions`).WithArgs("1").WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name:  "Failed because a status is in another list",
+			toIDs: []string{"2", "other"},
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^DELETE FROM list_status_transitions`).WithArgs("1").WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectExec(`^INSERT INTO list_status_transitions`).WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectRollback()
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			err = repo.SetTransitions(db.SaveToContext(ctx, tx), "list1", "1", tc.toIDs)
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				require.NoError(t, tx.Rollback())
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, tx.Commit())
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSQLXStatusRepositoryDelete(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := statuses.NewSQLXStatusRepository()
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedError error
+	}{
+		{
+			name: "Successful deletion closes the gap",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery(`^SELECT EXISTS`).WithArgs("1").WillReturnRows(sqlxmock.NewRows([]string{"exists"}).AddRow(false))
+				mockDB.ExpectQuery(`^DELETE FROM list_statuses`).WithArgs("list1", "1").
+					WillReturnRows(sqlxmock.NewRows([]string{"position"}).AddRow(1))
+				mockDB.ExpectExec(`^UPDATE list_statuses SET position = position - 1`).WithArgs("list1", 1).WillReturnResult(sqlxmock.NewResult(0, 2))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "Failed because the status still has todos",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery(`^SELECT EXISTS`).WithArgs("1").WillReturnRows(sqlxmock.NewRows([]string{"exists"}).AddRow(true))
+				mockDB.ExpectRollback()
+			},
+			expectedError: pkg.ErrConflict,
+		},
+		{
+			name: "Failed because the status is not in the list",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery(`^SELECT EXISTS`).WithArgs("1").WillReturnRows(sqlxmock.NewRows([]string{"exists"}).AddRow(false))
+				mockDB.ExpectQuery(`^DELETE FROM list_statuses`).WithArgs("list1", "1").WillReturnRows(sqlxmock.NewRows([]string{"position"}))
+				mockDB.ExpectRollback()
+			},
+			expectedError: pkg.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			err = repo.Delete(db.SaveToContext(ctx, tx), "list1", "1")
+
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				require.NoError(t, tx.Rollback())
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, tx.Commit())
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
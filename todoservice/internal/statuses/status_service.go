@@ -0,0 +1,162 @@
+package statuses
+
+import (
+	"context"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"slices"
+	"strings"
+	"unicode/utf8"
+)
+
+const maxNameLength = 64
+
+//go:generate mockery --name=StatusService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type StatusService interface {
+	CreateStatus(ctx context.Context, status models.Status) (models.Status, error)
+	GetStatus(ctx context.Context, listID, id string) (models.Status, error)
+	ListStatusesByListID(ctx context.Context, listID string) ([]models.Status, error)
+	UpdateStatus(ctx context.Context, status models.Status) (models.Status, error)
+	DeleteStatus(ctx context.Context, listID, id string) error
+}
+
+//go:generate mockery --name=UUIDService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type UUIDService interface {
+	Generate() string
+}
+
+var _ StatusService = &service{}
+
+type service struct {
+	repo        StatusRepository
+	uuidService UUIDService
+}
+
+func NewService(repo StatusRepository, uuidService UUIDService) StatusService {
+	return &service{repo: repo, uuidService: uuidService}
+}
+
+// CreateStatus appends the status as the last column of its list.
+func (s *service) CreateStatus(ctx context.Context, status models.Status) (models.Status, error) {
+	log.C(ctx).Info("creating status service")
+	status, err := normalizeStatus(status)
+	if err != nil {
+		return models.Status{}, err
+	}
+
+	status.ID = s.uuidService.Generate()
+	if err = validateAllowedNext(status); err != nil {
+		return models.Status{}, err
+	}
+	if _, err = s.repo.Create(ctx, status); err != nil {
+		return models.Status{}, err
+	}
+	if len(status.AllowedNext) > 0 {
+		if err = s.repo.SetTransitions(ctx, status.ListID, status.ID, status.AllowedNext); err != nil {
+			return models.Status{}, err
+		}
+	}
+	return s.repo.Get(ctx, status.ListID, status.ID)
+}
+
+func (s *service) GetStatus(ctx context.Context, listID, id string) (models.Status, error) {
+	log.C(ctx).Info("getting status service")
+	return s.repo.Get(ctx, listID, id)
+}
+
+func (s *service) ListStatusesByListID(ctx context.Context, listID string) ([]models.Status, error) {
+	log.C(ctx).Info("listing statuses by list id service")
+	return s.repo.GetAllByListID(ctx, listID)
+}
+
+// UpdateStatus renames the status, sets its done flag, moves it to status.Position and replaces its transitions.
+func (s *service) UpdateStatus(ctx context.Context, status models.Status) (models.Status, error) {
+	log.C(ctx).Info("updating status service")
+	status, err := normalizeStatus(status)
+	if err != nil {
+		return models.Status{}, err
+	}
+	if err = validateAllowedNext(status); err != nil {
+		return models.Status{}, err
+	}
+
+	statuses, err := s.repo.GetAllByListID(ctx, status.ListID)
+	if err != nil {
+		return models.Status{}, err
+	}
+	index := slices.IndexFunc(statuses, func(current models.Status) bool { return current.ID == status.ID })
+	if index < 0 {
+		return models.Status{}, fmt.Errorf("status %s not found: %w", status.ID, pkg.ErrNotFound)
+	}
+	current := statuses[index]
+	if status.Position < 0 || status.Position >= len(statuses) {
+		return models.Status{}, fmt.Errorf("status position must be between 0 and %d: %w", len(statuses)-1, pkg.ErrBadRequest)
+	}
+
+	if err = s.repo.Update(ctx, status); err != nil {
+		return models.Status{}, err
+	}
+	if status.Position != current.Position {
+		if err = s.repo.Move(ctx, status.ListID, status.ID, current.Position, status.Position); err != nil {
+			return models.Status{}, err
+		}
+	}
+	if !sameIDs(status.AllowedNext, current.AllowedNext) {
+		if err = s.repo.SetTransitions(ctx, status.ListID, status.ID, status.AllowedNext); err != nil {
+			return models.Status{}, err
+		}
+	}
+	return s.repo.Get(ctx, status.ListID, status.ID)
+}
+
+// DeleteStatus refuses to delete the last status of a list because every todo needs one.
+func (s *service) DeleteStatus(ctx context.Context, listID, id string) error {
+	log.C(ctx).Info("deleting status service")
+	statuses, err := s.repo.GetAllByListID(ctx, listID)
+	if err != nil {
+		return err
+	}
+	if len(statuses) == 1 && statuses[0].ID == id {
+		return fmt.Errorf("the last status of a list cannot be deleted: %w", pkg.ErrBadRequest)
+	}
+	return s.repo.Delete(ctx, listID, id)
+}
+
+// normalizeStatus trims the name and validates it.
+func normalizeStatus(status models.Status) (models.Status, error) {
+	status.Name = strings.TrimSpace(status.Name)
+	if status.Name == "" {
+		return models.Status{}, fmt.Errorf("status name cannot be empty: %w", pkg.ErrBadRequest)
+	}
+	if utf8.RuneCountInString(status.Name) > maxNameLength {
+		return models.Status{}, fmt.Errorf("status name cannot be longer than %d characters: %w", maxNameLength, pkg.ErrBadRequest)
+	}
+	return status, nil
+}
+
+// validateAllowedNext checks the format of the transitions; the repository checks that they belong to the list.
+func validateAllowedNext(status models.Status) error {
+	seen := make(map[string]struct{}, len(status.AllowedNext))
+	for _, id := range status.AllowedNext {
+		if err := pkg.ValidateUUID(id); err != nil {
+			return fmt.Errorf("invalid allowed status id %q: %w", id, pkg.ErrBadRequest)
+		}
+		if id == status.ID {
+			return fmt.Errorf("a status cannot transition to itself: %w", pkg.ErrBadRequest)
+		}
+		if _, ok := seen[id]; ok {
+			return fmt.Errorf("allowed status %s is listed twice: %w", id, pkg.ErrBadRequest)
+		}
+		seen[id] = struct{}{}
+	}
+	return nil
+}
+
+func sameIDs(a, b []string) bool {
+	a, b = slices.Clone(a), slices.Clone(b)
+	slices.Sort(a)
+	slices.Sort(b)
+	return slices.Equal(a, b)
+}
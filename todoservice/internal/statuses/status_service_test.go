@@ -0,0 +1,237 @@
+package statuses_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+const (
+	todoStatusID     = "5f3c9c3e-6d8a-4d8e-9a51-0b7a6f1c2d01"
+	progressStatusID = "5f3c9c3e-6d8a-4d8e-9a51-0b7a6f1c2d02"
+	doneStatusID     = "5f3c9c3e-6d8a-4d8e-9a51-0b7a6f1c2d03"
+)
+
+func TestServiceCreateStatus(t *testing.T) {
+	err := errors.New("error")
+	ctx := context.Background()
+	created := models.Status{ID: progressStatusID, ListID: "list1", Name: "In Progress", Position: 1, AllowedNext: []string{doneStatusID}}
+
+	tests := []struct {
+		name          string
+		input         models.Status
+		uuidService   func() *automock.UUIDService
+		repo          func() *automock.StatusRepository
+		expected      models.Status
+		expectedError error
+	}{
+		{
+			name:  "Create status with transitions",
+			input: models.Status{ListID: "list1", Name: "  In Progress  ", AllowedNext: []string{doneStatusID}},
+			uuidService: func() *automock.UUIDService {
+				uuidService := &automock.UUIDService{}
+				uuidService.EXPECT().Generate().Return(progressStatusID).Once()
+				return uuidService
+			},
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().Create(ctx, models.Status{ID: progressStatusID, ListID: "list1", Name: "In Progress", AllowedNext: []string{doneStatusID}}).
+					Return(progressStatusID, nil).Once()
+				repo.EXPECT().SetTransitions(ctx, "list1", progressStatusID, []string{doneStatusID}).Return(nil).Once()
+				repo.EXPECT().Get(ctx, "list1", progressStatusID).Return(created, nil).Once()
+				return repo
+			},
+			expected: created,
+		},
+		{
+			name:  "Error when the name is too long",
+			input: models.Status{ListID: "list1", Name: strings.Repeat("a", 65)},
+			uuidService: func() *automock.UUIDService {
+				return &automock.UUIDService{}
+			},
+			repo: func() *automock.StatusRepository {
+				return &automock.StatusRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+		{
+			name:  "Error when an allowed status is not a uuid",
+			input: models.Status{ListID: "list1", Name: "Blocked", AllowedNext: []string{"done"}},
+			uuidService: func() *automock.UUIDService {
+				uuidService := &automock.UUIDService{}
+				uuidService.EXPECT().Generate().Return(progressStatusID).Once()
+				return uuidService
+			},
+			repo: func() *automock.StatusRepository {
+				return &automock.StatusRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+		{
+			name:  "Error when repo create fails",
+			input: models.Status{ListID: "list1", Name: "Blocked"},
+			uuidService: func() *automock.UUIDService {
+				uuidService := &automock.UUIDService{}
+				uuidService.EXPECT().Generate().Return(progressStatusID).Once()
+				return uuidService
+			},
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().Create(ctx, models.Status{ID: progressStatusID, ListID: "list1", Name: "Blocked"}).Return("", err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uuidService := tt.uuidService()
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, uuidService, repo)
+
+			svc := statuses.NewService(repo, uuidService)
+			status, err := svc.CreateStatus(ctx, tt.input)
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, status)
+			}
+		})
+	}
+}
+
+func TestServiceUpdateStatus(t *testing.T) {
+	ctx := context.Background()
+	board := []models.Status{
+		{ID: todoStatusID, ListID: "list1", Name: "To Do", Position: 0, AllowedNext: []string{}},
+		{ID: progressStatusID, ListID: "list1", Name: "In Progress", Position: 1, AllowedNext: []string{doneStatusID}},
+		{ID: doneStatusID, ListID: "list1", Name: "Done", Position: 2, Done: true, AllowedNext: []string{}},
+	}
+
+	tests := []struct {
+		name          string
+		input         models.Status
+		repo          func() *automock.StatusRepository
+		expectedError error
+	}{
+		{
+			name:  "Rename status",
+			input: models.Status{ID: progressStatusID, ListID: "list1", Name: "Doing", Position: 1, AllowedNext: []string{doneStatusID}},
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().GetAllByListID(ctx, "list1").Return(board, nil).Once()
+				repo.EXPECT().Update(ctx, mock.AnythingOfType("models.Status")).Return(nil).Once()
+				repo.EXPECT().Get(ctx, "list1", progressStatusID).Return(board[1], nil).Once()
+				return repo
+			},
+		},
+		{
+			name:  "Move status and replace transitions",
+			input: models.Status{ID: progressStatusID, ListID: "list1", Name: "In Progress", Position: 0, AllowedNext: []string{todoStatusID, doneStatusID}},
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().GetAllByListID(ctx, "list1").Return(board, nil).Once()
+				repo.EXPECT().Update(ctx, mock.AnythingOfType("models.Status")).Return(nil).Once()
+				repo.EXPECT().Move(ctx, "list1", progressStatusID, 1, 0).Return(nil).Once()
+				repo.EXPECT().SetTransitions(ctx, "list1", progressStatusID, []string{todoStatusID, doneStatusID}).Return(nil).Once()
+				repo.EXPECT().Get(ctx, "list1", progressStatusID).Return(board[1], nil).Once()
+				return repo
+			},
+		},
+		{
+			name:  "Error when the position is out of range",
+			input: models.Status{ID: progressStatusID, ListID: "list1", Name: "In Progress", Position: 3},
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().GetAllByListID(ctx, "list1").Return(board, nil).Once()
+				return repo
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+		{
+			name:  "Error when the status allows itself",
+			input: models.Status{ID: progressStatusID, ListID: "list1", Name: "In Progress", Position: 1, AllowedNext: []string{progressStatusID}},
+			repo: func() *automock.StatusRepository {
+				return &automock.StatusRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+		{
+			name:  "Error when the status is not in the list",
+			input: models.Status{ID: "other", ListID: "list1", Name: "Other"},
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().GetAllByListID(ctx, "list1").Return(board, nil).Once()
+				return repo
+			},
+			expectedError: pkg.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer repo.AssertExpectations(t)
+
+			svc := statuses.NewService(repo, &automock.UUIDService{})
+			_, err := svc.UpdateStatus(ctx, tt.input)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestServiceDeleteStatus(t *testing.T) {
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		repo          func() *automock.StatusRepository
+		expectedError error
+	}{
+		{
+			name: "Delete status",
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().GetAllByListID(ctx, "list1").Return([]models.Status{{ID: todoStatusID}, {ID: doneStatusID}}, nil).Once()
+				repo.EXPECT().Delete(ctx, "list1", todoStatusID).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			name: "Error when deleting the last status",
+			repo: func() *automock.StatusRepository {
+				repo := &automock.StatusRepository{}
+				repo.EXPECT().GetAllByListID(ctx, "list1").Return([]models.Status{{ID: todoStatusID}}, nil).Once()
+				return repo
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer repo.AssertExpectations(t)
+
+			svc := statuses.NewService(repo, &automock.UUIDService{})
+			err := svc.DeleteStatus(ctx, "list1", todoStatusID)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,60 @@
+package tags
+
+import (
+	"database/sql"
+	"encoding/json"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+const (
+	// TodoTagsColumn selects the tags of the current row of todos as a JSON array, see FromJSON.
+	TodoTagsColumn = `COALESCE((
+			SELECT json_agg(json_build_object('id', tg.id, 'list_id', tg.list_id, 'name', tg.name, 'color', tg.color) ORDER BY tg.name)
+			FROM todo_tags tt JOIN tags tg ON tg.id = tt.tag_id
+			WHERE tt.todo_id = todos.id
+		), '[]') AS tags`
+
+	// ListTagsColumn selects the tags defined in the current row of lists as a JSON array, see FromJSON.
+	ListTagsColumn = `COALESCE((
+			SELECT json_agg(json_build_object('id', tg.id, 'list_id', tg.list_id, 'name', tg.name, 'color', tg.color) ORDER BY tg.name)
+			FROM tags tg
+			WHERE tg.list_id = lists.id
+		), '[]') AS tags`
+)
+
+type Converter struct{}
+
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) ConvertTagToModel(entity Entity) models.Tag {
+	return models.Tag{
+		ID:     entity.ID,
+		ListID: entity.ListID,
+		Name:   entity.Name,
+		Color:  entity.Color,
+	}
+}
+
+func (c *Converter) ConvertTagToEntity(tag models.Tag) Entity {
+	return Entity{
+		ID:     tag.ID,
+		ListID: tag.ListID,
+		Name:   tag.Name,
+		Color:  tag.Color,
+	}
+}
+
+// FromJSON decodes a column selected with TodoTagsColumn or ListTagsColumn. It never returns nil
+// so that entities without tags are encoded with an empty array.
+func FromJSON(column sql.NullString) []models.Tag {
+	result := make([]models.Tag, 0)
+	if !column.Valid {
+		return result
+	}
+	if err := json.Unmarshal([]byte(column.String), &result); err != nil {
+		return make([]models.Tag, 0)
+	}
+	return result
+}
@@ -0,0 +1,47 @@
+package tags_test
+
+import (
+	"database/sql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/tags"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"testing"
+)
+
+func TestFromJSON(t *testing.T) {
+	tests := []struct {
+		name     string
+		column   sql.NullString
+		expected []models.Tag
+	}{
+		{
+			name:   "Tags",
+			column: sql.NullString{String: `[{"id":"1","list_id":"list1","name":"work","color":"#9e9e9e"}]`, Valid: true},
+			expected: []models.Tag{
+				{ID: "1", ListID: "list1", Name: "work", Color: "#9e9e9e"},
+			},
+		},
+		{
+			name:     "No tags",
+			column:   sql.NullString{String: `[]`, Valid: true},
+			expected: []models.Tag{},
+		},
+		{
+			name:     "Null column",
+			column:   sql.NullString{},
+			expected: []models.Tag{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.expected, tags.FromJSON(tt.column))
+		})
+	}
+}
+
+func TestConvertTagToEntity(t *testing.T) {
+	converter := tags.NewConverter()
+	tag := models.Tag{ID: "1", ListID: "list1", Name: "work", Color: "#9e9e9e"}
+
+	assert.Equal(t, tag, converter.ConvertTagToModel(converter.ConvertTagToEntity(tag)))
+}
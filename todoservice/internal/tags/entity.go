@@ -0,0 +1,12 @@
+package tags
+
+import "time"
+
+type Entity struct {
+	ID        string    `db:"id"`
+	ListID    string    `db:"list_id"`
+	Name      string    `db:"name"`
+	Color     string    `db:"color"`
+	CreatedAt time.Time `db:"created_at"`
+	UpdatedAt time.Time `db:"updated_at"`
+}
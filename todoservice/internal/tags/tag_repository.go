@@ -0,0 +1,159 @@
+package tags
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+//go:generate mockery --name=TagRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TagRepository interface {
+	Create(ctx context.Context, tag models.Tag) (string, error)
+	Get(ctx context.Context, listID string, id string) (models.Tag, error)
+	GetAllByListID(ctx context.Context, listID string) ([]models.Tag, error)
+	Update(ctx context.Context, tag models.Tag) error
+	Delete(ctx context.Context, listID string, id string) error
+}
+
+type SQLXTagRepository struct {
+	converter *Converter
+}
+
+var _ TagRepository = &SQLXTagRepository{}
+
+func NewSQLXTagRepository() TagRepository {
+	return &SQLXTagRepository{converter: NewConverter()}
+}
+
+func (r *SQLXTagRepository) Create(ctx context.Context, tag models.Tag) (string, error) {
+	log.C(ctx).Info("creating tag repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return "", err
+	}
+
+	entity := r.converter.ConvertTagToEntity(tag)
+	query := `
+		INSERT INTO tags (id, list_id, name, color)
+		VALUES ($1, $2, $3, $4)
+		RETURNING id
+	`
+
+	var id string
+	err = tx.QueryRowContext(ctx, query, entity.ID, entity.ListID, entity.Name, entity.Color).Scan(&id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create tag: %v", err)
+		return "", fmt.Errorf("failed to create tag: %w", err)
+	}
+	log.C(ctx).Debugf("created tag with ID: %v", id)
+	return id, nil
+}
+
+func (r *SQLXTagRepository) Get(ctx context.Context, listID string, id string) (models.Tag, error) {
+	log.C(ctx).Info("getting tag repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return models.Tag{}, err
+	}
+
+	query := `
+		SELECT id, list_id, name, color, created_at, updated_at
+		FROM tags
+		WHERE list_id = $1 AND id = $2
+	`
+	var entity Entity
+	err = tx.GetContext(ctx, &entity, query, listID, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get tag: %v", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Tag{}, fmt.Errorf("tag not found: %w", err)
+		}
+		return models.Tag{}, fmt.Errorf("failed to get tag: %w", err)
+	}
+	return r.converter.ConvertTagToModel(entity), nil
+}
+
+func (r *SQLXTagRepository) GetAllByListID(ctx context.Context, listID string) ([]models.Tag, error) {
+	log.C(ctx).Info("getting all tags for a list repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	query := `
+		SELECT id, list_id, name, color, created_at, updated_at
+		FROM tags
+		WHERE list_id = $1
+		ORDER BY name
+	`
+	var entities []Entity
+	err = tx.SelectContext(ctx, &entities, query, listID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get tags: %v", err)
+		return nil, fmt.Errorf("failed to get tags: %w", err)
+	}
+
+	result := make([]models.Tag, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, r.converter.ConvertTagToModel(entity))
+	}
+	return result, nil
+}
+
+// Update renames or recolors the tag. Todos reference tags by id, so the change is visible on every tagged todo.
+func (r *SQLXTagRepository) Update(ctx context.Context, tag models.Tag) error {
+	log.C(ctx).Info("updating tag repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	entity := r.converter.ConvertTagToEntity(tag)
+	query := `
+		UPDATE tags
+		SET name = $1, color = $2
+		WHERE list_id = $3 AND id = $4
+	`
+	result, err := tx.ExecContext(ctx, query, entity.Name, entity.Color, entity.ListID, entity.ID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to update tag: %v", err)
+		return fmt.Errorf("failed to update tag: %w", err)
+	}
+	return requireAffected(result, entity.ID)
+}
+
+func (r *SQLXTagRepository) Delete(ctx context.Context, listID string, id string) error {
+	log.C(ctx).Info("deleting tag repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM tags WHERE list_id = $1 AND id = $2`, listID, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to delete tag: %v", err)
+		return fmt.Errorf("failed to delete tag: %w", err)
+	}
+	return requireAffected(result, id)
+}
+
+func requireAffected(result sql.Result, id string) error {
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("tag %s not found: %w", id, pkg.ErrNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,152 @@
+package tags_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/tags"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+	"time"
+)
+
+func TestSQLXTagRepositoryCreate(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := tags.NewSQLXTagRepository()
+	tag := models.Tag{ID: "1", ListID: "list1", Name: "work", Color: "#9e9e9e"}
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedID    string
+		expectedError string
+	}{
+		{
+			name: "Successful creation",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery(`^INSERT INTO tags`).WithArgs("1", "list1", "work", "#9e9e9e").
+					WillReturnRows(sqlxmock.NewRows([]string{"id"}).AddRow("1"))
+				mockDB.ExpectCommit()
+			},
+			expectedID: "1",
+		},
+		{
+			name: "Failed creation due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery(`^INSERT INTO tags`).WithArgs("1", "list1", "work", "#9e9e9e").WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: "failed to create tag: db error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			id, err := repo.Create(ctx, tag)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				require.NoError(t, tx.Rollback())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedID, id)
+				require.NoError(t, tx.Commit())
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSQLXTagRepositoryGetAllByListID(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := tags.NewSQLXTagRepository()
+
+	mockDB.ExpectBegin()
+	mockDB.ExpectQuery("SELECT id, list_id, name, color, created_at, updated_at FROM tags").WithArgs("list1").
+		WillReturnRows(sqlxmock.NewRows([]string{"id", "list_id", "name", "color", "created_at", "updated_at"}).
+			AddRow("1", "list1", "home", "#00ff00", time.Time{}, time.Time{}).
+			AddRow("2", "list1", "work", "#9e9e9e", time.Time{}, time.Time{}))
+	mockDB.ExpectCommit()
+
+	ctx := context.Background()
+	tx, err := database.BeginTxx(ctx, nil)
+	require.NoError(t, err)
+
+	result, err := repo.GetAllByListID(db.SaveToContext(ctx, tx), "list1")
+	require.NoError(t, err)
+	assert.Equal(t, []models.Tag{
+		{ID: "1", ListID: "list1", Name: "home", Color: "#00ff00"},
+		{ID: "2", ListID: "list1", Name: "work", Color: "#9e9e9e"},
+	}, result)
+	require.NoError(t, tx.Commit())
+	require.NoError(t, mockDB.ExpectationsWereMet())
+}
+
+func TestSQLXTagRepositoryUpdate(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := tags.NewSQLXTagRepository()
+	tag := models.Tag{ID: "1", ListID: "list1", Name: "office", Color: "#9e9e9e"}
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedError string
+	}{
+		{
+			name: "Successful rename",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^UPDATE tags`).WithArgs("office", "#9e9e9e", "list1", "1").WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "Failed because the tag is not in the list",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^UPDATE tags`).WithArgs("office", "#9e9e9e", "list1", "1").WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectRollback()
+			},
+			expectedError: "tag 1 not found: not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			err = repo.Update(ctx, tag)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				require.NoError(t, tx.Rollback())
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, tx.Commit())
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
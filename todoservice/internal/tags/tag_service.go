@@ -0,0 +1,103 @@
+package tags
+
+import (
+	"context"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"regexp"
+	"strings"
+	"unicode/utf8"
+)
+
+const (
+	DefaultColor  = "#9e9e9e"
+	maxNameLength = 64
+)
+
+var colorPattern = regexp.MustCompile(`^#[0-9a-fA-F]{6}$`)
+
+//go:generate mockery --name=TagService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TagService interface {
+	CreateTag(ctx context.Context, tag models.Tag) (models.Tag, error)
+	GetTag(ctx context.Context, listID, id string) (models.Tag, error)
+	ListTagsByListID(ctx context.Context, listID string) ([]models.Tag, error)
+	UpdateTag(ctx context.Context, tag models.Tag) (models.Tag, error)
+	DeleteTag(ctx context.Context, listID, id string) error
+}
+
+//go:generate mockery --name=UUIDService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type UUIDService interface {
+	Generate() string
+}
+
+var _ TagService = &service{}
+
+type service struct {
+	repo        TagRepository
+	uuidService UUIDService
+}
+
+func NewService(repo TagRepository, uuidService UUIDService) TagService {
+	return &service{repo: repo, uuidService: uuidService}
+}
+
+func (s *service) CreateTag(ctx context.Context, tag models.Tag) (models.Tag, error) {
+	log.C(ctx).Info("creating tag service")
+	tag, err := normalizeTag(tag)
+	if err != nil {
+		return models.Tag{}, err
+	}
+
+	tag.ID = s.uuidService.Generate()
+	if _, err = s.repo.Create(ctx, tag); err != nil {
+		return models.Tag{}, err
+	}
+	return tag, nil
+}
+
+func (s *service) GetTag(ctx context.Context, listID, id string) (models.Tag, error) {
+	log.C(ctx).Info("getting tag service")
+	return s.repo.Get(ctx, listID, id)
+}
+
+func (s *service) ListTagsByListID(ctx context.Context, listID string) ([]models.Tag, error) {
+	log.C(ctx).Info("listing tags by list id service")
+	return s.repo.GetAllByListID(ctx, listID)
+}
+
+func (s *service) UpdateTag(ctx context.Context, tag models.Tag) (models.Tag, error) {
+	log.C(ctx).Info("updating tag service")
+	tag, err := normalizeTag(tag)
+	if err != nil {
+		return models.Tag{}, err
+	}
+	if err = s.repo.Update(ctx, tag); err != nil {
+		return models.Tag{}, err
+	}
+	return tag, nil
+}
+
+func (s *service) DeleteTag(ctx context.Context, listID, id string) error {
+	log.C(ctx).Info("deleting tag service")
+	return s.repo.Delete(ctx, listID, id)
+}
+
+// normalizeTag trims the name, defaults the color and validates both.
+func normalizeTag(tag models.Tag) (models.Tag, error) {
+	tag.Name = strings.TrimSpace(tag.Name)
+	if tag.Name == "" {
+		return models.Tag{}, fmt.Errorf("tag name cannot be empty: %w", pkg.ErrBadRequest)
+	}
+	if utf8.RuneCountInString(tag.Name) > maxNameLength {
+		return models.Tag{}, fmt.Errorf("tag name cannot be longer than %d characters: %w", maxNameLength, pkg.ErrBadRequest)
+	}
+	if tag.Color == "" {
+		tag.Color = DefaultColor
+	}
+	if !colorPattern.MatchString(tag.Color) {
+		return models.Tag{}, fmt.Errorf("tag color %q is not a #rrggbb color: %w", tag.Color, pkg.ErrBadRequest)
+	}
+	return tag, nil
+}
@@ -0,0 +1,165 @@
+package tags_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/tags"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/tags/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"strings"
+	"testing"
+)
+
+func TestServiceCreateTag(t *testing.T) {
+	id := "1"
+	err := errors.New("error")
+	ctx := context.Background()
+
+	tests := []struct {
+		name          string
+		input         models.Tag
+		uuidService   func() *automock.UUIDService
+		repo          func() *automock.TagRepository
+		expected      models.Tag
+		expectedError error
+	}{
+		{
+			name:  "Create tag with the default color",
+			input: models.Tag{ListID: "list1", Name: "  work  "},
+			uuidService: func() *automock.UUIDService {
+				uuidService := &automock.UUIDService{}
+				uuidService.EXPECT().Generate().Return(id).Once()
+				return uuidService
+			},
+			repo: func() *automock.TagRepository {
+				repo := &automock.TagRepository{}
+				repo.EXPECT().Create(ctx, models.Tag{ID: id, ListID: "list1", Name: "work", Color: tags.DefaultColor}).Return(id, nil).Once()
+				return repo
+			},
+			expected: models.Tag{ID: id, ListID: "list1", Name: "work", Color: tags.DefaultColor},
+		},
+		{
+			name:  "Error when the name is empty",
+			input: models.Tag{ListID: "list1", Name: "   "},
+			uuidService: func() *automock.UUIDService {
+				return &automock.UUIDService{}
+			},
+			repo: func() *automock.TagRepository {
+				return &automock.TagRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+		{
+			name:  "Error when the name is too long",
+			input: models.Tag{ListID: "list1", Name: strings.Repeat("a", 65)},
+			uuidService: func() *automock.UUIDService {
+				return &automock.UUIDService{}
+			},
+			repo: func() *automock.TagRepository {
+				return &automock.TagRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+		{
+			name:  "Error when the color is invalid",
+			input: models.Tag{ListID: "list1", Name: "work", Color: "red"},
+			uuidService: func() *automock.UUIDService {
+				return &automock.UUIDService{}
+			},
+			repo: func() *automock.TagRepository {
+				return &automock.TagRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+		{
+			name:  "Error when repo create fails",
+			input: models.Tag{ListID: "list1", Name: "work", Color: "#FF0000"},
+			uuidService: func() *automock.UUIDService {
+				uuidService := &automock.UUIDService{}
+				uuidService.EXPECT().Generate().Return(id).Once()
+				return uuidService
+			},
+			repo: func() *automock.TagRepository {
+				repo := &automock.TagRepository{}
+				repo.EXPECT().Create(ctx, models.Tag{ID: id, ListID: "list1", Name: "work", Color: "#FF0000"}).Return("", err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			uuidService := tt.uuidService()
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, uuidService, repo)
+
+			svc := tags.NewService(repo, uuidService)
+			tag, err := svc.CreateTag(ctx, tt.input)
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, tag)
+			}
+		})
+	}
+}
+
+func TestServiceUpdateTag(t *testing.T) {
+	ctx := context.Background()
+	tag := models.Tag{ID: "1", ListID: "list1", Name: "office", Color: "#00ff00"}
+
+	tests := []struct {
+		name          string
+		input         models.Tag
+		repo          func() *automock.TagRepository
+		expectedError error
+	}{
+		{
+			name:  "Rename tag",
+			input: tag,
+			repo: func() *automock.TagRepository {
+				repo := &automock.TagRepository{}
+				repo.EXPECT().Update(ctx, tag).Return(nil).Once()
+				return repo
+			},
+		},
+		{
+			name:  "Error when the tag does not exist",
+			input: tag,
+			repo: func() *automock.TagRepository {
+				repo := &automock.TagRepository{}
+				repo.EXPECT().Update(ctx, tag).Return(pkg.ErrNotFound).Once()
+				return repo
+			},
+			expectedError: pkg.ErrNotFound,
+		},
+		{
+			name:  "Error when the name is empty",
+			input: models.Tag{ID: "1", ListID: "list1", Color: "#00ff00"},
+			repo: func() *automock.TagRepository {
+				return &automock.TagRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer repo.AssertExpectations(t)
+
+			svc := tags.NewService(repo, &automock.UUIDService{})
+			_, err := svc.UpdateTag(ctx, tt.input)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
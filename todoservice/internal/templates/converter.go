@@ -0,0 +1,42 @@
+package templates
+
+import (
+	"encoding/json"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+type Converter struct{}
+
+func NewConverter() *Converter {
+	return &Converter{}
+}
+
+func (c *Converter) ConvertTemplateToModel(entity Entity) (models.Template, error) {
+	var blueprint models.Blueprint
+	if err := json.Unmarshal(entity.Blueprint, &blueprint); err != nil {
+		return models.Template{}, err
+	}
+	return models.Template{
+		ID:          entity.ID,
+		OwnerID:     entity.OwnerID,
+		Name:        entity.Name,
+		Description: entity.Description,
+		Blueprint:   blueprint,
+		CreatedAt:   entity.CreatedAt,
+	}, nil
+}
+
+func (c *Converter) ConvertTemplateToEntity(template models.Template) (Entity, error) {
+	blueprint, err := json.Marshal(template.Blueprint)
+	if err != nil {
+		return Entity{}, err
+	}
+	return Entity{
+		ID:          template.ID,
+		OwnerID:     template.OwnerID,
+		Name:        template.Name,
+		Description: template.Description,
+		Blueprint:   blueprint,
+		CreatedAt:   template.CreatedAt,
+	}, nil
+}
@@ -0,0 +1,14 @@
+package templates
+
+import (
+	"time"
+)
+
+type Entity struct {
+	ID          string    `db:"id"`
+	OwnerID     string    `db:"owner_id"`
+	Name        string    `db:"name"`
+	Description string    `db:"description"`
+	Blueprint   []byte    `db:"blueprint"`
+	CreatedAt   time.Time `db:"created_at"`
+}
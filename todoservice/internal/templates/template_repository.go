@@ -0,0 +1,148 @@
+package templates
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+)
+
+//go:generate mockery --name=TemplateRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TemplateRepository interface {
+	Create(ctx context.Context, template models.Template) (string, error)
+	Get(ctx context.Context, ownerID string, id string) (models.Template, error)
+	GetAllByOwnerID(ctx context.Context, ownerID string) ([]models.Template, error)
+	Delete(ctx context.Context, ownerID string, id string) error
+}
+
+type SQLXTemplateRepository struct {
+	converter *Converter
+}
+
+var _ TemplateRepository = &SQLXTemplateRepository{}
+
+func NewSQLXTemplateRepository() TemplateRepository {
+	return &SQLXTemplateRepository{converter: NewConverter()}
+}
+
+func (r *SQLXTemplateRepository) Create(ctx context.Context, template models.Template) (string, error) {
+	log.C(ctx).Info("creating template repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return "", err
+	}
+
+	entity, err := r.converter.ConvertTemplateToEntity(template)
+	if err != nil {
+		log.C(ctx).Errorf("failed to encode template blueprint: %v", err)
+		return "", fmt.Errorf("failed to encode template blueprint: %w", err)
+	}
+	query := `
+		INSERT INTO list_templates (id, owner_id, name, description, blueprint, created_at)
+		VALUES ($1, $2, $3, $4, $5, $6)
+		RETURNING id
+	`
+
+	var id string
+	err = tx.QueryRowContext(ctx, query, entity.ID, entity.OwnerID, entity.Name, entity.Description, entity.Blueprint, entity.CreatedAt).Scan(&id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to create template: %v", err)
+		return "", fmt.Errorf("failed to create template: %w", err)
+	}
+	log.C(ctx).Debugf("created template with ID: %v", id)
+	return id, nil
+}
+
+// Get returns the template only to its owner, the templates of other users are reported as not found.
+func (r *SQLXTemplateRepository) Get(ctx context.Context, ownerID string, id string) (models.Template, error) {
+	log.C(ctx).Info("getting template repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return models.Template{}, err
+	}
+
+	query := `
+		SELECT id, owner_id, name, description, blueprint, created_at
+		FROM list_templates
+		WHERE owner_id = $1 AND id = $2
+	`
+	var entity Entity
+	err = tx.GetContext(ctx, &entity, query, ownerID, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get template: %v", err)
+		if errors.Is(err, sql.ErrNoRows) {
+			return models.Template{}, fmt.Errorf("template %s not found: %w", id, pkg.ErrNotFound)
+		}
+		return models.Template{}, fmt.Errorf("failed to get template: %w", err)
+	}
+
+	template, err := r.converter.ConvertTemplateToModel(entity)
+	if err != nil {
+		log.C(ctx).Errorf("failed to decode template blueprint: %v", err)
+		return models.Template{}, fmt.Errorf("failed to decode template blueprint: %w", err)
+	}
+	return template, nil
+}
+
+// GetAllByOwnerID returns the templates of the user, newest first.
+func (r *SQLXTemplateRepository) GetAllByOwnerID(ctx context.Context, ownerID string) ([]models.Template, error) {
+	log.C(ctx).Info("getting all templates for a user repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	query := `
+		SELECT id, owner_id, name, description, blueprint, created_at
+		FROM list_templates
+		WHERE owner_id = $1
+		ORDER BY created_at DESC, id
+	`
+	var entities []Entity
+	err = tx.SelectContext(ctx, &entities, query, ownerID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get templates: %v", err)
+		return nil, fmt.Errorf("failed to get templates: %w", err)
+	}
+
+	result := make([]models.Template, 0, len(entities))
+	for _, entity := range entities {
+		template, err := r.converter.ConvertTemplateToModel(entity)
+		if err != nil {
+			log.C(ctx).Errorf("failed to decode template blueprint: %v", err)
+			return nil, fmt.Errorf("failed to decode template blueprint: %w", err)
+		}
+		result = append(result, template)
+	}
+	return result, nil
+}
+
+func (r *SQLXTemplateRepository) Delete(ctx context.Context, ownerID string, id string) error {
+	log.C(ctx).Info("deleting template repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM list_templates WHERE owner_id = $1 AND id = $2`, ownerID, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to delete template: %v", err)
+		return fmt.Errorf("failed to delete template: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("template %s not found: %w", id, pkg.ErrNotFound)
+	}
+	return nil
+}
@@ -0,0 +1,182 @@
+package templates_test
+
+import (
+	"context"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/templates"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	sqlxmock "github.com/zhashkevych/go-sqlxmock"
+	"testing"
+	"time"
+)
+
+func TestSQLXTemplateRepositoryCreate(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := templates.NewSQLXTemplateRepository()
+	template := models.Template{ID: "1", OwnerID: "user1", Name: "Sprint", Blueprint: models.Blueprint{
+		Statuses: []models.BlueprintStatus{{Name: "Open"}},
+	}}
+	blueprint := []byte(`{"statuses":[{"name":"Open","done":false,"allowed_next":null}],"tags":null,"todos":null}`)
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedID    string
+		expectedError string
+	}{
+		{
+			name: "Successful creation",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery(`^INSERT INTO list_templates`).WithArgs("1", "user1", "Sprint", "", blueprint, time.Time{}).
+					WillReturnRows(sqlxmock.NewRows([]string{"id"}).AddRow("1"))
+				mockDB.ExpectCommit()
+			},
+			expectedID: "1",
+		},
+		{
+			name: "Failed creation due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery(`^INSERT INTO list_templates`).WithArgs("1", "user1", "Sprint", "", blueprint, time.Time{}).
+					WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: "failed to create template: db error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			id, err := repo.Create(db.SaveToContext(ctx, tx), template)
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				require.NoError(t, tx.Rollback())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedID, id)
+				require.NoError(t, tx.Commit())
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSQLXTemplateRepositoryGet(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := templates.NewSQLXTemplateRepository()
+	columns := []string{"id", "owner_id", "name", "description", "blueprint", "created_at"}
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expected      models.Template
+		expectedError error
+	}{
+		{
+			name: "Successful get",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT id, owner_id, name, description, blueprint, created_at FROM list_templates").WithArgs("user1", "1").
+					WillReturnRows(sqlxmock.NewRows(columns).AddRow("1", "user1", "Sprint", "", []byte(`{"tags":[{"name":"urgent","color":"red"}]}`), time.Time{}))
+				mockDB.ExpectCommit()
+			},
+			expected: models.Template{ID: "1", OwnerID: "user1", Name: "Sprint", Blueprint: models.Blueprint{
+				Tags: []models.BlueprintTag{{Name: "urgent", Color: "red"}},
+			}},
+		},
+		{
+			name: "Not found when the template belongs to another user",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT id, owner_id, name, description, blueprint, created_at FROM list_templates").WithArgs("user1", "1").
+					WillReturnRows(sqlxmock.NewRows(columns))
+				mockDB.ExpectRollback()
+			},
+			expectedError: pkg.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			result, err := repo.Get(db.SaveToContext(ctx, tx), "user1", "1")
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				require.NoError(t, tx.Rollback())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expected, result)
+				require.NoError(t, tx.Commit())
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSQLXTemplateRepositoryDelete(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := templates.NewSQLXTemplateRepository()
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedError error
+	}{
+		{
+			name: "Successful deletion",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^DELETE FROM list_templates`).WithArgs("user1", "1").WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "Not found when nothing was deleted",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^DELETE FROM list_templates`).WithArgs("user1", "1").WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectRollback()
+			},
+			expectedError: pkg.ErrNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			err = repo.Delete(db.SaveToContext(ctx, tx), "user1", "1")
+			if tc.expectedError != nil {
+				assert.ErrorIs(t, err, tc.expectedError)
+				require.NoError(t, tx.Rollback())
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, tx.Commit())
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
@@ -0,0 +1,474 @@
+package templates
+
+import (
+	"context"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/lists"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/tags"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/todos"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"slices"
+	"strings"
+	"time"
+)
+
+const copySuffix = " (copy)"
+
+//go:generate mockery --name=TemplateService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TemplateService interface {
+	CloneList(ctx context.Context, userID, listID string, options models.CloneOptions) (models.List, error)
+	SaveTemplate(ctx context.Context, userID, listID, name, description string) (models.Template, error)
+	GetTemplate(ctx context.Context, userID, id string) (models.Template, error)
+	ListTemplates(ctx context.Context, userID string) ([]models.Template, error)
+	DeleteTemplate(ctx context.Context, userID, id string) error
+	CreateListFromTemplate(ctx context.Context, userID, id, name string, anchorDate *time.Time) (models.List, error)
+}
+
+//go:generate mockery --name=UUIDService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type UUIDService interface {
+	Generate() string
+}
+
+//go:generate mockery --name=TimeService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type TimeService interface {
+	Now() time.Time
+}
+
+var _ TemplateService = &service{}
+
+type service struct {
+	repo        TemplateRepository
+	listRepo    lists.ListRepository
+	statusRepo  statuses.StatusRepository
+	tagRepo     tags.TagRepository
+	todoRepo    todos.TodoRepository
+	uuidService UUIDService
+	timeService TimeService
+}
+
+func NewService(repo TemplateRepository, listRepo lists.ListRepository, statusRepo statuses.StatusRepository, tagRepo tags.TagRepository, todoRepo todos.TodoRepository, uuidService UUIDService, timeService TimeService) TemplateService {
+	return &service{
+		repo:        repo,
+		listRepo:    listRepo,
+		statusRepo:  statusRepo,
+		tagRepo:     tagRepo,
+		todoRepo:    todoRepo,
+		uuidService: uuidService,
+		timeService: timeService,
+	}
+}
+
+// CloneList copies the list with its statuses, tags, todos and dependencies into a new list owned by the user.
+// Collaborators of the source list are invited only when options.IncludeCollaborators is set, see collaborators.
+// Todos assigned to anyone but the user or an invited collaborator are left unassigned.
+func (s *service) CloneList(ctx context.Context, userID, listID string, options models.CloneOptions) (models.List, error) {
+	log.C(ctx).Info("cloning list service")
+	source, err := s.listRepo.Get(ctx, listID)
+	if err != nil {
+		return models.List{}, err
+	}
+	blueprint, err := s.snapshot(ctx, listID)
+	if err != nil {
+		return models.List{}, err
+	}
+
+	name := strings.TrimSpace(options.Name)
+	if name == "" {
+		name = source.Name + copySuffix
+	}
+	visibility := source.Visibility
+	if !options.IncludeCollaborators && visibility == constants.VisibilityShared {
+		visibility = constants.VisibilityPrivate
+	}
+	var invited []models.Access
+	if options.IncludeCollaborators {
+		if invited, err = s.collaborators(ctx, userID, listID); err != nil {
+			return models.List{}, err
+		}
+	}
+	for i, todo := range blueprint.Todos {
+		if todo.AssignedTo != nil && *todo.AssignedTo != userID && !slices.ContainsFunc(invited, func(access models.Access) bool {
+			return access.UserID == *todo.AssignedTo
+		}) {
+			blueprint.Todos[i].AssignedTo = nil
+		}
+	}
+
+	list, err := s.createList(ctx, userID, name, source.Description, visibility)
+	if err != nil {
+		return models.List{}, err
+	}
+	for _, access := range invited {
+		access.ListID = list.ID
+		if _, err = s.listRepo.CreateAccess(ctx, access); err != nil {
+			return models.List{}, err
+		}
+	}
+	if err = s.instantiate(ctx, list.ID, blueprint, options.ResetCompletion, options.AnchorDate); err != nil {
+		return models.List{}, err
+	}
+	return s.listRepo.Get(ctx, list.ID)
+}
+
+// SaveTemplate stores the content of the list as a template of the user. Assignees are not kept.
+func (s *service) SaveTemplate(ctx context.Context, userID, listID, name, description string) (models.Template, error) {
+	log.C(ctx).Info("saving template service")
+	name = strings.TrimSpace(name)
+	if name == "" {
+		return models.Template{}, fmt.Errorf("template name is required: %w", pkg.ErrBadRequest)
+	}
+
+	blueprint, err := s.snapshot(ctx, listID)
+	if err != nil {
+		return models.Template{}, err
+	}
+	for i := range blueprint.Todos {
+		blueprint.Todos[i].AssignedTo = nil
+	}
+
+	template := models.Template{
+		ID:          s.uuidService.Generate(),
+		OwnerID:     userID,
+		Name:        name,
+		Description: description,
+		Blueprint:   blueprint,
+		CreatedAt:   s.timeService.Now(),
+	}
+	if _, err = s.repo.Create(ctx, template); err != nil {
+		return models.Template{}, err
+	}
+	return template, nil
+}
+
+func (s *service) GetTemplate(ctx context.Context, userID, id string) (models.Template, error) {
+	log.C(ctx).Info("getting template service")
+	return s.repo.Get(ctx, userID, id)
+}
+
+func (s *service) ListTemplates(ctx context.Context, userID string) ([]models.Template, error) {
+	log.C(ctx).Info("listing templates service")
+	return s.repo.GetAllByOwnerID(ctx, userID)
+}
+
+func (s *service) DeleteTemplate(ctx context.Context, userID, id string) error {
+	log.C(ctx).Info("deleting template service")
+	return s.repo.Delete(ctx, userID, id)
+}
+
+// CreateListFromTemplate creates a private list of the user with the content of the template. All todos start
+// open and their dates are shifted so that the earliest of them falls on anchorDate, today when it is nil.
+// An empty name means the name of the template.
+func (s *service) CreateListFromTemplate(ctx context.Context, userID, id, name string, anchorDate *time.Time) (models.List, error) {
+	log.C(ctx).Info("creating list from template service")
+	template, err := s.repo.Get(ctx, userID, id)
+	if err != nil {
+		return models.List{}, err
+	}
+
+	name = strings.TrimSpace(name)
+	if name == "" {
+		name = template.Name
+	}
+	if anchorDate == nil {
+		today := s.timeService.Now().Truncate(24 * time.Hour)
+		anchorDate = &today
+	}
+
+	list, err := s.createList(ctx, userID, name, template.Description, constants.VisibilityPrivate)
+	if err != nil {
+		return models.List{}, err
+	}
+	if err = s.instantiate(ctx, list.ID, template.Blueprint, true, anchorDate); err != nil {
+		return models.List{}, err
+	}
+	return s.listRepo.Get(ctx, list.ID)
+}
+
+// snapshot reads the statuses, tags, todos and dependencies of the list into a blueprint.
+func (s *service) snapshot(ctx context.Context, listID string) (models.Blueprint, error) {
+	listStatuses, err := s.statusRepo.GetAllByListID(ctx, listID)
+	if err != nil {
+		return models.Blueprint{}, err
+	}
+	listTags, err := s.tagRepo.GetAllByListID(ctx, listID)
+	if err != nil {
+		return models.Blueprint{}, err
+	}
+	listTodos, err := s.todoRepo.GetAllByListIDs(ctx, []string{listID})
+	if err != nil {
+		return models.Blueprint{}, err
+	}
+	dependencies, err := s.todoRepo.GetDependenciesByListID(ctx, listID)
+	if err != nil {
+		return models.Blueprint{}, err
+	}
+
+	statusNames := make(map[string]string, len(listStatuses))
+	for _, status := range listStatuses {
+		statusNames[status.ID] = status.Name
+	}
+	blueprint := models.Blueprint{
+		Statuses: make([]models.BlueprintStatus, 0, len(listStatuses)),
+		Tags:     make([]models.BlueprintTag, 0, len(listTags)),
+		Todos:    make([]models.BlueprintTodo, 0, len(listTodos)),
+		Anchor:   earliestDate(listTodos),
+	}
+	for _, status := range listStatuses {
+		allowedNext := make([]string, 0, len(status.AllowedNext))
+		for _, id := range status.AllowedNext {
+			allowedNext = append(allowedNext, statusNames[id])
+		}
+		blueprint.Statuses = append(blueprint.Statuses, models.BlueprintStatus{Name: status.Name, Done: status.Done, AllowedNext: allowedNext})
+	}
+	for _, tag := range listTags {
+		blueprint.Tags = append(blueprint.Tags, models.BlueprintTag{Name: tag.Name, Color: tag.Color})
+	}
+
+	indexes := make(map[string]int, len(listTodos))
+	for i, todo := range listTodos {
+		indexes[todo.ID] = i
+		tagNames := make([]string, 0, len(todo.Tags))
+		for _, tag := range todo.Tags {
+			tagNames = append(tagNames, tag.Name)
+		}
+		blueprint.Todos = append(blueprint.Todos, models.BlueprintTodo{
+			Title:       todo.Title,
+			Description: todo.Description,
+			Priority:    todo.Priority,
+			Status:      statusNames[todo.StatusID],
+			Position:    todo.Position,
+			Tags:        tagNames,
+			BlockedBy:   []int{},
+			StartOffset: offset(blueprint.Anchor, todo.StartDate),
+			DueOffset:   offset(blueprint.Anchor, todo.DueDate),
+			AssignedTo:  todo.AssignedTo,
+		})
+	}
+	for _, dependency := range dependencies {
+		todo, ok := indexes[dependency.TodoID]
+		blocker, blockerOK := indexes[dependency.BlockedByID]
+		if ok && blockerOK {
+			blueprint.Todos[todo].BlockedBy = append(blueprint.Todos[todo].BlockedBy, blocker)
+		}
+	}
+	return blueprint, nil
+}
+
+// instantiate fills the freshly created list with the blueprint. The statuses of the blueprint replace the
+// default statuses of the list. With resetCompletion the todos of done statuses go to the first open status.
+// When anchorDate is set the dates of the todos are placed relative to it instead of the blueprint anchor.
+func (s *service) instantiate(ctx context.Context, listID string, blueprint models.Blueprint, resetCompletion bool, anchorDate *time.Time) error {
+	listStatuses, err := s.createStatuses(ctx, listID, blueprint.Statuses)
+	if err != nil {
+		return err
+	}
+
+	tagIDs := make(map[string]string, len(blueprint.Tags))
+	for _, tag := range blueprint.Tags {
+		id, err := s.tagRepo.Create(ctx, models.Tag{ID: s.uuidService.Generate(), ListID: listID, Name: tag.Name, Color: tag.Color})
+		if err != nil {
+			return err
+		}
+		tagIDs[tag.Name] = id
+	}
+
+	anchor := blueprint.Anchor
+	if anchorDate != nil {
+		anchor = anchorDate
+	}
+	ids := make([]string, 0, len(blueprint.Todos))
+	for _, item := range blueprint.Todos {
+		status := statusFor(listStatuses, item.Status, resetCompletion)
+		todo := models.Todo{
+			ID:          s.uuidService.Generate(),
+			ListID:      listID,
+			Title:       item.Title,
+			Description: item.Description,
+			Priority:    item.Priority,
+			StatusID:    status.ID,
+			Completed:   status.Done,
+			Position:    item.Position,
+			StartDate:   shift(anchor, item.StartOffset),
+			DueDate:     shift(anchor, item.DueOffset),
+			AssignedTo:  item.AssignedTo,
+			CreatedAt:   s.timeService.Now(),
+			UpdatedAt:   s.timeService.Now(),
+		}
+		if _, err = s.todoRepo.Create(ctx, todo); err != nil {
+			return err
+		}
+		ids = append(ids, todo.ID)
+
+		todoTagIDs := make([]string, 0, len(item.Tags))
+		for _, name := range item.Tags {
+			if id, ok := tagIDs[name]; ok {
+				todoTagIDs = append(todoTagIDs, id)
+			}
+		}
+		if len(todoTagIDs) > 0 {
+			if err = s.todoRepo.SetTags(ctx, todo.ID, todoTagIDs); err != nil {
+				return err
+			}
+		}
+	}
+
+	for i, item := range blueprint.Todos {
+		for _, blocker := range item.BlockedBy {
+			if blocker < 0 || blocker >= len(ids) || blocker == i {
+				continue
+			}
+			if err = s.todoRepo.AddDependency(ctx, ids[i], ids[blocker]); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// createStatuses replaces the default statuses of the list with the given ones and returns the statuses of the
+// list in order. The defaults are kept when there are no statuses to create.
+func (s *service) createStatuses(ctx context.Context, listID string, blueprint []models.BlueprintStatus) ([]models.Status, error) {
+	defaults, err := s.statusRepo.GetAllByListID(ctx, listID)
+	if err != nil {
+		return nil, err
+	}
+	if len(blueprint) == 0 {
+		return defaults, nil
+	}
+	for _, status := range defaults {
+		if err = s.statusRepo.Delete(ctx, listID, status.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	created := make([]models.Status, 0, len(blueprint))
+	statusIDs := make(map[string]string, len(blueprint))
+	for _, item := range blueprint {
+		status := models.Status{ID: s.uuidService.Generate(), ListID: listID, Name: item.Name, Done: item.Done}
+		if status.ID, err = s.statusRepo.Create(ctx, status); err != nil {
+			return nil, err
+		}
+		created = append(created, status)
+		statusIDs[item.Name] = status.ID
+	}
+	for i, item := range blueprint {
+		if len(item.AllowedNext) == 0 {
+			continue
+		}
+		toIDs := make([]string, 0, len(item.AllowedNext))
+		for _, name := range item.AllowedNext {
+			if id, ok := statusIDs[name]; ok {
+				toIDs = append(toIDs, id)
+			}
+		}
+		if err = s.statusRepo.SetTransitions(ctx, listID, created[i].ID, toIDs); err != nil {
+			return nil, err
+		}
+	}
+	return created, nil
+}
+
+func (s *service) createList(ctx context.Context, ownerID, name, description string, visibility constants.Visibility) (models.List, error) {
+	list := models.List{
+		ID:          s.uuidService.Generate(),
+		Name:        name,
+		Description: description,
+		OwnerID:     ownerID,
+		Visibility:  visibility,
+		CreatedAt:   s.timeService.Now(),
+		UpdatedAt:   s.timeService.Now(),
+	}
+	if _, err := s.listRepo.Create(ctx, list); err != nil {
+		return models.List{}, err
+	}
+	return list, nil
+}
+
+// collaborators returns the invitations to the clone for the members of the source list who accepted theirs,
+// other than the user. The owner and pending invitations are left out, and no role exceeds the one of the user,
+// who is capped at reader when not a member of the source list.
+func (s *service) collaborators(ctx context.Context, userID, sourceID string) ([]models.Access, error) {
+	accesses, err := s.listRepo.GetAccessesByListID(ctx, sourceID)
+	if err != nil {
+		return nil, err
+	}
+	limit := constants.Reader
+	for _, access := range accesses {
+		if access.UserID == userID && access.Status != constants.StatusPending {
+			limit = access.Role
+		}
+	}
+
+	var invited []models.Access
+	for _, access := range accesses {
+		if access.UserID == userID || access.Status != constants.StatusAccepted {
+			continue
+		}
+		role := access.Role
+		if constants.RolePower(role) > constants.RolePower(limit) {
+			role = limit
+		}
+		invited = append(invited, models.Access{UserID: access.UserID, Role: role})
+	}
+	return invited, nil
+}
+
+// statusFor returns the status with the name, falling back to the first open status of the list.
+// With resetCompletion a done status is replaced by the first open status as well.
+func statusFor(listStatuses []models.Status, name string, resetCompletion bool) models.Status {
+	var open *models.Status
+	for i := range listStatuses {
+		if !listStatuses[i].Done {
+			open = &listStatuses[i]
+			break
+		}
+	}
+	for _, status := range listStatuses {
+		if status.Name != name {
+			continue
+		}
+		if resetCompletion && status.Done && open != nil {
+			return *open
+		}
+		return status
+	}
+	if open != nil {
+		return *open
+	}
+	if len(listStatuses) == 0 {
+		return models.Status{}
+	}
+	return listStatuses[0]
+}
+
+func earliestDate(listTodos []models.Todo) *time.Time {
+	var earliest *time.Time
+	for _, todo := range listTodos {
+		for _, date := range []*time.Time{todo.StartDate, todo.DueDate} {
+			if date != nil && (earliest == nil || date.Before(*earliest)) {
+				earliest = date
+			}
+		}
+	}
+	return earliest
+}
+
+func offset(anchor, date *time.Time) *time.Duration {
+	if anchor == nil || date == nil {
+		return nil
+	}
+	result := date.Sub(*anchor)
+	return &result
+}
+
+func shift(anchor *time.Time, offset *time.Duration) *time.Time {
+	if anchor == nil || offset == nil {
+		return nil
+	}
+	result := anchor.Add(*offset)
+	return &result
+}
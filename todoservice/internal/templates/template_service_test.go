@@ -0,0 +1,301 @@
+package templates_test
+
+import (
+	"context"
+	"errors"
+	listmock "github.com/Victor-Uzunov/devops-project/todoservice/internal/lists/automock"
+	statusmock "github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses/automock"
+	tagmock "github.com/Victor-Uzunov/devops-project/todoservice/internal/tags/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/templates"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/templates/automock"
+	todomock "github.com/Victor-Uzunov/devops-project/todoservice/internal/todos/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"testing"
+	"time"
+)
+
+type repositories struct {
+	templates *automock.TemplateRepository
+	lists     *listmock.ListRepository
+	statuses  *statusmock.StatusRepository
+	tags      *tagmock.TagRepository
+	todos     *todomock.TodoRepository
+	uuid      *automock.UUIDService
+	time      *automock.TimeService
+}
+
+func newRepositories(now time.Time, ids ...string) repositories {
+	r := repositories{
+		templates: &automock.TemplateRepository{},
+		lists:     &listmock.ListRepository{},
+		statuses:  &statusmock.StatusRepository{},
+		tags:      &tagmock.TagRepository{},
+		todos:     &todomock.TodoRepository{},
+		uuid:      &automock.UUIDService{},
+		time:      &automock.TimeService{},
+	}
+	for _, id := range ids {
+		r.uuid.EXPECT().Generate().Return(id).Once()
+	}
+	r.time.EXPECT().Now().Return(now).Maybe()
+	return r
+}
+
+func (r repositories) service() templates.TemplateService {
+	return templates.NewService(r.templates, r.lists, r.statuses, r.tags, r.todos, r.uuid, r.time)
+}
+
+func (r repositories) assert(t *testing.T) {
+	mock.AssertExpectationsForObjects(t, r.templates, r.lists, r.statuses, r.tags, r.todos, r.uuid)
+}
+
+func date(day int) *time.Time {
+	result := time.Date(2026, time.January, day, 0, 0, 0, 0, time.UTC)
+	return &result
+}
+
+func TestServiceCloneList(t *testing.T) {
+	ctx := context.Background()
+	err := errors.New("error")
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	other := "user2"
+	source := models.List{ID: "list1", Name: "Trip", Description: "summer", OwnerID: "owner", Visibility: constants.VisibilityShared}
+	sourceStatuses := []models.Status{
+		{ID: "s1", ListID: "list1", Name: "Open", AllowedNext: []string{"s2"}},
+		{ID: "s2", ListID: "list1", Name: "Closed", Done: true},
+	}
+	sourceTodos := []models.Todo{
+		{ID: "a", ListID: "list1", Title: "Book", StatusID: "s2", Completed: true, Position: "a", DueDate: date(10),
+			AssignedTo: &other, Tags: []models.Tag{{ID: "t1", ListID: "list1", Name: "urgent"}}},
+		{ID: "b", ListID: "list1", Title: "Pack", StatusID: "s1", Position: "b", StartDate: date(12)},
+	}
+	anchor := time.Date(2026, time.February, 1, 0, 0, 0, 0, time.UTC)
+	shifted := anchor.AddDate(0, 0, 2)
+
+	tests := []struct {
+		name          string
+		options       models.CloneOptions
+		setup         func() repositories
+		expected      models.List
+		expectedError error
+	}{
+		{
+			name:    "Clone with reset completion and a new anchor date",
+			options: models.CloneOptions{ResetCompletion: true, AnchorDate: &anchor},
+			setup: func() repositories {
+				r := newRepositories(now, "new", "n1", "n2", "nt", "na", "nb")
+				r.lists.EXPECT().Get(ctx, "list1").Return(source, nil).Once()
+				r.statuses.EXPECT().GetAllByListID(ctx, "list1").Return(sourceStatuses, nil).Once()
+				r.tags.EXPECT().GetAllByListID(ctx, "list1").Return([]models.Tag{{ID: "t1", ListID: "list1", Name: "urgent", Color: "red"}}, nil).Once()
+				r.todos.EXPECT().GetAllByListIDs(ctx, []string{"list1"}).Return(sourceTodos, nil).Once()
+				r.todos.EXPECT().GetDependenciesByListID(ctx, "list1").Return([]models.Dependency{{TodoID: "b", BlockedByID: "a"}}, nil).Once()
+
+				r.lists.EXPECT().Create(ctx, models.List{ID: "new", Name: "Trip (copy)", Description: "summer", OwnerID: "user1",
+					Visibility: constants.VisibilityPrivate, CreatedAt: now, UpdatedAt: now}).Return("new", nil).Once()
+				r.statuses.EXPECT().GetAllByListID(ctx, "new").Return([]models.Status{{ID: "d1", ListID: "new"}}, nil).Once()
+				r.statuses.EXPECT().Delete(ctx, "new", "d1").Return(nil).Once()
+				r.statuses.EXPECT().Create(ctx, models.Status{ID: "n1", ListID: "new", Name: "Open"}).Return("n1", nil).Once()
+				r.statuses.EXPECT().Create(ctx, models.Status{ID: "n2", ListID: "new", Name: "Closed", Done: true}).Return("n2", nil).Once()
+				r.statuses.EXPECT().SetTransitions(ctx, "new", "n1", []string{"n2"}).Return(nil).Once()
+				r.tags.EXPECT().Create(ctx, models.Tag{ID: "nt", ListID: "new", Name: "urgent", Color: "red"}).Return("nt", nil).Once()
+				r.todos.EXPECT().Create(ctx, models.Todo{ID: "na", ListID: "new", Title: "Book", StatusID: "n1", Position: "a",
+					DueDate: &anchor, CreatedAt: now, UpdatedAt: now}).Return("na", nil).Once()
+				r.todos.EXPECT().SetTags(ctx, "na", []string{"nt"}).Return(nil).Once()
+				r.todos.EXPECT().Create(ctx, models.Todo{ID: "nb", ListID: "new", Title: "Pack", StatusID: "n1", Position: "b",
+					StartDate: &shifted, CreatedAt: now, UpdatedAt: now}).Return("nb", nil).Once()
+				r.todos.EXPECT().AddDependency(ctx, "nb", "na").Return(nil).Once()
+				r.lists.EXPECT().Get(ctx, "new").Return(models.List{ID: "new", Name: "Trip (copy)"}, nil).Once()
+				return r
+			},
+			expected: models.List{ID: "new", Name: "Trip (copy)"},
+		},
+		{
+			name:    "Clone with collaborators keeps completion and the invited assignees",
+			options: models.CloneOptions{Name: "Trip 2027", IncludeCollaborators: true},
+			setup: func() repositories {
+				r := newRepositories(now, "new", "n1", "n2", "nt", "na")
+				r.lists.EXPECT().Get(ctx, "list1").Return(source, nil).Once()
+				r.statuses.EXPECT().GetAllByListID(ctx, "list1").Return(sourceStatuses, nil).Once()
+				r.tags.EXPECT().GetAllByListID(ctx, "list1").Return([]models.Tag{{ID: "t1", ListID: "list1", Name: "urgent", Color: "red"}}, nil).Once()
+				r.todos.EXPECT().GetAllByListIDs(ctx, []string{"list1"}).Return(sourceTodos[:1], nil).Once()
+				r.todos.EXPECT().GetDependenciesByListID(ctx, "list1").Return([]models.Dependency{}, nil).Once()
+
+				r.lists.EXPECT().Create(ctx, models.List{ID: "new", Name: "Trip 2027", Description: "summer", OwnerID: "user1",
+					Visibility: constants.VisibilityShared, CreatedAt: now, UpdatedAt: now}).Return("new", nil).Once()
+				r.lists.EXPECT().GetAccessesByListID(ctx, "list1").Return([]models.Access{
+					{ListID: "list1", UserID: "owner", Role: constants.Admin, Status: constants.StatusOwner},
+					{ListID: "list1", UserID: "user1", Role: constants.Writer, Status: constants.StatusAccepted},
+					{ListID: "list1", UserID: other, Role: constants.Admin, Status: constants.StatusAccepted},
+					{ListID: "list1", UserID: "reader", Role: constants.Reader, Status: constants.StatusAccepted},
+					{ListID: "list1", UserID: "invitee", Role: constants.Writer, Status: constants.StatusPending},
+				}, nil).Once()
+				r.lists.EXPECT().CreateAccess(ctx, models.Access{ListID: "new", UserID: other, Role: constants.Writer}).Return(models.Access{}, nil).Once()
+				r.lists.EXPECT().CreateAccess(ctx, models.Access{ListID: "new", UserID: "reader", Role: constants.Reader}).Return(models.Access{}, nil).Once()
+				r.statuses.EXPECT().GetAllByListID(ctx, "new").Return([]models.Status{}, nil).Once()
+				r.statuses.EXPECT().Create(ctx, models.Status{ID: "n1", ListID: "new", Name: "Open"}).Return("n1", nil).Once()
+				r.statuses.EXPECT().Create(ctx, models.Status{ID: "n2", ListID: "new", Name: "Closed", Done: true}).Return("n2", nil).Once()
+				r.statuses.EXPECT().SetTransitions(ctx, "new", "n1", []string{"n2"}).Return(nil).Once()
+				r.tags.EXPECT().Create(ctx, models.Tag{ID: "nt", ListID: "new", Name: "urgent", Color: "red"}).Return("nt", nil).Once()
+				r.todos.EXPECT().Create(ctx, models.Todo{ID: "na", ListID: "new", Title: "Book", StatusID: "n2", Completed: true, Position: "a",
+					DueDate: date(10), AssignedTo: &other, CreatedAt: now, UpdatedAt: now}).Return("na", nil).Once()
+				r.todos.EXPECT().SetTags(ctx, "na", []string{"nt"}).Return(nil).Once()
+				r.lists.EXPECT().Get(ctx, "new").Return(models.List{ID: "new", Name: "Trip 2027"}, nil).Once()
+				return r
+			},
+			expected: models.List{ID: "new", Name: "Trip 2027"},
+		},
+		{
+			name: "Error when the source list does not exist",
+			setup: func() repositories {
+				r := newRepositories(now)
+				r.lists.EXPECT().Get(ctx, "list1").Return(models.List{}, err).Once()
+				return r
+			},
+			expectedError: err,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := tt.setup()
+
+			result, err := r.service().CloneList(ctx, "user1", "list1", tt.options)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+			r.assert(t)
+		})
+	}
+}
+
+func TestServiceSaveTemplate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	assignee := "user2"
+
+	tests := []struct {
+		name          string
+		templateName  string
+		setup         func() repositories
+		expected      models.Template
+		expectedError error
+	}{
+		{
+			name:         "Save a list without its assignees",
+			templateName: " Sprint ",
+			setup: func() repositories {
+				r := newRepositories(now, "tpl1")
+				r.statuses.EXPECT().GetAllByListID(ctx, "list1").Return([]models.Status{{ID: "s1", ListID: "list1", Name: "Open"}}, nil).Once()
+				r.tags.EXPECT().GetAllByListID(ctx, "list1").Return([]models.Tag{}, nil).Once()
+				r.todos.EXPECT().GetAllByListIDs(ctx, []string{"list1"}).Return([]models.Todo{
+					{ID: "a", ListID: "list1", Title: "Plan", StatusID: "s1", Position: "a", StartDate: date(5), DueDate: date(7), AssignedTo: &assignee},
+				}, nil).Once()
+				r.todos.EXPECT().GetDependenciesByListID(ctx, "list1").Return([]models.Dependency{}, nil).Once()
+				r.templates.EXPECT().Create(ctx, mock.AnythingOfType("models.Template")).Return("tpl1", nil).Once()
+				return r
+			},
+			expected: models.Template{
+				ID: "tpl1", OwnerID: "user1", Name: "Sprint", Description: "two weeks", CreatedAt: now,
+				Blueprint: models.Blueprint{
+					Statuses: []models.BlueprintStatus{{Name: "Open", AllowedNext: []string{}}},
+					Tags:     []models.BlueprintTag{},
+					Todos: []models.BlueprintTodo{{Title: "Plan", Status: "Open", Position: "a", Tags: []string{}, BlockedBy: []int{},
+						StartOffset: durationPtr(0), DueOffset: durationPtr(48 * time.Hour)}},
+					Anchor: date(5),
+				},
+			},
+		},
+		{
+			name:         "Error when the name is empty",
+			templateName: " ",
+			setup: func() repositories {
+				return newRepositories(now)
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := tt.setup()
+
+			result, err := r.service().SaveTemplate(ctx, "user1", "list1", tt.templateName, "two weeks")
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+			r.assert(t)
+		})
+	}
+}
+
+func TestServiceCreateListFromTemplate(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2026, time.March, 1, 12, 0, 0, 0, time.UTC)
+	today := time.Date(2026, time.March, 1, 0, 0, 0, 0, time.UTC)
+	template := models.Template{ID: "tpl1", OwnerID: "user1", Name: "Sprint", Description: "two weeks", Blueprint: models.Blueprint{
+		Todos: []models.BlueprintTodo{{Title: "Plan", Status: "Done", Position: "a", DueOffset: durationPtr(48 * time.Hour)}},
+	}}
+	due := today.Add(48 * time.Hour)
+
+	tests := []struct {
+		name          string
+		setup         func() repositories
+		expected      models.List
+		expectedError error
+	}{
+		{
+			name: "Create a list anchored today with the default statuses",
+			setup: func() repositories {
+				r := newRepositories(now, "new", "na")
+				r.templates.EXPECT().Get(ctx, "user1", "tpl1").Return(template, nil).Once()
+				r.lists.EXPECT().Create(ctx, models.List{ID: "new", Name: "Sprint", Description: "two weeks", OwnerID: "user1",
+					Visibility: constants.VisibilityPrivate, CreatedAt: now, UpdatedAt: now}).Return("new", nil).Once()
+				r.statuses.EXPECT().GetAllByListID(ctx, "new").Return([]models.Status{
+					{ID: "d1", ListID: "new", Name: "To Do"},
+					{ID: "d4", ListID: "new", Name: "Done", Done: true},
+				}, nil).Once()
+				r.todos.EXPECT().Create(ctx, models.Todo{ID: "na", ListID: "new", Title: "Plan", StatusID: "d1", Position: "a",
+					DueDate: &due, CreatedAt: now, UpdatedAt: now}).Return("na", nil).Once()
+				r.lists.EXPECT().Get(ctx, "new").Return(models.List{ID: "new", Name: "Sprint"}, nil).Once()
+				return r
+			},
+			expected: models.List{ID: "new", Name: "Sprint"},
+		},
+		{
+			name: "Error when the template belongs to another user",
+			setup: func() repositories {
+				r := newRepositories(now)
+				r.templates.EXPECT().Get(ctx, "user1", "tpl1").Return(models.Template{}, pkg.ErrNotFound).Once()
+				return r
+			},
+			expectedError: pkg.ErrNotFound,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := tt.setup()
+
+			result, err := r.service().CreateListFromTemplate(ctx, "user1", "tpl1", "", nil)
+			if tt.expectedError != nil {
+				require.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+			r.assert(t)
+		})
+	}
+}
+
+func durationPtr(d time.Duration) *time.Duration {
+	return &d
+}
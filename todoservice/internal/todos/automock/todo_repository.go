@@ -25,59 +25,146 @@ func (_m *TodoRepository) EXPECT() *TodoRepository_Expecter {
 	return &TodoRepository_Expecter{mock: &_m.Mock}
 }
 
-// CompleteTodo provides a mock function with given fields: ctx, id
-func (_m *TodoRepository) CompleteTodo(ctx context.Context, id string) (models.Todo, error) {
-	ret := _m.Called(ctx, id)
+// AddDependency provides a mock function with given fields: ctx, id, blockedByID
+func (_m *TodoRepository) AddDependency(ctx context.Context, id string, blockedByID string) error {
+	ret := _m.Called(ctx, id, blockedByID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CompleteTodo")
+		panic("no return value specified for AddDependency")
 	}
 
-	var r0 models.Todo
-	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Todo, error)); ok {
-		return rf(ctx, id)
-	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) models.Todo); ok {
-		r0 = rf(ctx, id)
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, blockedByID)
 	} else {
-		r0 = ret.Get(0).(models.Todo)
+		r0 = ret.Error(0)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+	return r0
+}
+
+// TodoRepository_AddDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDependency'
+type TodoRepository_AddDependency_Call struct {
+	*mock.Call
+}
+
+// AddDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - blockedByID string
+func (_e *TodoRepository_Expecter) AddDependency(ctx interface{}, id interface{}, blockedByID interface{}) *TodoRepository_AddDependency_Call {
+	return &TodoRepository_AddDependency_Call{Call: _e.mock.On("AddDependency", ctx, id, blockedByID)}
+}
+
+func (_c *TodoRepository_AddDependency_Call) Run(run func(ctx context.Context, id string, blockedByID string)) *TodoRepository_AddDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_AddDependency_Call) Return(_a0 error) *TodoRepository_AddDependency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepository_AddDependency_Call) RunAndReturn(run func(context.Context, string, string) error) *TodoRepository_AddDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddTag provides a mock function with given fields: ctx, id, tagID
+func (_m *TodoRepository) AddTag(ctx context.Context, id string, tagID string) error {
+	ret := _m.Called(ctx, id, tagID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, tagID)
 	} else {
-		r1 = ret.Error(1)
+		r0 = ret.Error(0)
 	}
 
-	return r0, r1
+	return r0
 }
 
-// TodoRepository_CompleteTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteTodo'
-type TodoRepository_CompleteTodo_Call struct {
+// TodoRepository_AddTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTag'
+type TodoRepository_AddTag_Call struct {
 	*mock.Call
 }
 
-// CompleteTodo is a helper method to define mock.On call
+// AddTag is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *TodoRepository_Expecter) CompleteTodo(ctx interface{}, id interface{}) *TodoRepository_CompleteTodo_Call {
-	return &TodoRepository_CompleteTodo_Call{Call: _e.mock.On("CompleteTodo", ctx, id)}
+//   - tagID string
+func (_e *TodoRepository_Expecter) AddTag(ctx interface{}, id interface{}, tagID interface{}) *TodoRepository_AddTag_Call {
+	return &TodoRepository_AddTag_Call{Call: _e.mock.On("AddTag", ctx, id, tagID)}
 }
 
-func (_c *TodoRepository_CompleteTodo_Call) Run(run func(ctx context.Context, id string)) *TodoRepository_CompleteTodo_Call {
+func (_c *TodoRepository_AddTag_Call) Run(run func(ctx context.Context, id string, tagID string)) *TodoRepository_AddTag_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *TodoRepository_CompleteTodo_Call) Return(_a0 models.Todo, _a1 error) *TodoRepository_CompleteTodo_Call {
-	_c.Call.Return(_a0, _a1)
+func (_c *TodoRepository_AddTag_Call) Return(_a0 error) *TodoRepository_AddTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepository_AddTag_Call) RunAndReturn(run func(context.Context, string, string) error) *TodoRepository_AddTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CopyTags provides a mock function with given fields: ctx, sourceID, targetID
+func (_m *TodoRepository) CopyTags(ctx context.Context, sourceID string, targetID string) error {
+	ret := _m.Called(ctx, sourceID, targetID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopyTags")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, sourceID, targetID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepository_CopyTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyTags'
+type TodoRepository_CopyTags_Call struct {
+	*mock.Call
+}
+
+// CopyTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - sourceID string
+//   - targetID string
+func (_e *TodoRepository_Expecter) CopyTags(ctx interface{}, sourceID interface{}, targetID interface{}) *TodoRepository_CopyTags_Call {
+	return &TodoRepository_CopyTags_Call{Call: _e.mock.On("CopyTags", ctx, sourceID, targetID)}
+}
+
+func (_c *TodoRepository_CopyTags_Call) Run(run func(ctx context.Context, sourceID string, targetID string)) *TodoRepository_CopyTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_CopyTags_Call) Return(_a0 error) *TodoRepository_CopyTags_Call {
+	_c.Call.Return(_a0)
 	return _c
 }
 
-func (_c *TodoRepository_CompleteTodo_Call) RunAndReturn(run func(context.Context, string) (models.Todo, error)) *TodoRepository_CompleteTodo_Call {
+func (_c *TodoRepository_CopyTags_Call) RunAndReturn(run func(context.Context, string, string) error) *TodoRepository_CopyTags_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -360,6 +447,693 @@ func (_c *TodoRepository_GetAllByListID_Call) RunAndReturn(run func(context.Cont
 	return _c
 }
 
+// GetAllByListIDs provides a mock function with given fields: ctx, listIDs
+func (_m *TodoRepository) GetAllByListIDs(ctx context.Context, listIDs []string) ([]models.Todo, error) {
+	ret := _m.Called(ctx, listIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllByListIDs")
+	}
+
+	var r0 []models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]models.Todo, error)); ok {
+		return rf(ctx, listIDs)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []models.Todo); ok {
+		r0 = rf(ctx, listIDs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, listIDs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepository_GetAllByListIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllByListIDs'
+type TodoRepository_GetAllByListIDs_Call struct {
+	*mock.Call
+}
+
+// GetAllByListIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listIDs []string
+func (_e *TodoRepository_Expecter) GetAllByListIDs(ctx interface{}, listIDs interface{}) *TodoRepository_GetAllByListIDs_Call {
+	return &TodoRepository_GetAllByListIDs_Call{Call: _e.mock.On("GetAllByListIDs", ctx, listIDs)}
+}
+
+func (_c *TodoRepository_GetAllByListIDs_Call) Run(run func(ctx context.Context, listIDs []string)) *TodoRepository_GetAllByListIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_GetAllByListIDs_Call) Return(_a0 []models.Todo, _a1 error) *TodoRepository_GetAllByListIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepository_GetAllByListIDs_Call) RunAndReturn(run func(context.Context, []string) ([]models.Todo, error)) *TodoRepository_GetAllByListIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllByTagID provides a mock function with given fields: ctx, listID, tagID
+func (_m *TodoRepository) GetAllByTagID(ctx context.Context, listID string, tagID string) ([]models.Todo, error) {
+	ret := _m.Called(ctx, listID, tagID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllByTagID")
+	}
+
+	var r0 []models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]models.Todo, error)); ok {
+		return rf(ctx, listID, tagID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []models.Todo); ok {
+		r0 = rf(ctx, listID, tagID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, listID, tagID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepository_GetAllByTagID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllByTagID'
+type TodoRepository_GetAllByTagID_Call struct {
+	*mock.Call
+}
+
+// GetAllByTagID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+//   - tagID string
+func (_e *TodoRepository_Expecter) GetAllByTagID(ctx interface{}, listID interface{}, tagID interface{}) *TodoRepository_GetAllByTagID_Call {
+	return &TodoRepository_GetAllByTagID_Call{Call: _e.mock.On("GetAllByTagID", ctx, listID, tagID)}
+}
+
+func (_c *TodoRepository_GetAllByTagID_Call) Run(run func(ctx context.Context, listID string, tagID string)) *TodoRepository_GetAllByTagID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_GetAllByTagID_Call) Return(_a0 []models.Todo, _a1 error) *TodoRepository_GetAllByTagID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepository_GetAllByTagID_Call) RunAndReturn(run func(context.Context, string, string) ([]models.Todo, error)) *TodoRepository_GetAllByTagID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlocked provides a mock function with given fields: ctx, id
+func (_m *TodoRepository) GetBlocked(ctx context.Context, id string) ([]models.Todo, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlocked")
+	}
+
+	var r0 []models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Todo, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Todo); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepository_GetBlocked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlocked'
+type TodoRepository_GetBlocked_Call struct {
+	*mock.Call
+}
+
+// GetBlocked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TodoRepository_Expecter) GetBlocked(ctx interface{}, id interface{}) *TodoRepository_GetBlocked_Call {
+	return &TodoRepository_GetBlocked_Call{Call: _e.mock.On("GetBlocked", ctx, id)}
+}
+
+func (_c *TodoRepository_GetBlocked_Call) Run(run func(ctx context.Context, id string)) *TodoRepository_GetBlocked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_GetBlocked_Call) Return(_a0 []models.Todo, _a1 error) *TodoRepository_GetBlocked_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepository_GetBlocked_Call) RunAndReturn(run func(context.Context, string) ([]models.Todo, error)) *TodoRepository_GetBlocked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetBlockers provides a mock function with given fields: ctx, id
+func (_m *TodoRepository) GetBlockers(ctx context.Context, id string) ([]models.Todo, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetBlockers")
+	}
+
+	var r0 []models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Todo, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Todo); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepository_GetBlockers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetBlockers'
+type TodoRepository_GetBlockers_Call struct {
+	*mock.Call
+}
+
+// GetBlockers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TodoRepository_Expecter) GetBlockers(ctx interface{}, id interface{}) *TodoRepository_GetBlockers_Call {
+	return &TodoRepository_GetBlockers_Call{Call: _e.mock.On("GetBlockers", ctx, id)}
+}
+
+func (_c *TodoRepository_GetBlockers_Call) Run(run func(ctx context.Context, id string)) *TodoRepository_GetBlockers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_GetBlockers_Call) Return(_a0 []models.Todo, _a1 error) *TodoRepository_GetBlockers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepository_GetBlockers_Call) RunAndReturn(run func(context.Context, string) ([]models.Todo, error)) *TodoRepository_GetBlockers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetDependenciesByListID provides a mock function with given fields: ctx, listID
+func (_m *TodoRepository) GetDependenciesByListID(ctx context.Context, listID string) ([]models.Dependency, error) {
+	ret := _m.Called(ctx, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetDependenciesByListID")
+	}
+
+	var r0 []models.Dependency
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Dependency, error)); ok {
+		return rf(ctx, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Dependency); ok {
+		r0 = rf(ctx, listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Dependency)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepository_GetDependenciesByListID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetDependenciesByListID'
+type TodoRepository_GetDependenciesByListID_Call struct {
+	*mock.Call
+}
+
+// GetDependenciesByListID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+func (_e *TodoRepository_Expecter) GetDependenciesByListID(ctx interface{}, listID interface{}) *TodoRepository_GetDependenciesByListID_Call {
+	return &TodoRepository_GetDependenciesByListID_Call{Call: _e.mock.On("GetDependenciesByListID", ctx, listID)}
+}
+
+func (_c *TodoRepository_GetDependenciesByListID_Call) Run(run func(ctx context.Context, listID string)) *TodoRepository_GetDependenciesByListID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_GetDependenciesByListID_Call) Return(_a0 []models.Dependency, _a1 error) *TodoRepository_GetDependenciesByListID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepository_GetDependenciesByListID_Call) RunAndReturn(run func(context.Context, string) ([]models.Dependency, error)) *TodoRepository_GetDependenciesByListID_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetLastPosition provides a mock function with given fields: ctx, listID
+func (_m *TodoRepository) GetLastPosition(ctx context.Context, listID string) (string, error) {
+	ret := _m.Called(ctx, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetLastPosition")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (string, error)); ok {
+		return rf(ctx, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) string); ok {
+		r0 = rf(ctx, listID)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoRepository_GetLastPosition_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetLastPosition'
+type TodoRepository_GetLastPosition_Call struct {
+	*mock.Call
+}
+
+// GetLastPosition is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+func (_e *TodoRepository_Expecter) GetLastPosition(ctx interface{}, listID interface{}) *TodoRepository_GetLastPosition_Call {
+	return &TodoRepository_GetLastPosition_Call{Call: _e.mock.On("GetLastPosition", ctx, listID)}
+}
+
+func (_c *TodoRepository_GetLastPosition_Call) Run(run func(ctx context.Context, listID string)) *TodoRepository_GetLastPosition_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_GetLastPosition_Call) Return(_a0 string, _a1 error) *TodoRepository_GetLastPosition_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoRepository_GetLastPosition_Call) RunAndReturn(run func(context.Context, string) (string, error)) *TodoRepository_GetLastPosition_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// InSavepoint provides a mock function with given fields: ctx, fn
+func (_m *TodoRepository) InSavepoint(ctx context.Context, fn func(ctx context.Context) error) error {
+	ret := _m.Called(ctx, fn)
+
+	if len(ret) == 0 {
+		panic("no return value specified for InSavepoint")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, func(ctx context.Context) error) error); ok {
+		r0 = rf(ctx, fn)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepository_InSavepoint_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'InSavepoint'
+type TodoRepository_InSavepoint_Call struct {
+	*mock.Call
+}
+
+// InSavepoint is a helper method to define mock.On call
+//   - ctx context.Context
+//   - fn func(ctx context.Context) error
+func (_e *TodoRepository_Expecter) InSavepoint(ctx interface{}, fn interface{}) *TodoRepository_InSavepoint_Call {
+	return &TodoRepository_InSavepoint_Call{Call: _e.mock.On("InSavepoint", ctx, fn)}
+}
+
+func (_c *TodoRepository_InSavepoint_Call) Run(run func(ctx context.Context, fn func(ctx context.Context) error)) *TodoRepository_InSavepoint_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(func(ctx context.Context) error))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_InSavepoint_Call) Return(_a0 error) *TodoRepository_InSavepoint_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepository_InSavepoint_Call) RunAndReturn(run func(context.Context, func(ctx context.Context) error) error) *TodoRepository_InSavepoint_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// LockList provides a mock function with given fields: ctx, listID
+func (_m *TodoRepository) LockList(ctx context.Context, listID string) error {
+	ret := _m.Called(ctx, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for LockList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, listID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepository_LockList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'LockList'
+type TodoRepository_LockList_Call struct {
+	*mock.Call
+}
+
+// LockList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+func (_e *TodoRepository_Expecter) LockList(ctx interface{}, listID interface{}) *TodoRepository_LockList_Call {
+	return &TodoRepository_LockList_Call{Call: _e.mock.On("LockList", ctx, listID)}
+}
+
+func (_c *TodoRepository_LockList_Call) Run(run func(ctx context.Context, listID string)) *TodoRepository_LockList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_LockList_Call) Return(_a0 error) *TodoRepository_LockList_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepository_LockList_Call) RunAndReturn(run func(context.Context, string) error) *TodoRepository_LockList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveToList provides a mock function with given fields: ctx, todo
+func (_m *TodoRepository) MoveToList(ctx context.Context, todo models.Todo) error {
+	ret := _m.Called(ctx, todo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveToList")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Todo) error); ok {
+		r0 = rf(ctx, todo)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepository_MoveToList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveToList'
+type TodoRepository_MoveToList_Call struct {
+	*mock.Call
+}
+
+// MoveToList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todo models.Todo
+func (_e *TodoRepository_Expecter) MoveToList(ctx interface{}, todo interface{}) *TodoRepository_MoveToList_Call {
+	return &TodoRepository_MoveToList_Call{Call: _e.mock.On("MoveToList", ctx, todo)}
+}
+
+func (_c *TodoRepository_MoveToList_Call) Run(run func(ctx context.Context, todo models.Todo)) *TodoRepository_MoveToList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Todo))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_MoveToList_Call) Return(_a0 error) *TodoRepository_MoveToList_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepository_MoveToList_Call) RunAndReturn(run func(context.Context, models.Todo) error) *TodoRepository_MoveToList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveToStatus provides a mock function with given fields: ctx, id, statusID, position
+func (_m *TodoRepository) MoveToStatus(ctx context.Context, id string, statusID string, position string) error {
+	ret := _m.Called(ctx, id, statusID, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveToStatus")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) error); ok {
+		r0 = rf(ctx, id, statusID, position)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepository_MoveToStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveToStatus'
+type TodoRepository_MoveToStatus_Call struct {
+	*mock.Call
+}
+
+// MoveToStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - statusID string
+//   - position string
+func (_e *TodoRepository_Expecter) MoveToStatus(ctx interface{}, id interface{}, statusID interface{}, position interface{}) *TodoRepository_MoveToStatus_Call {
+	return &TodoRepository_MoveToStatus_Call{Call: _e.mock.On("MoveToStatus", ctx, id, statusID, position)}
+}
+
+func (_c *TodoRepository_MoveToStatus_Call) Run(run func(ctx context.Context, id string, statusID string, position string)) *TodoRepository_MoveToStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_MoveToStatus_Call) Return(_a0 error) *TodoRepository_MoveToStatus_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepository_MoveToStatus_Call) RunAndReturn(run func(context.Context, string, string, string) error) *TodoRepository_MoveToStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveDependency provides a mock function with given fields: ctx, id, blockedByID
+func (_m *TodoRepository) RemoveDependency(ctx context.Context, id string, blockedByID string) error {
+	ret := _m.Called(ctx, id, blockedByID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveDependency")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, blockedByID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepository_RemoveDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveDependency'
+type TodoRepository_RemoveDependency_Call struct {
+	*mock.Call
+}
+
+// RemoveDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - blockedByID string
+func (_e *TodoRepository_Expecter) RemoveDependency(ctx interface{}, id interface{}, blockedByID interface{}) *TodoRepository_RemoveDependency_Call {
+	return &TodoRepository_RemoveDependency_Call{Call: _e.mock.On("RemoveDependency", ctx, id, blockedByID)}
+}
+
+func (_c *TodoRepository_RemoveDependency_Call) Run(run func(ctx context.Context, id string, blockedByID string)) *TodoRepository_RemoveDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_RemoveDependency_Call) Return(_a0 error) *TodoRepository_RemoveDependency_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepository_RemoveDependency_Call) RunAndReturn(run func(context.Context, string, string) error) *TodoRepository_RemoveDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// RemoveTag provides a mock function with given fields: ctx, id, tagID
+func (_m *TodoRepository) RemoveTag(ctx context.Context, id string, tagID string) error {
+	ret := _m.Called(ctx, id, tagID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for RemoveTag")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) error); ok {
+		r0 = rf(ctx, id, tagID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepository_RemoveTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTag'
+type TodoRepository_RemoveTag_Call struct {
+	*mock.Call
+}
+
+// RemoveTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - tagID string
+func (_e *TodoRepository_Expecter) RemoveTag(ctx interface{}, id interface{}, tagID interface{}) *TodoRepository_RemoveTag_Call {
+	return &TodoRepository_RemoveTag_Call{Call: _e.mock.On("RemoveTag", ctx, id, tagID)}
+}
+
+func (_c *TodoRepository_RemoveTag_Call) Run(run func(ctx context.Context, id string, tagID string)) *TodoRepository_RemoveTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_RemoveTag_Call) Return(_a0 error) *TodoRepository_RemoveTag_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepository_RemoveTag_Call) RunAndReturn(run func(context.Context, string, string) error) *TodoRepository_RemoveTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// SetTags provides a mock function with given fields: ctx, id, tagIDs
+func (_m *TodoRepository) SetTags(ctx context.Context, id string, tagIDs []string) error {
+	ret := _m.Called(ctx, id, tagIDs)
+
+	if len(ret) == 0 {
+		panic("no return value specified for SetTags")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, []string) error); ok {
+		r0 = rf(ctx, id, tagIDs)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoRepository_SetTags_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'SetTags'
+type TodoRepository_SetTags_Call struct {
+	*mock.Call
+}
+
+// SetTags is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - tagIDs []string
+func (_e *TodoRepository_Expecter) SetTags(ctx interface{}, id interface{}, tagIDs interface{}) *TodoRepository_SetTags_Call {
+	return &TodoRepository_SetTags_Call{Call: _e.mock.On("SetTags", ctx, id, tagIDs)}
+}
+
+func (_c *TodoRepository_SetTags_Call) Run(run func(ctx context.Context, id string, tagIDs []string)) *TodoRepository_SetTags_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].([]string))
+	})
+	return _c
+}
+
+func (_c *TodoRepository_SetTags_Call) Return(_a0 error) *TodoRepository_SetTags_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoRepository_SetTags_Call) RunAndReturn(run func(context.Context, string, []string) error) *TodoRepository_SetTags_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Update provides a mock function with given fields: ctx, todo
 func (_m *TodoRepository) Update(ctx context.Context, todo models.Todo) error {
 	ret := _m.Called(ctx, todo)
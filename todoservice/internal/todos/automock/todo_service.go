@@ -10,42 +10,734 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	models "github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+
+	todos "github.com/Victor-Uzunov/devops-project/todoservice/internal/todos"
 )
 
-// TodoService is an autogenerated mock type for the TodoService type
-type TodoService struct {
-	mock.Mock
+// TodoService is an autogenerated mock type for the TodoService type
+type TodoService struct {
+	mock.Mock
+}
+
+type TodoService_Expecter struct {
+	mock *mock.Mock
+}
+
+func (_m *TodoService) EXPECT() *TodoService_Expecter {
+	return &TodoService_Expecter{mock: &_m.Mock}
+}
+
+// AddDependency provides a mock function with given fields: ctx, id, blockedByID
+func (_m *TodoService) AddDependency(ctx context.Context, id string, blockedByID string) (models.Todo, error) {
+	ret := _m.Called(ctx, id, blockedByID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddDependency")
+	}
+
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (models.Todo, error)); ok {
+		return rf(ctx, id, blockedByID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) models.Todo); ok {
+		r0 = rf(ctx, id, blockedByID)
+	} else {
+		r0 = ret.Get(0).(models.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, blockedByID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_AddDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddDependency'
+type TodoService_AddDependency_Call struct {
+	*mock.Call
+}
+
+// AddDependency is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - blockedByID string
+func (_e *TodoService_Expecter) AddDependency(ctx interface{}, id interface{}, blockedByID interface{}) *TodoService_AddDependency_Call {
+	return &TodoService_AddDependency_Call{Call: _e.mock.On("AddDependency", ctx, id, blockedByID)}
+}
+
+func (_c *TodoService_AddDependency_Call) Run(run func(ctx context.Context, id string, blockedByID string)) *TodoService_AddDependency_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_AddDependency_Call) Return(_a0 models.Todo, _a1 error) *TodoService_AddDependency_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_AddDependency_Call) RunAndReturn(run func(context.Context, string, string) (models.Todo, error)) *TodoService_AddDependency_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// AddTag provides a mock function with given fields: ctx, id, tagID
+func (_m *TodoService) AddTag(ctx context.Context, id string, tagID string) (models.Todo, error) {
+	ret := _m.Called(ctx, id, tagID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for AddTag")
+	}
+
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (models.Todo, error)); ok {
+		return rf(ctx, id, tagID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) models.Todo); ok {
+		r0 = rf(ctx, id, tagID)
+	} else {
+		r0 = ret.Get(0).(models.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, tagID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_AddTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'AddTag'
+type TodoService_AddTag_Call struct {
+	*mock.Call
+}
+
+// AddTag is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - tagID string
+func (_e *TodoService_Expecter) AddTag(ctx interface{}, id interface{}, tagID interface{}) *TodoService_AddTag_Call {
+	return &TodoService_AddTag_Call{Call: _e.mock.On("AddTag", ctx, id, tagID)}
+}
+
+func (_c *TodoService_AddTag_Call) Run(run func(ctx context.Context, id string, tagID string)) *TodoService_AddTag_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_AddTag_Call) Return(_a0 models.Todo, _a1 error) *TodoService_AddTag_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_AddTag_Call) RunAndReturn(run func(context.Context, string, string) (models.Todo, error)) *TodoService_AddTag_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// BulkUpdateTodos provides a mock function with given fields: ctx, actor, operations
+func (_m *TodoService) BulkUpdateTodos(ctx context.Context, actor todos.Actor, operations []models.BulkOperation) ([]models.BulkResult, error) {
+	ret := _m.Called(ctx, actor, operations)
+
+	if len(ret) == 0 {
+		panic("no return value specified for BulkUpdateTodos")
+	}
+
+	var r0 []models.BulkResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, todos.Actor, []models.BulkOperation) ([]models.BulkResult, error)); ok {
+		return rf(ctx, actor, operations)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, todos.Actor, []models.BulkOperation) []models.BulkResult); ok {
+		r0 = rf(ctx, actor, operations)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.BulkResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, todos.Actor, []models.BulkOperation) error); ok {
+		r1 = rf(ctx, actor, operations)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_BulkUpdateTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'BulkUpdateTodos'
+type TodoService_BulkUpdateTodos_Call struct {
+	*mock.Call
+}
+
+// BulkUpdateTodos is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actor todos.Actor
+//   - operations []models.BulkOperation
+func (_e *TodoService_Expecter) BulkUpdateTodos(ctx interface{}, actor interface{}, operations interface{}) *TodoService_BulkUpdateTodos_Call {
+	return &TodoService_BulkUpdateTodos_Call{Call: _e.mock.On("BulkUpdateTodos", ctx, actor, operations)}
+}
+
+func (_c *TodoService_BulkUpdateTodos_Call) Run(run func(ctx context.Context, actor todos.Actor, operations []models.BulkOperation)) *TodoService_BulkUpdateTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todos.Actor), args[2].([]models.BulkOperation))
+	})
+	return _c
+}
+
+func (_c *TodoService_BulkUpdateTodos_Call) Return(_a0 []models.BulkResult, _a1 error) *TodoService_BulkUpdateTodos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_BulkUpdateTodos_Call) RunAndReturn(run func(context.Context, todos.Actor, []models.BulkOperation) ([]models.BulkResult, error)) *TodoService_BulkUpdateTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CompleteTodo provides a mock function with given fields: ctx, id
+func (_m *TodoService) CompleteTodo(ctx context.Context, id string) (models.Todo, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CompleteTodo")
+	}
+
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Todo, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Todo); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_CompleteTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteTodo'
+type TodoService_CompleteTodo_Call struct {
+	*mock.Call
+}
+
+// CompleteTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TodoService_Expecter) CompleteTodo(ctx interface{}, id interface{}) *TodoService_CompleteTodo_Call {
+	return &TodoService_CompleteTodo_Call{Call: _e.mock.On("CompleteTodo", ctx, id)}
+}
+
+func (_c *TodoService_CompleteTodo_Call) Run(run func(ctx context.Context, id string)) *TodoService_CompleteTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_CompleteTodo_Call) Return(_a0 models.Todo, _a1 error) *TodoService_CompleteTodo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_CompleteTodo_Call) RunAndReturn(run func(context.Context, string) (models.Todo, error)) *TodoService_CompleteTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CopyTodo provides a mock function with given fields: ctx, actor, id, listID
+func (_m *TodoService) CopyTodo(ctx context.Context, actor todos.Actor, id string, listID string) (models.Todo, error) {
+	ret := _m.Called(ctx, actor, id, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CopyTodo")
+	}
+
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, todos.Actor, string, string) (models.Todo, error)); ok {
+		return rf(ctx, actor, id, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, todos.Actor, string, string) models.Todo); ok {
+		r0 = rf(ctx, actor, id, listID)
+	} else {
+		r0 = ret.Get(0).(models.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, todos.Actor, string, string) error); ok {
+		r1 = rf(ctx, actor, id, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_CopyTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CopyTodo'
+type TodoService_CopyTodo_Call struct {
+	*mock.Call
+}
+
+// CopyTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actor todos.Actor
+//   - id string
+//   - listID string
+func (_e *TodoService_Expecter) CopyTodo(ctx interface{}, actor interface{}, id interface{}, listID interface{}) *TodoService_CopyTodo_Call {
+	return &TodoService_CopyTodo_Call{Call: _e.mock.On("CopyTodo", ctx, actor, id, listID)}
+}
+
+func (_c *TodoService_CopyTodo_Call) Run(run func(ctx context.Context, actor todos.Actor, id string, listID string)) *TodoService_CopyTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todos.Actor), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_CopyTodo_Call) Return(_a0 models.Todo, _a1 error) *TodoService_CopyTodo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_CopyTodo_Call) RunAndReturn(run func(context.Context, todos.Actor, string, string) (models.Todo, error)) *TodoService_CopyTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// CreateTodo provides a mock function with given fields: ctx, todo
+func (_m *TodoService) CreateTodo(ctx context.Context, todo models.Todo) (string, error) {
+	ret := _m.Called(ctx, todo)
+
+	if len(ret) == 0 {
+		panic("no return value specified for CreateTodo")
+	}
+
+	var r0 string
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, models.Todo) (string, error)); ok {
+		return rf(ctx, todo)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, models.Todo) string); ok {
+		r0 = rf(ctx, todo)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, models.Todo) error); ok {
+		r1 = rf(ctx, todo)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_CreateTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTodo'
+type TodoService_CreateTodo_Call struct {
+	*mock.Call
+}
+
+// CreateTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - todo models.Todo
+func (_e *TodoService_Expecter) CreateTodo(ctx interface{}, todo interface{}) *TodoService_CreateTodo_Call {
+	return &TodoService_CreateTodo_Call{Call: _e.mock.On("CreateTodo", ctx, todo)}
+}
+
+func (_c *TodoService_CreateTodo_Call) Run(run func(ctx context.Context, todo models.Todo)) *TodoService_CreateTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(models.Todo))
+	})
+	return _c
+}
+
+func (_c *TodoService_CreateTodo_Call) Return(_a0 string, _a1 error) *TodoService_CreateTodo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_CreateTodo_Call) RunAndReturn(run func(context.Context, models.Todo) (string, error)) *TodoService_CreateTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// DeleteTodo provides a mock function with given fields: ctx, id
+func (_m *TodoService) DeleteTodo(ctx context.Context, id string) error {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for DeleteTodo")
+	}
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// TodoService_DeleteTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTodo'
+type TodoService_DeleteTodo_Call struct {
+	*mock.Call
+}
+
+// DeleteTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TodoService_Expecter) DeleteTodo(ctx interface{}, id interface{}) *TodoService_DeleteTodo_Call {
+	return &TodoService_DeleteTodo_Call{Call: _e.mock.On("DeleteTodo", ctx, id)}
+}
+
+func (_c *TodoService_DeleteTodo_Call) Run(run func(ctx context.Context, id string)) *TodoService_DeleteTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_DeleteTodo_Call) Return(_a0 error) *TodoService_DeleteTodo_Call {
+	_c.Call.Return(_a0)
+	return _c
+}
+
+func (_c *TodoService_DeleteTodo_Call) RunAndReturn(run func(context.Context, string) error) *TodoService_DeleteTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetAllTodos provides a mock function with given fields: ctx
+func (_m *TodoService) GetAllTodos(ctx context.Context) ([]models.Todo, error) {
+	ret := _m.Called(ctx)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetAllTodos")
+	}
+
+	var r0 []models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Todo, error)); ok {
+		return rf(ctx)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context) []models.Todo); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_GetAllTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllTodos'
+type TodoService_GetAllTodos_Call struct {
+	*mock.Call
+}
+
+// GetAllTodos is a helper method to define mock.On call
+//   - ctx context.Context
+func (_e *TodoService_Expecter) GetAllTodos(ctx interface{}) *TodoService_GetAllTodos_Call {
+	return &TodoService_GetAllTodos_Call{Call: _e.mock.On("GetAllTodos", ctx)}
+}
+
+func (_c *TodoService_GetAllTodos_Call) Run(run func(ctx context.Context)) *TodoService_GetAllTodos_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context))
+	})
+	return _c
+}
+
+func (_c *TodoService_GetAllTodos_Call) Return(_a0 []models.Todo, _a1 error) *TodoService_GetAllTodos_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_GetAllTodos_Call) RunAndReturn(run func(context.Context) ([]models.Todo, error)) *TodoService_GetAllTodos_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// GetTodo provides a mock function with given fields: ctx, id
+func (_m *TodoService) GetTodo(ctx context.Context, id string) (models.Todo, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetTodo")
+	}
+
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Todo, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) models.Todo); ok {
+		r0 = rf(ctx, id)
+	} else {
+		r0 = ret.Get(0).(models.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_GetTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodo'
+type TodoService_GetTodo_Call struct {
+	*mock.Call
+}
+
+// GetTodo is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TodoService_Expecter) GetTodo(ctx interface{}, id interface{}) *TodoService_GetTodo_Call {
+	return &TodoService_GetTodo_Call{Call: _e.mock.On("GetTodo", ctx, id)}
+}
+
+func (_c *TodoService_GetTodo_Call) Run(run func(ctx context.Context, id string)) *TodoService_GetTodo_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_GetTodo_Call) Return(_a0 models.Todo, _a1 error) *TodoService_GetTodo_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_GetTodo_Call) RunAndReturn(run func(context.Context, string) (models.Todo, error)) *TodoService_GetTodo_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBlocked provides a mock function with given fields: ctx, id
+func (_m *TodoService) ListBlocked(ctx context.Context, id string) ([]models.Todo, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBlocked")
+	}
+
+	var r0 []models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Todo, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Todo); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_ListBlocked_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBlocked'
+type TodoService_ListBlocked_Call struct {
+	*mock.Call
+}
+
+// ListBlocked is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TodoService_Expecter) ListBlocked(ctx interface{}, id interface{}) *TodoService_ListBlocked_Call {
+	return &TodoService_ListBlocked_Call{Call: _e.mock.On("ListBlocked", ctx, id)}
+}
+
+func (_c *TodoService_ListBlocked_Call) Run(run func(ctx context.Context, id string)) *TodoService_ListBlocked_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_ListBlocked_Call) Return(_a0 []models.Todo, _a1 error) *TodoService_ListBlocked_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_ListBlocked_Call) RunAndReturn(run func(context.Context, string) ([]models.Todo, error)) *TodoService_ListBlocked_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListBlockers provides a mock function with given fields: ctx, id
+func (_m *TodoService) ListBlockers(ctx context.Context, id string) ([]models.Todo, error) {
+	ret := _m.Called(ctx, id)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListBlockers")
+	}
+
+	var r0 []models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Todo, error)); ok {
+		return rf(ctx, id)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Todo); ok {
+		r0 = rf(ctx, id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_ListBlockers_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListBlockers'
+type TodoService_ListBlockers_Call struct {
+	*mock.Call
+}
+
+// ListBlockers is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+func (_e *TodoService_Expecter) ListBlockers(ctx interface{}, id interface{}) *TodoService_ListBlockers_Call {
+	return &TodoService_ListBlockers_Call{Call: _e.mock.On("ListBlockers", ctx, id)}
+}
+
+func (_c *TodoService_ListBlockers_Call) Run(run func(ctx context.Context, id string)) *TodoService_ListBlockers_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_ListBlockers_Call) Return(_a0 []models.Todo, _a1 error) *TodoService_ListBlockers_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_ListBlockers_Call) RunAndReturn(run func(context.Context, string) ([]models.Todo, error)) *TodoService_ListBlockers_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// ListTodosByListID provides a mock function with given fields: ctx, listID
+func (_m *TodoService) ListTodosByListID(ctx context.Context, listID string) ([]models.Todo, error) {
+	ret := _m.Called(ctx, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for ListTodosByListID")
+	}
+
+	var r0 []models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Todo, error)); ok {
+		return rf(ctx, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Todo); ok {
+		r0 = rf(ctx, listID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_ListTodosByListID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTodosByListID'
+type TodoService_ListTodosByListID_Call struct {
+	*mock.Call
+}
+
+// ListTodosByListID is a helper method to define mock.On call
+//   - ctx context.Context
+//   - listID string
+func (_e *TodoService_Expecter) ListTodosByListID(ctx interface{}, listID interface{}) *TodoService_ListTodosByListID_Call {
+	return &TodoService_ListTodosByListID_Call{Call: _e.mock.On("ListTodosByListID", ctx, listID)}
+}
+
+func (_c *TodoService_ListTodosByListID_Call) Run(run func(ctx context.Context, listID string)) *TodoService_ListTodosByListID_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string))
+	})
+	return _c
 }
 
-type TodoService_Expecter struct {
-	mock *mock.Mock
+func (_c *TodoService_ListTodosByListID_Call) Return(_a0 []models.Todo, _a1 error) *TodoService_ListTodosByListID_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
 }
 
-func (_m *TodoService) EXPECT() *TodoService_Expecter {
-	return &TodoService_Expecter{mock: &_m.Mock}
+func (_c *TodoService_ListTodosByListID_Call) RunAndReturn(run func(context.Context, string) ([]models.Todo, error)) *TodoService_ListTodosByListID_Call {
+	_c.Call.Return(run)
+	return _c
 }
 
-// CompleteTodo provides a mock function with given fields: ctx, id
-func (_m *TodoService) CompleteTodo(ctx context.Context, id string) (models.Todo, error) {
-	ret := _m.Called(ctx, id)
+// ListTodosByListIDs provides a mock function with given fields: ctx, listIDs
+func (_m *TodoService) ListTodosByListIDs(ctx context.Context, listIDs []string) ([]models.Todo, error) {
+	ret := _m.Called(ctx, listIDs)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CompleteTodo")
+		panic("no return value specified for ListTodosByListIDs")
 	}
 
-	var r0 models.Todo
+	var r0 []models.Todo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Todo, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]models.Todo, error)); ok {
+		return rf(ctx, listIDs)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) models.Todo); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []models.Todo); ok {
+		r0 = rf(ctx, listIDs)
 	} else {
-		r0 = ret.Get(0).(models.Todo)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, listIDs)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -53,56 +745,58 @@ func (_m *TodoService) CompleteTodo(ctx context.Context, id string) (models.Todo
 	return r0, r1
 }
 
-// TodoService_CompleteTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CompleteTodo'
-type TodoService_CompleteTodo_Call struct {
+// TodoService_ListTodosByListIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTodosByListIDs'
+type TodoService_ListTodosByListIDs_Call struct {
 	*mock.Call
 }
 
-// CompleteTodo is a helper method to define mock.On call
+// ListTodosByListIDs is a helper method to define mock.On call
 //   - ctx context.Context
-//   - id string
-func (_e *TodoService_Expecter) CompleteTodo(ctx interface{}, id interface{}) *TodoService_CompleteTodo_Call {
-	return &TodoService_CompleteTodo_Call{Call: _e.mock.On("CompleteTodo", ctx, id)}
+//   - listIDs []string
+func (_e *TodoService_Expecter) ListTodosByListIDs(ctx interface{}, listIDs interface{}) *TodoService_ListTodosByListIDs_Call {
+	return &TodoService_ListTodosByListIDs_Call{Call: _e.mock.On("ListTodosByListIDs", ctx, listIDs)}
 }
 
-func (_c *TodoService_CompleteTodo_Call) Run(run func(ctx context.Context, id string)) *TodoService_CompleteTodo_Call {
+func (_c *TodoService_ListTodosByListIDs_Call) Run(run func(ctx context.Context, listIDs []string)) *TodoService_ListTodosByListIDs_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].([]string))
 	})
 	return _c
 }
 
-func (_c *TodoService_CompleteTodo_Call) Return(_a0 models.Todo, _a1 error) *TodoService_CompleteTodo_Call {
+func (_c *TodoService_ListTodosByListIDs_Call) Return(_a0 []models.Todo, _a1 error) *TodoService_ListTodosByListIDs_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TodoService_CompleteTodo_Call) RunAndReturn(run func(context.Context, string) (models.Todo, error)) *TodoService_CompleteTodo_Call {
+func (_c *TodoService_ListTodosByListIDs_Call) RunAndReturn(run func(context.Context, []string) ([]models.Todo, error)) *TodoService_ListTodosByListIDs_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// CreateTodo provides a mock function with given fields: ctx, todo
-func (_m *TodoService) CreateTodo(ctx context.Context, todo models.Todo) (string, error) {
-	ret := _m.Called(ctx, todo)
+// ListTodosByTagID provides a mock function with given fields: ctx, listID, tagID
+func (_m *TodoService) ListTodosByTagID(ctx context.Context, listID string, tagID string) ([]models.Todo, error) {
+	ret := _m.Called(ctx, listID, tagID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for CreateTodo")
+		panic("no return value specified for ListTodosByTagID")
 	}
 
-	var r0 string
+	var r0 []models.Todo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, models.Todo) (string, error)); ok {
-		return rf(ctx, todo)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) ([]models.Todo, error)); ok {
+		return rf(ctx, listID, tagID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, models.Todo) string); ok {
-		r0 = rf(ctx, todo)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) []models.Todo); ok {
+		r0 = rf(ctx, listID, tagID)
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.Todo)
+		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, models.Todo) error); ok {
-		r1 = rf(ctx, todo)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, listID, tagID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -110,105 +804,177 @@ func (_m *TodoService) CreateTodo(ctx context.Context, todo models.Todo) (string
 	return r0, r1
 }
 
-// TodoService_CreateTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'CreateTodo'
-type TodoService_CreateTodo_Call struct {
+// TodoService_ListTodosByTagID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTodosByTagID'
+type TodoService_ListTodosByTagID_Call struct {
 	*mock.Call
 }
 
-// CreateTodo is a helper method to define mock.On call
+// ListTodosByTagID is a helper method to define mock.On call
 //   - ctx context.Context
-//   - todo models.Todo
-func (_e *TodoService_Expecter) CreateTodo(ctx interface{}, todo interface{}) *TodoService_CreateTodo_Call {
-	return &TodoService_CreateTodo_Call{Call: _e.mock.On("CreateTodo", ctx, todo)}
+//   - listID string
+//   - tagID string
+func (_e *TodoService_Expecter) ListTodosByTagID(ctx interface{}, listID interface{}, tagID interface{}) *TodoService_ListTodosByTagID_Call {
+	return &TodoService_ListTodosByTagID_Call{Call: _e.mock.On("ListTodosByTagID", ctx, listID, tagID)}
 }
 
-func (_c *TodoService_CreateTodo_Call) Run(run func(ctx context.Context, todo models.Todo)) *TodoService_CreateTodo_Call {
+func (_c *TodoService_ListTodosByTagID_Call) Run(run func(ctx context.Context, listID string, tagID string)) *TodoService_ListTodosByTagID_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(models.Todo))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *TodoService_CreateTodo_Call) Return(_a0 string, _a1 error) *TodoService_CreateTodo_Call {
+func (_c *TodoService_ListTodosByTagID_Call) Return(_a0 []models.Todo, _a1 error) *TodoService_ListTodosByTagID_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TodoService_CreateTodo_Call) RunAndReturn(run func(context.Context, models.Todo) (string, error)) *TodoService_CreateTodo_Call {
+func (_c *TodoService_ListTodosByTagID_Call) RunAndReturn(run func(context.Context, string, string) ([]models.Todo, error)) *TodoService_ListTodosByTagID_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// DeleteTodo provides a mock function with given fields: ctx, id
-func (_m *TodoService) DeleteTodo(ctx context.Context, id string) error {
-	ret := _m.Called(ctx, id)
+// MoveTodo provides a mock function with given fields: ctx, id, beforeID, afterID
+func (_m *TodoService) MoveTodo(ctx context.Context, id string, beforeID string, afterID string) (models.Todo, error) {
+	ret := _m.Called(ctx, id, beforeID, afterID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for DeleteTodo")
+		panic("no return value specified for MoveTodo")
 	}
 
-	var r0 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) error); ok {
-		r0 = rf(ctx, id)
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) (models.Todo, error)); ok {
+		return rf(ctx, id, beforeID, afterID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, string) models.Todo); ok {
+		r0 = rf(ctx, id, beforeID, afterID)
 	} else {
-		r0 = ret.Error(0)
+		r0 = ret.Get(0).(models.Todo)
 	}
 
-	return r0
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, string) error); ok {
+		r1 = rf(ctx, id, beforeID, afterID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
 }
 
-// TodoService_DeleteTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'DeleteTodo'
-type TodoService_DeleteTodo_Call struct {
+// TodoService_MoveTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveTodo'
+type TodoService_MoveTodo_Call struct {
 	*mock.Call
 }
 
-// DeleteTodo is a helper method to define mock.On call
+// MoveTodo is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *TodoService_Expecter) DeleteTodo(ctx interface{}, id interface{}) *TodoService_DeleteTodo_Call {
-	return &TodoService_DeleteTodo_Call{Call: _e.mock.On("DeleteTodo", ctx, id)}
+//   - beforeID string
+//   - afterID string
+func (_e *TodoService_Expecter) MoveTodo(ctx interface{}, id interface{}, beforeID interface{}, afterID interface{}) *TodoService_MoveTodo_Call {
+	return &TodoService_MoveTodo_Call{Call: _e.mock.On("MoveTodo", ctx, id, beforeID, afterID)}
 }
 
-func (_c *TodoService_DeleteTodo_Call) Run(run func(ctx context.Context, id string)) *TodoService_DeleteTodo_Call {
+func (_c *TodoService_MoveTodo_Call) Run(run func(ctx context.Context, id string, beforeID string, afterID string)) *TodoService_MoveTodo_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *TodoService_DeleteTodo_Call) Return(_a0 error) *TodoService_DeleteTodo_Call {
-	_c.Call.Return(_a0)
+func (_c *TodoService_MoveTodo_Call) Return(_a0 models.Todo, _a1 error) *TodoService_MoveTodo_Call {
+	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TodoService_DeleteTodo_Call) RunAndReturn(run func(context.Context, string) error) *TodoService_DeleteTodo_Call {
+func (_c *TodoService_MoveTodo_Call) RunAndReturn(run func(context.Context, string, string, string) (models.Todo, error)) *TodoService_MoveTodo_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetAllTodos provides a mock function with given fields: ctx
-func (_m *TodoService) GetAllTodos(ctx context.Context) ([]models.Todo, error) {
-	ret := _m.Called(ctx)
+// MoveTodoToList provides a mock function with given fields: ctx, actor, id, listID
+func (_m *TodoService) MoveTodoToList(ctx context.Context, actor todos.Actor, id string, listID string) (models.Todo, error) {
+	ret := _m.Called(ctx, actor, id, listID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetAllTodos")
+		panic("no return value specified for MoveTodoToList")
+	}
+
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, todos.Actor, string, string) (models.Todo, error)); ok {
+		return rf(ctx, actor, id, listID)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, todos.Actor, string, string) models.Todo); ok {
+		r0 = rf(ctx, actor, id, listID)
+	} else {
+		r0 = ret.Get(0).(models.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, todos.Actor, string, string) error); ok {
+		r1 = rf(ctx, actor, id, listID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_MoveTodoToList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveTodoToList'
+type TodoService_MoveTodoToList_Call struct {
+	*mock.Call
+}
+
+// MoveTodoToList is a helper method to define mock.On call
+//   - ctx context.Context
+//   - actor todos.Actor
+//   - id string
+//   - listID string
+func (_e *TodoService_Expecter) MoveTodoToList(ctx interface{}, actor interface{}, id interface{}, listID interface{}) *TodoService_MoveTodoToList_Call {
+	return &TodoService_MoveTodoToList_Call{Call: _e.mock.On("MoveTodoToList", ctx, actor, id, listID)}
+}
+
+func (_c *TodoService_MoveTodoToList_Call) Run(run func(ctx context.Context, actor todos.Actor, id string, listID string)) *TodoService_MoveTodoToList_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(todos.Actor), args[2].(string), args[3].(string))
+	})
+	return _c
+}
+
+func (_c *TodoService_MoveTodoToList_Call) Return(_a0 models.Todo, _a1 error) *TodoService_MoveTodoToList_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_MoveTodoToList_Call) RunAndReturn(run func(context.Context, todos.Actor, string, string) (models.Todo, error)) *TodoService_MoveTodoToList_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
+// MoveTodosToList provides a mock function with given fields: ctx, actor, ids, listID
+func (_m *TodoService) MoveTodosToList(ctx context.Context, actor todos.Actor, ids []string, listID string) ([]models.Todo, error) {
+	ret := _m.Called(ctx, actor, ids, listID)
+
+	if len(ret) == 0 {
+		panic("no return value specified for MoveTodosToList")
 	}
 
 	var r0 []models.Todo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context) ([]models.Todo, error)); ok {
-		return rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, todos.Actor, []string, string) ([]models.Todo, error)); ok {
+		return rf(ctx, actor, ids, listID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context) []models.Todo); ok {
-		r0 = rf(ctx)
+	if rf, ok := ret.Get(0).(func(context.Context, todos.Actor, []string, string) []models.Todo); ok {
+		r0 = rf(ctx, actor, ids, listID)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]models.Todo)
 		}
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
-		r1 = rf(ctx)
+	if rf, ok := ret.Get(1).(func(context.Context, todos.Actor, []string, string) error); ok {
+		r1 = rf(ctx, actor, ids, listID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -216,55 +982,58 @@ func (_m *TodoService) GetAllTodos(ctx context.Context) ([]models.Todo, error) {
 	return r0, r1
 }
 
-// TodoService_GetAllTodos_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetAllTodos'
-type TodoService_GetAllTodos_Call struct {
+// TodoService_MoveTodosToList_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'MoveTodosToList'
+type TodoService_MoveTodosToList_Call struct {
 	*mock.Call
 }
 
-// GetAllTodos is a helper method to define mock.On call
+// MoveTodosToList is a helper method to define mock.On call
 //   - ctx context.Context
-func (_e *TodoService_Expecter) GetAllTodos(ctx interface{}) *TodoService_GetAllTodos_Call {
-	return &TodoService_GetAllTodos_Call{Call: _e.mock.On("GetAllTodos", ctx)}
+//   - actor todos.Actor
+//   - ids []string
+//   - listID string
+func (_e *TodoService_Expecter) MoveTodosToList(ctx interface{}, actor interface{}, ids interface{}, listID interface{}) *TodoService_MoveTodosToList_Call {
+	return &TodoService_MoveTodosToList_Call{Call: _e.mock.On("MoveTodosToList", ctx, actor, ids, listID)}
 }
 
-func (_c *TodoService_GetAllTodos_Call) Run(run func(ctx context.Context)) *TodoService_GetAllTodos_Call {
+func (_c *TodoService_MoveTodosToList_Call) Run(run func(ctx context.Context, actor todos.Actor, ids []string, listID string)) *TodoService_MoveTodosToList_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context))
+		run(args[0].(context.Context), args[1].(todos.Actor), args[2].([]string), args[3].(string))
 	})
 	return _c
 }
 
-func (_c *TodoService_GetAllTodos_Call) Return(_a0 []models.Todo, _a1 error) *TodoService_GetAllTodos_Call {
+func (_c *TodoService_MoveTodosToList_Call) Return(_a0 []models.Todo, _a1 error) *TodoService_MoveTodosToList_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TodoService_GetAllTodos_Call) RunAndReturn(run func(context.Context) ([]models.Todo, error)) *TodoService_GetAllTodos_Call {
+func (_c *TodoService_MoveTodosToList_Call) RunAndReturn(run func(context.Context, todos.Actor, []string, string) ([]models.Todo, error)) *TodoService_MoveTodosToList_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// GetTodo provides a mock function with given fields: ctx, id
-func (_m *TodoService) GetTodo(ctx context.Context, id string) (models.Todo, error) {
-	ret := _m.Called(ctx, id)
+// RemoveDependency provides a mock function with given fields: ctx, id, blockedByID
+func (_m *TodoService) RemoveDependency(ctx context.Context, id string, blockedByID string) (models.Todo, error) {
+	ret := _m.Called(ctx, id, blockedByID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for GetTodo")
+		panic("no return value specified for RemoveDependency")
 	}
 
 	var r0 models.Todo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) (models.Todo, error)); ok {
-		return rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (models.Todo, error)); ok {
+		return rf(ctx, id, blockedByID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) models.Todo); ok {
-		r0 = rf(ctx, id)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) models.Todo); ok {
+		r0 = rf(ctx, id, blockedByID)
 	} else {
 		r0 = ret.Get(0).(models.Todo)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, id)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, blockedByID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -272,58 +1041,57 @@ func (_m *TodoService) GetTodo(ctx context.Context, id string) (models.Todo, err
 	return r0, r1
 }
 
-// TodoService_GetTodo_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetTodo'
-type TodoService_GetTodo_Call struct {
+// TodoService_RemoveDependency_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveDependency'
+type TodoService_RemoveDependency_Call struct {
 	*mock.Call
 }
 
-// GetTodo is a helper method to define mock.On call
+// RemoveDependency is a helper method to define mock.On call
 //   - ctx context.Context
 //   - id string
-func (_e *TodoService_Expecter) GetTodo(ctx interface{}, id interface{}) *TodoService_GetTodo_Call {
-	return &TodoService_GetTodo_Call{Call: _e.mock.On("GetTodo", ctx, id)}
+//   - blockedByID string
+func (_e *TodoService_Expecter) RemoveDependency(ctx interface{}, id interface{}, blockedByID interface{}) *TodoService_RemoveDependency_Call {
+	return &TodoService_RemoveDependency_Call{Call: _e.mock.On("RemoveDependency", ctx, id, blockedByID)}
 }
 
-func (_c *TodoService_GetTodo_Call) Run(run func(ctx context.Context, id string)) *TodoService_GetTodo_Call {
+func (_c *TodoService_RemoveDependency_Call) Run(run func(ctx context.Context, id string, blockedByID string)) *TodoService_RemoveDependency_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *TodoService_GetTodo_Call) Return(_a0 models.Todo, _a1 error) *TodoService_GetTodo_Call {
+func (_c *TodoService_RemoveDependency_Call) Return(_a0 models.Todo, _a1 error) *TodoService_RemoveDependency_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TodoService_GetTodo_Call) RunAndReturn(run func(context.Context, string) (models.Todo, error)) *TodoService_GetTodo_Call {
+func (_c *TodoService_RemoveDependency_Call) RunAndReturn(run func(context.Context, string, string) (models.Todo, error)) *TodoService_RemoveDependency_Call {
 	_c.Call.Return(run)
 	return _c
 }
 
-// ListTodosByListID provides a mock function with given fields: ctx, listID
-func (_m *TodoService) ListTodosByListID(ctx context.Context, listID string) ([]models.Todo, error) {
-	ret := _m.Called(ctx, listID)
+// RemoveTag provides a mock function with given fields: ctx, id, tagID
+func (_m *TodoService) RemoveTag(ctx context.Context, id string, tagID string) (models.Todo, error) {
+	ret := _m.Called(ctx, id, tagID)
 
 	if len(ret) == 0 {
-		panic("no return value specified for ListTodosByListID")
+		panic("no return value specified for RemoveTag")
 	}
 
-	var r0 []models.Todo
+	var r0 models.Todo
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, string) ([]models.Todo, error)); ok {
-		return rf(ctx, listID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) (models.Todo, error)); ok {
+		return rf(ctx, id, tagID)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, string) []models.Todo); ok {
-		r0 = rf(ctx, listID)
+	if rf, ok := ret.Get(0).(func(context.Context, string, string) models.Todo); ok {
+		r0 = rf(ctx, id, tagID)
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).([]models.Todo)
-		}
+		r0 = ret.Get(0).(models.Todo)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
-		r1 = rf(ctx, listID)
+	if rf, ok := ret.Get(1).(func(context.Context, string, string) error); ok {
+		r1 = rf(ctx, id, tagID)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -331,31 +1099,32 @@ func (_m *TodoService) ListTodosByListID(ctx context.Context, listID string) ([]
 	return r0, r1
 }
 
-// TodoService_ListTodosByListID_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'ListTodosByListID'
-type TodoService_ListTodosByListID_Call struct {
+// TodoService_RemoveTag_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'RemoveTag'
+type TodoService_RemoveTag_Call struct {
 	*mock.Call
 }
 
-// ListTodosByListID is a helper method to define mock.On call
+// RemoveTag is a helper method to define mock.On call
 //   - ctx context.Context
-//   - listID string
-func (_e *TodoService_Expecter) ListTodosByListID(ctx interface{}, listID interface{}) *TodoService_ListTodosByListID_Call {
-	return &TodoService_ListTodosByListID_Call{Call: _e.mock.On("ListTodosByListID", ctx, listID)}
+//   - id string
+//   - tagID string
+func (_e *TodoService_Expecter) RemoveTag(ctx interface{}, id interface{}, tagID interface{}) *TodoService_RemoveTag_Call {
+	return &TodoService_RemoveTag_Call{Call: _e.mock.On("RemoveTag", ctx, id, tagID)}
 }
 
-func (_c *TodoService_ListTodosByListID_Call) Run(run func(ctx context.Context, listID string)) *TodoService_ListTodosByListID_Call {
+func (_c *TodoService_RemoveTag_Call) Run(run func(ctx context.Context, id string, tagID string)) *TodoService_RemoveTag_Call {
 	_c.Call.Run(func(args mock.Arguments) {
-		run(args[0].(context.Context), args[1].(string))
+		run(args[0].(context.Context), args[1].(string), args[2].(string))
 	})
 	return _c
 }
 
-func (_c *TodoService_ListTodosByListID_Call) Return(_a0 []models.Todo, _a1 error) *TodoService_ListTodosByListID_Call {
+func (_c *TodoService_RemoveTag_Call) Return(_a0 models.Todo, _a1 error) *TodoService_RemoveTag_Call {
 	_c.Call.Return(_a0, _a1)
 	return _c
 }
 
-func (_c *TodoService_ListTodosByListID_Call) RunAndReturn(run func(context.Context, string) ([]models.Todo, error)) *TodoService_ListTodosByListID_Call {
+func (_c *TodoService_RemoveTag_Call) RunAndReturn(run func(context.Context, string, string) (models.Todo, error)) *TodoService_RemoveTag_Call {
 	_c.Call.Return(run)
 	return _c
 }
@@ -581,6 +1350,65 @@ func (_c *TodoService_UpdateTodoPriority_Call) RunAndReturn(run func(context.Con
 	return _c
 }
 
+// UpdateTodoStatus provides a mock function with given fields: ctx, id, statusID, position
+func (_m *TodoService) UpdateTodoStatus(ctx context.Context, id string, statusID string, position *int) (models.Todo, error) {
+	ret := _m.Called(ctx, id, statusID, position)
+
+	if len(ret) == 0 {
+		panic("no return value specified for UpdateTodoStatus")
+	}
+
+	var r0 models.Todo
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *int) (models.Todo, error)); ok {
+		return rf(ctx, id, statusID, position)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, string, string, *int) models.Todo); ok {
+		r0 = rf(ctx, id, statusID, position)
+	} else {
+		r0 = ret.Get(0).(models.Todo)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, string, string, *int) error); ok {
+		r1 = rf(ctx, id, statusID, position)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TodoService_UpdateTodoStatus_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'UpdateTodoStatus'
+type TodoService_UpdateTodoStatus_Call struct {
+	*mock.Call
+}
+
+// UpdateTodoStatus is a helper method to define mock.On call
+//   - ctx context.Context
+//   - id string
+//   - statusID string
+//   - position *int
+func (_e *TodoService_Expecter) UpdateTodoStatus(ctx interface{}, id interface{}, statusID interface{}, position interface{}) *TodoService_UpdateTodoStatus_Call {
+	return &TodoService_UpdateTodoStatus_Call{Call: _e.mock.On("UpdateTodoStatus", ctx, id, statusID, position)}
+}
+
+func (_c *TodoService_UpdateTodoStatus_Call) Run(run func(ctx context.Context, id string, statusID string, position *int)) *TodoService_UpdateTodoStatus_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].(string), args[2].(string), args[3].(*int))
+	})
+	return _c
+}
+
+func (_c *TodoService_UpdateTodoStatus_Call) Return(_a0 models.Todo, _a1 error) *TodoService_UpdateTodoStatus_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *TodoService_UpdateTodoStatus_Call) RunAndReturn(run func(context.Context, string, string, *int) (models.Todo, error)) *TodoService_UpdateTodoStatus_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // UpdateTodoTitle provides a mock function with given fields: ctx, id, name
 func (_m *TodoService) UpdateTodoTitle(ctx context.Context, id string, name string) (models.Todo, error) {
 	ret := _m.Called(ctx, id, name)
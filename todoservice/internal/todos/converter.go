@@ -2,6 +2,7 @@ package todos
 
 import (
 	"database/sql"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/tags"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
 	"time"
@@ -19,8 +20,10 @@ func (c *Converter) ConvertTodoToModel(entity Entity) models.Todo {
 		ListID:      entity.ListID,
 		Title:       entity.Title,
 		Description: entity.Description,
-		Tags:        pkg.JSONRawMessageFromNullableString(entity.Tags),
+		Tags:        tags.FromJSON(entity.Tags),
 		Completed:   entity.Completed,
+		StatusID:    entity.StatusID.String,
+		Position:    entity.Position,
 		DueDate:     convertNullTimeToTime(entity.DueDate),
 		StartDate:   convertNullTimeToTime(entity.StartDate),
 		Priority:    entity.Priority,
@@ -36,14 +39,15 @@ func (c *Converter) ConvertTodoToEntity(todo models.Todo) Entity {
 		ListID:      todo.ListID,
 		Title:       todo.Title,
 		Description: todo.Description,
-		Tags:        pkg.NewNullableStringFromJSONRawMessage(todo.Tags),
 		Completed:   todo.Completed,
-		DueDate:     convertTimeToNullTime(*todo.DueDate),
-		StartDate:   convertTimeToNullTime(*todo.StartDate),
+		StatusID:    sql.NullString{String: todo.StatusID, Valid: todo.StatusID != ""},
+		Position:    todo.Position,
+		DueDate:     convertTimeToNullTime(todo.DueDate),
+		StartDate:   convertTimeToNullTime(todo.StartDate),
 		Priority:    todo.Priority,
 		CreatedAt:   todo.CreatedAt,
 		UpdatedAt:   todo.UpdatedAt,
-		AssignedTo:  todo.AssignedTo,
+		AssignedTo:  assignee(todo.AssignedTo),
 	}
 }
 
@@ -55,15 +59,30 @@ func convertNullTimeToTime(nullTime sql.NullTime) *time.Time {
 	}
 }
 
-func convertTimeToNullTime(t time.Time) sql.NullTime {
-	if t.IsZero() {
+func convertTimeToNullTime(t *time.Time) sql.NullTime {
+	if t == nil || t.IsZero() {
 		return sql.NullTime{
 			Time:  time.Time{},
 			Valid: false,
 		}
 	}
 	return sql.NullTime{
-		Time:  t,
+		Time:  *t,
 		Valid: true,
 	}
 }
+
+// assignee stores a todo without an assignee as NULL.
+func assignee(userID *string) *string {
+	if userID == nil {
+		return nil
+	}
+	return pkg.NullIfEmpty(*userID)
+}
+
+func (c *Converter) ConvertDependencyToModel(entity DependencyEntity) models.Dependency {
+	return models.Dependency{
+		TodoID:      entity.TodoID,
+		BlockedByID: entity.BlockedByID,
+	}
+}
@@ -24,7 +24,8 @@ func TestConvertTodoToModel(t *testing.T) {
 	}
 	assignedTo := "someone"
 
-	tags, err := json.Marshal([]constants2.TagType{constants2.TagWork, constants2.TagFinance})
+	modelTags := []models.Tag{{ID: "tagID", ListID: "listID", Name: "work", Color: "#9e9e9e"}}
+	tags, err := json.Marshal(modelTags)
 	require.NoError(t, err)
 
 	entity := todos.Entity{
@@ -46,7 +47,7 @@ func TestConvertTodoToModel(t *testing.T) {
 		Title:       "Test Todo",
 		Description: "This is a test todo",
 		ListID:      "listID",
-		Tags:        json.RawMessage(string(tags)),
+		Tags:        modelTags,
 		Completed:   false,
 		DueDate:     &dueTime,
 		Priority:    constants2.PriorityHigh,
@@ -73,15 +74,14 @@ func TestConvertTodoToEntity(t *testing.T) {
 	}
 	assignedTo := "someone"
 
-	tags, err := json.Marshal([]constants2.TagType{constants2.TagWork, constants2.TagFinance})
-	require.NoError(t, err)
+	modelTags := []models.Tag{{ID: "tagID", ListID: "listID", Name: "work", Color: "#9e9e9e"}}
 
 	model := models.Todo{
 		ID:          "1",
 		Title:       "Test Todo",
 		Description: "This is a test todo",
 		ListID:      "listID",
-		Tags:        json.RawMessage(string(tags)),
+		Tags:        modelTags,
 		Completed:   false,
 		DueDate:     &dueTime,
 		StartDate:   &dueTime,
@@ -96,7 +96,6 @@ func TestConvertTodoToEntity(t *testing.T) {
 		Title:       "Test Todo",
 		Description: "This is a test todo",
 		ListID:      "listID",
-		Tags:        pkg.NewValidNullableString(string(tags)),
 		Completed:   false,
 		DueDate:     nullTime,
 		StartDate:   nullTime,
@@ -13,6 +13,8 @@ type Entity struct {
 	Description string                  `db:"description"`
 	Tags        sql.NullString          `db:"tags"`
 	Completed   bool                    `db:"completed"`
+	StatusID    sql.NullString          `db:"status_id"`
+	Position    string                  `db:"position"`
 	DueDate     sql.NullTime            `db:"due_date"`
 	StartDate   sql.NullTime            `db:"start_date"`
 	Priority    constants.PriorityLevel `db:"priority"`
@@ -20,3 +22,8 @@ type Entity struct {
 	UpdatedAt   time.Time               `db:"updated_at"`
 	AssignedTo  *string                 `db:"assigned_to"`
 }
+
+type DependencyEntity struct {
+	TodoID      string `db:"todo_id"`
+	BlockedByID string `db:"blocked_by_id"`
+}
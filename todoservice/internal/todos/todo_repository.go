@@ -6,10 +6,12 @@ import (
 	"errors"
 	"fmt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/tags"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
 )
 
 //go:generate mockery --name=TodoRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
@@ -18,13 +20,28 @@ type TodoRepository interface {
 	Get(ctx context.Context, id string) (models.Todo, error)
 	GetAll(ctx context.Context) ([]models.Todo, error)
 	GetAllByListID(ctx context.Context, listID string) ([]models.Todo, error)
+	GetAllByListIDs(ctx context.Context, listIDs []string) ([]models.Todo, error)
 	Delete(ctx context.Context, id string) error
 	Create(ctx context.Context, list models.Todo) (string, error)
-	CompleteTodo(ctx context.Context, id string) (models.Todo, error)
+	GetLastPosition(ctx context.Context, listID string) (string, error)
+	LockList(ctx context.Context, listID string) error
+	MoveToStatus(ctx context.Context, id string, statusID string, position string) error
 	UpdateTodoTitle(ctx context.Context, id string, title string) (models.Todo, error)
 	UpdateTodoDescription(ctx context.Context, id string, description string) (models.Todo, error)
 	UpdateTodoPriority(ctx context.Context, id string, priority constants.PriorityLevel) (models.Todo, error)
 	UpdateAssignedTo(ctx context.Context, id string, userID string) (models.Todo, error)
+	GetAllByTagID(ctx context.Context, listID string, tagID string) ([]models.Todo, error)
+	SetTags(ctx context.Context, id string, tagIDs []string) error
+	AddTag(ctx context.Context, id string, tagID string) error
+	RemoveTag(ctx context.Context, id string, tagID string) error
+	AddDependency(ctx context.Context, id string, blockedByID string) error
+	RemoveDependency(ctx context.Context, id string, blockedByID string) error
+	GetBlockers(ctx context.Context, id string) ([]models.Todo, error)
+	GetBlocked(ctx context.Context, id string) ([]models.Todo, error)
+	GetDependenciesByListID(ctx context.Context, listID string) ([]models.Dependency, error)
+	MoveToList(ctx context.Context, todo models.Todo) error
+	CopyTags(ctx context.Context, sourceID string, targetID string) error
+	InSavepoint(ctx context.Context, fn func(ctx context.Context) error) error
 }
 
 type SQLXTodoRepository struct {
@@ -58,8 +75,8 @@ func (r *SQLXTodoRepository) Create(ctx context.Context, todo models.Todo) (stri
 	}
 
 	insertTodoQuery := `
-		INSERT INTO todos (id, title, description, list_id, completed, tags, priority, due_date, start_date, assigned_to, created_at, updated_at)
-		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+		INSERT INTO todos (id, title, description, list_id, completed, priority, due_date, start_date, assigned_to, created_at, updated_at, status_id, position)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13)
 		RETURNING id
 	`
 
@@ -70,13 +87,14 @@ func (r *SQLXTodoRepository) Create(ctx context.Context, todo models.Todo) (stri
 		entity.Description,
 		entity.ListID,
 		entity.Completed,
-		entity.Tags,
 		entity.Priority,
 		entity.DueDate,
 		entity.StartDate,
-		pkg.NullIfEmpty(*entity.AssignedTo),
+		entity.AssignedTo,
 		entity.CreatedAt,
 		entity.UpdatedAt,
+		entity.StatusID,
+		entity.Position,
 	).Scan(&id)
 	log.C(ctx).Debugf("created todo with ID: %v", id)
 	if err != nil {
@@ -95,7 +113,7 @@ func (r *SQLXTodoRepository) Get(ctx context.Context, id string) (models.Todo, e
 	}
 
 	query := `
-		SELECT id, title, description, list_id, priority, due_date, start_date, completed, tags, created_at, updated_at, assigned_to
+		SELECT id, title, description, list_id, priority, due_date, start_date, completed, status_id, position, ` + tags.TodoTagsColumn + `, created_at, updated_at, assigned_to
 		FROM todos
 		WHERE id = $1
 `
@@ -127,8 +145,8 @@ func (r *SQLXTodoRepository) Update(ctx context.Context, todo models.Todo) error
 	updateTodoQuery := `
 		UPDATE todos
 		SET title = $1, description = $2, 
-		    priority = $3, due_date = $4, start_date = $5, completed = $6, tags = $7, assigned_to = $8
-		WHERE id = $9
+		    priority = $3, due_date = $4, start_date = $5, completed = $6, assigned_to = $7
+		WHERE id = $8
 	`
 
 	_, err = tx.ExecContext(ctx, updateTodoQuery,
@@ -138,8 +156,7 @@ func (r *SQLXTodoRepository) Update(ctx context.Context, todo models.Todo) error
 		entity.DueDate,
 		entity.StartDate,
 		entity.Completed,
-		entity.Tags,
-		pkg.NullIfEmpty(*entity.AssignedTo),
+		entity.AssignedTo,
 		entity.ID,
 	)
 	if err != nil {
@@ -174,9 +191,10 @@ func (r *SQLXTodoRepository) GetAllByListID(ctx context.Context, listID string)
 		return []models.Todo{}, err
 	}
 	query := `
-		SELECT id, title, description, list_id, priority, due_date, start_date, completed, tags, created_at, updated_at
+		SELECT id, title, description, list_id, priority, due_date, start_date, completed, status_id, position, ` + tags.TodoTagsColumn + `, created_at, updated_at
 		FROM todos
 		WHERE list_id = $1
+		ORDER BY position, id
 	`
 
 	var todos []Entity
@@ -199,6 +217,36 @@ func (r *SQLXTodoRepository) GetAllByListID(ctx context.Context, listID string)
 	return result, nil
 }
 
+func (r *SQLXTodoRepository) GetAllByListIDs(ctx context.Context, listIDs []string) ([]models.Todo, error) {
+	log.C(ctx).Info("getting all todos for multiple lists")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return []models.Todo{}, err
+	}
+	query := `
+		SELECT id, title, description, list_id, priority, due_date, start_date, completed, status_id, position, ` + tags.TodoTagsColumn + `, created_at, updated_at, assigned_to
+		FROM todos
+		WHERE list_id = ANY($1)
+		ORDER BY list_id, position, id
+	`
+
+	var todos []Entity
+
+	err = tx.SelectContext(ctx, &todos, query, pq.Array(listIDs))
+	if err != nil {
+		log.C(ctx).Errorf("failed to get todos: %v", err)
+		return nil, fmt.Errorf("failed to get todos: %w", err)
+	}
+
+	result := make([]models.Todo, 0, len(todos))
+	for _, entity := range todos {
+		result = append(result, r.converter.ConvertTodoToModel(entity))
+	}
+
+	return result, nil
+}
+
 func (r *SQLXTodoRepository) GetAll(ctx context.Context) ([]models.Todo, error) {
 	log.C(ctx).Info("getting all todos")
 	tx, err := db.FromContext(ctx)
@@ -207,7 +255,7 @@ func (r *SQLXTodoRepository) GetAll(ctx context.Context) ([]models.Todo, error)
 		return []models.Todo{}, err
 	}
 	query := `
-		SELECT id, title, description, list_id, priority, due_date, start_date, completed, tags, created_at, updated_at
+		SELECT id, title, description, list_id, priority, due_date, start_date, completed, status_id, position, ` + tags.TodoTagsColumn + `, created_at, updated_at
 		FROM todos
 	`
 
@@ -231,34 +279,65 @@ func (r *SQLXTodoRepository) GetAll(ctx context.Context) ([]models.Todo, error)
 	return result, nil
 }
 
-func (r *SQLXTodoRepository) CompleteTodo(ctx context.Context, id string) (models.Todo, error) {
-	log.C(ctx).Info("completing todo repository")
+// LockList locks the list until the end of the transaction. Positions are computed from the positions of
+// the other todos of the list, so they are only read with the list locked to keep concurrent changes from
+// computing the same position.
+func (r *SQLXTodoRepository) LockList(ctx context.Context, listID string) error {
+	log.C(ctx).Info("locking list of todos repository")
 	tx, err := db.FromContext(ctx)
 	if err != nil {
 		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
-		return models.Todo{}, err
+		return err
 	}
 
-	updateListQuery := `
-		UPDATE todos
-		SET completed = true
-		WHERE id = $1
-	`
+	if _, err = tx.ExecContext(ctx, `SELECT id FROM lists WHERE id = $1 FOR UPDATE`, listID); err != nil {
+		log.C(ctx).Errorf("failed to lock list: %v", err)
+		return fmt.Errorf("failed to lock list: %w", err)
+	}
+	return nil
+}
 
-	_, err = tx.ExecContext(ctx, updateListQuery, id)
+// GetLastPosition returns the greatest position in the list or an empty string for a list without todos.
+func (r *SQLXTodoRepository) GetLastPosition(ctx context.Context, listID string) (string, error) {
+	log.C(ctx).Info("getting last todo position repository")
+	tx, err := db.FromContext(ctx)
 	if err != nil {
-		log.C(ctx).Errorf("failed to complete todo: %v", err)
-		return models.Todo{}, err
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return "", err
 	}
 
-	updatedTodo, err := r.Get(ctx, id)
+	var position string
+	err = tx.GetContext(ctx, &position, `SELECT COALESCE(MAX(position), '') FROM todos WHERE list_id = $1`, listID)
 	if err != nil {
-		log.C(ctx).Errorf("error while fetching completed todo: %v", err)
-		return models.Todo{}, err
+		log.C(ctx).Errorf("failed to get last todo position: %v", err)
+		return "", fmt.Errorf("failed to get last todo position: %w", err)
 	}
+	return position, nil
+}
 
-	log.C(ctx).Info("todo completed successfully")
-	return updatedTodo, nil
+// MoveToStatus sets the status and the position of the todo. Moving a todo never touches the positions of
+// the other todos of the list.
+func (r *SQLXTodoRepository) MoveToStatus(ctx context.Context, id string, statusID string, position string) error {
+	log.C(ctx).Info("moving todo to status repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `UPDATE todos SET status_id = $1, position = $2 WHERE id = $3`, statusID, position, id)
+	if err != nil {
+		log.C(ctx).Errorf("failed to move todo: %v", err)
+		return fmt.Errorf("failed to move todo: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("todo %s not found: %w", id, pkg.ErrNotFound)
+	}
+	return nil
 }
 
 func (r *SQLXTodoRepository) UpdateTodoDescription(ctx context.Context, todoID string, description string) (models.Todo, error) {
@@ -380,3 +459,324 @@ func (r *SQLXTodoRepository) UpdateAssignedTo(ctx context.Context, todoID string
 	log.C(ctx).Info("todo assigned_to updated successfully")
 	return updatedTodo, nil
 }
+
+func (r *SQLXTodoRepository) GetAllByTagID(ctx context.Context, listID string, tagID string) ([]models.Todo, error) {
+	log.C(ctx).Info("getting all todos by tag")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+	query := `
+		SELECT id, title, description, list_id, priority, due_date, start_date, completed, status_id, position, ` + tags.TodoTagsColumn + `, created_at, updated_at, assigned_to
+		FROM todos
+		WHERE list_id = $1 AND id IN (SELECT todo_id FROM todo_tags WHERE tag_id = $2)
+		ORDER BY position, id
+	`
+
+	var todos []Entity
+	err = tx.SelectContext(ctx, &todos, query, listID, tagID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to get todos by tag: %v", err)
+		return nil, fmt.Errorf("failed to get todos: %w", err)
+	}
+
+	result := make([]models.Todo, 0, len(todos))
+	for _, entity := range todos {
+		result = append(result, r.converter.ConvertTodoToModel(entity))
+	}
+	return result, nil
+}
+
+// SetTags replaces the tags of the todo with tagIDs, which must all be tags of the list of the todo.
+func (r *SQLXTodoRepository) SetTags(ctx context.Context, todoID string, tagIDs []string) error {
+	log.C(ctx).Info("setting todo tags repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM todo_tags WHERE todo_id = $1`, todoID); err != nil {
+		log.C(ctx).Errorf("failed to clear todo tags: %v", err)
+		return fmt.Errorf("failed to clear todo tags: %w", err)
+	}
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	insertQuery := `
+		INSERT INTO todo_tags (todo_id, tag_id)
+		SELECT t.id, tg.id
+		FROM todos t
+		JOIN tags tg ON tg.list_id = t.list_id
+		WHERE t.id = $1 AND tg.id = ANY($2)
+	`
+	result, err := tx.ExecContext(ctx, insertQuery, todoID, pq.Array(tagIDs))
+	if err != nil {
+		log.C(ctx).Errorf("failed to set todo tags: %v", err)
+		return fmt.Errorf("failed to set todo tags: %w", err)
+	}
+	inserted, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	if inserted != int64(len(tagIDs)) {
+		log.C(ctx).Errorf("only %d of %d tags belong to the list of todo %s", inserted, len(tagIDs), todoID)
+		return fmt.Errorf("tags must belong to the list of the todo: %w", pkg.ErrBadRequest)
+	}
+	return nil
+}
+
+func (r *SQLXTodoRepository) AddTag(ctx context.Context, todoID string, tagID string) error {
+	log.C(ctx).Info("adding todo tag repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	sameListQuery := `
+		SELECT EXISTS (
+			SELECT 1
+			FROM todos t
+			JOIN tags tg ON tg.list_id = t.list_id
+			WHERE t.id = $1 AND tg.id = $2
+		)
+	`
+	var sameList bool
+	if err = tx.GetContext(ctx, &sameList, sameListQuery, todoID, tagID); err != nil {
+		log.C(ctx).Errorf("failed to check the list of the tag: %v", err)
+		return fmt.Errorf("failed to check the list of the tag: %w", err)
+	}
+	if !sameList {
+		return fmt.Errorf("tag %s does not belong to the list of the todo: %w", tagID, pkg.ErrBadRequest)
+	}
+
+	insertQuery := `
+		INSERT INTO todo_tags (todo_id, tag_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`
+	if _, err = tx.ExecContext(ctx, insertQuery, todoID, tagID); err != nil {
+		log.C(ctx).Errorf("failed to add todo tag: %v", err)
+		return fmt.Errorf("failed to add todo tag: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLXTodoRepository) RemoveTag(ctx context.Context, todoID string, tagID string) error {
+	log.C(ctx).Info("removing todo tag repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	if _, err = tx.ExecContext(ctx, `DELETE FROM todo_tags WHERE todo_id = $1 AND tag_id = $2`, todoID, tagID); err != nil {
+		log.C(ctx).Errorf("failed to remove todo tag: %v", err)
+		return fmt.Errorf("failed to remove todo tag: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLXTodoRepository) AddDependency(ctx context.Context, todoID string, blockedByID string) error {
+	log.C(ctx).Info("adding todo dependency repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	insertQuery := `
+		INSERT INTO todo_dependencies (todo_id, blocked_by_id)
+		VALUES ($1, $2)
+		ON CONFLICT DO NOTHING
+	`
+	if _, err = tx.ExecContext(ctx, insertQuery, todoID, blockedByID); err != nil {
+		log.C(ctx).Errorf("failed to add todo dependency: %v", err)
+		return fmt.Errorf("failed to add todo dependency: %w", err)
+	}
+	return nil
+}
+
+func (r *SQLXTodoRepository) RemoveDependency(ctx context.Context, todoID string, blockedByID string) error {
+	log.C(ctx).Info("removing todo dependency repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	result, err := tx.ExecContext(ctx, `DELETE FROM todo_dependencies WHERE todo_id = $1 AND blocked_by_id = $2`, todoID, blockedByID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to remove todo dependency: %v", err)
+		return fmt.Errorf("failed to remove todo dependency: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("todo %s is not blocked by todo %s: %w", todoID, blockedByID, pkg.ErrNotFound)
+	}
+	return nil
+}
+
+// GetBlockers returns the todos that the todo waits for, ordered by position.
+func (r *SQLXTodoRepository) GetBlockers(ctx context.Context, todoID string) ([]models.Todo, error) {
+	log.C(ctx).Info("getting todo blockers repository")
+	query := `
+		SELECT id, title, description, list_id, priority, due_date, start_date, completed, status_id, position, ` + tags.TodoTagsColumn + `, created_at, updated_at, assigned_to
+		FROM todos
+		WHERE id IN (SELECT blocked_by_id FROM todo_dependencies WHERE todo_id = $1)
+		ORDER BY position, id
+	`
+	return r.selectTodos(ctx, query, todoID)
+}
+
+// GetBlocked returns the todos that wait for the todo, ordered by position.
+func (r *SQLXTodoRepository) GetBlocked(ctx context.Context, todoID string) ([]models.Todo, error) {
+	log.C(ctx).Info("getting todos blocked by todo repository")
+	query := `
+		SELECT id, title, description, list_id, priority, due_date, start_date, completed, status_id, position, ` + tags.TodoTagsColumn + `, created_at, updated_at, assigned_to
+		FROM todos
+		WHERE id IN (SELECT todo_id FROM todo_dependencies WHERE blocked_by_id = $1)
+		ORDER BY position, id
+	`
+	return r.selectTodos(ctx, query, todoID)
+}
+
+func (r *SQLXTodoRepository) GetDependenciesByListID(ctx context.Context, listID string) ([]models.Dependency, error) {
+	log.C(ctx).Info("getting todo dependencies by list id repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	query := `
+		SELECT d.todo_id, d.blocked_by_id
+		FROM todo_dependencies d
+		JOIN todos t ON t.id = d.todo_id
+		WHERE t.list_id = $1
+	`
+	var entities []DependencyEntity
+	if err = tx.SelectContext(ctx, &entities, query, listID); err != nil {
+		log.C(ctx).Errorf("failed to get todo dependencies: %v", err)
+		return nil, fmt.Errorf("failed to get todo dependencies: %w", err)
+	}
+
+	result := make([]models.Dependency, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, r.converter.ConvertDependencyToModel(entity))
+	}
+	return result, nil
+}
+
+// MoveToList moves the todo to todo.ListID with the given status, position and assignee. Its tags are
+// replaced with the tags of the same name in the new list, tags without a counterpart are dropped together
+// with the dependencies of the todo, which never cross lists.
+func (r *SQLXTodoRepository) MoveToList(ctx context.Context, todo models.Todo) error {
+	log.C(ctx).Info("moving todo to list repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+	entity := r.converter.ConvertTodoToEntity(todo)
+
+	updateQuery := `
+		UPDATE todos
+		SET list_id = $1, status_id = $2, position = $3, assigned_to = $4
+		WHERE id = $5
+	`
+	result, err := tx.ExecContext(ctx, updateQuery, entity.ListID, entity.StatusID, entity.Position, entity.AssignedTo, entity.ID)
+	if err != nil {
+		log.C(ctx).Errorf("failed to move todo to list: %v", err)
+		return fmt.Errorf("failed to move todo to list: %w", err)
+	}
+	affected, err := result.RowsAffected()
+	if err != nil {
+		return fmt.Errorf("failed to read affected rows: %w", err)
+	}
+	if affected == 0 {
+		return fmt.Errorf("todo %s not found: %w", todo.ID, pkg.ErrNotFound)
+	}
+
+	remapTagsQuery := `
+		UPDATE todo_tags tt
+		SET tag_id = target.id
+		FROM tags source, tags target
+		WHERE tt.todo_id = $1 AND source.id = tt.tag_id AND target.list_id = $2 AND target.name = source.name
+	`
+	if _, err = tx.ExecContext(ctx, remapTagsQuery, entity.ID, entity.ListID); err != nil {
+		log.C(ctx).Errorf("failed to remap todo tags: %v", err)
+		return fmt.Errorf("failed to remap todo tags: %w", err)
+	}
+	dropTagsQuery := `
+		DELETE FROM todo_tags tt
+		USING tags tg
+		WHERE tt.todo_id = $1 AND tg.id = tt.tag_id AND tg.list_id <> $2
+	`
+	if _, err = tx.ExecContext(ctx, dropTagsQuery, entity.ID, entity.ListID); err != nil {
+		log.C(ctx).Errorf("failed to drop todo tags: %v", err)
+		return fmt.Errorf("failed to drop todo tags: %w", err)
+	}
+	if _, err = tx.ExecContext(ctx, `DELETE FROM todo_dependencies WHERE todo_id = $1 OR blocked_by_id = $1`, entity.ID); err != nil {
+		log.C(ctx).Errorf("failed to drop todo dependencies: %v", err)
+		return fmt.Errorf("failed to drop todo dependencies: %w", err)
+	}
+	return nil
+}
+
+// CopyTags tags the todo targetID with the tags of the list of targetID named like the tags of the todo sourceID.
+func (r *SQLXTodoRepository) CopyTags(ctx context.Context, sourceID string, targetID string) error {
+	log.C(ctx).Info("copying todo tags repository")
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return err
+	}
+
+	insertQuery := `
+		INSERT INTO todo_tags (todo_id, tag_id)
+		SELECT t.id, target.id
+		FROM todo_tags tt
+		JOIN tags source ON source.id = tt.tag_id
+		JOIN todos t ON t.id = $2
+		JOIN tags target ON target.list_id = t.list_id AND target.name = source.name
+		WHERE tt.todo_id = $1
+		ON CONFLICT DO NOTHING
+	`
+	if _, err = tx.ExecContext(ctx, insertQuery, sourceID, targetID); err != nil {
+		log.C(ctx).Errorf("failed to copy todo tags: %v", err)
+		return fmt.Errorf("failed to copy todo tags: %w", err)
+	}
+	return nil
+}
+
+// InSavepoint runs fn so that a failure only undoes the changes made by fn, see db.WithSavepoint.
+func (r *SQLXTodoRepository) InSavepoint(ctx context.Context, fn func(ctx context.Context) error) error {
+	return db.WithSavepoint(ctx, "todo_operation", fn)
+}
+
+func (r *SQLXTodoRepository) selectTodos(ctx context.Context, query string, args ...interface{}) ([]models.Todo, error) {
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %v", err)
+		return nil, err
+	}
+
+	var todos []Entity
+	if err = tx.SelectContext(ctx, &todos, query, args...); err != nil {
+		log.C(ctx).Errorf("failed to get todos: %v", err)
+		return nil, fmt.Errorf("failed to get todos: %w", err)
+	}
+
+	result := make([]models.Todo, 0, len(todos))
+	for _, entity := range todos {
+		result = append(result, r.converter.ConvertTodoToModel(entity))
+	}
+	return result, nil
+}
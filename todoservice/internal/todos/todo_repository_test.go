@@ -7,9 +7,9 @@ import (
 	"fmt"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/todos"
-	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	sqlxmock "github.com/zhashkevych/go-sqlxmock"
@@ -17,6 +17,11 @@ import (
 	"time"
 )
 
+var (
+	testTags     = []models.Tag{{ID: "tagID", ListID: "listID", Name: "work", Color: "#9e9e9e"}}
+	testTagsJSON = `[{"id":"tagID","list_id":"listID","name":"work","color":"#9e9e9e"}]`
+)
+
 func TestSQLXTodoRepositoryCreate(t *testing.T) {
 	database, mockDB, err := sqlxmock.Newx()
 	require.NoError(t, err)
@@ -39,15 +44,17 @@ func TestSQLXTodoRepositoryCreate(t *testing.T) {
 				Description: "Test Description",
 				ListID:      "4cfd7e64-7431-4690-a2a0-1268917cedf3",
 				Priority:    constants.PriorityLow,
-				Tags:        pkg.JSONRawMessageFromNullableString(pkg.NewValidNullableString("tag1, tag2")),
+				Tags:        testTags,
 				DueDate:     &date,
 				StartDate:   &date,
 				AssignedTo:  &assignedTo,
+				StatusID:    "status1",
+				Position:    "V",
 			},
 			setupMocks: func() {
 				mockDB.ExpectBegin()
 				mockDB.ExpectQuery(`^INSERT INTO todos`).WithArgs(
-					"4cfd7e64-7431-4690-a2a0-1268917cedf3", "Test Todo", "Test Description", "4cfd7e64-7431-4690-a2a0-1268917cedf3", false, "tag1, tag2", constants.PriorityLow, nil, nil, "someone", sqlxmock.AnyArg(), sqlxmock.AnyArg(),
+					"4cfd7e64-7431-4690-a2a0-1268917cedf3", "Test Todo", "Test Description", "4cfd7e64-7431-4690-a2a0-1268917cedf3", false, constants.PriorityLow, nil, nil, "someone", sqlxmock.AnyArg(), sqlxmock.AnyArg(), "status1", "V",
 				).WillReturnRows(sqlxmock.NewRows([]string{"id"}).AddRow("1"))
 
 				mockDB.ExpectCommit()
@@ -63,15 +70,17 @@ func TestSQLXTodoRepositoryCreate(t *testing.T) {
 				Description: "Test Description",
 				ListID:      "4cfd7e64-7431-4690-a2a0-1268917cedf3",
 				Priority:    constants.PriorityLow,
-				Tags:        pkg.JSONRawMessageFromNullableString(pkg.NewValidNullableString("tag1, tag2")),
+				Tags:        testTags,
 				DueDate:     &date,
 				StartDate:   &date,
 				AssignedTo:  &assignedTo,
+				StatusID:    "status1",
+				Position:    "V",
 			},
 			setupMocks: func() {
 				mockDB.ExpectBegin()
 				mockDB.ExpectQuery(`^INSERT INTO todos`).WithArgs(
-					"4cfd7e64-7431-4690-a2a0-1268917cedf3", "Test Todo", "Test Description", "4cfd7e64-7431-4690-a2a0-1268917cedf3", false, "tag1, tag2", constants.PriorityLow, nil, nil, "someone", sqlxmock.AnyArg(), sqlxmock.AnyArg(),
+					"4cfd7e64-7431-4690-a2a0-1268917cedf3", "Test Todo", "Test Description", "4cfd7e64-7431-4690-a2a0-1268917cedf3", false, constants.PriorityLow, nil, nil, "someone", sqlxmock.AnyArg(), sqlxmock.AnyArg(), "status1", "V",
 				).WillReturnError(errors.New("db error"))
 				mockDB.ExpectRollback()
 			},
@@ -128,9 +137,9 @@ func TestSQLXTodoRepositoryGet(t *testing.T) {
 			id:   "4cfd7e64-7431-4690-a2a0-1268917cedf3",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectQuery("SELECT id, title, description, list_id, priority, due_date, start_date, completed, tags, created_at, updated_at, assigned_to FROM todos").WithArgs(
+				mockDB.ExpectQuery("SELECT id, title, description, list_id, priority, due_date, start_date, completed, .* AS tags, created_at, updated_at, assigned_to FROM todos").WithArgs(
 					"4cfd7e64-7431-4690-a2a0-1268917cedf3").WillReturnRows(sqlxmock.NewRows([]string{"id", "title", "description", "list_id", "priority", "due_date", "start_date", "completed", "tags", "assigned_to", "created_at", "updated_at"}).
-					AddRow("4cfd7e64-7431-4690-a2a0-1268917cedf3", "Test Todo", "Test Description", "4cfd7e64-7431-4690-a2a0-1268917cedf3", constants.PriorityLow, time.Time{}, time.Time{}, false, "tag1, tag2", "", time.Time{}, time.Time{}))
+					AddRow("4cfd7e64-7431-4690-a2a0-1268917cedf3", "Test Todo", "Test Description", "4cfd7e64-7431-4690-a2a0-1268917cedf3", constants.PriorityLow, time.Time{}, time.Time{}, false, testTagsJSON, "", time.Time{}, time.Time{}))
 
 				mockDB.ExpectCommit()
 			},
@@ -140,7 +149,7 @@ func TestSQLXTodoRepositoryGet(t *testing.T) {
 				Description: "Test Description",
 				ListID:      "4cfd7e64-7431-4690-a2a0-1268917cedf3",
 				Priority:    constants.PriorityLow,
-				Tags:        pkg.JSONRawMessageFromNullableString(pkg.NewValidNullableString("tag1, tag2")),
+				Tags:        testTags,
 				DueDate:     &time.Time{},
 				StartDate:   &time.Time{},
 				AssignedTo:  &assignedTo,
@@ -152,7 +161,7 @@ func TestSQLXTodoRepositoryGet(t *testing.T) {
 			id:   "4cfd7e64-7431-4690-a2a0-1268917cedf3",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectQuery("SELECT id, title, description, list_id, priority, due_date, start_date, completed, tags, created_at, updated_at, assigned_to FROM todos").WithArgs(
+				mockDB.ExpectQuery("SELECT id, title, description, list_id, priority, due_date, start_date, completed, .* AS tags, created_at, updated_at, assigned_to FROM todos").WithArgs(
 					"4cfd7e64-7431-4690-a2a0-1268917cedf3").WillReturnError(errors.New("db error"))
 				mockDB.ExpectRollback()
 			},
@@ -211,7 +220,7 @@ func TestSQLXTodoRepositoryUpdate(t *testing.T) {
 			setupMocks: func() {
 				mockDB.ExpectBegin()
 				mockDB.ExpectExec("UPDATE todos").
-					WithArgs("Test Todo", "Test Description", constants.PriorityLow, nil, nil, false, "tag1, tag2", "someone", "4cfd7e64-7431-4690-a2a0-1268917cedf3").
+					WithArgs("Test Todo", "Test Description", constants.PriorityLow, nil, nil, false, "someone", "4cfd7e64-7431-4690-a2a0-1268917cedf3").
 					WillReturnResult(sqlxmock.NewResult(1, 1))
 
 				mockDB.ExpectCommit()
@@ -222,7 +231,7 @@ func TestSQLXTodoRepositoryUpdate(t *testing.T) {
 				Description: "Test Description",
 				ListID:      "4cfd7e64-7431-4690-a2a0-1268917cedf3",
 				Priority:    constants.PriorityLow,
-				Tags:        pkg.JSONRawMessageFromNullableString(pkg.NewValidNullableString("tag1, tag2")),
+				Tags:        testTags,
 				DueDate:     &date,
 				StartDate:   &date,
 				AssignedTo:  &assignedTo,
@@ -235,7 +244,7 @@ func TestSQLXTodoRepositoryUpdate(t *testing.T) {
 			setupMocks: func() {
 				mockDB.ExpectBegin()
 				mockDB.ExpectExec("UPDATE todos").
-					WithArgs("Test Todo", "Test Description", constants.PriorityLow, nil, nil, false, "tag1, tag2", "someone", "4cfd7e64-7431-4690-a2a0-1268917cedf3").
+					WithArgs("Test Todo", "Test Description", constants.PriorityLow, nil, nil, false, "someone", "4cfd7e64-7431-4690-a2a0-1268917cedf3").
 					WillReturnError(errors.New("db error"))
 				mockDB.ExpectRollback()
 			},
@@ -245,7 +254,7 @@ func TestSQLXTodoRepositoryUpdate(t *testing.T) {
 				Description: "Test Description",
 				ListID:      "4cfd7e64-7431-4690-a2a0-1268917cedf3",
 				Priority:    constants.PriorityLow,
-				Tags:        pkg.JSONRawMessageFromNullableString(pkg.NewValidNullableString("tag1, tag2")),
+				Tags:        testTags,
 				DueDate:     &date,
 				StartDate:   &date,
 				AssignedTo:  &assignedTo,
@@ -365,11 +374,11 @@ func TestSQLXTodoRepositoryGetAllByListID(t *testing.T) {
 			userID: "owner_id",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectQuery("SELECT id, title, description, list_id, priority, due_date, start_date, completed, tags, created_at, updated_at FROM todos").
+				mockDB.ExpectQuery("SELECT id, title, description, list_id, priority, due_date, start_date, completed, .* AS tags, created_at, updated_at FROM todos").
 					WithArgs("owner_id").
 					WillReturnRows(sqlxmock.NewRows([]string{"id", "title", "description", "list_id", "priority", "due_date", "start_date", "completed", "tags", "created_at", "updated_at"}).
-						AddRow("1", "Todo 1", "Desc 1", "owner_id", constants.PriorityLow, nil, nil, false, "tag1, tag2", time.Time{}, time.Time{}).
-						AddRow("2", "Todo 2", "Desc 2", "owner_id", constants.PriorityLow, nil, nil, false, "tag1, tag2", time.Time{}, time.Time{}))
+						AddRow("1", "Todo 1", "Desc 1", "owner_id", constants.PriorityLow, nil, nil, false, testTagsJSON, time.Time{}, time.Time{}).
+						AddRow("2", "Todo 2", "Desc 2", "owner_id", constants.PriorityLow, nil, nil, false, testTagsJSON, time.Time{}, time.Time{}))
 				mockDB.ExpectCommit()
 			},
 			expectedLists: []models.Todo{
@@ -379,7 +388,7 @@ func TestSQLXTodoRepositoryGetAllByListID(t *testing.T) {
 					Description: "Desc 1",
 					ListID:      "owner_id",
 					Priority:    constants.PriorityLow,
-					Tags:        pkg.JSONRawMessageFromNullableString(pkg.NewValidNullableString("tag1, tag2")),
+					Tags:        testTags,
 				},
 				{
 					ID:          "2",
@@ -387,7 +396,7 @@ func TestSQLXTodoRepositoryGetAllByListID(t *testing.T) {
 					Description: "Desc 2",
 					ListID:      "owner_id",
 					Priority:    constants.PriorityLow,
-					Tags:        pkg.JSONRawMessageFromNullableString(pkg.NewValidNullableString("tag1, tag2")),
+					Tags:        testTags,
 				},
 			},
 			expectedError: nil,
@@ -397,7 +406,7 @@ func TestSQLXTodoRepositoryGetAllByListID(t *testing.T) {
 			userID: "owner-id",
 			setupMocks: func() {
 				mockDB.ExpectBegin()
-				mockDB.ExpectQuery("SELECT id, title, description, list_id, priority, due_date, start_date, completed, tags, created_at, updated_at FROM todos").
+				mockDB.ExpectQuery("SELECT id, title, description, list_id, priority, due_date, start_date, completed, .* AS tags, created_at, updated_at FROM todos").
 					WithArgs("owner-id").
 					WillReturnError(sql.ErrNoRows)
 				mockDB.ExpectRollback()
@@ -436,3 +445,343 @@ func TestSQLXTodoRepositoryGetAllByListID(t *testing.T) {
 		})
 	}
 }
+
+func TestSQLXTodoRepositorySetTags(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := todos.NewSQLXTodoRepository()
+	tagIDs := []string{"tag1", "tag2"}
+
+	testCases := []struct {
+		name          string
+		tagIDs        []string
+		setupMocks    func()
+		expectedError string
+	}{
+		{
+			name:   "Successful replace of the tags",
+			tagIDs: tagIDs,
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^DELETE FROM todo_tags`).WithArgs("1").WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectExec(`^INSERT INTO todo_tags`).WithArgs("1", pq.Array(tagIDs)).WillReturnResult(sqlxmock.NewResult(0, 2))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name:   "Successful clear of the tags",
+			tagIDs: []string{},
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^DELETE FROM todo_tags`).WithArgs("1").WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name:   "Failed because a tag belongs to another list",
+			tagIDs: tagIDs,
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^DELETE FROM todo_tags`).WithArgs("1").WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectExec(`^INSERT INTO todo_tags`).WithArgs("1", pq.Array(tagIDs)).WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectRollback()
+			},
+			expectedError: "tags must belong to the list of the todo: bad request",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			err = repo.SetTags(ctx, "1", tc.tagIDs)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				require.NoError(t, tx.Rollback())
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, tx.Commit())
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSQLXTodoRepositoryGetLastPosition(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := todos.NewSQLXTodoRepository()
+
+	testCases := []struct {
+		name             string
+		setupMocks       func()
+		expectedPosition string
+		expectedError    string
+	}{
+		{
+			name: "Success",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery(`^SELECT COALESCE\(MAX\(position\), ''\) FROM todos`).WithArgs("listID").WillReturnRows(sqlxmock.NewRows([]string{"position"}).AddRow("V"))
+				mockDB.ExpectCommit()
+			},
+			expectedPosition: "V",
+		},
+		{
+			name: "Failed because of a database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery(`^SELECT COALESCE\(MAX\(position\), ''\) FROM todos`).WithArgs("listID").WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: "failed to get last todo position: db error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			position, err := repo.GetLastPosition(ctx, "listID")
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				require.NoError(t, tx.Rollback())
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedPosition, position)
+				require.NoError(t, tx.Commit())
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSQLXTodoRepositoryLockList(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := todos.NewSQLXTodoRepository()
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedError string
+	}{
+		{
+			name: "Success",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^SELECT id FROM lists WHERE id = \$1 FOR UPDATE`).WithArgs("listID").WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "Failed because of a database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^SELECT id FROM lists WHERE id = \$1 FOR UPDATE`).WithArgs("listID").WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: "failed to lock list: db error",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			err = repo.LockList(ctx, "listID")
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				require.NoError(t, tx.Rollback())
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, tx.Commit())
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSQLXTodoRepositoryMoveToStatus(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := todos.NewSQLXTodoRepository()
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedError string
+	}{
+		{
+			name: "Success",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^UPDATE todos SET status_id`).WithArgs("status2", "V", "1").WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "Failed because the todo does not exist",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^UPDATE todos SET status_id`).WithArgs("status2", "V", "1").WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectRollback()
+			},
+			expectedError: "todo 1 not found: not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			err = repo.MoveToStatus(ctx, "1", "status2", "V")
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				require.NoError(t, tx.Rollback())
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, tx.Commit())
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSQLXTodoRepositoryRemoveDependency(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := todos.NewSQLXTodoRepository()
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedError string
+	}{
+		{
+			name: "Success",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^DELETE FROM todo_dependencies`).WithArgs("1", "2").WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "Failed because the dependency does not exist",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^DELETE FROM todo_dependencies`).WithArgs("1", "2").WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectRollback()
+			},
+			expectedError: "todo 1 is not blocked by todo 2: not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			err = repo.RemoveDependency(ctx, "1", "2")
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				require.NoError(t, tx.Rollback())
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, tx.Commit())
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
+
+func TestSQLXTodoRepositoryMoveToList(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := todos.NewSQLXTodoRepository()
+	todo := models.Todo{ID: "1", ListID: "list2", StatusID: "status2", Position: "V"}
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedError string
+	}{
+		{
+			name: "Success",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^UPDATE todos SET list_id`).WithArgs("list2", "status2", "V", nil, "1").WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectExec(`^UPDATE todo_tags tt SET tag_id`).WithArgs("1", "list2").WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectExec(`^DELETE FROM todo_tags tt USING tags`).WithArgs("1", "list2").WillReturnResult(sqlxmock.NewResult(0, 1))
+				mockDB.ExpectExec(`^DELETE FROM todo_dependencies`).WithArgs("1").WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectCommit()
+			},
+		},
+		{
+			name: "Failed because the todo does not exist",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectExec(`^UPDATE todos SET list_id`).WithArgs("list2", "status2", "V", nil, "1").WillReturnResult(sqlxmock.NewResult(0, 0))
+				mockDB.ExpectRollback()
+			},
+			expectedError: "todo 1 not found: not found",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			err = repo.MoveToList(ctx, todo)
+
+			if tc.expectedError != "" {
+				assert.EqualError(t, err, tc.expectedError)
+				require.NoError(t, tx.Rollback())
+			} else {
+				require.NoError(t, err)
+				require.NoError(t, tx.Commit())
+			}
+
+			require.NoError(t, mockDB.ExpectationsWereMet())
+		})
+	}
+}
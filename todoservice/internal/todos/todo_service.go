@@ -2,12 +2,32 @@ package todos
 
 import (
 	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/rank"
+	"slices"
 	"time"
 )
 
+// Config controls how the service enforces the dependencies between todos.
+type Config struct {
+	// BlockCompletion rejects moving a todo to a done status while any of its blockers is still open.
+	BlockCompletion bool `envconfig:"APP_TODO_BLOCK_COMPLETION" default:"true"`
+}
+
+// Actor is the user moving or copying todos between lists. Admins may write to any list.
+type Actor struct {
+	UserID string
+	Admin  bool
+}
+
 //go:generate mockery --name=TodoService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
 type TodoService interface {
 	CreateTodo(ctx context.Context, todo models.Todo) (string, error)
@@ -16,11 +36,33 @@ type TodoService interface {
 	UpdateTodo(ctx context.Context, todo models.Todo) error
 	DeleteTodo(ctx context.Context, id string) error
 	ListTodosByListID(ctx context.Context, listID string) ([]models.Todo, error)
+	ListTodosByListIDs(ctx context.Context, listIDs []string) ([]models.Todo, error)
 	CompleteTodo(ctx context.Context, id string) (models.Todo, error)
 	UpdateTodoTitle(ctx context.Context, id, name string) (models.Todo, error)
 	UpdateTodoDescription(ctx context.Context, id, description string) (models.Todo, error)
 	UpdateTodoPriority(ctx context.Context, id string, priority constants.PriorityLevel) (models.Todo, error)
 	UpdateAssignedTo(ctx context.Context, id, userID string) (models.Todo, error)
+	ListTodosByTagID(ctx context.Context, listID, tagID string) ([]models.Todo, error)
+	AddTag(ctx context.Context, id, tagID string) (models.Todo, error)
+	RemoveTag(ctx context.Context, id, tagID string) (models.Todo, error)
+	UpdateTodoStatus(ctx context.Context, id, statusID string, position *int) (models.Todo, error)
+	MoveTodo(ctx context.Context, id, beforeID, afterID string) (models.Todo, error)
+	AddDependency(ctx context.Context, id, blockedByID string) (models.Todo, error)
+	RemoveDependency(ctx context.Context, id, blockedByID string) (models.Todo, error)
+	ListBlockers(ctx context.Context, id string) ([]models.Todo, error)
+	ListBlocked(ctx context.Context, id string) ([]models.Todo, error)
+	MoveTodoToList(ctx context.Context, actor Actor, id, listID string) (models.Todo, error)
+	MoveTodosToList(ctx context.Context, actor Actor, ids []string, listID string) ([]models.Todo, error)
+	CopyTodo(ctx context.Context, actor Actor, id, listID string) (models.Todo, error)
+	BulkUpdateTodos(ctx context.Context, actor Actor, operations []models.BulkOperation) ([]models.BulkResult, error)
+}
+
+// AccessRepository reads lists and the access of users to them, it is implemented by the list repository.
+//
+//go:generate mockery --name=AccessRepository --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
+type AccessRepository interface {
+	Get(ctx context.Context, id string) (models.List, error)
+	GetAccess(ctx context.Context, listID string, userID string) (models.Access, error)
 }
 
 //go:generate mockery --name=UUIDService --output=automock --with-expecter=true --outpkg=automock --case=underscore --disable-version-string
@@ -37,12 +79,15 @@ var _ TodoService = &service{}
 
 type service struct {
 	repo        TodoRepository
+	statusRepo  statuses.StatusRepository
+	accessRepo  AccessRepository
 	uuidService UUIDService
 	timeService TimeService
+	config      Config
 }
 
-func NewService(repo TodoRepository, uuidService UUIDService, timeService TimeService) TodoService {
-	return &service{repo: repo, uuidService: uuidService, timeService: timeService}
+func NewService(repo TodoRepository, statusRepo statuses.StatusRepository, accessRepo AccessRepository, uuidService UUIDService, timeService TimeService, config Config) TodoService {
+	return &service{repo: repo, statusRepo: statusRepo, accessRepo: accessRepo, uuidService: uuidService, timeService: timeService, config: config}
 }
 
 func (s *service) CreateTodo(ctx context.Context, todo models.Todo) (string, error) {
@@ -51,13 +96,40 @@ func (s *service) CreateTodo(ctx context.Context, todo models.Todo) (string, err
 		return "", err
 	}
 
+	status, err := s.initialStatus(ctx, todo)
+	if err != nil {
+		return "", err
+	}
+	todo.StatusID = status.ID
+	todo.Completed = status.Done
+
+	if err = s.repo.LockList(ctx, todo.ListID); err != nil {
+		return "", err
+	}
+	last, err := s.repo.GetLastPosition(ctx, todo.ListID)
+	if err != nil {
+		return "", err
+	}
+	if todo.Position, err = rank.Between(last, ""); err != nil {
+		return "", rankError(err)
+	}
+
 	todo.ID = s.uuidService.Generate()
 	todo.CreatedAt = s.timeService.Now()
 	todo.UpdatedAt = s.timeService.Now()
 
 	log.C(ctx).Debugf("creating todo with id %s", todo.ID)
 
-	return s.repo.Create(ctx, todo)
+	id, err := s.repo.Create(ctx, todo)
+	if err != nil {
+		return "", err
+	}
+	if todo.TagIDs != nil {
+		if err = s.repo.SetTags(ctx, id, todo.TagIDs); err != nil {
+			return "", err
+		}
+	}
+	return id, nil
 }
 
 func (s *service) GetTodo(ctx context.Context, id string) (models.Todo, error) {
@@ -65,6 +137,8 @@ func (s *service) GetTodo(ctx context.Context, id string) (models.Todo, error) {
 	return s.repo.Get(ctx, id)
 }
 
+// UpdateTodo moves the todo to todo.StatusID when it differs from the current status. Otherwise a change of
+// todo.Completed moves the todo to the first done or not done status of its list.
 func (s *service) UpdateTodo(ctx context.Context, todo models.Todo) error {
 	log.C(ctx).Info("updating todo service")
 	if err := validateTodo(todo); err != nil {
@@ -77,7 +151,31 @@ func (s *service) UpdateTodo(ctx context.Context, todo models.Todo) error {
 		return err
 	}
 	todo.UpdatedAt = s.timeService.Now()
-	return s.repo.Update(ctx, todo)
+	if err = s.repo.Update(ctx, todo); err != nil {
+		return err
+	}
+	switch {
+	case todo.StatusID != "" && todo.StatusID != dbTodo.StatusID:
+		target, err := s.listStatus(ctx, dbTodo.ListID, todo.StatusID)
+		if err != nil {
+			return err
+		}
+		if err = s.moveToStatus(ctx, dbTodo, target, nil); err != nil {
+			return err
+		}
+	case todo.Completed != dbTodo.Completed:
+		target, err := s.firstStatus(ctx, dbTodo.ListID, todo.Completed)
+		if err != nil {
+			return err
+		}
+		if err = s.moveToStatus(ctx, dbTodo, target, nil); err != nil {
+			return err
+		}
+	}
+	if todo.TagIDs != nil {
+		return s.repo.SetTags(ctx, todo.ID, todo.TagIDs)
+	}
+	return nil
 }
 
 func (s *service) DeleteTodo(ctx context.Context, id string) error {
@@ -90,14 +188,34 @@ func (s *service) ListTodosByListID(ctx context.Context, listID string) ([]model
 	return s.repo.GetAllByListID(ctx, listID)
 }
 
+func (s *service) ListTodosByListIDs(ctx context.Context, listIDs []string) ([]models.Todo, error) {
+	log.C(ctx).Info("listing todos by list ids")
+	return s.repo.GetAllByListIDs(ctx, listIDs)
+}
+
 func (s *service) GetAllTodos(ctx context.Context) ([]models.Todo, error) {
 	log.C(ctx).Info("getting all todos service")
 	return s.repo.GetAll(ctx)
 }
 
+// CompleteTodo moves the todo to the first done status of its list unless it already is in a done status.
 func (s *service) CompleteTodo(ctx context.Context, id string) (models.Todo, error) {
 	log.C(ctx).Info("completing todo service")
-	return s.repo.CompleteTodo(ctx, id)
+	todo, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	if todo.Completed {
+		return todo, nil
+	}
+	target, err := s.firstStatus(ctx, todo.ListID, true)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	if err = s.moveToStatus(ctx, todo, target, nil); err != nil {
+		return models.Todo{}, err
+	}
+	return s.repo.Get(ctx, id)
 }
 
 func (s *service) UpdateTodoTitle(ctx context.Context, id, title string) (models.Todo, error) {
@@ -120,6 +238,634 @@ func (s *service) UpdateAssignedTo(ctx context.Context, id, userID string) (mode
 	return s.repo.UpdateAssignedTo(ctx, id, userID)
 }
 
+func (s *service) ListTodosByTagID(ctx context.Context, listID, tagID string) ([]models.Todo, error) {
+	log.C(ctx).Info("listing todos by tag id")
+	return s.repo.GetAllByTagID(ctx, listID, tagID)
+}
+
+func (s *service) AddTag(ctx context.Context, id, tagID string) (models.Todo, error) {
+	log.C(ctx).Info("adding todo tag service")
+	if err := pkg.ValidateUUID(tagID); err != nil {
+		return models.Todo{}, fmt.Errorf("invalid tag id: %w", pkg.ErrBadRequest)
+	}
+	if err := s.repo.AddTag(ctx, id, tagID); err != nil {
+		return models.Todo{}, err
+	}
+	return s.repo.Get(ctx, id)
+}
+
+func (s *service) RemoveTag(ctx context.Context, id, tagID string) (models.Todo, error) {
+	log.C(ctx).Info("removing todo tag service")
+	if err := s.repo.RemoveTag(ctx, id, tagID); err != nil {
+		return models.Todo{}, err
+	}
+	return s.repo.Get(ctx, id)
+}
+
+// UpdateTodoStatus moves the todo to position in the column of statusID, appending it when position is nil.
+func (s *service) UpdateTodoStatus(ctx context.Context, id, statusID string, position *int) (models.Todo, error) {
+	log.C(ctx).Info("updating todo status service")
+	if err := pkg.ValidateUUID(statusID); err != nil {
+		return models.Todo{}, fmt.Errorf("invalid status id: %w", pkg.ErrBadRequest)
+	}
+	if position != nil && *position < 0 {
+		return models.Todo{}, fmt.Errorf("position cannot be negative: %w", pkg.ErrBadRequest)
+	}
+	todo, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	target, err := s.listStatus(ctx, todo.ListID, statusID)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	if err = s.moveToStatus(ctx, todo, target, position); err != nil {
+		return models.Todo{}, err
+	}
+	return s.repo.Get(ctx, id)
+}
+
+// MoveTodo puts the todo right before the todo beforeID and right after the todo afterID, either of which
+// may be empty. Both neighbours must belong to the list of the todo.
+func (s *service) MoveTodo(ctx context.Context, id, beforeID, afterID string) (models.Todo, error) {
+	log.C(ctx).Info("moving todo service")
+	if beforeID == "" && afterID == "" {
+		return models.Todo{}, fmt.Errorf("a todo to move before or after is required: %w", pkg.ErrBadRequest)
+	}
+	if beforeID == id || afterID == id {
+		return models.Todo{}, fmt.Errorf("todo %s cannot be moved next to itself: %w", id, pkg.ErrBadRequest)
+	}
+	todo, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	others, err := s.otherTodos(ctx, todo)
+	if err != nil {
+		return models.Todo{}, err
+	}
+
+	after := slices.IndexFunc(others, func(other models.Todo) bool { return other.ID == afterID })
+	if afterID != "" && after < 0 {
+		return models.Todo{}, fmt.Errorf("todo %s is not in list %s: %w", afterID, todo.ListID, pkg.ErrBadRequest)
+	}
+	before := slices.IndexFunc(others, func(other models.Todo) bool { return other.ID == beforeID })
+	if beforeID != "" && before < 0 {
+		return models.Todo{}, fmt.Errorf("todo %s is not in list %s: %w", beforeID, todo.ListID, pkg.ErrBadRequest)
+	}
+	if after >= 0 && before >= 0 && after >= before {
+		return models.Todo{}, fmt.Errorf("todo %s does not come before todo %s: %w", afterID, beforeID, pkg.ErrBadRequest)
+	}
+
+	position, err := rank.Insert(positions(others), after, before)
+	if err != nil {
+		return models.Todo{}, rankError(err)
+	}
+	if err = s.repo.MoveToStatus(ctx, id, todo.StatusID, position); err != nil {
+		return models.Todo{}, err
+	}
+	return s.repo.Get(ctx, id)
+}
+
+// AddDependency makes the todo wait for the todo blockedByID of the same list. Adding a dependency that
+// already exists is a no-op, one that closes a cycle is a conflict.
+func (s *service) AddDependency(ctx context.Context, id, blockedByID string) (models.Todo, error) {
+	log.C(ctx).Info("adding todo dependency service")
+	if err := pkg.ValidateUUID(blockedByID); err != nil {
+		return models.Todo{}, fmt.Errorf("invalid blocker id: %w", pkg.ErrBadRequest)
+	}
+	if blockedByID == id {
+		return models.Todo{}, fmt.Errorf("todo %s cannot block itself: %w", id, pkg.ErrBadRequest)
+	}
+	todo, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	blocker, err := s.repo.Get(ctx, blockedByID)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	if blocker.ListID != todo.ListID {
+		return models.Todo{}, fmt.Errorf("todo %s is not in list %s: %w", blockedByID, todo.ListID, pkg.ErrBadRequest)
+	}
+
+	dependencies, err := s.repo.GetDependenciesByListID(ctx, todo.ListID)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	if waitsFor(dependencies, blockedByID, id) {
+		return models.Todo{}, fmt.Errorf("todo %s already waits for todo %s: %w", blockedByID, id, pkg.ErrConflict)
+	}
+
+	if err = s.repo.AddDependency(ctx, id, blockedByID); err != nil {
+		return models.Todo{}, err
+	}
+	return s.repo.Get(ctx, id)
+}
+
+func (s *service) RemoveDependency(ctx context.Context, id, blockedByID string) (models.Todo, error) {
+	log.C(ctx).Info("removing todo dependency service")
+	if err := s.repo.RemoveDependency(ctx, id, blockedByID); err != nil {
+		return models.Todo{}, err
+	}
+	return s.repo.Get(ctx, id)
+}
+
+func (s *service) ListBlockers(ctx context.Context, id string) ([]models.Todo, error) {
+	log.C(ctx).Info("listing todo blockers service")
+	return s.repo.GetBlockers(ctx, id)
+}
+
+func (s *service) ListBlocked(ctx context.Context, id string) ([]models.Todo, error) {
+	log.C(ctx).Info("listing todos blocked by todo service")
+	return s.repo.GetBlocked(ctx, id)
+}
+
+// MoveTodoToList appends the todo to another list. The actor needs write access to both lists and neither
+// of them may be archived. The todo keeps the status and the tags named like its current ones in the target
+// list, falling back to the first status with the same done flag, loses its dependencies and is unassigned
+// when its assignee has no access to the target list.
+func (s *service) MoveTodoToList(ctx context.Context, actor Actor, id, listID string) (models.Todo, error) {
+	log.C(ctx).Info("moving todo to list service")
+	if err := pkg.ValidateUUID(listID); err != nil {
+		return models.Todo{}, fmt.Errorf("invalid list id: %w", pkg.ErrBadRequest)
+	}
+	if err := s.checkWritable(ctx, actor, listID); err != nil {
+		return models.Todo{}, err
+	}
+	return s.moveToList(ctx, actor, id, listID)
+}
+
+// MoveTodosToList moves the todos in the given order, see MoveTodoToList. The request transaction makes
+// the move all or nothing.
+func (s *service) MoveTodosToList(ctx context.Context, actor Actor, ids []string, listID string) ([]models.Todo, error) {
+	log.C(ctx).Info("moving todos to list service")
+	if len(ids) == 0 {
+		return nil, fmt.Errorf("at least one todo to move is required: %w", pkg.ErrBadRequest)
+	}
+	if err := pkg.ValidateUUID(listID); err != nil {
+		return nil, fmt.Errorf("invalid list id: %w", pkg.ErrBadRequest)
+	}
+	if err := s.checkWritable(ctx, actor, listID); err != nil {
+		return nil, err
+	}
+
+	result := make([]models.Todo, 0, len(ids))
+	for _, id := range ids {
+		todo, err := s.moveToList(ctx, actor, id, listID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to move todo %s: %w", id, err)
+		}
+		result = append(result, todo)
+	}
+	return result, nil
+}
+
+// CopyTodo appends a copy of the todo to a list, which may be the list of the todo. Like a move it is
+// rejected when either list is archived. The copy gets the status, tags and assignee the todo would get when
+// moved there, but no comments, attachments or dependencies.
+func (s *service) CopyTodo(ctx context.Context, actor Actor, id, listID string) (models.Todo, error) {
+	log.C(ctx).Info("copying todo service")
+	if err := pkg.ValidateUUID(listID); err != nil {
+		return models.Todo{}, fmt.Errorf("invalid list id: %w", pkg.ErrBadRequest)
+	}
+	todo, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	if err = s.checkWritable(ctx, actor, todo.ListID); err != nil {
+		return models.Todo{}, err
+	}
+	if listID != todo.ListID {
+		if err = s.checkWritable(ctx, actor, listID); err != nil {
+			return models.Todo{}, err
+		}
+	}
+
+	copied, err := s.placeInList(ctx, todo, listID)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	copied.ID = s.uuidService.Generate()
+	copied.CreatedAt = s.timeService.Now()
+	copied.UpdatedAt = s.timeService.Now()
+
+	if _, err = s.repo.Create(ctx, copied); err != nil {
+		return models.Todo{}, err
+	}
+	if err = s.repo.CopyTags(ctx, todo.ID, copied.ID); err != nil {
+		return models.Todo{}, err
+	}
+	return s.repo.Get(ctx, copied.ID)
+}
+
+// BulkUpdateTodos applies the operations in order. Every operation is authorized on its own and runs in a
+// savepoint of the request transaction, so a failed operation is undone and reported in its result while the
+// others are kept. Archived lists are read-only for everyone, admins included.
+func (s *service) BulkUpdateTodos(ctx context.Context, actor Actor, operations []models.BulkOperation) ([]models.BulkResult, error) {
+	log.C(ctx).Info("bulk updating todos service")
+	if len(operations) == 0 {
+		return nil, fmt.Errorf("at least one operation is required: %w", pkg.ErrBadRequest)
+	}
+	if len(operations) > constants.MaxBatchIDs {
+		return nil, fmt.Errorf("at most %d operations are allowed: %w", constants.MaxBatchIDs, pkg.ErrBadRequest)
+	}
+
+	results := make([]models.BulkResult, 0, len(operations))
+	for _, operation := range operations {
+		result := models.BulkResult{Operation: operation}
+		result.Err = s.repo.InSavepoint(ctx, func(ctx context.Context) error {
+			todo, err := s.applyBulkOperation(ctx, actor, operation)
+			if err != nil {
+				return err
+			}
+			result.Todo = todo
+			return nil
+		})
+		// Serialization failures and deadlocks fail the whole batch so that the request transaction is retried.
+		if _, retryable := db.RetryableSQLState(result.Err); retryable {
+			return nil, result.Err
+		}
+		if result.Err != nil {
+			log.C(ctx).Debugf("bulk %s of todo %s failed: %v", operation.Action, operation.TodoID, result.Err)
+			result.Todo = nil
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+func (s *service) applyBulkOperation(ctx context.Context, actor Actor, operation models.BulkOperation) (*models.Todo, error) {
+	if err := validateBulkOperation(operation); err != nil {
+		return nil, err
+	}
+	todo, err := s.repo.Get(ctx, operation.TodoID)
+	if errors.Is(err, sql.ErrNoRows) && !actor.Admin {
+		// Answer like for a todo of a list the actor cannot write to, so that the result does not reveal which todos exist.
+		return nil, fmt.Errorf("user %s cannot write to todo %s: %w", actor.UserID, operation.TodoID, pkg.ErrForbidden)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err = s.checkWritable(ctx, actor, todo.ListID); err != nil {
+		return nil, err
+	}
+
+	var updated models.Todo
+	switch operation.Action {
+	case models.BulkComplete:
+		updated, err = s.CompleteTodo(ctx, todo.ID)
+	case models.BulkDelete:
+		return nil, s.repo.Delete(ctx, todo.ID)
+	case models.BulkReassign:
+		updated, err = s.reassign(ctx, todo, operation.AssignedTo)
+	case models.BulkSetPriority:
+		updated, err = s.repo.UpdateTodoPriority(ctx, todo.ID, operation.Priority)
+	case models.BulkMove:
+		updated = todo
+		if operation.ListID != todo.ListID {
+			if err = s.checkWritable(ctx, actor, operation.ListID); err != nil {
+				return nil, err
+			}
+			updated, err = s.relocate(ctx, todo, operation.ListID)
+		}
+	case models.BulkAddTag:
+		updated, err = s.AddTag(ctx, todo.ID, operation.TagID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &updated, nil
+}
+
+// reassign assigns the todo to a user who owns its list or accepted an invitation to it.
+func (s *service) reassign(ctx context.Context, todo models.Todo, userID string) (models.Todo, error) {
+	member, err := s.isMember(ctx, todo.ListID, userID)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	if !member {
+		return models.Todo{}, fmt.Errorf("user %s has no access to list %s: %w", userID, todo.ListID, pkg.ErrBadRequest)
+	}
+	return s.repo.UpdateAssignedTo(ctx, todo.ID, userID)
+}
+
+func (s *service) moveToList(ctx context.Context, actor Actor, id, listID string) (models.Todo, error) {
+	todo, err := s.repo.Get(ctx, id)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	if todo.ListID == listID {
+		return todo, nil
+	}
+	if err = s.checkWritable(ctx, actor, todo.ListID); err != nil {
+		return models.Todo{}, err
+	}
+	return s.relocate(ctx, todo, listID)
+}
+
+// relocate appends the todo to the list as placeInList describes it.
+func (s *service) relocate(ctx context.Context, todo models.Todo, listID string) (models.Todo, error) {
+	moved, err := s.placeInList(ctx, todo, listID)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	if err = s.repo.MoveToList(ctx, moved); err != nil {
+		return models.Todo{}, err
+	}
+	return s.repo.Get(ctx, todo.ID)
+}
+
+// placeInList returns the todo as it would be at the end of the list.
+func (s *service) placeInList(ctx context.Context, todo models.Todo, listID string) (models.Todo, error) {
+	status, err := s.matchingStatus(ctx, todo, listID)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	if err = s.repo.LockList(ctx, listID); err != nil {
+		return models.Todo{}, err
+	}
+	last, err := s.repo.GetLastPosition(ctx, listID)
+	if err != nil {
+		return models.Todo{}, err
+	}
+	position, err := rank.Between(last, "")
+	if err != nil {
+		return models.Todo{}, rankError(err)
+	}
+	if todo.AssignedTo != nil && *todo.AssignedTo != "" {
+		member, err := s.isMember(ctx, listID, *todo.AssignedTo)
+		if err != nil {
+			return models.Todo{}, err
+		}
+		if !member {
+			log.C(ctx).Debugf("unassigning todo %s, user %s has no access to list %s", todo.ID, *todo.AssignedTo, listID)
+			todo.AssignedTo = nil
+		}
+	}
+
+	todo.ListID = listID
+	todo.StatusID = status.ID
+	todo.Completed = status.Done
+	todo.Position = position
+	return todo, nil
+}
+
+// matchingStatus returns the status of the list named like the status of the todo, or the first status
+// of the list with the same done flag.
+func (s *service) matchingStatus(ctx context.Context, todo models.Todo, listID string) (models.Status, error) {
+	if todo.StatusID != "" {
+		current, err := s.statusRepo.Get(ctx, todo.ListID, todo.StatusID)
+		if err != nil {
+			return models.Status{}, err
+		}
+		if current.ListID == listID {
+			return current, nil
+		}
+		candidates, err := s.statusRepo.GetAllByListID(ctx, listID)
+		if err != nil {
+			return models.Status{}, err
+		}
+		for _, candidate := range candidates {
+			if candidate.Name == current.Name {
+				return candidate, nil
+			}
+		}
+	}
+	return s.firstStatus(ctx, listID, todo.Completed)
+}
+
+func (s *service) checkWriteAccess(ctx context.Context, actor Actor, listID string) error {
+	if actor.Admin {
+		return nil
+	}
+	access, err := s.accessRepo.GetAccess(ctx, listID, actor.UserID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return fmt.Errorf("user %s has no access to list %s: %w", actor.UserID, listID, pkg.ErrForbidden)
+	}
+	if err != nil {
+		return err
+	}
+	if access.Status == constants.StatusPending || constants.RolePower(access.Role) < constants.RolePower(constants.Writer) {
+		return fmt.Errorf("user %s cannot write to list %s: %w", actor.UserID, listID, pkg.ErrForbidden)
+	}
+	return nil
+}
+
+// checkWritable checks that the actor may write to the list and that the list is not archived.
+func (s *service) checkWritable(ctx context.Context, actor Actor, listID string) error {
+	if err := s.checkWriteAccess(ctx, actor, listID); err != nil {
+		return err
+	}
+	list, err := s.accessRepo.Get(ctx, listID)
+	if err != nil {
+		return err
+	}
+	if list.ArchivedAt != nil {
+		return fmt.Errorf("list %s is archived and read-only: %w", listID, pkg.ErrConflict)
+	}
+	return nil
+}
+
+// isMember reports whether the user owns the list or accepted an invitation to it.
+func (s *service) isMember(ctx context.Context, listID, userID string) (bool, error) {
+	access, err := s.accessRepo.GetAccess(ctx, listID, userID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return access.Status != constants.StatusPending, nil
+}
+
+// moveToStatus enforces the allowed transitions of the current status of the todo and, when configured,
+// keeps a todo out of the done statuses while it has open blockers. Reordering a todo within its status
+// is always allowed.
+func (s *service) moveToStatus(ctx context.Context, todo models.Todo, target models.Status, index *int) error {
+	if todo.StatusID != "" && todo.StatusID != target.ID {
+		current, err := s.statusRepo.Get(ctx, todo.ListID, todo.StatusID)
+		if err != nil {
+			return err
+		}
+		if len(current.AllowedNext) > 0 && !slices.Contains(current.AllowedNext, target.ID) {
+			return fmt.Errorf("todos in status %q cannot move to status %q: %w", current.Name, target.Name, pkg.ErrConflict)
+		}
+	}
+	if target.Done && !todo.Completed && s.config.BlockCompletion {
+		blockers, err := s.repo.GetBlockers(ctx, todo.ID)
+		if err != nil {
+			return err
+		}
+		open := slices.DeleteFunc(blockers, func(blocker models.Todo) bool { return blocker.Completed })
+		if len(open) > 0 {
+			return fmt.Errorf("todo %s is blocked by %d open todos: %w", todo.ID, len(open), pkg.ErrConflict)
+		}
+	}
+	position, err := s.columnPosition(ctx, todo, target.ID, index)
+	if err != nil {
+		return err
+	}
+	return s.repo.MoveToStatus(ctx, todo.ID, target.ID, position)
+}
+
+// columnPosition returns the position that puts the todo at index of the column of statusID, or at the end
+// of the column when index is nil or past its end. The todo goes to the end of the list for an empty column.
+func (s *service) columnPosition(ctx context.Context, todo models.Todo, statusID string, index *int) (string, error) {
+	others, err := s.otherTodos(ctx, todo)
+	if err != nil {
+		return "", err
+	}
+	before, last, count := -1, -1, 0
+	for i, other := range others {
+		if other.StatusID != statusID {
+			continue
+		}
+		if index != nil && *index == count {
+			before = i
+		}
+		last = i
+		count++
+	}
+
+	var position string
+	switch {
+	case before >= 0:
+		position, err = rank.Insert(positions(others), -1, before)
+	case last >= 0:
+		position, err = rank.Insert(positions(others), last, -1)
+	default:
+		position, err = rank.Insert(positions(others), -1, -1)
+	}
+	if err != nil {
+		return "", rankError(err)
+	}
+	return position, nil
+}
+
+// otherTodos locks the list of todo and returns its todos without todo itself, ordered by position.
+func (s *service) otherTodos(ctx context.Context, todo models.Todo) ([]models.Todo, error) {
+	if err := s.repo.LockList(ctx, todo.ListID); err != nil {
+		return nil, err
+	}
+	todos, err := s.repo.GetAllByListID(ctx, todo.ListID)
+	if err != nil {
+		return nil, err
+	}
+	return slices.DeleteFunc(todos, func(other models.Todo) bool { return other.ID == todo.ID }), nil
+}
+
+// rankError reports two todos sharing a position as a conflict, no position sorts between them.
+func rankError(err error) error {
+	if errors.Is(err, rank.ErrOutOfOrder) {
+		return fmt.Errorf("failed to rank todo: %w: %w", err, pkg.ErrConflict)
+	}
+	return fmt.Errorf("failed to rank todo: %w", err)
+}
+
+func positions(todos []models.Todo) []string {
+	result := make([]string, 0, len(todos))
+	for _, todo := range todos {
+		result = append(result, todo.Position)
+	}
+	return result
+}
+
+// initialStatus validates the status requested for a new todo or picks the first status matching todo.Completed.
+func (s *service) initialStatus(ctx context.Context, todo models.Todo) (models.Status, error) {
+	if todo.StatusID != "" {
+		if err := pkg.ValidateUUID(todo.StatusID); err != nil {
+			return models.Status{}, fmt.Errorf("invalid status id: %w", pkg.ErrBadRequest)
+		}
+		return s.listStatus(ctx, todo.ListID, todo.StatusID)
+	}
+	return s.firstStatus(ctx, todo.ListID, todo.Completed)
+}
+
+// listStatus reports a status outside of the list as a bad request rather than as a missing resource.
+func (s *service) listStatus(ctx context.Context, listID, statusID string) (models.Status, error) {
+	status, err := s.statusRepo.Get(ctx, listID, statusID)
+	if errors.Is(err, sql.ErrNoRows) {
+		return models.Status{}, fmt.Errorf("status %s does not belong to list %s: %w", statusID, listID, pkg.ErrBadRequest)
+	}
+	return status, err
+}
+
+func (s *service) firstStatus(ctx context.Context, listID string, done bool) (models.Status, error) {
+	status, err := s.statusRepo.GetFirst(ctx, listID, done)
+	if errors.Is(err, pkg.ErrNotFound) {
+		kind := "open"
+		if done {
+			kind = "done"
+		}
+		return models.Status{}, fmt.Errorf("list %s has no %s status: %w", listID, kind, pkg.ErrBadRequest)
+	}
+	return status, err
+}
+
+// waitsFor reports whether the todo from waits for the todo to, directly or through other todos.
+func waitsFor(dependencies []models.Dependency, from, to string) bool {
+	blockers := make(map[string][]string)
+	for _, dependency := range dependencies {
+		blockers[dependency.TodoID] = append(blockers[dependency.TodoID], dependency.BlockedByID)
+	}
+	visited := map[string]bool{from: true}
+	queue := []string{from}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, next := range blockers[current] {
+			if next == to {
+				return true
+			}
+			if !visited[next] {
+				visited[next] = true
+				queue = append(queue, next)
+			}
+		}
+	}
+	return false
+}
+
 func validateTodo(todo models.Todo) error {
+	seen := make(map[string]struct{}, len(todo.TagIDs))
+	for _, tagID := range todo.TagIDs {
+		if err := pkg.ValidateUUID(tagID); err != nil {
+			return fmt.Errorf("invalid tag id %q: %w", tagID, pkg.ErrBadRequest)
+		}
+		if _, ok := seen[tagID]; ok {
+			return fmt.Errorf("tag %s is listed more than once: %w", tagID, pkg.ErrBadRequest)
+		}
+		seen[tagID] = struct{}{}
+	}
+	return nil
+}
+
+func validateBulkOperation(operation models.BulkOperation) error {
+	if err := pkg.ValidateUUID(operation.TodoID); err != nil {
+		return fmt.Errorf("invalid todo id: %w", pkg.ErrBadRequest)
+	}
+	switch operation.Action {
+	case models.BulkComplete, models.BulkDelete:
+	case models.BulkReassign:
+		if err := pkg.ValidateUUID(operation.AssignedTo); err != nil {
+			return fmt.Errorf("invalid assignee id: %w", pkg.ErrBadRequest)
+		}
+	case models.BulkSetPriority:
+		switch operation.Priority {
+		case constants.PriorityHigh, constants.PriorityMedium, constants.PriorityLow:
+		default:
+			return fmt.Errorf("invalid priority %q: %w", operation.Priority, pkg.ErrBadRequest)
+		}
+	case models.BulkMove:
+		if err := pkg.ValidateUUID(operation.ListID); err != nil {
+			return fmt.Errorf("invalid list id: %w", pkg.ErrBadRequest)
+		}
+	case models.BulkAddTag:
+		if err := pkg.ValidateUUID(operation.TagID); err != nil {
+			return fmt.Errorf("invalid tag id: %w", pkg.ErrBadRequest)
+		}
+	default:
+		return fmt.Errorf("unknown action %q: %w", operation.Action, pkg.ErrBadRequest)
+	}
 	return nil
 }
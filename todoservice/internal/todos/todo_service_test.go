@@ -2,11 +2,17 @@ package todos_test
 
 import (
 	"context"
+	"database/sql"
 	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
+	statusmock "github.com/Victor-Uzunov/devops-project/todoservice/internal/statuses/automock"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/todos"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/todos/automock"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
@@ -14,8 +20,26 @@ import (
 	"time"
 )
 
+const (
+	todoStatusID     = "5f3c9c3e-6d8a-4d8e-9a51-0b7a6f1c2d01"
+	progressStatusID = "5f3c9c3e-6d8a-4d8e-9a51-0b7a6f1c2d02"
+	doneStatusID     = "5f3c9c3e-6d8a-4d8e-9a51-0b7a6f1c2d03"
+)
+
+var (
+	todoStatus     = models.Status{ID: todoStatusID, ListID: "1", Name: "To Do", Position: 0, AllowedNext: []string{progressStatusID}}
+	progressStatus = models.Status{ID: progressStatusID, ListID: "1", Name: "In Progress", Position: 1, AllowedNext: []string{}}
+	doneStatus     = models.Status{ID: doneStatusID, ListID: "1", Name: "Done", Position: 2, Done: true, AllowedNext: []string{}}
+)
+
+func withTagIDs(todo models.Todo, tagIDs ...string) models.Todo {
+	todo.TagIDs = tagIDs
+	return todo
+}
+
 func TestServiceCreateTodo(t *testing.T) {
 	id := "1"
+	tagID := "4cfd7e64-7431-4690-a2a0-1268917cedf3"
 	mockTime := time.Time{}
 	err := errors.New("error")
 	ctx := context.Background()
@@ -33,6 +57,8 @@ func TestServiceCreateTodo(t *testing.T) {
 		Description: "Test description",
 		ListID:      "1",
 		Priority:    constants.PriorityLow,
+		StatusID:    todoStatusID,
+		Position:    "V",
 		CreatedAt:   mockTime,
 		UpdatedAt:   mockTime,
 	}
@@ -41,6 +67,7 @@ func TestServiceCreateTodo(t *testing.T) {
 		name          string
 		uuidService   func() *automock.UUIDService
 		repo          func() *automock.TodoRepository
+		statusRepo    func() *statusmock.StatusRepository
 		timeService   func() *automock.TimeService
 		input         models.Todo
 		expectedError error
@@ -54,9 +81,16 @@ func TestServiceCreateTodo(t *testing.T) {
 			},
 			repo: func() *automock.TodoRepository {
 				repo := &automock.TodoRepository{}
+				repo.EXPECT().LockList(ctx, "1").Return(nil).Once()
+				repo.EXPECT().GetLastPosition(ctx, "1").Return("", nil).Once()
 				repo.EXPECT().Create(ctx, model).Return(id, nil).Once()
 				return repo
 			},
+			statusRepo: func() *statusmock.StatusRepository {
+				statusRepo := &statusmock.StatusRepository{}
+				statusRepo.EXPECT().GetFirst(ctx, "1", false).Return(todoStatus, nil).Once()
+				return statusRepo
+			},
 			timeService: func() *automock.TimeService {
 				timeService := &automock.TimeService{}
 				timeService.EXPECT().Now().Return(mockTime).Twice()
@@ -74,9 +108,16 @@ func TestServiceCreateTodo(t *testing.T) {
 			},
 			repo: func() *automock.TodoRepository {
 				repo := &automock.TodoRepository{}
+				repo.EXPECT().LockList(ctx, "1").Return(nil).Once()
+				repo.EXPECT().GetLastPosition(ctx, "1").Return("", nil).Once()
 				repo.EXPECT().Create(ctx, model).Return("", err).Once()
 				return repo
 			},
+			statusRepo: func() *statusmock.StatusRepository {
+				statusRepo := &statusmock.StatusRepository{}
+				statusRepo.EXPECT().GetFirst(ctx, "1", false).Return(todoStatus, nil).Once()
+				return statusRepo
+			},
 			timeService: func() *automock.TimeService {
 				timeService := &automock.TimeService{}
 				timeService.EXPECT().Now().Return(mockTime).Twice()
@@ -85,15 +126,61 @@ func TestServiceCreateTodo(t *testing.T) {
 			input:         modelInput,
 			expectedError: err,
 		},
+		{
+			name: "Create new todo with tags",
+			uuidService: func() *automock.UUIDService {
+				uuidService := &automock.UUIDService{}
+				uuidService.EXPECT().Generate().Return(id).Once()
+				return uuidService
+			},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().LockList(ctx, "1").Return(nil).Once()
+				repo.EXPECT().GetLastPosition(ctx, "1").Return("", nil).Once()
+				repo.EXPECT().Create(ctx, withTagIDs(model, tagID)).Return(id, nil).Once()
+				repo.EXPECT().SetTags(ctx, id, []string{tagID}).Return(nil).Once()
+				return repo
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				statusRepo := &statusmock.StatusRepository{}
+				statusRepo.EXPECT().GetFirst(ctx, "1", false).Return(todoStatus, nil).Once()
+				return statusRepo
+			},
+			timeService: func() *automock.TimeService {
+				timeService := &automock.TimeService{}
+				timeService.EXPECT().Now().Return(mockTime).Twice()
+				return timeService
+			},
+			input:         withTagIDs(modelInput, tagID),
+			expectedError: nil,
+		},
+		{
+			name: "Error when a tag is repeated",
+			uuidService: func() *automock.UUIDService {
+				return &automock.UUIDService{}
+			},
+			repo: func() *automock.TodoRepository {
+				return &automock.TodoRepository{}
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				return &statusmock.StatusRepository{}
+			},
+			timeService: func() *automock.TimeService {
+				return &automock.TimeService{}
+			},
+			input:         withTagIDs(modelInput, tagID, tagID),
+			expectedError: pkg.ErrBadRequest,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			uuidService := tt.uuidService()
 			repo := tt.repo()
+			statusRepo := tt.statusRepo()
 			timeService := tt.timeService()
-			defer mock.AssertExpectationsForObjects(t, uuidService, repo, timeService)
+			defer mock.AssertExpectationsForObjects(t, uuidService, repo, statusRepo, timeService)
 
-			svc := todos.NewService(repo, uuidService, timeService)
+			svc := todos.NewService(repo, statusRepo, &automock.AccessRepository{}, uuidService, timeService, todos.Config{})
 			_, err := svc.CreateTodo(ctx, tt.input)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -166,7 +253,7 @@ func TestServiceGetTodo(t *testing.T) {
 			timeService := tt.timeService()
 			defer mock.AssertExpectationsForObjects(t, uuidService, repo, timeService)
 
-			svc := todos.NewService(repo, uuidService, timeService)
+			svc := todos.NewService(repo, &statusmock.StatusRepository{}, &automock.AccessRepository{}, uuidService, timeService, todos.Config{})
 			_, err := svc.GetTodo(ctx, id)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -256,7 +343,7 @@ func TestServiceUpdateTodo(t *testing.T) {
 			timeService := tt.timeService()
 			defer mock.AssertExpectationsForObjects(t, uuidService, repo, timeService)
 
-			svc := todos.NewService(repo, uuidService, timeService)
+			svc := todos.NewService(repo, &statusmock.StatusRepository{}, &automock.AccessRepository{}, uuidService, timeService, todos.Config{})
 			err := svc.UpdateTodo(ctx, modelInput)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -322,7 +409,7 @@ func TestServiceDeleteTodo(t *testing.T) {
 			timeService := tt.timeService()
 			defer mock.AssertExpectationsForObjects(t, uuidService, repo, timeService)
 
-			svc := todos.NewService(repo, uuidService, timeService)
+			svc := todos.NewService(repo, &statusmock.StatusRepository{}, &automock.AccessRepository{}, uuidService, timeService, todos.Config{})
 			err := svc.DeleteTodo(ctx, id)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -396,7 +483,7 @@ func TestServiceListTodosByListID(t *testing.T) {
 			timeService := tt.timeService()
 			defer mock.AssertExpectationsForObjects(t, uuidService, repo, timeService)
 
-			svc := todos.NewService(repo, uuidService, timeService)
+			svc := todos.NewService(repo, &statusmock.StatusRepository{}, &automock.AccessRepository{}, uuidService, timeService, todos.Config{})
 			_, err := svc.ListTodosByListID(ctx, id)
 			if tt.expectedError != nil {
 				require.Error(t, err)
@@ -407,3 +494,1154 @@ func TestServiceListTodosByListID(t *testing.T) {
 		})
 	}
 }
+
+func TestServiceAddTag(t *testing.T) {
+	id := "1"
+	tagID := "4cfd7e64-7431-4690-a2a0-1268917cedf3"
+	err := errors.New("error")
+	ctx := context.Background()
+
+	model := models.Todo{
+		ID:     id,
+		ListID: "1",
+		Tags:   []models.Tag{{ID: tagID, ListID: "1", Name: "work", Color: "#9e9e9e"}},
+	}
+
+	tests := []struct {
+		name          string
+		tagID         string
+		repo          func() *automock.TodoRepository
+		expected      models.Todo
+		expectedError error
+	}{
+		{
+			name:  "Add tag to todo",
+			tagID: tagID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().AddTag(ctx, id, tagID).Return(nil).Once()
+				repo.EXPECT().Get(ctx, id).Return(model, nil).Once()
+				return repo
+			},
+			expected: model,
+		},
+		{
+			name:  "Error when tag id is invalid",
+			tagID: "not-a-uuid",
+			repo: func() *automock.TodoRepository {
+				return &automock.TodoRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+		{
+			name:  "Error when repo add tag fails",
+			tagID: tagID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().AddTag(ctx, id, tagID).Return(err).Once()
+				return repo
+			},
+			expectedError: err,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer repo.AssertExpectations(t)
+
+			svc := todos.NewService(repo, &statusmock.StatusRepository{}, &automock.AccessRepository{}, &automock.UUIDService{}, &automock.TimeService{}, todos.Config{})
+			todo, err := svc.AddTag(ctx, id, tt.tagID)
+			if tt.expectedError != nil {
+				require.Error(t, err)
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				assert.NoError(t, err)
+				assert.Equal(t, tt.expected, todo)
+			}
+		})
+	}
+}
+
+func TestServiceUpdateTodoStatus(t *testing.T) {
+	id := "1"
+	ctx := context.Background()
+	position := 0
+	todo := models.Todo{ID: id, ListID: "1", StatusID: todoStatusID, Position: "3V"}
+	moved := models.Todo{ID: id, ListID: "1", StatusID: progressStatusID, Position: "3"}
+
+	tests := []struct {
+		name          string
+		statusID      string
+		position      *int
+		repo          func() *automock.TodoRepository
+		statusRepo    func() *statusmock.StatusRepository
+		expected      models.Todo
+		expectedError error
+	}{
+		{
+			name:     "Move todo along an allowed transition",
+			statusID: progressStatusID,
+			position: &position,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				repo.EXPECT().LockList(ctx, "1").Return(nil).Once()
+				repo.EXPECT().GetAllByListID(ctx, "1").Return(listTodos(todo), nil).Once()
+				repo.EXPECT().MoveToStatus(ctx, id, progressStatusID, "3").Return(nil).Once()
+				repo.EXPECT().Get(ctx, id).Return(moved, nil).Once()
+				return repo
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				statusRepo := &statusmock.StatusRepository{}
+				statusRepo.EXPECT().Get(ctx, "1", progressStatusID).Return(progressStatus, nil).Once()
+				statusRepo.EXPECT().Get(ctx, "1", todoStatusID).Return(todoStatus, nil).Once()
+				return statusRepo
+			},
+			expected: moved,
+		},
+		{
+			name:     "Reorder todo within its status",
+			statusID: todoStatusID,
+			position: &position,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				repo.EXPECT().LockList(ctx, "1").Return(nil).Once()
+				repo.EXPECT().GetAllByListID(ctx, "1").Return(listTodos(todo), nil).Once()
+				repo.EXPECT().MoveToStatus(ctx, id, todoStatusID, "1").Return(nil).Once()
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				return repo
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				statusRepo := &statusmock.StatusRepository{}
+				statusRepo.EXPECT().Get(ctx, "1", todoStatusID).Return(todoStatus, nil).Once()
+				return statusRepo
+			},
+			expected: todo,
+		},
+		{
+			name:     "Error when the transition is not allowed",
+			statusID: doneStatusID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				return repo
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				statusRepo := &statusmock.StatusRepository{}
+				statusRepo.EXPECT().Get(ctx, "1", doneStatusID).Return(doneStatus, nil).Once()
+				statusRepo.EXPECT().Get(ctx, "1", todoStatusID).Return(todoStatus, nil).Once()
+				return statusRepo
+			},
+			expectedError: pkg.ErrConflict,
+		},
+		{
+			name:     "Error when the status belongs to another list",
+			statusID: doneStatusID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				return repo
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				statusRepo := &statusmock.StatusRepository{}
+				statusRepo.EXPECT().Get(ctx, "1", doneStatusID).Return(models.Status{}, fmt.Errorf("status not found: %w", sql.ErrNoRows)).Once()
+				return statusRepo
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+		{
+			name:     "Error when the status id is invalid",
+			statusID: "done",
+			repo: func() *automock.TodoRepository {
+				return &automock.TodoRepository{}
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				return &statusmock.StatusRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			statusRepo := tt.statusRepo()
+			defer mock.AssertExpectationsForObjects(t, repo, statusRepo)
+
+			svc := todos.NewService(repo, statusRepo, &automock.AccessRepository{}, &automock.UUIDService{}, &automock.TimeService{}, todos.Config{})
+			result, err := svc.UpdateTodoStatus(ctx, id, tt.statusID, tt.position)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceCompleteTodo(t *testing.T) {
+	id := "1"
+	ctx := context.Background()
+	todo := models.Todo{ID: id, ListID: "1", StatusID: progressStatusID}
+	completed := models.Todo{ID: id, ListID: "1", StatusID: doneStatusID, Completed: true}
+
+	tests := []struct {
+		name          string
+		repo          func() *automock.TodoRepository
+		statusRepo    func() *statusmock.StatusRepository
+		expected      models.Todo
+		expectedError error
+	}{
+		{
+			name: "Move todo to the first done status",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				repo.EXPECT().GetBlockers(ctx, id).Return([]models.Todo{{ID: "a", ListID: "1", Completed: true}}, nil).Once()
+				repo.EXPECT().LockList(ctx, "1").Return(nil).Once()
+				repo.EXPECT().GetAllByListID(ctx, "1").Return(listTodos(todo), nil).Once()
+				repo.EXPECT().MoveToStatus(ctx, id, doneStatusID, "Z").Return(nil).Once()
+				repo.EXPECT().Get(ctx, id).Return(completed, nil).Once()
+				return repo
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				statusRepo := &statusmock.StatusRepository{}
+				statusRepo.EXPECT().GetFirst(ctx, "1", true).Return(doneStatus, nil).Once()
+				statusRepo.EXPECT().Get(ctx, "1", progressStatusID).Return(progressStatus, nil).Once()
+				return statusRepo
+			},
+			expected: completed,
+		},
+		{
+			name: "Keep a completed todo in its status",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(completed, nil).Once()
+				return repo
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				return &statusmock.StatusRepository{}
+			},
+			expected: completed,
+		},
+		{
+			name: "Error when a blocker is still open",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				repo.EXPECT().GetBlockers(ctx, id).Return([]models.Todo{{ID: "a", ListID: "1", Completed: true}, {ID: "b", ListID: "1"}}, nil).Once()
+				return repo
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				statusRepo := &statusmock.StatusRepository{}
+				statusRepo.EXPECT().GetFirst(ctx, "1", true).Return(doneStatus, nil).Once()
+				statusRepo.EXPECT().Get(ctx, "1", progressStatusID).Return(progressStatus, nil).Once()
+				return statusRepo
+			},
+			expectedError: pkg.ErrConflict,
+		},
+		{
+			name: "Error when the list has no done status",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				return repo
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				statusRepo := &statusmock.StatusRepository{}
+				statusRepo.EXPECT().GetFirst(ctx, "1", true).Return(models.Status{}, fmt.Errorf("no status: %w", pkg.ErrNotFound)).Once()
+				return statusRepo
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			statusRepo := tt.statusRepo()
+			defer mock.AssertExpectationsForObjects(t, repo, statusRepo)
+
+			svc := todos.NewService(repo, statusRepo, &automock.AccessRepository{}, &automock.UUIDService{}, &automock.TimeService{}, todos.Config{BlockCompletion: true})
+			result, err := svc.CompleteTodo(ctx, id)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceMoveTodo(t *testing.T) {
+	id := "1"
+	ctx := context.Background()
+	todo := models.Todo{ID: id, ListID: "1", StatusID: todoStatusID, Position: "3V"}
+
+	tests := []struct {
+		name          string
+		beforeID      string
+		afterID       string
+		repo          func() *automock.TodoRepository
+		expectedError error
+	}{
+		{
+			name:    "Move todo after a neighbour",
+			afterID: "b",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				repo.EXPECT().LockList(ctx, "1").Return(nil).Once()
+				repo.EXPECT().GetAllByListID(ctx, "1").Return(listTodos(todo), nil).Once()
+				repo.EXPECT().MoveToStatus(ctx, id, todoStatusID, "6").Return(nil).Once()
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				return repo
+			},
+		},
+		{
+			name:     "Move todo to the top of the list",
+			beforeID: "a",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				repo.EXPECT().LockList(ctx, "1").Return(nil).Once()
+				repo.EXPECT().GetAllByListID(ctx, "1").Return(listTodos(todo), nil).Once()
+				repo.EXPECT().MoveToStatus(ctx, id, todoStatusID, "1").Return(nil).Once()
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				return repo
+			},
+		},
+		{
+			name:     "Move todo between both neighbours",
+			beforeID: "c",
+			afterID:  "a",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				repo.EXPECT().LockList(ctx, "1").Return(nil).Once()
+				repo.EXPECT().GetAllByListID(ctx, "1").Return(listTodos(todo), nil).Once()
+				repo.EXPECT().MoveToStatus(ctx, id, todoStatusID, "4").Return(nil).Once()
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				return repo
+			},
+		},
+		{
+			name:     "Error when the neighbours are swapped",
+			beforeID: "a",
+			afterID:  "c",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				repo.EXPECT().LockList(ctx, "1").Return(nil).Once()
+				repo.EXPECT().GetAllByListID(ctx, "1").Return(listTodos(todo), nil).Once()
+				return repo
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+		{
+			name:    "Error when the neighbour is in another list",
+			afterID: "d",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				repo.EXPECT().LockList(ctx, "1").Return(nil).Once()
+				repo.EXPECT().GetAllByListID(ctx, "1").Return(listTodos(todo), nil).Once()
+				return repo
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+		{
+			name:     "Error when the neighbours share a position",
+			beforeID: "c",
+			afterID:  "b",
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				repo.EXPECT().LockList(ctx, "1").Return(nil).Once()
+				repo.EXPECT().GetAllByListID(ctx, "1").Return([]models.Todo{
+					{ID: "b", ListID: "1", StatusID: progressStatusID, Position: "5V"},
+					{ID: "c", ListID: "1", StatusID: progressStatusID, Position: "5V"},
+				}, nil).Once()
+				return repo
+			},
+			expectedError: pkg.ErrConflict,
+		},
+		{
+			name:    "Error when the todo is its own neighbour",
+			afterID: id,
+			repo: func() *automock.TodoRepository {
+				return &automock.TodoRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+		{
+			name: "Error without neighbours",
+			repo: func() *automock.TodoRepository {
+				return &automock.TodoRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer mock.AssertExpectationsForObjects(t, repo)
+
+			svc := todos.NewService(repo, &statusmock.StatusRepository{}, &automock.AccessRepository{}, &automock.UUIDService{}, &automock.TimeService{}, todos.Config{})
+			result, err := svc.MoveTodo(ctx, id, tt.beforeID, tt.afterID)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, todo, result)
+			}
+		})
+	}
+}
+
+// listTodos returns the todos of list "1" ordered by position, with todo between the to do and the in progress ones.
+func TestServiceAddDependency(t *testing.T) {
+	id := "4cfd7e64-7431-4690-a2a0-1268917cedf1"
+	blockerID := "4cfd7e64-7431-4690-a2a0-1268917cedf2"
+	ctx := context.Background()
+	todo := models.Todo{ID: id, ListID: "1"}
+	blocker := models.Todo{ID: blockerID, ListID: "1"}
+
+	tests := []struct {
+		name          string
+		blockedByID   string
+		repo          func() *automock.TodoRepository
+		expected      models.Todo
+		expectedError error
+	}{
+		{
+			name:        "Add dependency",
+			blockedByID: blockerID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				repo.EXPECT().Get(ctx, blockerID).Return(blocker, nil).Once()
+				repo.EXPECT().GetDependenciesByListID(ctx, "1").Return([]models.Dependency{{TodoID: blockerID, BlockedByID: "c"}}, nil).Once()
+				repo.EXPECT().AddDependency(ctx, id, blockerID).Return(nil).Once()
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				return repo
+			},
+			expected: todo,
+		},
+		{
+			name:        "Error when the dependency closes a cycle",
+			blockedByID: blockerID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				repo.EXPECT().Get(ctx, blockerID).Return(blocker, nil).Once()
+				repo.EXPECT().GetDependenciesByListID(ctx, "1").Return([]models.Dependency{
+					{TodoID: blockerID, BlockedByID: "c"},
+					{TodoID: "c", BlockedByID: id},
+				}, nil).Once()
+				return repo
+			},
+			expectedError: pkg.ErrConflict,
+		},
+		{
+			name:        "Error when the blocker is in another list",
+			blockedByID: blockerID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				repo.EXPECT().Get(ctx, blockerID).Return(models.Todo{ID: blockerID, ListID: "2"}, nil).Once()
+				return repo
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+		{
+			name:        "Error when the todo blocks itself",
+			blockedByID: id,
+			repo: func() *automock.TodoRepository {
+				return &automock.TodoRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+		{
+			name:        "Error when the blocker id is invalid",
+			blockedByID: "blocker",
+			repo: func() *automock.TodoRepository {
+				return &automock.TodoRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			defer repo.AssertExpectations(t)
+
+			svc := todos.NewService(repo, &statusmock.StatusRepository{}, &automock.AccessRepository{}, &automock.UUIDService{}, &automock.TimeService{}, todos.Config{})
+			result, err := svc.AddDependency(ctx, id, tt.blockedByID)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceMoveTodoToList(t *testing.T) {
+	id := "1"
+	targetID := "4cfd7e64-7431-4690-a2a0-1268917cede0"
+	ctx := context.Background()
+	writer := todos.Actor{UserID: "u1"}
+	assignee := "u2"
+	todo := models.Todo{ID: id, ListID: "1", StatusID: progressStatusID, Position: "3V", AssignedTo: &assignee}
+	targetStatuses := []models.Status{
+		{ID: "t1", ListID: targetID, Name: "To Do", Position: 0},
+		{ID: "t2", ListID: targetID, Name: "In Progress", Position: 1},
+	}
+	moved := models.Todo{ID: id, ListID: targetID, StatusID: "t2", Position: "l", AssignedTo: &assignee}
+	unassigned := models.Todo{ID: id, ListID: targetID, StatusID: "t1", Position: "l"}
+	ownerAccess := models.Access{ListID: "1", UserID: "u1", Role: constants.Admin, Status: constants.StatusOwner}
+	writerAccess := models.Access{ListID: targetID, UserID: "u1", Role: constants.Writer, Status: constants.StatusAccepted}
+	noAccess := fmt.Errorf("list not found: %w", sql.ErrNoRows)
+	archivedAt := time.Date(2026, 10, 19, 16, 0, 0, 0, time.UTC)
+	source := models.List{ID: "1"}
+	target := models.List{ID: targetID}
+
+	tests := []struct {
+		name          string
+		actor         todos.Actor
+		repo          func() *automock.TodoRepository
+		statusRepo    func() *statusmock.StatusRepository
+		accessRepo    func() *automock.AccessRepository
+		expected      models.Todo
+		expectedError error
+	}{
+		{
+			name:  "Move todo to the status with the same name",
+			actor: writer,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				repo.EXPECT().LockList(ctx, targetID).Return(nil).Once()
+				repo.EXPECT().GetLastPosition(ctx, targetID).Return("V", nil).Once()
+				repo.EXPECT().MoveToList(ctx, moved).Return(nil).Once()
+				repo.EXPECT().Get(ctx, id).Return(moved, nil).Once()
+				return repo
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				statusRepo := &statusmock.StatusRepository{}
+				statusRepo.EXPECT().Get(ctx, "1", progressStatusID).Return(progressStatus, nil).Once()
+				statusRepo.EXPECT().GetAllByListID(ctx, targetID).Return(targetStatuses, nil).Once()
+				return statusRepo
+			},
+			accessRepo: func() *automock.AccessRepository {
+				accessRepo := &automock.AccessRepository{}
+				accessRepo.EXPECT().GetAccess(ctx, targetID, "u1").Return(writerAccess, nil).Once()
+				accessRepo.EXPECT().Get(ctx, targetID).Return(target, nil).Once()
+				accessRepo.EXPECT().GetAccess(ctx, "1", "u1").Return(ownerAccess, nil).Once()
+				accessRepo.EXPECT().Get(ctx, "1").Return(source, nil).Once()
+				accessRepo.EXPECT().GetAccess(ctx, targetID, assignee).Return(models.Access{Role: constants.Reader, Status: constants.StatusAccepted}, nil).Once()
+				return accessRepo
+			},
+			expected: moved,
+		},
+		{
+			name:  "Unassign the todo when the assignee has no access to the target list",
+			actor: todos.Actor{UserID: "admin", Admin: true},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				repo.EXPECT().LockList(ctx, targetID).Return(nil).Once()
+				repo.EXPECT().GetLastPosition(ctx, targetID).Return("V", nil).Once()
+				repo.EXPECT().MoveToList(ctx, unassigned).Return(nil).Once()
+				repo.EXPECT().Get(ctx, id).Return(unassigned, nil).Once()
+				return repo
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				statusRepo := &statusmock.StatusRepository{}
+				statusRepo.EXPECT().Get(ctx, "1", progressStatusID).Return(progressStatus, nil).Once()
+				statusRepo.EXPECT().GetAllByListID(ctx, targetID).Return(targetStatuses[:1], nil).Once()
+				statusRepo.EXPECT().GetFirst(ctx, targetID, false).Return(targetStatuses[0], nil).Once()
+				return statusRepo
+			},
+			accessRepo: func() *automock.AccessRepository {
+				accessRepo := &automock.AccessRepository{}
+				accessRepo.EXPECT().Get(ctx, targetID).Return(target, nil).Once()
+				accessRepo.EXPECT().Get(ctx, "1").Return(source, nil).Once()
+				accessRepo.EXPECT().GetAccess(ctx, targetID, assignee).Return(models.Access{}, noAccess).Once()
+				return accessRepo
+			},
+			expected: unassigned,
+		},
+		{
+			name:  "Keep a todo that is already in the target list",
+			actor: writer,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(moved, nil).Once()
+				return repo
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				return &statusmock.StatusRepository{}
+			},
+			accessRepo: func() *automock.AccessRepository {
+				accessRepo := &automock.AccessRepository{}
+				accessRepo.EXPECT().GetAccess(ctx, targetID, "u1").Return(writerAccess, nil).Once()
+				accessRepo.EXPECT().Get(ctx, targetID).Return(target, nil).Once()
+				return accessRepo
+			},
+			expected: moved,
+		},
+		{
+			name:  "Error when the actor can only read the target list",
+			actor: writer,
+			repo: func() *automock.TodoRepository {
+				return &automock.TodoRepository{}
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				return &statusmock.StatusRepository{}
+			},
+			accessRepo: func() *automock.AccessRepository {
+				accessRepo := &automock.AccessRepository{}
+				accessRepo.EXPECT().GetAccess(ctx, targetID, "u1").Return(models.Access{Role: constants.Reader, Status: constants.StatusAccepted}, nil).Once()
+				return accessRepo
+			},
+			expectedError: pkg.ErrForbidden,
+		},
+		{
+			name:  "Error when the actor has no access to the source list",
+			actor: writer,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				return repo
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				return &statusmock.StatusRepository{}
+			},
+			accessRepo: func() *automock.AccessRepository {
+				accessRepo := &automock.AccessRepository{}
+				accessRepo.EXPECT().GetAccess(ctx, targetID, "u1").Return(writerAccess, nil).Once()
+				accessRepo.EXPECT().Get(ctx, targetID).Return(target, nil).Once()
+				accessRepo.EXPECT().GetAccess(ctx, "1", "u1").Return(models.Access{}, noAccess).Once()
+				return accessRepo
+			},
+			expectedError: pkg.ErrForbidden,
+		},
+		{
+			name:  "Error when the target list is archived",
+			actor: writer,
+			repo: func() *automock.TodoRepository {
+				return &automock.TodoRepository{}
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				return &statusmock.StatusRepository{}
+			},
+			accessRepo: func() *automock.AccessRepository {
+				accessRepo := &automock.AccessRepository{}
+				accessRepo.EXPECT().GetAccess(ctx, targetID, "u1").Return(writerAccess, nil).Once()
+				accessRepo.EXPECT().Get(ctx, targetID).Return(models.List{ID: targetID, ArchivedAt: &archivedAt}, nil).Once()
+				return accessRepo
+			},
+			expectedError: pkg.ErrConflict,
+		},
+		{
+			name:  "Error when the todo is in an archived list",
+			actor: todos.Actor{UserID: "admin", Admin: true},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				return repo
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				return &statusmock.StatusRepository{}
+			},
+			accessRepo: func() *automock.AccessRepository {
+				accessRepo := &automock.AccessRepository{}
+				accessRepo.EXPECT().Get(ctx, targetID).Return(target, nil).Once()
+				accessRepo.EXPECT().Get(ctx, "1").Return(models.List{ID: "1", ArchivedAt: &archivedAt}, nil).Once()
+				return accessRepo
+			},
+			expectedError: pkg.ErrConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			statusRepo := tt.statusRepo()
+			accessRepo := tt.accessRepo()
+			defer mock.AssertExpectationsForObjects(t, repo, statusRepo, accessRepo)
+
+			svc := todos.NewService(repo, statusRepo, accessRepo, &automock.UUIDService{}, &automock.TimeService{}, todos.Config{})
+			result, err := svc.MoveTodoToList(ctx, tt.actor, id, targetID)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceCopyTodo(t *testing.T) {
+	id := "1"
+	copyID := "2"
+	listID := "4cfd7e64-7431-4690-a2a0-1268917cede1"
+	archivedID := "4cfd7e64-7431-4690-a2a0-1268917cede2"
+	ctx := context.Background()
+	now := time.Now()
+	archivedAt := time.Date(2026, 10, 19, 16, 0, 0, 0, time.UTC)
+	admin := todos.Actor{UserID: "admin", Admin: true}
+	todo := models.Todo{ID: id, ListID: listID, Title: "Todo", StatusID: todoStatusID, Position: "V"}
+	archivedTodo := models.Todo{ID: id, ListID: archivedID, Title: "Todo", StatusID: todoStatusID, Position: "V"}
+	copied := models.Todo{ID: copyID, ListID: listID, Title: "Todo", StatusID: todoStatusID, Position: "l", CreatedAt: now, UpdatedAt: now}
+	status := models.Status{ID: todoStatusID, ListID: listID, Name: "To Do"}
+	list := models.List{ID: listID}
+	archived := models.List{ID: archivedID, ArchivedAt: &archivedAt}
+
+	tests := []struct {
+		name          string
+		target        string
+		repo          func() *automock.TodoRepository
+		statusRepo    func() *statusmock.StatusRepository
+		accessRepo    func() *automock.AccessRepository
+		uuidService   func() *automock.UUIDService
+		timeService   func() *automock.TimeService
+		expected      models.Todo
+		expectedError error
+	}{
+		{
+			name:   "Copy todo within its list",
+			target: listID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				repo.EXPECT().LockList(ctx, listID).Return(nil).Once()
+				repo.EXPECT().GetLastPosition(ctx, listID).Return("V", nil).Once()
+				repo.EXPECT().Create(ctx, copied).Return(copyID, nil).Once()
+				repo.EXPECT().CopyTags(ctx, id, copyID).Return(nil).Once()
+				repo.EXPECT().Get(ctx, copyID).Return(copied, nil).Once()
+				return repo
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				statusRepo := &statusmock.StatusRepository{}
+				statusRepo.EXPECT().Get(ctx, listID, todoStatusID).Return(status, nil).Once()
+				return statusRepo
+			},
+			accessRepo: func() *automock.AccessRepository {
+				accessRepo := &automock.AccessRepository{}
+				accessRepo.EXPECT().Get(ctx, listID).Return(list, nil).Once()
+				return accessRepo
+			},
+			uuidService: func() *automock.UUIDService {
+				uuidService := &automock.UUIDService{}
+				uuidService.EXPECT().Generate().Return(copyID).Once()
+				return uuidService
+			},
+			timeService: func() *automock.TimeService {
+				timeService := &automock.TimeService{}
+				timeService.EXPECT().Now().Return(now).Twice()
+				return timeService
+			},
+			expected: copied,
+		},
+		{
+			name:   "Error when copying into an archived list",
+			target: archivedID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(todo, nil).Once()
+				return repo
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				return &statusmock.StatusRepository{}
+			},
+			accessRepo: func() *automock.AccessRepository {
+				accessRepo := &automock.AccessRepository{}
+				accessRepo.EXPECT().Get(ctx, listID).Return(list, nil).Once()
+				accessRepo.EXPECT().Get(ctx, archivedID).Return(archived, nil).Once()
+				return accessRepo
+			},
+			uuidService: func() *automock.UUIDService {
+				return &automock.UUIDService{}
+			},
+			timeService: func() *automock.TimeService {
+				return &automock.TimeService{}
+			},
+			expectedError: pkg.ErrConflict,
+		},
+		{
+			name:   "Error when copying out of an archived list",
+			target: listID,
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, id).Return(archivedTodo, nil).Once()
+				return repo
+			},
+			statusRepo: func() *statusmock.StatusRepository {
+				return &statusmock.StatusRepository{}
+			},
+			accessRepo: func() *automock.AccessRepository {
+				accessRepo := &automock.AccessRepository{}
+				accessRepo.EXPECT().Get(ctx, archivedID).Return(archived, nil).Once()
+				return accessRepo
+			},
+			uuidService: func() *automock.UUIDService {
+				return &automock.UUIDService{}
+			},
+			timeService: func() *automock.TimeService {
+				return &automock.TimeService{}
+			},
+			expectedError: pkg.ErrConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			statusRepo := tt.statusRepo()
+			accessRepo := tt.accessRepo()
+			uuidService := tt.uuidService()
+			timeService := tt.timeService()
+			defer mock.AssertExpectationsForObjects(t, repo, statusRepo, accessRepo, uuidService, timeService)
+
+			svc := todos.NewService(repo, statusRepo, accessRepo, uuidService, timeService, todos.Config{})
+			result, err := svc.CopyTodo(ctx, admin, id, tt.target)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func TestServiceMoveTodosToList(t *testing.T) {
+	targetID := "4cfd7e64-7431-4690-a2a0-1268917cede0"
+	ctx := context.Background()
+	actor := todos.Actor{UserID: "u1"}
+	archivedAt := time.Date(2026, 10, 19, 16, 0, 0, 0, time.UTC)
+	writerAccess := models.Access{UserID: "u1", Role: constants.Writer, Status: constants.StatusAccepted}
+	inTarget := models.Todo{ID: "1", ListID: targetID}
+	inArchived := models.Todo{ID: "2", ListID: "1"}
+
+	tests := []struct {
+		name          string
+		ids           []string
+		repo          func() *automock.TodoRepository
+		accessRepo    func() *automock.AccessRepository
+		expected      []models.Todo
+		expectedError error
+	}{
+		{
+			name: "Keep todos that are already in the target list",
+			ids:  []string{"1"},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(inTarget, nil).Once()
+				return repo
+			},
+			accessRepo: func() *automock.AccessRepository {
+				accessRepo := &automock.AccessRepository{}
+				accessRepo.EXPECT().GetAccess(ctx, targetID, "u1").Return(writerAccess, nil).Once()
+				accessRepo.EXPECT().Get(ctx, targetID).Return(models.List{ID: targetID}, nil).Once()
+				return accessRepo
+			},
+			expected: []models.Todo{inTarget},
+		},
+		{
+			name: "Error when the target list is archived",
+			ids:  []string{"1"},
+			repo: func() *automock.TodoRepository {
+				return &automock.TodoRepository{}
+			},
+			accessRepo: func() *automock.AccessRepository {
+				accessRepo := &automock.AccessRepository{}
+				accessRepo.EXPECT().GetAccess(ctx, targetID, "u1").Return(writerAccess, nil).Once()
+				accessRepo.EXPECT().Get(ctx, targetID).Return(models.List{ID: targetID, ArchivedAt: &archivedAt}, nil).Once()
+				return accessRepo
+			},
+			expectedError: pkg.ErrConflict,
+		},
+		{
+			name: "Error when a todo is in an archived list",
+			ids:  []string{"1", "2"},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().Get(ctx, "1").Return(inTarget, nil).Once()
+				repo.EXPECT().Get(ctx, "2").Return(inArchived, nil).Once()
+				return repo
+			},
+			accessRepo: func() *automock.AccessRepository {
+				accessRepo := &automock.AccessRepository{}
+				accessRepo.EXPECT().GetAccess(ctx, targetID, "u1").Return(writerAccess, nil).Once()
+				accessRepo.EXPECT().Get(ctx, targetID).Return(models.List{ID: targetID}, nil).Once()
+				accessRepo.EXPECT().GetAccess(ctx, "1", "u1").Return(writerAccess, nil).Once()
+				accessRepo.EXPECT().Get(ctx, "1").Return(models.List{ID: "1", ArchivedAt: &archivedAt}, nil).Once()
+				return accessRepo
+			},
+			expectedError: pkg.ErrConflict,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			accessRepo := tt.accessRepo()
+			defer mock.AssertExpectationsForObjects(t, repo, accessRepo)
+
+			svc := todos.NewService(repo, &statusmock.StatusRepository{}, accessRepo, &automock.UUIDService{}, &automock.TimeService{}, todos.Config{})
+			result, err := svc.MoveTodosToList(ctx, actor, tt.ids, targetID)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tt.expected, result)
+			}
+		})
+	}
+}
+
+func listTodos(todo models.Todo) []models.Todo {
+	return []models.Todo{
+		{ID: "a", ListID: "1", StatusID: todoStatusID, Position: "1V"},
+		todo,
+		{ID: "b", ListID: "1", StatusID: progressStatusID, Position: "5V"},
+		{ID: "c", ListID: "1", StatusID: progressStatusID, Position: "7V"},
+	}
+}
+
+func TestServiceBulkUpdateTodos(t *testing.T) {
+	ctx := context.Background()
+	const (
+		todoID   = "6a1c9c3e-6d8a-4d8e-9a51-0b7a6f1c2d01"
+		otherID  = "6a1c9c3e-6d8a-4d8e-9a51-0b7a6f1c2d02"
+		listID   = "7b1c9c3e-6d8a-4d8e-9a51-0b7a6f1c2d01"
+		targetID = "7b1c9c3e-6d8a-4d8e-9a51-0b7a6f1c2d02"
+		memberID = "8c1c9c3e-6d8a-4d8e-9a51-0b7a6f1c2d01"
+	)
+	actor := todos.Actor{UserID: "u1"}
+	writerAccess := models.Access{ListID: listID, UserID: "u1", Role: constants.Writer, Status: constants.StatusAccepted}
+	readerAccess := models.Access{ListID: listID, UserID: "u1", Role: constants.Reader, Status: constants.StatusAccepted}
+	archivedAt := time.Date(2026, 10, 19, 16, 0, 0, 0, time.UTC)
+	todo := models.Todo{ID: todoID, ListID: listID, Priority: constants.PriorityLow}
+	prioritized := models.Todo{ID: todoID, ListID: listID, Priority: constants.PriorityHigh}
+	other := models.Todo{ID: otherID, ListID: listID}
+	inSavepoint := func(ctx context.Context, fn func(ctx context.Context) error) error {
+		return fn(ctx)
+	}
+	serializationFailure := &pq.Error{Code: db.SQLStateSerializationFailure}
+
+	setPriority := models.BulkOperation{Action: models.BulkSetPriority, TodoID: todoID, Priority: constants.PriorityHigh}
+	deleteOther := models.BulkOperation{Action: models.BulkDelete, TodoID: otherID}
+	reassign := models.BulkOperation{Action: models.BulkReassign, TodoID: todoID, AssignedTo: memberID}
+	complete := models.BulkOperation{Action: models.BulkComplete, TodoID: todoID}
+	move := models.BulkOperation{Action: models.BulkMove, TodoID: todoID, ListID: targetID}
+
+	tests := []struct {
+		name           string
+		operations     []models.BulkOperation
+		repo           func() *automock.TodoRepository
+		accessRepo     func() *automock.AccessRepository
+		expectedTodos  []*models.Todo
+		expectedErrors []error
+		expectedError  error
+	}{
+		{
+			name:       "Apply every operation and report its result",
+			operations: []models.BulkOperation{setPriority, deleteOther},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().InSavepoint(ctx, mock.Anything).RunAndReturn(inSavepoint).Twice()
+				repo.EXPECT().Get(ctx, todoID).Return(todo, nil).Once()
+				repo.EXPECT().UpdateTodoPriority(ctx, todoID, constants.PriorityHigh).Return(prioritized, nil).Once()
+				repo.EXPECT().Get(ctx, otherID).Return(other, nil).Once()
+				repo.EXPECT().Delete(ctx, otherID).Return(nil).Once()
+				return repo
+			},
+			accessRepo: func() *automock.AccessRepository {
+				accessRepo := &automock.AccessRepository{}
+				accessRepo.EXPECT().GetAccess(ctx, listID, "u1").Return(writerAccess, nil).Twice()
+				accessRepo.EXPECT().Get(ctx, listID).Return(models.List{ID: listID}, nil).Twice()
+				return accessRepo
+			},
+			expectedTodos:  []*models.Todo{&prioritized, nil},
+			expectedErrors: []error{nil, nil},
+		},
+		{
+			name:       "Report a failed operation without stopping the others",
+			operations: []models.BulkOperation{reassign, deleteOther},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().InSavepoint(ctx, mock.Anything).RunAndReturn(inSavepoint).Twice()
+				repo.EXPECT().Get(ctx, todoID).Return(todo, nil).Once()
+				repo.EXPECT().Get(ctx, otherID).Return(other, nil).Once()
+				repo.EXPECT().Delete(ctx, otherID).Return(nil).Once()
+				return repo
+			},
+			accessRepo: func() *automock.AccessRepository {
+				accessRepo := &automock.AccessRepository{}
+				accessRepo.EXPECT().GetAccess(ctx, listID, "u1").Return(writerAccess, nil).Twice()
+				accessRepo.EXPECT().Get(ctx, listID).Return(models.List{ID: listID}, nil).Twice()
+				accessRepo.EXPECT().GetAccess(ctx, listID, memberID).Return(models.Access{}, sql.ErrNoRows).Once()
+				return accessRepo
+			},
+			expectedTodos:  []*models.Todo{nil, nil},
+			expectedErrors: []error{pkg.ErrBadRequest, nil},
+		},
+		{
+			name:       "Reject writes to an archived list",
+			operations: []models.BulkOperation{complete},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().InSavepoint(ctx, mock.Anything).RunAndReturn(inSavepoint).Once()
+				repo.EXPECT().Get(ctx, todoID).Return(todo, nil).Once()
+				return repo
+			},
+			accessRepo: func() *automock.AccessRepository {
+				accessRepo := &automock.AccessRepository{}
+				accessRepo.EXPECT().GetAccess(ctx, listID, "u1").Return(writerAccess, nil).Once()
+				accessRepo.EXPECT().Get(ctx, listID).Return(models.List{ID: listID, ArchivedAt: &archivedAt}, nil).Once()
+				return accessRepo
+			},
+			expectedTodos:  []*models.Todo{nil},
+			expectedErrors: []error{pkg.ErrConflict},
+		},
+		{
+			name:       "Reject moving a todo into an archived list",
+			operations: []models.BulkOperation{move},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().InSavepoint(ctx, mock.Anything).RunAndReturn(inSavepoint).Once()
+				repo.EXPECT().Get(ctx, todoID).Return(todo, nil).Once()
+				return repo
+			},
+			accessRepo: func() *automock.AccessRepository {
+				accessRepo := &automock.AccessRepository{}
+				accessRepo.EXPECT().GetAccess(ctx, listID, "u1").Return(writerAccess, nil).Once()
+				accessRepo.EXPECT().Get(ctx, listID).Return(models.List{ID: listID}, nil).Once()
+				accessRepo.EXPECT().GetAccess(ctx, targetID, "u1").Return(writerAccess, nil).Once()
+				accessRepo.EXPECT().Get(ctx, targetID).Return(models.List{ID: targetID, ArchivedAt: &archivedAt}, nil).Once()
+				return accessRepo
+			},
+			expectedTodos:  []*models.Todo{nil},
+			expectedErrors: []error{pkg.ErrConflict},
+		},
+		{
+			name:       "Reject moving a todo out of an archived list",
+			operations: []models.BulkOperation{move},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().InSavepoint(ctx, mock.Anything).RunAndReturn(inSavepoint).Once()
+				repo.EXPECT().Get(ctx, todoID).Return(todo, nil).Once()
+				return repo
+			},
+			accessRepo: func() *automock.AccessRepository {
+				accessRepo := &automock.AccessRepository{}
+				accessRepo.EXPECT().GetAccess(ctx, listID, "u1").Return(writerAccess, nil).Once()
+				accessRepo.EXPECT().Get(ctx, listID).Return(models.List{ID: listID, ArchivedAt: &archivedAt}, nil).Once()
+				return accessRepo
+			},
+			expectedTodos:  []*models.Todo{nil},
+			expectedErrors: []error{pkg.ErrConflict},
+		},
+		{
+			name:       "Reject an operation on a list the actor can only read",
+			operations: []models.BulkOperation{complete},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().InSavepoint(ctx, mock.Anything).RunAndReturn(inSavepoint).Once()
+				repo.EXPECT().Get(ctx, todoID).Return(todo, nil).Once()
+				return repo
+			},
+			accessRepo: func() *automock.AccessRepository {
+				accessRepo := &automock.AccessRepository{}
+				accessRepo.EXPECT().GetAccess(ctx, listID, "u1").Return(readerAccess, nil).Once()
+				return accessRepo
+			},
+			expectedTodos:  []*models.Todo{nil},
+			expectedErrors: []error{pkg.ErrForbidden},
+		},
+		{
+			name:       "Reject a missing todo like a todo the actor cannot write to",
+			operations: []models.BulkOperation{complete},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().InSavepoint(ctx, mock.Anything).RunAndReturn(inSavepoint).Once()
+				repo.EXPECT().Get(ctx, todoID).Return(models.Todo{}, fmt.Errorf("todo not found: %w", sql.ErrNoRows)).Once()
+				return repo
+			},
+			accessRepo: func() *automock.AccessRepository {
+				return &automock.AccessRepository{}
+			},
+			expectedTodos:  []*models.Todo{nil},
+			expectedErrors: []error{pkg.ErrForbidden},
+		},
+		{
+			name:       "Error when an operation hits a serialization failure",
+			operations: []models.BulkOperation{complete, deleteOther},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().InSavepoint(ctx, mock.Anything).RunAndReturn(inSavepoint).Once()
+				repo.EXPECT().Get(ctx, todoID).Return(models.Todo{}, serializationFailure).Once()
+				return repo
+			},
+			accessRepo: func() *automock.AccessRepository {
+				return &automock.AccessRepository{}
+			},
+			expectedError: serializationFailure,
+		},
+		{
+			name:       "Reject an unknown action",
+			operations: []models.BulkOperation{{Action: "archive", TodoID: todoID}},
+			repo: func() *automock.TodoRepository {
+				repo := &automock.TodoRepository{}
+				repo.EXPECT().InSavepoint(ctx, mock.Anything).RunAndReturn(inSavepoint).Once()
+				return repo
+			},
+			accessRepo: func() *automock.AccessRepository {
+				return &automock.AccessRepository{}
+			},
+			expectedTodos:  []*models.Todo{nil},
+			expectedErrors: []error{pkg.ErrBadRequest},
+		},
+		{
+			name: "Error without operations",
+			repo: func() *automock.TodoRepository {
+				return &automock.TodoRepository{}
+			},
+			accessRepo: func() *automock.AccessRepository {
+				return &automock.AccessRepository{}
+			},
+			expectedError: pkg.ErrBadRequest,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			repo := tt.repo()
+			accessRepo := tt.accessRepo()
+			defer mock.AssertExpectationsForObjects(t, repo, accessRepo)
+
+			svc := todos.NewService(repo, &statusmock.StatusRepository{}, accessRepo, &automock.UUIDService{}, &automock.TimeService{}, todos.Config{})
+			results, err := svc.BulkUpdateTodos(ctx, actor, tt.operations)
+			if tt.expectedError != nil {
+				assert.ErrorIs(t, err, tt.expectedError)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, results, len(tt.operations))
+			for i, result := range results {
+				assert.Equal(t, tt.operations[i], result.Operation)
+				assert.Equal(t, tt.expectedTodos[i], result.Todo)
+				if tt.expectedErrors[i] != nil {
+					assert.ErrorIs(t, result.Err, tt.expectedErrors[i])
+				} else {
+					assert.NoError(t, result.Err)
+				}
+			}
+		})
+	}
+}
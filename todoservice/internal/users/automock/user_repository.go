@@ -357,6 +357,65 @@ func (_c *UserRepository_GetByEmail_Call) RunAndReturn(run func(context.Context,
 	return _c
 }
 
+// GetByIDs provides a mock function with given fields: ctx, ids
+func (_m *UserRepository) GetByIDs(ctx context.Context, ids []string) ([]models.User, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetByIDs")
+	}
+
+	var r0 []models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]models.User, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []models.User); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserRepository_GetByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetByIDs'
+type UserRepository_GetByIDs_Call struct {
+	*mock.Call
+}
+
+// GetByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []string
+func (_e *UserRepository_Expecter) GetByIDs(ctx interface{}, ids interface{}) *UserRepository_GetByIDs_Call {
+	return &UserRepository_GetByIDs_Call{Call: _e.mock.On("GetByIDs", ctx, ids)}
+}
+
+func (_c *UserRepository_GetByIDs_Call) Run(run func(ctx context.Context, ids []string)) *UserRepository_GetByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *UserRepository_GetByIDs_Call) Return(_a0 []models.User, _a1 error) *UserRepository_GetByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserRepository_GetByIDs_Call) RunAndReturn(run func(context.Context, []string) ([]models.User, error)) *UserRepository_GetByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Logout provides a mock function with given fields: ctx, email
 func (_m *UserRepository) Logout(ctx context.Context, email string) error {
 	ret := _m.Called(ctx, email)
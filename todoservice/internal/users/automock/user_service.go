@@ -357,6 +357,65 @@ func (_c *UserService_GetUserByEmail_Call) RunAndReturn(run func(context.Context
 	return _c
 }
 
+// GetUsersByIDs provides a mock function with given fields: ctx, ids
+func (_m *UserService) GetUsersByIDs(ctx context.Context, ids []string) ([]models.User, error) {
+	ret := _m.Called(ctx, ids)
+
+	if len(ret) == 0 {
+		panic("no return value specified for GetUsersByIDs")
+	}
+
+	var r0 []models.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, []string) ([]models.User, error)); ok {
+		return rf(ctx, ids)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, []string) []models.User); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]models.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, []string) error); ok {
+		r1 = rf(ctx, ids)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UserService_GetUsersByIDs_Call is a *mock.Call that shadows Run/Return methods with type explicit version for method 'GetUsersByIDs'
+type UserService_GetUsersByIDs_Call struct {
+	*mock.Call
+}
+
+// GetUsersByIDs is a helper method to define mock.On call
+//   - ctx context.Context
+//   - ids []string
+func (_e *UserService_Expecter) GetUsersByIDs(ctx interface{}, ids interface{}) *UserService_GetUsersByIDs_Call {
+	return &UserService_GetUsersByIDs_Call{Call: _e.mock.On("GetUsersByIDs", ctx, ids)}
+}
+
+func (_c *UserService_GetUsersByIDs_Call) Run(run func(ctx context.Context, ids []string)) *UserService_GetUsersByIDs_Call {
+	_c.Call.Run(func(args mock.Arguments) {
+		run(args[0].(context.Context), args[1].([]string))
+	})
+	return _c
+}
+
+func (_c *UserService_GetUsersByIDs_Call) Return(_a0 []models.User, _a1 error) *UserService_GetUsersByIDs_Call {
+	_c.Call.Return(_a0, _a1)
+	return _c
+}
+
+func (_c *UserService_GetUsersByIDs_Call) RunAndReturn(run func(context.Context, []string) ([]models.User, error)) *UserService_GetUsersByIDs_Call {
+	_c.Call.Return(run)
+	return _c
+}
+
 // Logout provides a mock function with given fields: ctx, email
 func (_m *UserService) Logout(ctx context.Context, email string) error {
 	ret := _m.Called(ctx, email)
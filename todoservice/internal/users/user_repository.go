@@ -8,6 +8,7 @@ import (
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
 	"time"
 )
 
@@ -15,6 +16,7 @@ import (
 type UserRepository interface {
 	Update(ctx context.Context, user models.User) error
 	Get(ctx context.Context, id string) (models.User, error)
+	GetByIDs(ctx context.Context, ids []string) ([]models.User, error)
 	GetByEmail(ctx context.Context, email string) (models.User, error)
 	GetAll(ctx context.Context) ([]models.User, error)
 	Delete(ctx context.Context, id string) error
@@ -95,6 +97,34 @@ func (r *SQLXUserRepository) Get(ctx context.Context, id string) (models.User, e
 	return r.converter.ConvertUserToModel(entity), nil
 }
 
+func (r *SQLXUserRepository) GetByIDs(ctx context.Context, ids []string) ([]models.User, error) {
+	log.C(ctx).Infof("getting users by ids from repo user: %+v", ids)
+	tx, err := db.FromContext(ctx)
+	if err != nil {
+		log.C(ctx).Errorf("error while getting transaction from context: %+v", err)
+		return nil, err
+	}
+
+	query := `
+		SELECT id, email, github_id, role, created_at, updated_at
+		FROM users
+		WHERE id = ANY($1)
+`
+	var entities []Entity
+	err = tx.SelectContext(ctx, &entities, query, pq.Array(ids))
+	if err != nil {
+		log.C(ctx).Errorf("error in getting users by ids from repo: %+v", err)
+		return nil, fmt.Errorf("failed to get users: %w", err)
+	}
+
+	result := make([]models.User, 0, len(entities))
+	for _, entity := range entities {
+		result = append(result, r.converter.ConvertUserToModel(entity))
+	}
+	log.C(ctx).Debugf("got %d users from repo", len(result))
+	return result, nil
+}
+
 func (r *SQLXUserRepository) GetByEmail(ctx context.Context, email string) (models.User, error) {
 	log.C(ctx).Infof("getting user from repo user with email: %+v", email)
 	tx, err := db.FromContext(ctx)
@@ -217,7 +247,7 @@ func (r *SQLXUserRepository) SaveRefreshToken(ctx context.Context, email string,
 }
 
 func (r *SQLXUserRepository) FindByRefreshToken(ctx context.Context, refreshToken string) (models.User, error) {
-	log.C(ctx).Infof("finding user by refresh token: %+v", refreshToken)
+	log.C(ctx).Info("finding user by refresh token")
 	tx, err := db.FromContext(ctx)
 	if err != nil {
 		log.C(ctx).Errorf("error while getting transaction from context: %+v", err)
@@ -7,6 +7,7 @@ import (
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/db"
 	"github.com/Victor-Uzunov/devops-project/todoservice/internal/users"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/models"
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	sqlxmock "github.com/zhashkevych/go-sqlxmock"
@@ -170,6 +171,75 @@ func TestSQLXUserRepositoryGet(t *testing.T) {
 	}
 }
 
+func TestSQLXUserRepositoryGetByIDs(t *testing.T) {
+	database, mockDB, err := sqlxmock.Newx()
+	require.NoError(t, err)
+	repo := users.NewSQLXUserRepository()
+	ids := []string{"1", "2"}
+
+	testCases := []struct {
+		name          string
+		setupMocks    func()
+		expectedUsers []models.User
+		expectedError error
+	}{
+		{
+			name: "Successful get of users by ids",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT id, email, github_id, role, created_at, updated_at FROM users").WithArgs(
+					pq.Array(ids)).WillReturnRows(sqlxmock.NewRows([]string{"id", "email", "github_id", "role", "created_at", "updated_at"}).
+					AddRow("1", "first@example.com", "first", "reader", time.Time{}, time.Time{}).
+					AddRow("2", "second@example.com", "second", "writer", time.Time{}, time.Time{}))
+				mockDB.ExpectCommit()
+			},
+			expectedUsers: []models.User{
+				{ID: "1", Email: "first@example.com", GithubID: "first", Role: "reader"},
+				{ID: "2", Email: "second@example.com", GithubID: "second", Role: "writer"},
+			},
+			expectedError: nil,
+		},
+		{
+			name: "Failed get users due to database error",
+			setupMocks: func() {
+				mockDB.ExpectBegin()
+				mockDB.ExpectQuery("SELECT id, email, github_id, role, created_at, updated_at FROM users").WithArgs(
+					pq.Array(ids)).WillReturnError(errors.New("db error"))
+				mockDB.ExpectRollback()
+			},
+			expectedError: fmt.Errorf("failed to get users: %w", errors.New("db error")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tc.setupMocks()
+			ctx := context.Background()
+			tx, err := database.BeginTxx(ctx, nil)
+			require.NoError(t, err)
+
+			ctx = db.SaveToContext(ctx, tx)
+
+			result, err := repo.GetByIDs(ctx, ids)
+
+			if tc.expectedError != nil {
+				require.Error(t, err)
+				assert.EqualError(t, err, tc.expectedError.Error())
+				err = tx.Rollback()
+				require.NoError(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.expectedUsers, result)
+				err = tx.Commit()
+				require.NoError(t, err)
+			}
+
+			err = mockDB.ExpectationsWereMet()
+			require.NoError(t, err)
+		})
+	}
+}
+
 func TestSQLXUserRepositoryUpdate(t *testing.T) {
 	database, mockDB, err := sqlxmock.Newx()
 	require.NoError(t, err)
@@ -11,6 +11,7 @@ import (
 type UserService interface {
 	CreateUser(ctx context.Context, list models.User) (string, error)
 	GetUser(ctx context.Context, id string) (models.User, error)
+	GetUsersByIDs(ctx context.Context, ids []string) ([]models.User, error)
 	GetUserByEmail(ctx context.Context, username string) (models.User, error)
 	GetAllUsers(ctx context.Context) ([]models.User, error)
 	UpdateUser(ctx context.Context, todo models.User) error
@@ -63,6 +64,11 @@ func (s *service) GetUser(ctx context.Context, id string) (models.User, error) {
 	return s.repo.Get(ctx, id)
 }
 
+func (s *service) GetUsersByIDs(ctx context.Context, ids []string) ([]models.User, error) {
+	log.C(ctx).Infof("getting users: %v", ids)
+	return s.repo.GetByIDs(ctx, ids)
+}
+
 func (s *service) DeleteUser(ctx context.Context, id string) error {
 	log.C(ctx).Infof("deleting user: %v", id)
 	return s.repo.Delete(ctx, id)
@@ -89,7 +95,7 @@ func (s *service) GetAllUsers(ctx context.Context) ([]models.User, error) {
 }
 
 func (s *service) SaveRefreshToken(ctx context.Context, email string, refreshToken string, expirationTime time.Time) error {
-	log.C(ctx).Infof("service layer: saving refresh token: %v", refreshToken)
+	log.C(ctx).Info("service layer: saving refresh token")
 	return s.repo.SaveRefreshToken(ctx, email, refreshToken, expirationTime)
 }
 
@@ -103,7 +109,7 @@ func (s *service) GetUserByEmail(ctx context.Context, email string) (models.User
 }
 
 func (s *service) FindByRefreshToken(ctx context.Context, refreshToken string) (models.User, error) {
-	log.C(ctx).Infof("getting user by refresh token: %v", refreshToken)
+	log.C(ctx).Info("getting user by refresh token")
 	return s.repo.FindByRefreshToken(ctx, refreshToken)
 }
 
@@ -0,0 +1,20 @@
+BEGIN;
+
+ALTER TABLE lists ADD COLUMN tags jsonb;
+ALTER TABLE todos ADD COLUMN tags jsonb;
+
+UPDATE lists l
+SET tags = (SELECT jsonb_agg(tg.name ORDER BY tg.name) FROM tags tg WHERE tg.list_id = l.id);
+
+UPDATE todos t
+SET tags = (
+    SELECT jsonb_agg(tg.name ORDER BY tg.name)
+    FROM todo_tags tt
+    JOIN tags tg ON tg.id = tt.tag_id
+    WHERE tt.todo_id = t.id
+);
+
+DROP TABLE todo_tags;
+DROP TABLE tags;
+
+COMMIT;
@@ -0,0 +1,50 @@
+BEGIN;
+
+CREATE TABLE tags (
+    id UUID PRIMARY KEY NOT NULL,
+    list_id UUID NOT NULL REFERENCES lists(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    color VARCHAR(7) NOT NULL DEFAULT '#9e9e9e',
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (list_id, name)
+);
+
+CREATE TABLE todo_tags (
+    todo_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    tag_id UUID NOT NULL REFERENCES tags(id) ON DELETE CASCADE,
+    PRIMARY KEY (todo_id, tag_id)
+);
+
+CREATE INDEX idx_todo_tags_tag_id ON todo_tags(tag_id);
+
+CREATE OR REPLACE TRIGGER update_tags_timestamp
+    BEFORE UPDATE ON tags
+    FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+-- Every name found in the jsonb tags of a list or of one of its todos becomes a tag of that list.
+INSERT INTO tags (id, list_id, name)
+SELECT gen_random_uuid(), list_id, name
+FROM (
+    SELECT l.id AS list_id, btrim(tag.name) AS name
+    FROM lists l
+    CROSS JOIN LATERAL jsonb_array_elements_text(CASE WHEN jsonb_typeof(l.tags) = 'array' THEN l.tags ELSE '[]'::jsonb END) AS tag(name)
+    UNION
+    SELECT t.list_id, btrim(tag.name)
+    FROM todos t
+    CROSS JOIN LATERAL jsonb_array_elements_text(CASE WHEN jsonb_typeof(t.tags) = 'array' THEN t.tags ELSE '[]'::jsonb END) AS tag(name)
+    WHERE t.list_id IS NOT NULL
+) AS existing
+WHERE name <> '';
+
+INSERT INTO todo_tags (todo_id, tag_id)
+SELECT DISTINCT t.id, tg.id
+FROM todos t
+CROSS JOIN LATERAL jsonb_array_elements_text(CASE WHEN jsonb_typeof(t.tags) = 'array' THEN t.tags ELSE '[]'::jsonb END) AS tag(name)
+JOIN tags tg ON tg.list_id = t.list_id AND tg.name = btrim(tag.name);
+
+ALTER TABLE lists DROP COLUMN tags;
+ALTER TABLE todos DROP COLUMN tags;
+
+COMMIT;
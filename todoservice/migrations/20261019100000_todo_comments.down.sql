@@ -0,0 +1,6 @@
+BEGIN;
+
+DROP TABLE todo_comment_mentions;
+DROP TABLE todo_comments;
+
+COMMIT;
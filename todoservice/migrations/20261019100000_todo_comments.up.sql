@@ -0,0 +1,27 @@
+BEGIN;
+
+CREATE TABLE todo_comments (
+    id UUID PRIMARY KEY NOT NULL,
+    todo_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    author_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    body TEXT NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_todo_comments_todo_id ON todo_comments(todo_id, created_at);
+
+CREATE TABLE todo_comment_mentions (
+    comment_id UUID NOT NULL REFERENCES todo_comments(id) ON DELETE CASCADE,
+    user_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    PRIMARY KEY (comment_id, user_id)
+);
+
+CREATE INDEX idx_todo_comment_mentions_user_id ON todo_comment_mentions(user_id);
+
+CREATE OR REPLACE TRIGGER update_todo_comments_timestamp
+    BEFORE UPDATE ON todo_comments
+    FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+COMMIT;
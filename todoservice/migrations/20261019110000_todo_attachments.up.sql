@@ -0,0 +1,16 @@
+BEGIN;
+
+CREATE TABLE todo_attachments (
+    id UUID PRIMARY KEY NOT NULL,
+    todo_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    uploader_id UUID REFERENCES users(id) ON DELETE SET NULL,
+    filename VARCHAR(255) NOT NULL,
+    content_type VARCHAR(255) NOT NULL,
+    size BIGINT NOT NULL CHECK (size >= 0),
+    storage_key VARCHAR(255) NOT NULL UNIQUE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_todo_attachments_todo_id ON todo_attachments(todo_id, created_at);
+
+COMMIT;
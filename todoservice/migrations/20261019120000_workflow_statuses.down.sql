@@ -0,0 +1,17 @@
+BEGIN;
+
+DROP TRIGGER sync_list_statuses_todos_completed ON list_statuses;
+DROP FUNCTION sync_status_todos_completed();
+DROP TRIGGER sync_todos_completed ON todos;
+DROP FUNCTION sync_todo_completed();
+
+ALTER TABLE todos DROP COLUMN position;
+ALTER TABLE todos DROP COLUMN status_id;
+
+DROP TRIGGER create_lists_default_statuses ON lists;
+DROP FUNCTION create_default_statuses();
+
+DROP TABLE list_status_transitions;
+DROP TABLE list_statuses;
+
+COMMIT;
@@ -0,0 +1,101 @@
+BEGIN;
+
+CREATE TABLE list_statuses (
+    id UUID PRIMARY KEY NOT NULL,
+    list_id UUID NOT NULL REFERENCES lists(id) ON DELETE CASCADE,
+    name VARCHAR(64) NOT NULL,
+    position INTEGER NOT NULL CHECK (position >= 0),
+    done BOOLEAN NOT NULL DEFAULT FALSE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    UNIQUE (list_id, name),
+    -- deferred so that reordering can shift the positions of several statuses in one statement
+    UNIQUE (list_id, position) DEFERRABLE INITIALLY DEFERRED
+);
+
+-- A status without outgoing transitions allows moving its todos to any status of the list.
+CREATE TABLE list_status_transitions (
+    from_status_id UUID NOT NULL REFERENCES list_statuses(id) ON DELETE CASCADE,
+    to_status_id UUID NOT NULL REFERENCES list_statuses(id) ON DELETE CASCADE,
+    PRIMARY KEY (from_status_id, to_status_id)
+);
+
+CREATE OR REPLACE TRIGGER update_list_statuses_timestamp
+    BEFORE UPDATE ON list_statuses
+    FOR EACH ROW
+EXECUTE FUNCTION update_timestamp();
+
+CREATE OR REPLACE FUNCTION create_default_statuses()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO list_statuses (id, list_id, name, position, done)
+    VALUES (gen_random_uuid(), NEW.id, 'To Do', 0, FALSE),
+           (gen_random_uuid(), NEW.id, 'In Progress', 1, FALSE),
+           (gen_random_uuid(), NEW.id, 'Review', 2, FALSE),
+           (gen_random_uuid(), NEW.id, 'Done', 3, TRUE);
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER create_lists_default_statuses
+    AFTER INSERT ON lists
+    FOR EACH ROW
+EXECUTE FUNCTION create_default_statuses();
+
+INSERT INTO list_statuses (id, list_id, name, position, done)
+SELECT gen_random_uuid(), l.id, s.name, s.position, s.done
+FROM lists l
+CROSS JOIN (VALUES ('To Do', 0, FALSE), ('In Progress', 1, FALSE), ('Review', 2, FALSE), ('Done', 3, TRUE)) AS s(name, position, done);
+
+-- position orders the todos within their status column.
+ALTER TABLE todos ADD COLUMN status_id UUID REFERENCES list_statuses(id);
+ALTER TABLE todos ADD COLUMN position INTEGER NOT NULL DEFAULT 0;
+
+UPDATE todos t
+SET status_id = s.id
+FROM list_statuses s
+WHERE s.list_id = t.list_id AND s.name = CASE WHEN t.completed THEN 'Done' ELSE 'To Do' END;
+
+UPDATE todos t
+SET position = numbered.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY status_id ORDER BY created_at, id) - 1 AS position
+    FROM todos
+    WHERE status_id IS NOT NULL
+) AS numbered
+WHERE numbered.id = t.id;
+
+CREATE INDEX idx_todos_status_id_position ON todos(status_id, position);
+
+-- todos.completed is kept as a copy of the done flag of the status of the todo, so that
+-- filters and metrics on completed keep working without joining list_statuses.
+CREATE OR REPLACE FUNCTION sync_todo_completed()
+RETURNS TRIGGER AS $$
+BEGIN
+    IF NEW.status_id IS NOT NULL THEN
+        SELECT done INTO NEW.completed FROM list_statuses WHERE id = NEW.status_id;
+    END IF;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER sync_todos_completed
+    BEFORE INSERT OR UPDATE ON todos
+    FOR EACH ROW
+EXECUTE FUNCTION sync_todo_completed();
+
+CREATE OR REPLACE FUNCTION sync_status_todos_completed()
+RETURNS TRIGGER AS $$
+BEGIN
+    UPDATE todos SET completed = NEW.done WHERE status_id = NEW.id;
+    RETURN NEW;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER sync_list_statuses_todos_completed
+    AFTER UPDATE OF done ON list_statuses
+    FOR EACH ROW
+    WHEN (OLD.done IS DISTINCT FROM NEW.done)
+EXECUTE FUNCTION sync_status_todos_completed();
+
+COMMIT;
@@ -0,0 +1,23 @@
+BEGIN;
+
+DROP INDEX idx_list_access_user_id_position;
+ALTER TABLE list_access DROP COLUMN position;
+
+ALTER TABLE todos ADD COLUMN status_position INTEGER NOT NULL DEFAULT 0;
+
+UPDATE todos t
+SET status_position = numbered.position
+FROM (
+    SELECT id, ROW_NUMBER() OVER (PARTITION BY status_id ORDER BY position, id) - 1 AS position
+    FROM todos
+    WHERE status_id IS NOT NULL
+) AS numbered
+WHERE numbered.id = t.id;
+
+DROP INDEX idx_todos_list_id_position;
+ALTER TABLE todos DROP COLUMN position;
+ALTER TABLE todos RENAME COLUMN status_position TO position;
+
+CREATE INDEX idx_todos_status_id_position ON todos(status_id, position);
+
+COMMIT;
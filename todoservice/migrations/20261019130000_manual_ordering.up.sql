@@ -0,0 +1,39 @@
+BEGIN;
+
+-- position becomes a fractional rank key (see pkg/rank) that orders the todos of a list. The board
+-- columns of a list share its key space, so a column keeps the relative order of its todos.
+ALTER TABLE todos ADD COLUMN list_position TEXT COLLATE "C";
+
+UPDATE todos t
+SET list_position = LPAD(numbered.rank::text, 10, '0') || 'V'
+FROM (
+    SELECT t.id, ROW_NUMBER() OVER (PARTITION BY t.list_id ORDER BY s.position, t.position, t.created_at, t.id) AS rank
+    FROM todos t
+    LEFT JOIN list_statuses s ON s.id = t.status_id
+) AS numbered
+WHERE numbered.id = t.id;
+
+DROP INDEX idx_todos_status_id_position;
+ALTER TABLE todos DROP COLUMN position;
+ALTER TABLE todos RENAME COLUMN list_position TO position;
+ALTER TABLE todos ALTER COLUMN position SET NOT NULL;
+
+CREATE INDEX idx_todos_list_id_position ON todos(list_id, position);
+
+-- list_access.position orders the lists of a user, independently for every user.
+ALTER TABLE list_access ADD COLUMN position TEXT COLLATE "C";
+
+UPDATE list_access a
+SET position = LPAD(numbered.rank::text, 10, '0') || 'V'
+FROM (
+    SELECT a.list_id, a.user_id, ROW_NUMBER() OVER (PARTITION BY a.user_id ORDER BY l.created_at, l.id) AS rank
+    FROM list_access a
+    JOIN lists l ON l.id = a.list_id
+) AS numbered
+WHERE numbered.list_id = a.list_id AND numbered.user_id = a.user_id;
+
+ALTER TABLE list_access ALTER COLUMN position SET NOT NULL;
+
+CREATE INDEX idx_list_access_user_id_position ON list_access(user_id, position);
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+-- A row means that todo_id cannot start until blocked_by_id is done. Both todos belong to the same list
+-- and the service keeps the graph free of cycles.
+CREATE TABLE todo_dependencies (
+    todo_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    blocked_by_id UUID NOT NULL REFERENCES todos(id) ON DELETE CASCADE,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (todo_id, blocked_by_id),
+    CHECK (todo_id <> blocked_by_id)
+);
+
+CREATE INDEX idx_todo_dependencies_blocked_by_id ON todo_dependencies(blocked_by_id);
+
+COMMIT;
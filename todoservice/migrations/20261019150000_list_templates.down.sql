@@ -0,0 +1,5 @@
+BEGIN;
+
+DROP TABLE list_templates;
+
+COMMIT;
@@ -0,0 +1,15 @@
+BEGIN;
+
+-- blueprint is a JSON snapshot of the statuses, tags and todos of the list the template was saved from.
+CREATE TABLE list_templates (
+    id UUID PRIMARY KEY NOT NULL,
+    owner_id UUID NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    name VARCHAR(255) NOT NULL,
+    description TEXT NOT NULL DEFAULT '',
+    blueprint JSONB NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_list_templates_owner_id ON list_templates(owner_id, created_at);
+
+COMMIT;
@@ -0,0 +1,5 @@
+BEGIN;
+
+ALTER TABLE lists DROP COLUMN archived_at;
+
+COMMIT;
@@ -0,0 +1,6 @@
+BEGIN;
+
+-- archived_at is set while the list is archived. Archived lists are read-only and hidden from the default listings.
+ALTER TABLE lists ADD COLUMN archived_at TIMESTAMP;
+
+COMMIT;
@@ -0,0 +1,8 @@
+BEGIN;
+
+DROP TRIGGER queue_todo_attachments_blob_deletion ON todo_attachments;
+DROP FUNCTION queue_attachment_blob_deletion();
+
+DROP TABLE attachment_blob_deletions;
+
+COMMIT;
@@ -0,0 +1,28 @@
+BEGIN;
+
+-- Blobs of deleted attachments are only removed from the blob store once the deletion has committed.
+-- The trigger queues their keys in the same transaction, which also covers the attachments removed by
+-- the cascades from todos, lists and users, and the blob sweeper deletes them afterwards.
+CREATE TABLE attachment_blob_deletions (
+    storage_key VARCHAR(255) PRIMARY KEY NOT NULL,
+    deleted_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+
+CREATE INDEX idx_attachment_blob_deletions_deleted_at ON attachment_blob_deletions(deleted_at);
+
+CREATE OR REPLACE FUNCTION queue_attachment_blob_deletion()
+RETURNS TRIGGER AS $$
+BEGIN
+    INSERT INTO attachment_blob_deletions (storage_key)
+    VALUES (OLD.storage_key)
+    ON CONFLICT (storage_key) DO NOTHING;
+    RETURN OLD;
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE OR REPLACE TRIGGER queue_todo_attachments_blob_deletion
+    AFTER DELETE ON todo_attachments
+    FOR EACH ROW
+EXECUTE FUNCTION queue_attachment_blob_deletion();
+
+COMMIT;
@@ -0,0 +1,8 @@
+// Package migrations embeds the SQL migrations of the todo service. Files are named
+// <version>_<name>.up.sql and <version>_<name>.down.sql, the same layout golang-migrate uses.
+package migrations
+
+import "embed"
+
+//go:embed *.sql
+var FS embed.FS
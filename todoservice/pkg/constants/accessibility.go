@@ -3,8 +3,11 @@ package constants
 type Accessibility string
 
 const (
-	IsOwner       Accessibility = "owner"
-	HasAccessList Accessibility = "has_access_list"
-	HasAccessTodo Accessibility = "has_access_todo"
-	NoRestriction Accessibility = "no_restriction"
+	IsOwner        Accessibility = "owner"
+	HasAccessList  Accessibility = "has_access_list"
+	HasAccessLists Accessibility = "has_access_lists"
+	HasAccessTodo  Accessibility = "has_access_todo"
+	NoRestriction  Accessibility = "no_restriction"
+	// HasAccessWritableList is HasAccessList for changes to the list, which are rejected while it is archived.
+	HasAccessWritableList Accessibility = "has_access_writable_list"
 )
@@ -15,4 +15,5 @@ const (
 	DefaultDateTime     = "01-01-0001"
 	CookieAge           = 31536000
 	DateFormat          = time.RFC3339
+	MaxBatchIDs         = 100
 )
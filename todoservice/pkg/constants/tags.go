@@ -1,14 +0,0 @@
-package constants
-
-type TagType string
-
-const (
-	TagWork      TagType = "work"
-	TagPersonal  TagType = "personal"
-	TagShopping  TagType = "shopping"
-	TagHealth    TagType = "health"
-	TagFitness   TagType = "fitness"
-	TagFinance   TagType = "finance"
-	TagImportant TagType = "important"
-	TagUrgent    TagType = "urgent"
-)
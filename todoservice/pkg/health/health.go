@@ -0,0 +1,150 @@
+// Package health serves liveness and readiness endpoints backed by registered dependency checkers.
+package health
+
+import (
+	"context"
+	"encoding/json"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"net/http"
+	"sync"
+	"sync/atomic"
+	"time"
+)
+
+const (
+	StatusOK           = "ok"
+	StatusFail         = "fail"
+	StatusShuttingDown = "shutting_down"
+)
+
+// Checker probes a single dependency. Check must return promptly once ctx is done.
+type Checker interface {
+	Name() string
+	Check(ctx context.Context) error
+}
+
+type checkerFunc struct {
+	name  string
+	check func(ctx context.Context) error
+}
+
+func (c checkerFunc) Name() string {
+	return c.name
+}
+
+func (c checkerFunc) Check(ctx context.Context) error {
+	return c.check(ctx)
+}
+
+// CheckerFunc adapts check to a Checker called name.
+func CheckerFunc(name string, check func(ctx context.Context) error) Checker {
+	return checkerFunc{name: name, check: check}
+}
+
+type Result struct {
+	Name       string `json:"name"`
+	Status     string `json:"status"`
+	Error      string `json:"error,omitempty"`
+	DurationMs int64  `json:"duration_ms"`
+}
+
+type Report struct {
+	Status string   `json:"status"`
+	Checks []Result `json:"checks,omitempty"`
+}
+
+type Config struct {
+	CheckTimeout time.Duration `envconfig:"APP_HEALTH_CHECK_TIMEOUT" default:"2s"`
+}
+
+type Health struct {
+	mu           sync.RWMutex
+	checkers     []Checker
+	timeout      time.Duration
+	shuttingDown atomic.Bool
+}
+
+func New(config Config) *Health {
+	return &Health{timeout: config.CheckTimeout}
+}
+
+// Register adds checker to the readiness probe.
+func (h *Health) Register(checker Checker) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.checkers = append(h.checkers, checker)
+}
+
+// Shutdown makes readiness fail so that load balancers stop routing traffic while requests drain.
+func (h *Health) Shutdown() {
+	h.shuttingDown.Store(true)
+}
+
+// Check runs all checkers concurrently, each bounded by the configured timeout.
+func (h *Health) Check(ctx context.Context) Report {
+	h.mu.RLock()
+	checkers := append([]Checker(nil), h.checkers...)
+	h.mu.RUnlock()
+
+	report := Report{Status: StatusOK, Checks: make([]Result, len(checkers))}
+	var wg sync.WaitGroup
+	for i, checker := range checkers {
+		wg.Add(1)
+		go func(i int, checker Checker) {
+			defer wg.Done()
+			report.Checks[i] = h.run(ctx, checker)
+		}(i, checker)
+	}
+	wg.Wait()
+
+	for _, result := range report.Checks {
+		if result.Status != StatusOK {
+			report.Status = StatusFail
+		}
+	}
+	if h.shuttingDown.Load() {
+		report.Status = StatusShuttingDown
+	}
+	return report
+}
+
+func (h *Health) run(ctx context.Context, checker Checker) Result {
+	ctx, cancel := context.WithTimeout(ctx, h.timeout)
+	defer cancel()
+
+	start := time.Now()
+	result := Result{Name: checker.Name(), Status: StatusOK}
+	if err := checker.Check(ctx); err != nil {
+		log.C(ctx).Errorf("health check %s failed: %v", checker.Name(), err)
+		result.Status = StatusFail
+		result.Error = err.Error()
+	}
+	result.DurationMs = time.Since(start).Milliseconds()
+	return result
+}
+
+// LivenessHandler reports that the process is up. It never probes dependencies,
+// so an outage of the database does not get the service restarted.
+func (h *Health) LivenessHandler(w http.ResponseWriter, r *http.Request) {
+	writeReport(w, r, http.StatusOK, Report{Status: StatusOK})
+}
+
+// ReadinessHandler answers 503 when any checker fails or the service is shutting down.
+func (h *Health) ReadinessHandler(w http.ResponseWriter, r *http.Request) {
+	report := h.Check(r.Context())
+	status := http.StatusOK
+	if report.Status != StatusOK {
+		status = http.StatusServiceUnavailable
+	}
+	writeReport(w, r, status, report)
+}
+
+func writeReport(w http.ResponseWriter, r *http.Request, status int, report Report) {
+	w.Header().Set("Content-Type", constants.ContentTypeJSON)
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(report); err != nil {
+		log.C(r.Context()).Errorf("failed to encode health report: %v", err)
+	}
+}
@@ -0,0 +1,97 @@
+package health_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/health"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestReadinessHandler(t *testing.T) {
+	healthy := health.CheckerFunc("database", func(ctx context.Context) error { return nil })
+	failing := health.CheckerFunc("migrations", func(ctx context.Context) error { return errors.New("migration version is 1, expected 2") })
+	hanging := health.CheckerFunc("upstream", func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	})
+
+	tests := []struct {
+		name           string
+		checkers       []health.Checker
+		shutdown       bool
+		expectedStatus int
+		expectedReport health.Report
+	}{
+		{
+			name:           "All checks pass",
+			checkers:       []health.Checker{healthy},
+			expectedStatus: http.StatusOK,
+			expectedReport: health.Report{Status: health.StatusOK, Checks: []health.Result{{Name: "database", Status: health.StatusOK}}},
+		},
+		{
+			name:           "Failing check",
+			checkers:       []health.Checker{healthy, failing},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedReport: health.Report{Status: health.StatusFail, Checks: []health.Result{
+				{Name: "database", Status: health.StatusOK},
+				{Name: "migrations", Status: health.StatusFail, Error: "migration version is 1, expected 2"},
+			}},
+		},
+		{
+			name:           "Check exceeding the timeout",
+			checkers:       []health.Checker{hanging},
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedReport: health.Report{Status: health.StatusFail, Checks: []health.Result{
+				{Name: "upstream", Status: health.StatusFail, Error: context.DeadlineExceeded.Error()},
+			}},
+		},
+		{
+			name:           "Shutting down",
+			checkers:       []health.Checker{healthy},
+			shutdown:       true,
+			expectedStatus: http.StatusServiceUnavailable,
+			expectedReport: health.Report{Status: health.StatusShuttingDown, Checks: []health.Result{{Name: "database", Status: health.StatusOK}}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			h := health.New(health.Config{CheckTimeout: 20 * time.Millisecond})
+			for _, checker := range tt.checkers {
+				h.Register(checker)
+			}
+			if tt.shutdown {
+				h.Shutdown()
+			}
+
+			rr := httptest.NewRecorder()
+			h.ReadinessHandler(rr, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+
+			assert.Equal(t, tt.expectedStatus, rr.Code)
+			var report health.Report
+			require.NoError(t, json.Unmarshal(rr.Body.Bytes(), &report))
+			for i := range report.Checks {
+				report.Checks[i].DurationMs = 0
+			}
+			assert.Equal(t, tt.expectedReport, report)
+		})
+	}
+}
+
+func TestLivenessHandler(t *testing.T) {
+	h := health.New(health.Config{CheckTimeout: time.Second})
+	h.Register(health.CheckerFunc("database", func(ctx context.Context) error { return errors.New("connection refused") }))
+	h.Shutdown()
+
+	rr := httptest.NewRecorder()
+	h.LivenessHandler(rr, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+
+	assert.Equal(t, http.StatusOK, rr.Code)
+	assert.JSONEq(t, `{"status": "ok"}`, rr.Body.String())
+}
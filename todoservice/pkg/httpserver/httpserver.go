@@ -0,0 +1,88 @@
+// Package httpserver runs the HTTP servers of the services and shuts them down gracefully.
+package httpserver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/health"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"net"
+	"net/http"
+	"time"
+)
+
+// DefaultMaxBodyBytes is the default of APP_SERVER_MAX_BODY_BYTES.
+const DefaultMaxBodyBytes = 1 << 20
+
+type Config struct {
+	// Address defaults to the port of the service on all interfaces when empty.
+	Address           string        `envconfig:"APP_SERVER_ADDRESS"`
+	ReadTimeout       time.Duration `envconfig:"APP_SERVER_READ_TIMEOUT" default:"15s"`
+	ReadHeaderTimeout time.Duration `envconfig:"APP_SERVER_READ_HEADER_TIMEOUT" default:"5s"`
+	WriteTimeout      time.Duration `envconfig:"APP_SERVER_WRITE_TIMEOUT" default:"30s"`
+	IdleTimeout       time.Duration `envconfig:"APP_SERVER_IDLE_TIMEOUT" default:"60s"`
+	MaxHeaderBytes    int           `envconfig:"APP_SERVER_MAX_HEADER_BYTES" default:"1048576"`
+	// MaxBodyBytes caps request bodies that are read into memory, streamed uploads have their own limits.
+	MaxBodyBytes int64  `envconfig:"APP_SERVER_MAX_BODY_BYTES" default:"1048576"`
+	TLSCertFile  string `envconfig:"APP_SERVER_TLS_CERT_FILE"`
+	TLSKeyFile   string `envconfig:"APP_SERVER_TLS_KEY_FILE"`
+	// ShutdownDelay keeps serving after readiness starts failing so that load balancers can deregister the instance.
+	ShutdownDelay   time.Duration `envconfig:"APP_SERVER_SHUTDOWN_DELAY" default:"5s"`
+	ShutdownTimeout time.Duration `envconfig:"APP_SERVER_SHUTDOWN_TIMEOUT" default:"20s"`
+
+	CORSConfig
+	SecurityHeadersConfig
+}
+
+func (c Config) Validate() error {
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		return errors.New("APP_SERVER_TLS_CERT_FILE and APP_SERVER_TLS_KEY_FILE must be set together")
+	}
+	return c.CORSConfig.Validate()
+}
+
+// Serve runs handler on listener until ctx is cancelled and then shuts down gracefully:
+// readiness starts failing, requests keep being served for ShutdownDelay
+// and in-flight requests get up to ShutdownTimeout to complete. name names the server in logs and errors.
+func Serve(ctx context.Context, name string, listener net.Listener, handler http.Handler, config Config, healthChecks *health.Health) error {
+	server := &http.Server{
+		Handler:           handler,
+		ReadTimeout:       config.ReadTimeout,
+		ReadHeaderTimeout: config.ReadHeaderTimeout,
+		WriteTimeout:      config.WriteTimeout,
+		IdleTimeout:       config.IdleTimeout,
+		MaxHeaderBytes:    config.MaxHeaderBytes,
+	}
+
+	serveErr := make(chan error, 1)
+	go func() {
+		log.C(ctx).Infof("%s listening on %s", name, listener.Addr())
+		if config.TLSCertFile != "" {
+			serveErr <- server.ServeTLS(listener, config.TLSCertFile, config.TLSKeyFile)
+			return
+		}
+		serveErr <- server.Serve(listener)
+	}()
+
+	select {
+	case err := <-serveErr:
+		return fmt.Errorf("%s stopped: %w", name, err)
+	case <-ctx.Done():
+	}
+
+	log.C(ctx).Infof("shutting down %s", name)
+	healthChecks.Shutdown()
+	time.Sleep(config.ShutdownDelay)
+
+	shutdownCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), config.ShutdownTimeout)
+	defer cancel()
+	if err := server.Shutdown(shutdownCtx); err != nil {
+		return fmt.Errorf("error shutting down %s: %w", name, err)
+	}
+	if err := <-serveErr; !errors.Is(err, http.ErrServerClosed) {
+		return fmt.Errorf("%s stopped: %w", name, err)
+	}
+	log.C(ctx).Infof("%s stopped", name)
+	return nil
+}
@@ -0,0 +1,75 @@
+package httpserver_test
+
+import (
+	"context"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/health"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/httpserver"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestServe_GracefulShutdown(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	baseURL := "http://" + listener.Addr().String()
+
+	healthChecks := health.New(health.Config{CheckTimeout: time.Second})
+	started := make(chan struct{})
+	router := http.NewServeMux()
+	router.HandleFunc("/readyz", healthChecks.ReadinessHandler)
+	router.HandleFunc("/slow", func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		time.Sleep(200 * time.Millisecond)
+		w.WriteHeader(http.StatusOK)
+	})
+
+	config := httpserver.Config{
+		ShutdownDelay:   100 * time.Millisecond,
+		ShutdownTimeout: time.Second,
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan error, 1)
+	go func() {
+		done <- httpserver.Serve(ctx, "test server", listener, router, config, healthChecks)
+	}()
+
+	resp, err := http.Get(baseURL + "/readyz")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusOK, resp.StatusCode)
+
+	slowStatus := make(chan int, 1)
+	go func() {
+		resp, err := http.Get(baseURL + "/slow")
+		if err != nil {
+			slowStatus <- 0
+			return
+		}
+		resp.Body.Close()
+		slowStatus <- resp.StatusCode
+	}()
+	<-started
+	cancel()
+
+	time.Sleep(20 * time.Millisecond)
+	resp, err = http.Get(baseURL + "/readyz")
+	require.NoError(t, err)
+	resp.Body.Close()
+	assert.Equal(t, http.StatusServiceUnavailable, resp.StatusCode)
+
+	assert.Equal(t, http.StatusOK, <-slowStatus)
+	assert.NoError(t, <-done)
+
+	_, err = http.Get(baseURL + "/readyz")
+	assert.Error(t, err)
+}
+
+func TestConfig_Validate(t *testing.T) {
+	assert.NoError(t, httpserver.Config{}.Validate())
+	assert.NoError(t, httpserver.Config{TLSCertFile: "cert.pem", TLSKeyFile: "key.pem"}.Validate())
+	assert.Error(t, httpserver.Config{TLSCertFile: "cert.pem"}.Validate())
+}
@@ -0,0 +1,136 @@
+package httpserver_test
+
+import (
+	"crypto/tls"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/httpserver"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestCORS_Preflight(t *testing.T) {
+	config := httpserver.CORSConfig{
+		AllowedOrigins:   []string{"http://localhost:8000"},
+		AllowedMethods:   []string{http.MethodGet, http.MethodPatch},
+		AllowedHeaders:   []string{"Authorization", "Content-Type"},
+		AllowCredentials: true,
+		MaxAge:           10 * time.Minute,
+	}
+	router := mux.NewRouter()
+	router.HandleFunc("/todos/{id}/title", func(w http.ResponseWriter, r *http.Request) {
+		t.Fatal("preflight must not reach the router")
+	}).Methods(http.MethodPatch)
+	handler := httpserver.NewCORS(config, []string{http.MethodGet}).Handler(router)
+
+	tests := []struct {
+		name          string
+		origin        string
+		method        string
+		expectedAllow string
+	}{
+		{
+			name:          "Allowed origin and method",
+			origin:        "http://localhost:8000",
+			method:        http.MethodPatch,
+			expectedAllow: "http://localhost:8000",
+		},
+		{
+			name:   "Unknown origin",
+			origin: "http://evil.example",
+			method: http.MethodPatch,
+		},
+		{
+			name:   "Method not allowed",
+			origin: "http://localhost:8000",
+			method: http.MethodDelete,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodOptions, "/todos/42/title", nil)
+			req.Header.Set("Origin", tt.origin)
+			req.Header.Set("Access-Control-Request-Method", tt.method)
+			req.Header.Set("Access-Control-Request-Headers", "authorization")
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, http.StatusNoContent, rr.Code)
+			assert.Equal(t, tt.expectedAllow, rr.Header().Get("Access-Control-Allow-Origin"))
+			if tt.expectedAllow != "" {
+				assert.Equal(t, "true", rr.Header().Get("Access-Control-Allow-Credentials"))
+				assert.Equal(t, "600", rr.Header().Get("Access-Control-Max-Age"))
+			}
+		})
+	}
+}
+
+func TestCORS_DefaultMethods(t *testing.T) {
+	config := httpserver.CORSConfig{AllowedOrigins: []string{"http://localhost:8000"}}
+	handler := httpserver.NewCORS(config, []string{http.MethodGet, http.MethodPost}).Handler(http.NotFoundHandler())
+
+	for method, expectedAllow := range map[string]string{http.MethodPost: "http://localhost:8000", http.MethodDelete: ""} {
+		req := httptest.NewRequest(http.MethodOptions, "/query", nil)
+		req.Header.Set("Origin", "http://localhost:8000")
+		req.Header.Set("Access-Control-Request-Method", method)
+		rr := httptest.NewRecorder()
+
+		handler.ServeHTTP(rr, req)
+
+		assert.Equal(t, expectedAllow, rr.Header().Get("Access-Control-Allow-Origin"), method)
+	}
+}
+
+func TestCORSConfig_Validate(t *testing.T) {
+	assert.NoError(t, httpserver.CORSConfig{AllowedOrigins: []string{"http://localhost:8000"}, AllowCredentials: true}.Validate())
+	assert.NoError(t, httpserver.CORSConfig{AllowedOrigins: []string{"*"}}.Validate())
+	assert.Error(t, httpserver.CORSConfig{AllowedOrigins: []string{"*"}, AllowCredentials: true}.Validate())
+}
+
+func TestSecurityHeaders(t *testing.T) {
+	handler := httpserver.SecurityHeaders(httpserver.SecurityHeadersConfig{HSTSMaxAge: time.Hour}, nil, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+
+	tests := []struct {
+		name         string
+		prepare      func(r *http.Request)
+		expectedHSTS string
+	}{
+		{
+			name:    "Plain HTTP",
+			prepare: func(r *http.Request) {},
+		},
+		{
+			name: "TLS",
+			prepare: func(r *http.Request) {
+				r.TLS = &tls.ConnectionState{}
+			},
+			expectedHSTS: "max-age=3600; includeSubDomains",
+		},
+		{
+			name: "TLS terminated by proxy",
+			prepare: func(r *http.Request) {
+				r.Header.Set("X-Forwarded-Proto", "https")
+			},
+			expectedHSTS: "max-age=3600; includeSubDomains",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/lists/42", nil)
+			tt.prepare(req)
+			rr := httptest.NewRecorder()
+
+			handler.ServeHTTP(rr, req)
+
+			assert.Equal(t, "nosniff", rr.Header().Get("X-Content-Type-Options"))
+			assert.Equal(t, "DENY", rr.Header().Get("X-Frame-Options"))
+			assert.Equal(t, httpserver.APIContentSecurityPolicy, rr.Header().Get("Content-Security-Policy"))
+			assert.Equal(t, tt.expectedHSTS, rr.Header().Get("Strict-Transport-Security"))
+		})
+	}
+}
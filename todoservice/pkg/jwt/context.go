@@ -0,0 +1,28 @@
+package jwt
+
+import "context"
+
+type contextKey int
+
+const (
+	claimsKey contextKey = iota
+	userIDKey
+)
+
+// WithClaims stores the claims of the authenticated user and their ID in ctx.
+func WithClaims(ctx context.Context, claims *Claims) context.Context {
+	ctx = context.WithValue(ctx, claimsKey, claims)
+	return context.WithValue(ctx, userIDKey, claims.ID)
+}
+
+// ClaimsFromContext returns the claims stored by WithClaims.
+func ClaimsFromContext(ctx context.Context) (*Claims, bool) {
+	claims, ok := ctx.Value(claimsKey).(*Claims)
+	return claims, ok
+}
+
+// UserIDFromContext returns the ID of the user stored by WithClaims.
+func UserIDFromContext(ctx context.Context) (string, bool) {
+	id, ok := ctx.Value(userIDKey).(string)
+	return id, ok
+}
@@ -0,0 +1,14 @@
+// Package jwttest authenticates requests in handler tests the way the JWT middleware does.
+package jwttest
+
+import (
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/jwt"
+	"net/http"
+)
+
+// WithUser returns req authenticated as the user id with role, whose email is <id>@example.com.
+func WithUser(req *http.Request, id string, role constants.Role) *http.Request {
+	claims := &jwt.Claims{ID: id, Email: id + "@example.com", Role: string(role)}
+	return req.WithContext(jwt.WithClaims(req.Context(), claims))
+}
@@ -11,6 +11,8 @@ import (
 type Config struct {
 	Level  string `envconfig:"APP_LOG_LEVEL" default:"debug"`
 	Format string `envconfig:"APP_LOG_FORMAT" default:"text"`
+	// DisableRedaction turns off the RedactionHook, which is installed by default.
+	DisableRedaction bool `envconfig:"APP_LOG_DISABLE_REDACTION" default:"false"`
 }
 
 type logKey struct{}
@@ -50,9 +52,27 @@ func SetupLogger(ctx context.Context, cfg Config) (context.Context, error) {
 		})
 	}
 
+	setRedaction(logEntry.Logger, !cfg.DisableRedaction)
+
 	return ContextWithLogger(ctx, logEntry), nil
 }
 
+// setRedaction installs a single RedactionHook ahead of the other hooks of logger, or removes it when enabled is false.
+func setRedaction(logger *logrus.Logger, enabled bool) {
+	hooks := make(logrus.LevelHooks)
+	if enabled {
+		hooks.Add(&RedactionHook{})
+	}
+	for level, levelHooks := range logger.Hooks {
+		for _, hook := range levelHooks {
+			if _, ok := hook.(*RedactionHook); !ok {
+				hooks[level] = append(hooks[level], hook)
+			}
+		}
+	}
+	logger.ReplaceHooks(hooks)
+}
+
 func ContextWithLogger(ctx context.Context, entry *logrus.Entry) context.Context {
 	return context.WithValue(ctx, logKey{}, entry)
 }
@@ -66,3 +86,8 @@ func LoggerFromContext(ctx context.Context) *logrus.Entry {
 	}
 	return entry.(*logrus.Entry)
 }
+
+// WithFields returns a context whose logger adds fields to every line logged through C.
+func WithFields(ctx context.Context, fields logrus.Fields) context.Context {
+	return ContextWithLogger(ctx, LoggerFromContext(ctx).WithFields(fields))
+}
@@ -0,0 +1,85 @@
+package log
+
+import (
+	"fmt"
+	"github.com/sirupsen/logrus"
+	"regexp"
+	"strings"
+)
+
+const (
+	Redacted      = "[REDACTED]"
+	RedactedEmail = "[REDACTED_EMAIL]"
+)
+
+// sensitiveFields are field names whose values are dropped entirely, compared case-insensitively.
+var sensitiveFields = map[string]struct{}{
+	"password":      {},
+	"secret":        {},
+	"client_secret": {},
+	"token":         {},
+	"access_token":  {},
+	"refresh_token": {},
+	"authorization": {},
+	"cookie":        {},
+	"jwt":           {},
+	"email":         {},
+}
+
+type redactionPattern struct {
+	pattern     *regexp.Regexp
+	replacement string
+}
+
+// redactionPatterns are applied in order to messages and string field values.
+var redactionPatterns = []redactionPattern{
+	{regexp.MustCompile(`([a-zA-Z][a-zA-Z0-9+.-]*://[^:/\s@]+:)[^@\s]+@`), "${1}" + Redacted + "@"},
+	{regexp.MustCompile(`(?i)\bbearer\s+[a-zA-Z0-9._~+/=-]+`), "Bearer " + Redacted},
+	{regexp.MustCompile(`\beyJ[a-zA-Z0-9_-]+\.[a-zA-Z0-9_-]+\.[a-zA-Z0-9_-]*`), Redacted},
+	{regexp.MustCompile(`\bgh[pousr]_[a-zA-Z0-9]{20,}`), Redacted},
+	{regexp.MustCompile(`(?i)\b(password|passwd|secret|token)(["']?\s*[:=]\s*["']?)[^\s"'&,;]+`), "${1}${2}" + Redacted},
+	{regexp.MustCompile(`[a-zA-Z0-9._%+-]+@[a-zA-Z0-9.-]+\.[a-zA-Z]{2,}`), RedactedEmail},
+}
+
+// RedactionHook removes secrets and personal data from every entry before it is formatted.
+type RedactionHook struct{}
+
+var _ logrus.Hook = &RedactionHook{}
+
+func (h *RedactionHook) Levels() []logrus.Level {
+	return logrus.AllLevels
+}
+
+func (h *RedactionHook) Fire(entry *logrus.Entry) error {
+	entry.Message = Redact(entry.Message)
+	data := make(logrus.Fields, len(entry.Data))
+	for key, value := range entry.Data {
+		data[key] = redactField(key, value)
+	}
+	entry.Data = data
+	return nil
+}
+
+// Redact masks connection string passwords, bearer tokens, JWTs, GitHub tokens, key=value secrets and emails in s.
+func Redact(s string) string {
+	for _, p := range redactionPatterns {
+		s = p.pattern.ReplaceAllString(s, p.replacement)
+	}
+	return s
+}
+
+func redactField(key string, value interface{}) interface{} {
+	if _, ok := sensitiveFields[strings.ToLower(key)]; ok {
+		return Redacted
+	}
+	switch v := value.(type) {
+	case string:
+		return Redact(v)
+	case error:
+		return Redact(v.Error())
+	case fmt.Stringer:
+		return Redact(v.String())
+	default:
+		return value
+	}
+}
@@ -2,9 +2,12 @@ package models
 
 import "github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 
+// Access is the membership of a user in a list. Position is the rank key (see pkg/rank) that orders
+// the list among the lists of the user.
 type Access struct {
-	ListID string         `json:"list_id"`
-	UserID string         `json:"user_id"`
-	Role   constants.Role `json:"role"`
-	Status string         `json:"status"`
+	ListID   string         `json:"list_id"`
+	UserID   string         `json:"user_id"`
+	Role     constants.Role `json:"role"`
+	Status   string         `json:"status"`
+	Position string         `json:"position"`
 }
@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// Attachment describes a file attached to a todo. ContentType is detected from the content, not taken from the client.
+type Attachment struct {
+	ID          string    `json:"id"`
+	TodoID      string    `json:"todo_id"`
+	UploaderID  string    `json:"uploader_id"`
+	Filename    string    `json:"filename"`
+	ContentType string    `json:"content_type"`
+	Size        int64     `json:"size"`
+	CreatedAt   time.Time `json:"created_at"`
+}
@@ -0,0 +1,34 @@
+package models
+
+import "github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+
+// BulkAction is the change a BulkOperation applies to its todo.
+type BulkAction string
+
+const (
+	BulkComplete    BulkAction = "complete"
+	BulkDelete      BulkAction = "delete"
+	BulkReassign    BulkAction = "reassign"
+	BulkSetPriority BulkAction = "set_priority"
+	BulkMove        BulkAction = "move"
+	BulkAddTag      BulkAction = "add_tag"
+)
+
+// BulkOperation is one item of a bulk request. Only the field of the action is read: AssignedTo for reassign,
+// Priority for set_priority, ListID for move and TagID for add_tag.
+type BulkOperation struct {
+	Action     BulkAction              `json:"action"`
+	TodoID     string                  `json:"todo_id"`
+	AssignedTo string                  `json:"assigned_to,omitempty"`
+	Priority   constants.PriorityLevel `json:"priority,omitempty"`
+	ListID     string                  `json:"list_id,omitempty"`
+	TagID      string                  `json:"tag_id,omitempty"`
+}
+
+// BulkResult is the outcome of Operation. Todo is the todo after the operation, it is nil when the todo was
+// deleted or the operation failed with Err.
+type BulkResult struct {
+	Operation BulkOperation
+	Todo      *Todo
+	Err       error
+}
@@ -0,0 +1,27 @@
+package models
+
+import "time"
+
+// Comment is a markdown comment on a todo. Mentions are the users referenced with @email in the body.
+type Comment struct {
+	ID        string    `json:"id"`
+	TodoID    string    `json:"todo_id"`
+	AuthorID  string    `json:"author_id"`
+	Body      string    `json:"body"`
+	Mentions  []Mention `json:"mentions"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type Mention struct {
+	UserID string `json:"user_id"`
+	Email  string `json:"email"`
+}
+
+// CommentPage is a page of the comments of a todo, oldest first. Total counts all comments of the todo.
+type CommentPage struct {
+	Comments []Comment `json:"comments"`
+	Total    int       `json:"total"`
+	Limit    int       `json:"limit"`
+	Offset   int       `json:"offset"`
+}
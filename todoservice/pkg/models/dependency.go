@@ -0,0 +1,7 @@
+package models
+
+// Dependency records that the todo TodoID cannot start until the todo BlockedByID is done.
+type Dependency struct {
+	TodoID      string `json:"todo_id"`
+	BlockedByID string `json:"blocked_by_id"`
+}
@@ -1,19 +1,21 @@
 package models
 
 import (
-	"encoding/json"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"time"
 )
 
+// List is archived while ArchivedAt is set. Archived lists are read-only and left out of the listings of
+// their users unless they are asked for.
 type List struct {
 	ID          string               `json:"id"`
 	Name        string               `json:"name"`
 	Description string               `json:"description"`
 	OwnerID     string               `json:"owner_id"`
 	SharedWith  []string             `json:"shared_with"`
-	Tags        json.RawMessage      `json:"tags"`
+	Tags        []Tag                `json:"tags"`
 	CreatedAt   time.Time            `json:"creation_date"`
 	UpdatedAt   time.Time            `json:"last_update_date"`
 	Visibility  constants.Visibility `json:"visibility"`
+	ArchivedAt  *time.Time           `json:"archived_at"`
 }
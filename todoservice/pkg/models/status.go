@@ -0,0 +1,16 @@
+package models
+
+import "time"
+
+// Status is a workflow column of a list. Todos in a Done status count as completed.
+// AllowedNext lists the statuses its todos may move to; when empty every status of the list is allowed.
+type Status struct {
+	ID          string    `json:"id"`
+	ListID      string    `json:"list_id"`
+	Name        string    `json:"name"`
+	Position    int       `json:"position"`
+	Done        bool      `json:"done"`
+	AllowedNext []string  `json:"allowed_next"`
+	CreatedAt   time.Time `json:"created_at"`
+	UpdatedAt   time.Time `json:"updated_at"`
+}
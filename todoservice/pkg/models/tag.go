@@ -0,0 +1,8 @@
+package models
+
+type Tag struct {
+	ID     string `json:"id"`
+	ListID string `json:"list_id"`
+	Name   string `json:"name"`
+	Color  string `json:"color"`
+}
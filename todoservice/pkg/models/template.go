@@ -0,0 +1,60 @@
+package models
+
+import (
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
+	"time"
+)
+
+// Template is a list saved for reuse. Only its owner sees it; lists created from it get the statuses,
+// tags and todos of the Blueprint.
+type Template struct {
+	ID          string    `json:"id"`
+	OwnerID     string    `json:"owner_id"`
+	Name        string    `json:"name"`
+	Description string    `json:"description"`
+	Blueprint   Blueprint `json:"blueprint"`
+	CreatedAt   time.Time `json:"created_at"`
+}
+
+// Blueprint is the content of a list without ids. Todos refer to statuses and tags by name and to their
+// blockers by index, and their dates are offsets from Anchor, the earliest start or due date of the todos.
+type Blueprint struct {
+	Statuses []BlueprintStatus `json:"statuses"`
+	Tags     []BlueprintTag    `json:"tags"`
+	Todos    []BlueprintTodo   `json:"todos"`
+	Anchor   *time.Time        `json:"anchor,omitempty"`
+}
+
+type BlueprintStatus struct {
+	Name        string   `json:"name"`
+	Done        bool     `json:"done"`
+	AllowedNext []string `json:"allowed_next"`
+}
+
+type BlueprintTag struct {
+	Name  string `json:"name"`
+	Color string `json:"color"`
+}
+
+type BlueprintTodo struct {
+	Title       string                  `json:"title"`
+	Description string                  `json:"description"`
+	Priority    constants.PriorityLevel `json:"priority"`
+	Status      string                  `json:"status"`
+	Position    string                  `json:"position"`
+	Tags        []string                `json:"tags"`
+	BlockedBy   []int                   `json:"blocked_by"`
+	StartOffset *time.Duration          `json:"start_offset,omitempty"`
+	DueOffset   *time.Duration          `json:"due_offset,omitempty"`
+	AssignedTo  *string                 `json:"assigned_to,omitempty"`
+}
+
+// CloneOptions control POST /lists/{id}/clone. An empty Name means the name of the source list with a
+// " (copy)" suffix. When AnchorDate is set the dates of the todos are shifted so that the earliest of them
+// falls on it, otherwise they are copied as they are.
+type CloneOptions struct {
+	Name                 string     `json:"name"`
+	IncludeCollaborators bool       `json:"include_collaborators"`
+	ResetCompletion      bool       `json:"reset_completion"`
+	AnchorDate           *time.Time `json:"anchor_date"`
+}
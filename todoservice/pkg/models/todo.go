@@ -1,18 +1,23 @@
 package models
 
 import (
-	"encoding/json"
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"time"
 )
 
+// Todo is returned with its Tags. On create and update TagIDs replaces the tags of the todo,
+// they are left untouched when TagIDs is nil. Completed mirrors the done flag of the status of the todo
+// and Position is the rank key (see pkg/rank) that orders the todo within its list and its status.
 type Todo struct {
 	ID          string                  `json:"id"`
 	ListID      string                  `json:"list_id"`
 	Title       string                  `json:"title"`
 	Description string                  `json:"description"`
-	Tags        json.RawMessage         `json:"tags"`
+	Tags        []Tag                   `json:"tags"`
+	TagIDs      []string                `json:"tag_ids,omitempty"`
 	Completed   bool                    `json:"completed"`
+	StatusID    string                  `json:"status_id"`
+	Position    string                  `json:"position"`
 	DueDate     *time.Time              `json:"due_date"`
 	StartDate   *time.Time              `json:"start_date"`
 	Priority    constants.PriorityLevel `json:"priority"`
@@ -0,0 +1,106 @@
+// Package problem writes the JSON error body returned by every todoservice endpoint.
+package problem
+
+import (
+	"database/sql"
+	"encoding/json"
+	"errors"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg"
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/log"
+	"github.com/lib/pq"
+	"net/http"
+)
+
+const (
+	ContentType     = "application/problem+json"
+	RequestIDHeader = "X-Request-ID"
+
+	CodeValidationFailed = "VALIDATION_FAILED"
+	CodeUnauthenticated  = "UNAUTHENTICATED"
+	CodeForbidden        = "FORBIDDEN"
+	CodeNotFound         = "NOT_FOUND"
+	CodeConflict         = "CONFLICT"
+	CodeTooLarge         = "PAYLOAD_TOO_LARGE"
+	CodeUnsupportedMedia = "UNSUPPORTED_MEDIA_TYPE"
+	CodeInternal         = "INTERNAL"
+
+	uniqueViolation     = "23505"
+	foreignKeyViolation = "23503"
+)
+
+type FieldError struct {
+	Field   string `json:"field"`
+	Message string `json:"message"`
+}
+
+type Details struct {
+	Status    int          `json:"status"`
+	Code      string       `json:"code"`
+	Message   string       `json:"message"`
+	Fields    []FieldError `json:"fields,omitempty"`
+	RequestID string       `json:"request_id,omitempty"`
+}
+
+// Write responds with a problem-details body whose code is derived from status.
+func Write(w http.ResponseWriter, r *http.Request, status int, message string, fields ...FieldError) {
+	details := Details{
+		Status:    status,
+		Code:      CodeForStatus(status),
+		Message:   message,
+		Fields:    fields,
+		RequestID: r.Header.Get(RequestIDHeader),
+	}
+
+	w.Header().Set("Content-Type", ContentType)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(details); err != nil {
+		log.C(r.Context()).Errorf("failed to encode problem details: %v", err)
+	}
+}
+
+func CodeForStatus(status int) string {
+	switch status {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return CodeValidationFailed
+	case http.StatusUnauthorized:
+		return CodeUnauthenticated
+	case http.StatusForbidden:
+		return CodeForbidden
+	case http.StatusNotFound:
+		return CodeNotFound
+	case http.StatusConflict:
+		return CodeConflict
+	case http.StatusRequestEntityTooLarge:
+		return CodeTooLarge
+	case http.StatusUnsupportedMediaType:
+		return CodeUnsupportedMedia
+	default:
+		return CodeInternal
+	}
+}
+
+// StatusFor picks the status code for errors with a well known cause and falls back to fallback otherwise.
+func StatusFor(err error, fallback int) int {
+	var pqErr *pq.Error
+	switch {
+	case errors.Is(err, sql.ErrNoRows), errors.Is(err, pkg.ErrNotFound):
+		return http.StatusNotFound
+	case errors.Is(err, pkg.ErrForbidden):
+		return http.StatusForbidden
+	case errors.Is(err, pkg.ErrBadRequest):
+		return http.StatusBadRequest
+	case errors.Is(err, pkg.ErrConflict):
+		return http.StatusConflict
+	case errors.Is(err, pkg.ErrTooLarge):
+		return http.StatusRequestEntityTooLarge
+	case errors.Is(err, pkg.ErrUnsupported):
+		return http.StatusUnsupportedMediaType
+	case errors.As(err, &pqErr) && pqErr.Code == uniqueViolation:
+		return http.StatusConflict
+	case errors.As(err, &pqErr) && pqErr.Code == foreignKeyViolation:
+		return http.StatusBadRequest
+	default:
+		return fallback
+	}
+}
@@ -0,0 +1,118 @@
+// Package rank generates fractional ordering keys. A key sorts between any two other keys without
+// renumbering its neighbours, so moving an element only rewrites the key of that element.
+package rank
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// digits is ordered by byte value, so keys compare correctly with plain string comparison and with
+// the "C" collation in Postgres.
+const digits = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+
+var (
+	ErrInvalidKey = errors.New("invalid rank key")
+	ErrOutOfOrder = errors.New("rank keys out of order")
+)
+
+// Between returns a key that sorts after lower and before upper. An empty lower or upper leaves that
+// side unbounded, so Between("", "") returns the key of the first element of an empty sequence.
+func Between(lower, upper string) (string, error) {
+	if err := validate(lower); err != nil {
+		return "", err
+	}
+	if err := validate(upper); err != nil {
+		return "", err
+	}
+	if lower != "" && upper != "" && lower >= upper {
+		return "", fmt.Errorf("%q is not before %q: %w", lower, upper, ErrOutOfOrder)
+	}
+	return midpoint(lower, upper), nil
+}
+
+// Insert returns a key for an element placed right after keys[after] and right before keys[before],
+// where keys is sorted. An index of -1 takes the gap next to the other index and with both indices
+// at -1 the element is appended.
+func Insert(keys []string, after, before int) (string, error) {
+	var lower, upper string
+	switch {
+	case after >= 0 && before >= 0:
+		lower, upper = keys[after], keys[before]
+	case after >= 0:
+		lower = keys[after]
+		if after+1 < len(keys) {
+			upper = keys[after+1]
+		}
+	case before >= 0:
+		upper = keys[before]
+		if before > 0 {
+			lower = keys[before-1]
+		}
+	case len(keys) > 0:
+		lower = keys[len(keys)-1]
+	}
+	return Between(lower, upper)
+}
+
+// validate rejects keys with a trailing zero digit since no key would sort between such a key and
+// the same key without that digit.
+func validate(key string) error {
+	if key == "" {
+		return nil
+	}
+	for i := 0; i < len(key); i++ {
+		if strings.IndexByte(digits, key[i]) < 0 {
+			return fmt.Errorf("%q: %w", key, ErrInvalidKey)
+		}
+	}
+	if key[len(key)-1] == digits[0] {
+		return fmt.Errorf("%q ends with %q: %w", key, digits[0], ErrInvalidKey)
+	}
+	return nil
+}
+
+// midpoint treats lower and upper as fractions in base 62, with an empty upper standing for 1.
+func midpoint(lower, upper string) string {
+	if upper != "" {
+		n := 0
+		for n < len(upper) && digitAt(lower, n) == upper[n] {
+			n++
+		}
+		if n > 0 {
+			return upper[:n] + midpoint(suffix(lower, n), upper[n:])
+		}
+	}
+
+	low := 0
+	if lower != "" {
+		low = strings.IndexByte(digits, lower[0])
+	}
+	high := len(digits)
+	if upper != "" {
+		high = strings.IndexByte(digits, upper[0])
+	}
+	if high-low > 1 {
+		return string(digits[(low+high+1)/2])
+	}
+	if len(upper) > 1 {
+		return upper[:1]
+	}
+	return string(digits[low]) + midpoint(suffix(lower, 1), "")
+}
+
+// digitAt pads key with zero digits.
+func digitAt(key string, i int) byte {
+	if i < len(key) {
+		return key[i]
+	}
+	return digits[0]
+}
+
+func suffix(key string, n int) string {
+	if n < len(key) {
+		return key[n:]
+	}
+	return ""
+}
@@ -0,0 +1,181 @@
+package rank_test
+
+import (
+	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/rank"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"testing"
+)
+
+func TestBetween(t *testing.T) {
+	tests := []struct {
+		name        string
+		lower       string
+		upper       string
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:     "Empty sequence",
+			expected: "V",
+		},
+		{
+			name:     "Append",
+			lower:    "V",
+			expected: "l",
+		},
+		{
+			name:     "Prepend",
+			upper:    "V",
+			expected: "G",
+		},
+		{
+			name:     "Between distant keys",
+			lower:    "A",
+			upper:    "a",
+			expected: "N",
+		},
+		{
+			name:     "Between adjacent keys",
+			lower:    "A",
+			upper:    "B",
+			expected: "AV",
+		},
+		{
+			name:     "Between a key and its extension",
+			lower:    "A",
+			upper:    "A1",
+			expected: "A0V",
+		},
+		{
+			name:     "Append after the last digit",
+			lower:    "z",
+			expected: "zV",
+		},
+		{
+			name:     "Migrated keys",
+			lower:    "0000000001V",
+			upper:    "0000000002V",
+			expected: "0000000002",
+		},
+		{
+			name:        "Out of order",
+			lower:       "B",
+			upper:       "A",
+			expectedErr: rank.ErrOutOfOrder,
+		},
+		{
+			name:        "Equal keys",
+			lower:       "B",
+			upper:       "B",
+			expectedErr: rank.ErrOutOfOrder,
+		},
+		{
+			name:        "Trailing zero",
+			lower:       "A0",
+			expectedErr: rank.ErrInvalidKey,
+		},
+		{
+			name:        "Invalid digit",
+			upper:       "A-",
+			expectedErr: rank.ErrInvalidKey,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key, err := rank.Between(test.lower, test.upper)
+			if test.expectedErr != nil {
+				require.ErrorIs(t, err, test.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, key)
+			assert.Greater(t, key, test.lower)
+			if test.upper != "" {
+				assert.Less(t, key, test.upper)
+			}
+		})
+	}
+}
+
+func TestBetween_RepeatedInsertsStaySorted(t *testing.T) {
+	lower, upper := "A", "B"
+	for i := 0; i < 200; i++ {
+		key, err := rank.Between(lower, upper)
+		require.NoError(t, err)
+		require.Greater(t, key, lower)
+		require.Less(t, key, upper)
+		if i%2 == 0 {
+			lower = key
+		} else {
+			upper = key
+		}
+	}
+}
+
+func TestInsert(t *testing.T) {
+	keys := []string{"A", "M", "c"}
+	tests := []struct {
+		name   string
+		keys   []string
+		after  int
+		before int
+		lower  string
+		upper  string
+	}{
+		{
+			name:   "Append to an empty sequence",
+			after:  -1,
+			before: -1,
+		},
+		{
+			name:   "Append",
+			keys:   keys,
+			after:  -1,
+			before: -1,
+			lower:  "c",
+		},
+		{
+			name:   "After the first key",
+			keys:   keys,
+			after:  0,
+			before: -1,
+			lower:  "A",
+			upper:  "M",
+		},
+		{
+			name:   "Before the first key",
+			keys:   keys,
+			after:  -1,
+			before: 0,
+			upper:  "A",
+		},
+		{
+			name:   "Before the last key",
+			keys:   keys,
+			after:  -1,
+			before: 2,
+			lower:  "M",
+			upper:  "c",
+		},
+		{
+			name:   "Between both indices",
+			keys:   keys,
+			after:  0,
+			before: 2,
+			lower:  "A",
+			upper:  "c",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			key, err := rank.Insert(test.keys, test.after, test.before)
+			require.NoError(t, err)
+			expected, err := rank.Between(test.lower, test.upper)
+			require.NoError(t, err)
+			assert.Equal(t, expected, key)
+		})
+	}
+}
@@ -8,6 +8,7 @@ import (
 	"github.com/Victor-Uzunov/devops-project/todoservice/pkg/constants"
 	"github.com/google/uuid"
 	"net/mail"
+	"strings"
 )
 
 var (
@@ -15,7 +16,10 @@ var (
 	ErrUnauthorized = errors.New("unauthorized")
 	ErrForbidden    = errors.New("forbidden")
 	ErrNotFound     = errors.New("not found")
+	ErrConflict     = errors.New("conflict")
 	ErrInternal     = errors.New("internal server error")
+	ErrTooLarge     = errors.New("request entity too large")
+	ErrUnsupported  = errors.New("unsupported media type")
 )
 
 func NewNullableStringFromJSONRawMessage(json json.RawMessage) sql.NullString {
@@ -51,6 +55,26 @@ func ValidateUUID(id string) error {
 	return nil
 }
 
+func SplitIDs(raw string) []string {
+	ids := make([]string, 0)
+	for _, id := range strings.Split(raw, ",") {
+		if id = strings.TrimSpace(id); id != "" {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func ValidateBatchIDs(ids []string) error {
+	if len(ids) == 0 {
+		return fmt.Errorf("at least one id is required: %w", ErrBadRequest)
+	}
+	if len(ids) > constants.MaxBatchIDs {
+		return fmt.Errorf("at most %d ids can be requested at once: %w", constants.MaxBatchIDs, ErrBadRequest)
+	}
+	return nil
+}
+
 func Contains(slice []constants.Role, item constants.Role) bool {
 	for _, str := range slice {
 		if str == item {